@@ -16,11 +16,13 @@ type Access interface {
 	IsSystem() bool
 	TeamNames() []string
 	CSRFToken() string
+	UserName() string
 }
 
 type access struct {
 	*jwt.Token
-	action string
+	action         string
+	permittedRoles map[string]bool
 }
 
 func (a *access) IsAuthenticated() bool {
@@ -36,7 +38,7 @@ func (a *access) IsAuthorized(team string) bool {
 
 		if len(teamParts) == 1 {
 			teamName = teamParts[0]
-			roleName = "owner"
+			roleName = OwnerRole
 
 		} else if len(teamParts) > 1 {
 			teamName = strings.Join(teamParts[:len(teamParts)-1], ":")
@@ -51,16 +53,7 @@ func (a *access) IsAuthorized(team string) bool {
 }
 
 func (a *access) HasPermission(role string) bool {
-	switch requiredRoles[a.action] {
-	case "owner":
-		return role == "owner"
-	case "member":
-		return role == "owner" || role == "member"
-	case "viewer":
-		return role == "owner" || role == "member" || role == "viewer"
-	default:
-		return false
-	}
+	return a.permittedRoles[role]
 }
 
 func (a *access) IsAdmin() bool {
@@ -129,84 +122,104 @@ func (a *access) CSRFToken() string {
 	return ""
 }
 
+func (a *access) UserName() string {
+	if claims, ok := a.Token.Claims.(jwt.MapClaims); ok {
+		if userNameClaim, ok := claims["user_name"]; ok {
+			if userName, ok := userNameClaim.(string); ok {
+				return userName
+			}
+		}
+	}
+	return ""
+}
+
+// requiredRoles determines the actions granted to the built-in roles. Each
+// role is also granted the actions of the roles below it.
 var requiredRoles = map[string]string{
-	atc.SaveConfig:                    "member",
-	atc.GetConfig:                     "viewer",
-	atc.GetBuild:                      "viewer",
-	atc.GetBuildPlan:                  "viewer",
-	atc.CreateBuild:                   "member",
-	atc.ListBuilds:                    "viewer",
-	atc.BuildEvents:                   "viewer",
-	atc.BuildResources:                "viewer",
-	atc.AbortBuild:                    "member",
-	atc.GetBuildPreparation:           "viewer",
-	atc.GetJob:                        "viewer",
-	atc.CreateJobBuild:                "member",
-	atc.ListAllJobs:                   "viewer",
-	atc.ListJobs:                      "viewer",
-	atc.ListJobBuilds:                 "viewer",
-	atc.ListJobInputs:                 "viewer",
-	atc.GetJobBuild:                   "viewer",
-	atc.PauseJob:                      "member",
-	atc.UnpauseJob:                    "member",
-	atc.GetVersionsDB:                 "viewer",
-	atc.JobBadge:                      "viewer",
-	atc.MainJobBadge:                  "viewer",
-	atc.ClearTaskCache:                "member",
-	atc.ListAllResources:              "viewer",
-	atc.ListResources:                 "viewer",
-	atc.ListResourceTypes:             "viewer",
-	atc.GetResource:                   "viewer",
-	atc.PauseResource:                 "member",
-	atc.UnpauseResource:               "member",
-	atc.CheckResource:                 "member",
-	atc.CheckResourceWebHook:          "member",
-	atc.CheckResourceType:             "member",
-	atc.ListResourceVersions:          "viewer",
-	atc.GetResourceVersion:            "viewer",
-	atc.EnableResourceVersion:         "member",
-	atc.DisableResourceVersion:        "member",
-	atc.ListBuildsWithVersionAsInput:  "viewer",
-	atc.ListBuildsWithVersionAsOutput: "viewer",
-	atc.GetResourceCausality:          "viewer",
-	atc.ListAllPipelines:              "viewer",
-	atc.ListPipelines:                 "viewer",
-	atc.GetPipeline:                   "viewer",
-	atc.DeletePipeline:                "member",
-	atc.OrderPipelines:                "member",
-	atc.PausePipeline:                 "member",
-	atc.UnpausePipeline:               "member",
-	atc.ExposePipeline:                "member",
-	atc.HidePipeline:                  "member",
-	atc.RenamePipeline:                "member",
-	atc.ListPipelineBuilds:            "viewer",
-	atc.CreatePipelineBuild:           "member",
-	atc.PipelineBadge:                 "viewer",
-	atc.RegisterWorker:                "member",
-	atc.LandWorker:                    "member",
-	atc.RetireWorker:                  "member",
-	atc.PruneWorker:                   "member",
-	atc.HeartbeatWorker:               "member",
-	atc.ListWorkers:                   "viewer",
-	atc.DeleteWorker:                  "member",
-	atc.SetLogLevel:                   "member",
-	atc.GetLogLevel:                   "viewer",
-	atc.DownloadCLI:                   "viewer",
-	atc.GetInfo:                       "viewer",
-	atc.GetInfoCreds:                  "viewer",
-	atc.ListContainers:                "viewer",
-	atc.GetContainer:                  "viewer",
-	atc.HijackContainer:               "member",
-	atc.ListDestroyingContainers:      "viewer",
-	atc.ReportWorkerContainers:        "member",
-	atc.ListVolumes:                   "viewer",
-	atc.ListDestroyingVolumes:         "viewer",
-	atc.ReportWorkerVolumes:           "member",
-	atc.ListTeams:                     "viewer",
-	atc.SetTeam:                       "owner",
-	atc.RenameTeam:                    "owner",
-	atc.DestroyTeam:                   "owner",
-	atc.ListTeamBuilds:                "viewer",
-	atc.SendInputToBuildPlan:          "member",
-	atc.ReadOutputFromBuildPlan:       "member",
+	atc.SaveConfig:                    MemberRole,
+	atc.GetConfig:                     ViewerRole,
+	atc.GetBuild:                      ViewerRole,
+	atc.GetBuildPlan:                  ViewerRole,
+	atc.CreateBuild:                   MemberRole,
+	atc.ListBuilds:                    ViewerRole,
+	atc.BuildEvents:                   ViewerRole,
+	atc.BuildResources:                ViewerRole,
+	atc.AbortBuild:                    MemberRole,
+	atc.GetBuildPreparation:           ViewerRole,
+	atc.GetJob:                        ViewerRole,
+	atc.CreateJobBuild:                MemberRole,
+	atc.RerunJobBuild:                 MemberRole,
+	atc.ListAllJobs:                   ViewerRole,
+	atc.ListJobs:                      ViewerRole,
+	atc.ListJobBuilds:                 ViewerRole,
+	atc.ListJobInputs:                 ViewerRole,
+	atc.GetJobBuild:                   ViewerRole,
+	atc.PauseJob:                      MemberRole,
+	atc.UnpauseJob:                    MemberRole,
+	atc.GetVersionsDB:                 ViewerRole,
+	atc.JobBadge:                      ViewerRole,
+	atc.MainJobBadge:                  ViewerRole,
+	atc.ClearTaskCache:                MemberRole,
+	atc.ListAllResources:              ViewerRole,
+	atc.ListResources:                 ViewerRole,
+	atc.ListResourceTypes:             ViewerRole,
+	atc.GetResource:                   ViewerRole,
+	atc.PauseResource:                 MemberRole,
+	atc.UnpauseResource:               MemberRole,
+	atc.PinResourceVersion:            MemberRole,
+	atc.UnpinResource:                 MemberRole,
+	atc.CheckResource:                 MemberRole,
+	atc.CheckResourceWebHook:          MemberRole,
+	atc.CheckResourceType:             MemberRole,
+	atc.ListResourceVersions:          ViewerRole,
+	atc.GetResourceVersion:            ViewerRole,
+	atc.EnableResourceVersion:         MemberRole,
+	atc.DisableResourceVersion:        MemberRole,
+	atc.ListBuildsWithVersionAsInput:  ViewerRole,
+	atc.ListBuildsWithVersionAsOutput: ViewerRole,
+	atc.GetResourceCausality:          ViewerRole,
+	atc.ListAllPipelines:              ViewerRole,
+	atc.ListPipelines:                 ViewerRole,
+	atc.GetPipeline:                   ViewerRole,
+	atc.DeletePipeline:                MemberRole,
+	atc.OrderPipelines:                MemberRole,
+	atc.PausePipeline:                 MemberRole,
+	atc.UnpausePipeline:               MemberRole,
+	atc.ArchivePipeline:               MemberRole,
+	atc.ExposePipeline:                MemberRole,
+	atc.HidePipeline:                  MemberRole,
+	atc.RenamePipeline:                MemberRole,
+	atc.ListPipelineBuilds:            ViewerRole,
+	atc.CreatePipelineBuild:           MemberRole,
+	atc.PipelineBadge:                 ViewerRole,
+	atc.RegisterWorker:                MemberRole,
+	atc.LandWorker:                    MemberRole,
+	atc.RetireWorker:                  MemberRole,
+	atc.PruneWorker:                   MemberRole,
+	atc.HeartbeatWorker:               MemberRole,
+	atc.ListWorkers:                   ViewerRole,
+	atc.DeleteWorker:                  MemberRole,
+	atc.SetLogLevel:                   MemberRole,
+	atc.GetLogLevel:                   ViewerRole,
+	atc.DownloadCLI:                   ViewerRole,
+	atc.GetInfo:                       ViewerRole,
+	atc.GetInfoCreds:                  ViewerRole,
+	atc.ListContainers:                ViewerRole,
+	atc.GetContainer:                  ViewerRole,
+	atc.HijackContainer:               MemberRole,
+	atc.ListDestroyingContainers:      ViewerRole,
+	atc.ReportWorkerContainers:        MemberRole,
+	atc.ListVolumes:                   ViewerRole,
+	atc.ListDestroyingVolumes:         ViewerRole,
+	atc.ReportWorkerVolumes:           MemberRole,
+	atc.ListTeams:                     ViewerRole,
+	atc.SetTeam:                       OwnerRole,
+	atc.RenameTeam:                    OwnerRole,
+	atc.SetTeamCredentialManager:      OwnerRole,
+	atc.ClearTeamCredentialManager:    OwnerRole,
+	atc.DestroyTeam:                   OwnerRole,
+	atc.ListTeamBuilds:                ViewerRole,
+	atc.SendInputToBuildPlan:          MemberRole,
+	atc.ReadOutputFromBuildPlan:       MemberRole,
+	atc.GetBuildArtifact:              ViewerRole,
 }
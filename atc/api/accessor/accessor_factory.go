@@ -17,12 +17,14 @@ type AccessFactory interface {
 }
 
 type accessFactory struct {
-	publicKey *rsa.PublicKey
+	publicKey     *rsa.PublicKey
+	rolesByAction map[string]map[string]jobScope
 }
 
-func NewAccessFactory(key *rsa.PublicKey) AccessFactory {
+func NewAccessFactory(key *rsa.PublicKey, policy RolePolicy) AccessFactory {
 	return &accessFactory{
-		publicKey: key,
+		publicKey:     key,
+		rolesByAction: policy.rolesByAction(),
 	}
 }
 
@@ -33,7 +35,23 @@ func (a *accessFactory) Create(r *http.Request, action string) Access {
 		token = &jwt.Token{}
 	}
 
-	return &access{token, action}
+	return &access{token, action, a.permittedRoles(r, action)}
+}
+
+// permittedRoles returns the roles permitted to perform the action. Roles
+// which are limited to some jobs are only permitted when the request is for
+// one of them.
+func (a *accessFactory) permittedRoles(r *http.Request, action string) map[string]bool {
+	job := r.URL.Query().Get(":job_name")
+
+	roles := map[string]bool{}
+	for role, scope := range a.rolesByAction[action] {
+		if scope.permits(job) {
+			roles[role] = true
+		}
+	}
+
+	return roles
 }
 
 func (a *accessFactory) parseToken(r *http.Request) (*jwt.Token, error) {
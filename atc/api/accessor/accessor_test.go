@@ -35,7 +35,7 @@ var _ = Describe("Accessor", func() {
 		Expect(err).NotTo(HaveOccurred())
 
 		publicKey := &key.PublicKey
-		accessorFactory = accessor.NewAccessFactory(publicKey)
+		accessorFactory = accessor.NewAccessFactory(publicKey, accessor.DefaultRolePolicy())
 
 	})
 	Describe("Is Admin", func() {
@@ -279,6 +279,34 @@ var _ = Describe("Accessor", func() {
 		})
 	})
 
+	Describe("Get User Name", func() {
+		JustBeforeEach(func() {
+			token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+			tokenString, err := token.SignedString(key)
+			Expect(err).NotTo(HaveOccurred())
+			req.Header.Add("Authorization", fmt.Sprintf("BEARER %s", tokenString))
+			access = accessorFactory.Create(req, "some-action")
+		})
+
+		Context("when request has user_name claim set", func() {
+			BeforeEach(func() {
+				claims = &jwt.MapClaims{"user_name": "some-user"}
+			})
+			It("returns the user name", func() {
+				Expect(access.UserName()).To(Equal("some-user"))
+			})
+		})
+
+		Context("when request does not have user_name claim set", func() {
+			BeforeEach(func() {
+				claims = &jwt.MapClaims{}
+			})
+			It("returns empty", func() {
+				Expect(access.UserName()).To(BeEmpty())
+			})
+		})
+	})
+
 	Describe("Get Team Names", func() {
 		JustBeforeEach(func() {
 			token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
@@ -389,6 +417,10 @@ var _ = Describe("Accessor", func() {
 		Entry("member :: "+atc.CreateJobBuild, atc.CreateJobBuild, "member", true),
 		Entry("viewer :: "+atc.CreateJobBuild, atc.CreateJobBuild, "viewer", false),
 
+		Entry("owner :: "+atc.RerunJobBuild, atc.RerunJobBuild, "owner", true),
+		Entry("member :: "+atc.RerunJobBuild, atc.RerunJobBuild, "member", true),
+		Entry("viewer :: "+atc.RerunJobBuild, atc.RerunJobBuild, "viewer", false),
+
 		Entry("owner :: "+atc.ListAllJobs, atc.ListAllJobs, "owner", true),
 		Entry("member :: "+atc.ListAllJobs, atc.ListAllJobs, "member", true),
 		Entry("viewer :: "+atc.ListAllJobs, atc.ListAllJobs, "viewer", true),
@@ -457,6 +489,14 @@ var _ = Describe("Accessor", func() {
 		Entry("member :: "+atc.UnpauseResource, atc.UnpauseResource, "member", true),
 		Entry("viewer :: "+atc.UnpauseResource, atc.UnpauseResource, "viewer", false),
 
+		Entry("owner :: "+atc.PinResourceVersion, atc.PinResourceVersion, "owner", true),
+		Entry("member :: "+atc.PinResourceVersion, atc.PinResourceVersion, "member", true),
+		Entry("viewer :: "+atc.PinResourceVersion, atc.PinResourceVersion, "viewer", false),
+
+		Entry("owner :: "+atc.UnpinResource, atc.UnpinResource, "owner", true),
+		Entry("member :: "+atc.UnpinResource, atc.UnpinResource, "member", true),
+		Entry("viewer :: "+atc.UnpinResource, atc.UnpinResource, "viewer", false),
+
 		Entry("owner :: "+atc.CheckResource, atc.CheckResource, "owner", true),
 		Entry("member :: "+atc.CheckResource, atc.CheckResource, "member", true),
 		Entry("viewer :: "+atc.CheckResource, atc.CheckResource, "viewer", false),
@@ -525,6 +565,10 @@ var _ = Describe("Accessor", func() {
 		Entry("member :: "+atc.UnpausePipeline, atc.UnpausePipeline, "member", true),
 		Entry("viewer :: "+atc.UnpausePipeline, atc.UnpausePipeline, "viewer", false),
 
+		Entry("owner :: "+atc.ArchivePipeline, atc.ArchivePipeline, "owner", true),
+		Entry("member :: "+atc.ArchivePipeline, atc.ArchivePipeline, "member", true),
+		Entry("viewer :: "+atc.ArchivePipeline, atc.ArchivePipeline, "viewer", false),
+
 		Entry("owner :: "+atc.ExposePipeline, atc.ExposePipeline, "owner", true),
 		Entry("member :: "+atc.ExposePipeline, atc.ExposePipeline, "member", true),
 		Entry("viewer :: "+atc.ExposePipeline, atc.ExposePipeline, "viewer", false),
@@ -641,6 +685,14 @@ var _ = Describe("Accessor", func() {
 		Entry("member :: "+atc.RenameTeam, atc.RenameTeam, "member", false),
 		Entry("viewer :: "+atc.RenameTeam, atc.RenameTeam, "viewer", false),
 
+		Entry("owner :: "+atc.SetTeamCredentialManager, atc.SetTeamCredentialManager, "owner", true),
+		Entry("member :: "+atc.SetTeamCredentialManager, atc.SetTeamCredentialManager, "member", false),
+		Entry("viewer :: "+atc.SetTeamCredentialManager, atc.SetTeamCredentialManager, "viewer", false),
+
+		Entry("owner :: "+atc.ClearTeamCredentialManager, atc.ClearTeamCredentialManager, "owner", true),
+		Entry("member :: "+atc.ClearTeamCredentialManager, atc.ClearTeamCredentialManager, "member", false),
+		Entry("viewer :: "+atc.ClearTeamCredentialManager, atc.ClearTeamCredentialManager, "viewer", false),
+
 		Entry("owner :: "+atc.DestroyTeam, atc.DestroyTeam, "owner", true),
 		Entry("member :: "+atc.DestroyTeam, atc.DestroyTeam, "member", false),
 		Entry("viewer :: "+atc.DestroyTeam, atc.DestroyTeam, "viewer", false),
@@ -656,5 +708,70 @@ var _ = Describe("Accessor", func() {
 		Entry("owner :: "+atc.ReadOutputFromBuildPlan, atc.ReadOutputFromBuildPlan, "owner", true),
 		Entry("member :: "+atc.ReadOutputFromBuildPlan, atc.ReadOutputFromBuildPlan, "member", true),
 		Entry("viewer :: "+atc.ReadOutputFromBuildPlan, atc.ReadOutputFromBuildPlan, "viewer", false),
+
+		Entry("owner :: "+atc.GetBuildArtifact, atc.GetBuildArtifact, "owner", true),
+		Entry("member :: "+atc.GetBuildArtifact, atc.GetBuildArtifact, "member", true),
+		Entry("viewer :: "+atc.GetBuildArtifact, atc.GetBuildArtifact, "viewer", true),
 	)
+
+	Describe("custom roles", func() {
+		BeforeEach(func() {
+			policy := accessor.DefaultRolePolicy()
+			policy["operator"] = []string{atc.PausePipeline, atc.UnpausePipeline}
+
+			accessorFactory = accessor.NewAccessFactory(&key.PublicKey, policy)
+		})
+
+		DescribeTable("role actions",
+			func(action, role string, authorized bool) {
+				claims := &jwt.MapClaims{"teams": []string{"some-team:" + role}}
+				token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+				tokenString, err := token.SignedString(key)
+				Expect(err).NotTo(HaveOccurred())
+				req.Header.Add("Authorization", fmt.Sprintf("BEARER %s", tokenString))
+				access := accessorFactory.Create(req, action)
+
+				Expect(access.IsAuthorized("some-team")).To(Equal(authorized))
+			},
+			Entry("operator :: "+atc.PausePipeline, atc.PausePipeline, "operator", true),
+			Entry("operator :: "+atc.UnpausePipeline, atc.UnpausePipeline, "operator", true),
+			Entry("operator :: "+atc.SaveConfig, atc.SaveConfig, "operator", false),
+			Entry("operator :: "+atc.GetPipeline, atc.GetPipeline, "operator", false),
+
+			Entry("owner :: "+atc.PausePipeline, atc.PausePipeline, "owner", true),
+			Entry("undefined :: "+atc.PausePipeline, atc.PausePipeline, "undefined", false),
+		)
+
+		Context("when a role is limited to some jobs", func() {
+			BeforeEach(func() {
+				policy := accessor.DefaultRolePolicy()
+				policy["releaser"] = []string{atc.GetJob, atc.CreateJobBuild + ":ship-it"}
+				policy["deployer"] = []string{atc.CreateJobBuild + ":deploy", atc.CreateJobBuild}
+
+				accessorFactory = accessor.NewAccessFactory(&key.PublicKey, policy)
+			})
+
+			DescribeTable("role actions on jobs",
+				func(action, role, job string, authorized bool) {
+					var err error
+					req, err = http.NewRequest("POST", "localhost:8080?:job_name="+job, nil)
+					Expect(err).NotTo(HaveOccurred())
+
+					claims := &jwt.MapClaims{"teams": []string{"some-team:" + role}}
+					token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+					tokenString, err := token.SignedString(key)
+					Expect(err).NotTo(HaveOccurred())
+					req.Header.Add("Authorization", fmt.Sprintf("BEARER %s", tokenString))
+					access := accessorFactory.Create(req, action)
+
+					Expect(access.IsAuthorized("some-team")).To(Equal(authorized))
+				},
+				Entry("releaser :: "+atc.CreateJobBuild+" on ship-it", atc.CreateJobBuild, "releaser", "ship-it", true),
+				Entry("releaser :: "+atc.CreateJobBuild+" on another job", atc.CreateJobBuild, "releaser", "some-job", false),
+				Entry("releaser :: "+atc.GetJob+" on another job", atc.GetJob, "releaser", "some-job", true),
+				Entry("deployer :: "+atc.CreateJobBuild+" on another job", atc.CreateJobBuild, "deployer", "some-job", true),
+				Entry("member :: "+atc.CreateJobBuild+" on another job", atc.CreateJobBuild, "member", "some-job", true),
+			)
+		})
+	})
 })
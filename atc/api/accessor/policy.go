@@ -0,0 +1,199 @@
+package accessor
+
+import (
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	OwnerRole  = "owner"
+	MemberRole = "member"
+	ViewerRole = "viewer"
+)
+
+// RolePolicy maps each role name to the API actions (the route names in
+// atc.Routes) that users with the role are permitted to perform.
+//
+// An action acting on a job may be followed by ':' and a job name, e.g.
+// "CreateJobBuild:ship-it", to permit it only on jobs with that name.
+type RolePolicy map[string][]string
+
+// DefaultRolePolicy returns the built-in owner, member, and viewer roles.
+func DefaultRolePolicy() RolePolicy {
+	policy := RolePolicy{}
+
+	for action, requiredRole := range requiredRoles {
+		switch requiredRole {
+		case ViewerRole:
+			policy[ViewerRole] = append(policy[ViewerRole], action)
+			fallthrough
+		case MemberRole:
+			policy[MemberRole] = append(policy[MemberRole], action)
+			fallthrough
+		case OwnerRole:
+			policy[OwnerRole] = append(policy[OwnerRole], action)
+		}
+	}
+
+	for _, actions := range policy {
+		sort.Strings(actions)
+	}
+
+	return policy
+}
+
+// LoadRolePolicy reads a YAML file mapping role names to lists of actions,
+// and merges it on top of the default policy. Roles defined in the file are
+// added to the built-in roles; defining a built-in role replaces it.
+func LoadRolePolicy(path string) (RolePolicy, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var custom RolePolicy
+	err = yaml.UnmarshalStrict(content, &custom)
+	if err != nil {
+		return nil, fmt.Errorf("malformed role policy: %s", err)
+	}
+
+	err = custom.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	policy := DefaultRolePolicy()
+	for role, actions := range custom {
+		policy[role] = actions
+	}
+
+	return policy, nil
+}
+
+// Validate checks that every role has a usable name, that every action
+// corresponds to a route in atc.Routes and that only actions acting on a job
+// are limited to a job.
+func (policy RolePolicy) Validate() error {
+	knownActions := map[string]bool{}
+	jobActions := map[string]bool{}
+	for _, route := range atc.Routes {
+		knownActions[route.Name] = true
+
+		if strings.Contains(route.Path, "/:job_name") {
+			jobActions[route.Name] = true
+		}
+	}
+
+	for _, role := range policy.Roles() {
+		if role == "" {
+			return fmt.Errorf("role policy contains a role with no name")
+		}
+
+		if strings.Contains(role, ":") {
+			return fmt.Errorf("role '%s' must not contain ':'", role)
+		}
+
+		for _, permission := range policy[role] {
+			action, job, scoped := splitPermission(permission)
+			if !knownActions[action] {
+				return fmt.Errorf("role '%s' refers to unknown action '%s'", role, action)
+			}
+
+			if scoped && job == "" {
+				return fmt.Errorf("role '%s' limits action '%s' to a job with no name", role, action)
+			}
+
+			if scoped && !jobActions[action] {
+				return fmt.Errorf("role '%s' limits action '%s' to a job, but the action does not act on a job", role, action)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Roles returns the sorted names of all roles in the policy.
+func (policy RolePolicy) Roles() []string {
+	roles := []string{}
+	for role := range policy {
+		roles = append(roles, role)
+	}
+
+	sort.Strings(roles)
+
+	return roles
+}
+
+// ValidateTeamAuth returns an error if the team auth config grants any role
+// that is not defined by the policy.
+func (policy RolePolicy) ValidateTeamAuth(auth atc.TeamAuth) error {
+	roles := []string{}
+	for role := range auth {
+		roles = append(roles, role)
+	}
+
+	sort.Strings(roles)
+
+	for _, role := range roles {
+		if _, found := policy[role]; !found {
+			return fmt.Errorf("unknown role '%s' (known roles: %s)", role, strings.Join(policy.Roles(), ", "))
+		}
+	}
+
+	return nil
+}
+
+// jobScope is the set of jobs on which a role may perform an action. A nil
+// jobScope permits the action on any job.
+type jobScope map[string]bool
+
+func (scope jobScope) permits(job string) bool {
+	return scope == nil || scope[job]
+}
+
+func (policy RolePolicy) rolesByAction() map[string]map[string]jobScope {
+	rolesByAction := map[string]map[string]jobScope{}
+
+	for role, permissions := range policy {
+		for _, permission := range permissions {
+			action, job, scoped := splitPermission(permission)
+
+			if rolesByAction[action] == nil {
+				rolesByAction[action] = map[string]jobScope{}
+			}
+
+			scope, found := rolesByAction[action][role]
+			if !scoped {
+				rolesByAction[action][role] = nil
+				continue
+			}
+
+			if found && scope == nil {
+				continue
+			}
+
+			if scope == nil {
+				scope = jobScope{}
+				rolesByAction[action][role] = scope
+			}
+
+			scope[job] = true
+		}
+	}
+
+	return rolesByAction
+}
+
+func splitPermission(permission string) (string, string, bool) {
+	parts := strings.SplitN(permission, ":", 2)
+	if len(parts) == 1 {
+		return parts[0], "", false
+	}
+
+	return parts[0], parts[1], true
+}
@@ -0,0 +1,224 @@
+package accessor_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RolePolicy", func() {
+	Describe("DefaultRolePolicy", func() {
+		var policy accessor.RolePolicy
+
+		BeforeEach(func() {
+			policy = accessor.DefaultRolePolicy()
+		})
+
+		It("defines the built-in roles", func() {
+			Expect(policy.Roles()).To(Equal([]string{"member", "owner", "viewer"}))
+		})
+
+		It("grants each role the actions of the roles below it", func() {
+			Expect(policy["viewer"]).To(ContainElement(atc.GetPipeline))
+			Expect(policy["viewer"]).ToNot(ContainElement(atc.PausePipeline))
+
+			Expect(policy["member"]).To(ContainElement(atc.GetPipeline))
+			Expect(policy["member"]).To(ContainElement(atc.PausePipeline))
+			Expect(policy["member"]).ToNot(ContainElement(atc.SetTeam))
+
+			Expect(policy["owner"]).To(ContainElement(atc.GetPipeline))
+			Expect(policy["owner"]).To(ContainElement(atc.PausePipeline))
+			Expect(policy["owner"]).To(ContainElement(atc.SetTeam))
+		})
+
+		It("is valid", func() {
+			Expect(policy.Validate()).To(Succeed())
+		})
+	})
+
+	Describe("LoadRolePolicy", func() {
+		var (
+			tmpdir  string
+			content string
+
+			policy  accessor.RolePolicy
+			loadErr error
+		)
+
+		BeforeEach(func() {
+			var err error
+			tmpdir, err = ioutil.TempDir("", "role-policy")
+			Expect(err).ToNot(HaveOccurred())
+
+			content = `
+operator:
+- GetPipeline
+- PausePipeline
+- UnpausePipeline
+- CreateJobBuild
+`
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(tmpdir)
+		})
+
+		JustBeforeEach(func() {
+			path := filepath.Join(tmpdir, "policy.yml")
+			err := ioutil.WriteFile(path, []byte(content), 0644)
+			Expect(err).ToNot(HaveOccurred())
+
+			policy, loadErr = accessor.LoadRolePolicy(path)
+		})
+
+		It("adds the custom roles to the built-in roles", func() {
+			Expect(loadErr).ToNot(HaveOccurred())
+			Expect(policy.Roles()).To(Equal([]string{"member", "operator", "owner", "viewer"}))
+			Expect(policy["operator"]).To(Equal([]string{
+				atc.GetPipeline,
+				atc.PausePipeline,
+				atc.UnpausePipeline,
+				atc.CreateJobBuild,
+			}))
+			Expect(policy["owner"]).To(Equal(accessor.DefaultRolePolicy()["owner"]))
+		})
+
+		Context("when a built-in role is redefined", func() {
+			BeforeEach(func() {
+				content = `
+viewer:
+- GetPipeline
+`
+			})
+
+			It("replaces it", func() {
+				Expect(loadErr).ToNot(HaveOccurred())
+				Expect(policy["viewer"]).To(Equal([]string{atc.GetPipeline}))
+			})
+		})
+
+		Context("when an action is not a known route", func() {
+			BeforeEach(func() {
+				content = `
+operator:
+- LaunchMissiles
+`
+			})
+
+			It("returns an error", func() {
+				Expect(loadErr).To(MatchError("role 'operator' refers to unknown action 'LaunchMissiles'"))
+			})
+		})
+
+		Context("when an action is limited to jobs", func() {
+			BeforeEach(func() {
+				content = `
+releaser:
+- GetJob
+- CreateJobBuild:ship-it
+- CreateJobBuild:ship-it-again
+`
+			})
+
+			It("loads the role", func() {
+				Expect(loadErr).ToNot(HaveOccurred())
+				Expect(policy["releaser"]).To(Equal([]string{
+					atc.GetJob,
+					atc.CreateJobBuild + ":ship-it",
+					atc.CreateJobBuild + ":ship-it-again",
+				}))
+			})
+		})
+
+		Context("when an action which does not act on a job is limited to a job", func() {
+			BeforeEach(func() {
+				content = `
+releaser:
+- PausePipeline:ship-it
+`
+			})
+
+			It("returns an error", func() {
+				Expect(loadErr).To(MatchError("role 'releaser' limits action 'PausePipeline' to a job, but the action does not act on a job"))
+			})
+		})
+
+		Context("when an action is limited to a job with no name", func() {
+			BeforeEach(func() {
+				content = `
+releaser:
+- "CreateJobBuild:"
+`
+			})
+
+			It("returns an error", func() {
+				Expect(loadErr).To(MatchError("role 'releaser' limits action 'CreateJobBuild' to a job with no name"))
+			})
+		})
+
+		Context("when a limited action is not a known route", func() {
+			BeforeEach(func() {
+				content = `
+releaser:
+- LaunchMissiles:ship-it
+`
+			})
+
+			It("returns an error", func() {
+				Expect(loadErr).To(MatchError("role 'releaser' refers to unknown action 'LaunchMissiles'"))
+			})
+		})
+
+		Context("when a role name contains ':'", func() {
+			BeforeEach(func() {
+				content = `
+"some:operator":
+- GetPipeline
+`
+			})
+
+			It("returns an error", func() {
+				Expect(loadErr).To(MatchError("role 'some:operator' must not contain ':'"))
+			})
+		})
+
+		Context("when the file is malformed", func() {
+			BeforeEach(func() {
+				content = `operator: GetPipeline`
+			})
+
+			It("returns an error", func() {
+				Expect(loadErr).To(HaveOccurred())
+				Expect(loadErr.Error()).To(ContainSubstring("malformed role policy"))
+			})
+		})
+	})
+
+	Describe("ValidateTeamAuth", func() {
+		var policy accessor.RolePolicy
+
+		BeforeEach(func() {
+			policy = accessor.DefaultRolePolicy()
+			policy["operator"] = []string{atc.PausePipeline}
+		})
+
+		It("allows roles defined by the policy", func() {
+			Expect(policy.ValidateTeamAuth(atc.TeamAuth{
+				"owner":    {"users": {"local:some-user"}},
+				"operator": {"groups": {"github:some-org"}},
+			})).To(Succeed())
+		})
+
+		It("rejects roles not defined by the policy", func() {
+			Expect(policy.ValidateTeamAuth(atc.TeamAuth{
+				"releaser": {"users": {"local:some-user"}},
+			})).To(MatchError("unknown role 'releaser' (known roles: member, operator, owner, viewer)"))
+		})
+	})
+})
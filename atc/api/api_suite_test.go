@@ -201,6 +201,7 @@ var _ = BeforeEach(func() {
 		fakeVariablesFactory,
 		credsManagers,
 		interceptTimeoutFactory,
+		accessor.DefaultRolePolicy(),
 	)
 
 	Expect(err).NotTo(HaveOccurred())
@@ -17,6 +17,8 @@ import (
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
 	"github.com/concourse/concourse/atc/engine/enginefakes"
+	"github.com/concourse/concourse/atc/worker"
+	"github.com/concourse/concourse/atc/worker/workerfakes"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/ghttp"
@@ -241,7 +243,7 @@ var _ = Describe("Builds API", func() {
 				It("does not set defaults for since and until", func() {
 					Expect(dbBuildFactory.VisibleBuildsCallCount()).To(Equal(1))
 
-					teamName, page := dbBuildFactory.VisibleBuildsArgsForCall(0)
+					teamName, page, _ := dbBuildFactory.VisibleBuildsArgsForCall(0)
 					Expect(page).To(Equal(db.Page{
 						Since: 0,
 						Until: 0,
@@ -259,7 +261,7 @@ var _ = Describe("Builds API", func() {
 				It("passes them through", func() {
 					Expect(dbBuildFactory.VisibleBuildsCallCount()).To(Equal(1))
 
-					_, page := dbBuildFactory.VisibleBuildsArgsForCall(0)
+					_, page, _ := dbBuildFactory.VisibleBuildsArgsForCall(0)
 					Expect(page).To(Equal(db.Page{
 						Since: 2,
 						Until: 3,
@@ -330,6 +332,27 @@ var _ = Describe("Builds API", func() {
 				})
 			})
 
+			Context("when annotations are passed", func() {
+				BeforeEach(func() {
+					queryParams = "?annotation=version&annotation=commit"
+
+					dbBuildFactory.VisibleBuildsReturns(returnedBuilds, db.Pagination{
+						Next: &db.Page{Since: 3, Limit: 2},
+					}, nil)
+				})
+
+				It("only lists the builds with the annotations", func() {
+					_, _, annotations := dbBuildFactory.VisibleBuildsArgsForCall(0)
+					Expect(annotations).To(Equal([]string{"version", "commit"}))
+				})
+
+				It("keeps them in the Link headers", func() {
+					Expect(response.Header["Link"]).To(ConsistOf([]string{
+						fmt.Sprintf(`<%s/api/v1/builds?since=3&limit=2&annotation=version&annotation=commit>; rel="next"`, externalURL),
+					}))
+				})
+			})
+
 			Context("when getting all builds fails", func() {
 				BeforeEach(func() {
 					dbBuildFactory.VisibleBuildsReturns(nil, db.Pagination{}, errors.New("oh no!"))
@@ -354,7 +377,7 @@ var _ = Describe("Builds API", func() {
 				It("does not set defaults for since and until", func() {
 					Expect(dbBuildFactory.VisibleBuildsCallCount()).To(Equal(1))
 
-					_, page := dbBuildFactory.VisibleBuildsArgsForCall(0)
+					_, page, _ := dbBuildFactory.VisibleBuildsArgsForCall(0)
 					Expect(page).To(Equal(db.Page{
 						Since: 0,
 						Until: 0,
@@ -371,7 +394,7 @@ var _ = Describe("Builds API", func() {
 				It("passes them through", func() {
 					Expect(dbBuildFactory.VisibleBuildsCallCount()).To(Equal(1))
 
-					_, page := dbBuildFactory.VisibleBuildsArgsForCall(0)
+					_, page, _ := dbBuildFactory.VisibleBuildsArgsForCall(0)
 					Expect(page).To(Equal(db.Page{
 						Since: 2,
 						Until: 3,
@@ -427,7 +450,7 @@ var _ = Describe("Builds API", func() {
 
 				It("returns builds for teams from the token", func() {
 					Expect(dbBuildFactory.VisibleBuildsCallCount()).To(Equal(1))
-					teamName, _ := dbBuildFactory.VisibleBuildsArgsForCall(0)
+					teamName, _, _ := dbBuildFactory.VisibleBuildsArgsForCall(0)
 					Expect(teamName).To(ConsistOf("some-team"))
 				})
 			})
@@ -515,6 +538,7 @@ var _ = Describe("Builds API", func() {
 					build.StartTimeReturns(time.Unix(1, 0))
 					build.EndTimeReturns(time.Unix(100, 0))
 					build.ReapTimeReturns(time.Unix(200, 0))
+					build.AnnotationsReturns(map[string]string{"version": "1.2.3"})
 					dbBuildFactory.BuildReturns(build, true, nil)
 					build.PipelineReturns(fakePipeline, true, nil)
 					fakePipeline.PublicReturns(true)
@@ -605,7 +629,8 @@ var _ = Describe("Builds API", func() {
 						"api_url": "/api/v1/builds/1",
 						"start_time": 1,
 						"end_time": 100,
-						"reap_time": 200
+						"reap_time": 200,
+						"annotations": {"version": "1.2.3"}
 					}`))
 						})
 					})
@@ -1176,6 +1201,7 @@ var _ = Describe("Builds API", func() {
 					},
 					InputsSatisfied:     db.BuildPreparationStatusBlocking,
 					MissingInputReasons: db.MissingInputReasons{"some-input": "some-reason"},
+					BlockingReasons:     []string{"max_in_flight 2 reached"},
 				}
 				dbBuildFactory.BuildReturns(build, true, nil)
 				build.JobNameReturns("job1")
@@ -1317,7 +1343,8 @@ var _ = Describe("Builds API", func() {
 					"inputs_satisfied": "blocking",
 					"missing_input_reasons": {
 						"some-input": "some-reason"
-					}
+					},
+					"blocking_reasons": ["max_in_flight 2 reached"]
 				}`))
 				})
 
@@ -1366,6 +1393,143 @@ var _ = Describe("Builds API", func() {
 		})
 	})
 
+	Describe("GET /api/v1/builds/:build_id/artifacts/:name", func() {
+		var response *http.Response
+
+		JustBeforeEach(func() {
+			var err error
+			response, err = http.Get(server.URL + "/api/v1/builds/42/artifacts/some-artifact")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				build.IDReturns(42)
+				build.TeamNameReturns("some-team")
+				dbBuildFactory.BuildReturns(build, true, nil)
+			})
+
+			Context("when not authorized", func() {
+				BeforeEach(func() {
+					fakeaccess.IsAuthorizedReturns(false)
+				})
+
+				It("returns 403", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				})
+			})
+
+			Context("when authorized", func() {
+				BeforeEach(func() {
+					fakeaccess.IsAuthorizedReturns(true)
+				})
+
+				It("looks up the build's artifact", func() {
+					Expect(fakeVolumeRepository.FindBuildArtifactVolumeCallCount()).To(Equal(1))
+					buildID, name := fakeVolumeRepository.FindBuildArtifactVolumeArgsForCall(0)
+					Expect(buildID).To(Equal(42))
+					Expect(name).To(Equal("some-artifact"))
+				})
+
+				Context("when the artifact is not found", func() {
+					BeforeEach(func() {
+						fakeVolumeRepository.FindBuildArtifactVolumeReturns(nil, false, nil)
+					})
+
+					It("returns 404", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+					})
+				})
+
+				Context("when finding the artifact fails", func() {
+					BeforeEach(func() {
+						fakeVolumeRepository.FindBuildArtifactVolumeReturns(nil, false, errors.New("nope"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+
+				Context("when the artifact is found", func() {
+					var (
+						fakeWorker *workerfakes.FakeWorker
+						fakeVolume *workerfakes.FakeVolume
+					)
+
+					BeforeEach(func() {
+						dbVolume := new(dbfakes.FakeCreatedVolume)
+						dbVolume.WorkerNameReturns("some-worker")
+						dbVolume.HandleReturns("some-handle")
+						fakeVolumeRepository.FindBuildArtifactVolumeReturns(dbVolume, true, nil)
+
+						otherWorker := new(workerfakes.FakeWorker)
+						otherWorker.NameReturns("other-worker")
+
+						fakeWorker = new(workerfakes.FakeWorker)
+						fakeWorker.NameReturns("some-worker")
+
+						fakeWorkerClient.RunningWorkersReturns([]worker.Worker{otherWorker, fakeWorker}, nil)
+
+						fakeVolume = new(workerfakes.FakeVolume)
+						fakeVolume.StreamOutReturns(ioutil.NopCloser(bytes.NewBufferString("some-tar-stream")), nil)
+					})
+
+					Context("when the volume is on its worker", func() {
+						BeforeEach(func() {
+							fakeWorker.LookupVolumeReturns(fakeVolume, true, nil)
+						})
+
+						It("streams out the volume", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusOK))
+							Expect(response.Header.Get("Content-Type")).To(Equal("application/octet-stream"))
+
+							body, err := ioutil.ReadAll(response.Body)
+							Expect(err).NotTo(HaveOccurred())
+							Expect(string(body)).To(Equal("some-tar-stream"))
+
+							_, handle := fakeWorker.LookupVolumeArgsForCall(0)
+							Expect(handle).To(Equal("some-handle"))
+
+							Expect(fakeVolume.StreamOutArgsForCall(0)).To(Equal("."))
+						})
+					})
+
+					Context("when the volume is no longer on its worker", func() {
+						BeforeEach(func() {
+							fakeWorker.LookupVolumeReturns(nil, false, nil)
+						})
+
+						It("returns 404", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+						})
+					})
+
+					Context("when the worker is gone", func() {
+						BeforeEach(func() {
+							fakeWorkerClient.RunningWorkersReturns([]worker.Worker{}, nil)
+						})
+
+						It("returns 404", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+						})
+					})
+				})
+			})
+		})
+	})
+
 	Describe("GET /api/v1/builds/:build_id/plan", func() {
 		var plan *json.RawMessage
 
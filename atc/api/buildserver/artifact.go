@@ -0,0 +1,84 @@
+package buildserver
+
+import (
+	"io"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/worker"
+)
+
+// GetBuildArtifact streams the volume of an output which was retained as an
+// artifact of the build, as a tarball.
+func (s *Server) GetBuildArtifact(build db.Build) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		name := r.FormValue(":name")
+
+		logger := s.logger.Session("get-build-artifact", lager.Data{
+			"build":    build.ID(),
+			"artifact": name,
+		})
+
+		dbVolume, found, err := s.volumeRepository.FindBuildArtifactVolume(build.ID(), name)
+		if err != nil {
+			logger.Error("failed-to-find-artifact", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			logger.Info("artifact-not-found")
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		volume, found, err := s.lookupVolume(logger, dbVolume)
+		if err != nil {
+			logger.Error("failed-to-lookup-volume", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			logger.Info("volume-not-found", lager.Data{
+				"worker": dbVolume.WorkerName(),
+				"volume": dbVolume.Handle(),
+			})
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		reader, err := volume.StreamOut(".")
+		if err != nil {
+			logger.Error("failed-to-stream-out-volume", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		defer reader.Close()
+
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.WriteHeader(http.StatusOK)
+
+		_, err = io.Copy(w, reader)
+		if err != nil {
+			logger.Error("failed-to-stream-artifact", err)
+		}
+	})
+}
+
+func (s *Server) lookupVolume(logger lager.Logger, dbVolume db.CreatedVolume) (worker.Volume, bool, error) {
+	workers, err := s.workerClient.RunningWorkers(logger)
+	if err != nil {
+		return nil, false, err
+	}
+
+	for _, w := range workers {
+		if w.Name() == dbVolume.WorkerName() {
+			return w.LookupVolume(logger, dbVolume.Handle())
+		}
+	}
+
+	return nil, false, nil
+}
@@ -37,12 +37,13 @@ func (s *Server) ListBuilds(w http.ResponseWriter, r *http.Request) {
 	}
 
 	page := db.Page{Until: until, Since: since, Limit: limit}
+	annotations := r.Form[atc.BuildsQueryAnnotation]
 
 	var builds []db.Build
 	var pagination db.Pagination
 
 	acc := accessor.GetAccessor(r)
-	builds, pagination, err = s.buildFactory.VisibleBuilds(acc.TeamNames(), page)
+	builds, pagination, err = s.buildFactory.VisibleBuilds(acc.TeamNames(), page, annotations)
 
 	if err != nil {
 		logger.Error("failed-to-get-all-builds", err)
@@ -51,11 +52,11 @@ func (s *Server) ListBuilds(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if pagination.Next != nil {
-		s.addNextLink(w, *pagination.Next)
+		s.addNextLink(w, *pagination.Next, annotations)
 	}
 
 	if pagination.Previous != nil {
-		s.addPreviousLink(w, *pagination.Previous)
+		s.addPreviousLink(w, *pagination.Previous, annotations)
 	}
 
 	w.Header().Set("Content-Type", "application/json")
@@ -74,26 +75,28 @@ func (s *Server) ListBuilds(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-func (s *Server) addNextLink(w http.ResponseWriter, page db.Page) {
+func (s *Server) addNextLink(w http.ResponseWriter, page db.Page, annotations []string) {
 	w.Header().Add("Link", fmt.Sprintf(
-		`<%s/api/v1/builds?%s=%d&%s=%d>; rel="%s"`,
+		`<%s/api/v1/builds?%s=%d&%s=%d%s>; rel="%s"`,
 		s.externalURL,
 		atc.PaginationQuerySince,
 		page.Since,
 		atc.PaginationQueryLimit,
 		page.Limit,
+		atc.BuildsAnnotationQuery(annotations),
 		atc.LinkRelNext,
 	))
 }
 
-func (s *Server) addPreviousLink(w http.ResponseWriter, page db.Page) {
+func (s *Server) addPreviousLink(w http.ResponseWriter, page db.Page, annotations []string) {
 	w.Header().Add("Link", fmt.Sprintf(
-		`<%s/api/v1/builds?%s=%d&%s=%d>; rel="%s"`,
+		`<%s/api/v1/builds?%s=%d&%s=%d%s>; rel="%s"`,
 		s.externalURL,
 		atc.PaginationQueryUntil,
 		page.Until,
 		atc.PaginationQueryLimit,
 		page.Limit,
+		atc.BuildsAnnotationQuery(annotations),
 		atc.LinkRelPrevious,
 	))
 }
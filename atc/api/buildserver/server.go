@@ -22,6 +22,7 @@ type Server struct {
 	workerClient        worker.Client
 	teamFactory         db.TeamFactory
 	buildFactory        db.BuildFactory
+	volumeRepository    db.VolumeRepository
 	eventHandlerFactory EventHandlerFactory
 	drain               <-chan struct{}
 	rejector            auth.Rejector
@@ -35,6 +36,7 @@ func NewServer(
 	workerClient worker.Client,
 	teamFactory db.TeamFactory,
 	buildFactory db.BuildFactory,
+	volumeRepository db.VolumeRepository,
 	eventHandlerFactory EventHandlerFactory,
 	drain <-chan struct{},
 ) *Server {
@@ -48,6 +50,7 @@ func NewServer(
 		workerClient:        workerClient,
 		teamFactory:         teamFactory,
 		buildFactory:        buildFactory,
+		volumeRepository:    volumeRepository,
 		eventHandlerFactory: eventHandlerFactory,
 		drain:               drain,
 
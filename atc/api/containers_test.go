@@ -362,11 +362,52 @@ var _ = Describe("Containers API", func() {
 					_, err := client.Do(req)
 					Expect(err).NotTo(HaveOccurred())
 
-					_, pipelineName, resourceName, variablesFactory := dbTeam.FindCheckContainersArgsForCall(0)
-					Expect(pipelineName).To(Equal("some-pipeline"))
+					_, pipelineRef, resourceName, variablesFactory := dbTeam.FindCheckContainersArgsForCall(0)
+					Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "some-pipeline"}))
 					Expect(resourceName).To(Equal("some-resource"))
 					Expect(variablesFactory).To(Equal(fakeVariablesFactory))
 				})
+
+				Context("when the pipeline is an instance", func() {
+					BeforeEach(func() {
+						req.URL.RawQuery = url.Values{
+							"type":          []string{"check"},
+							"resource_name": []string{"some-resource"},
+							"pipeline_name": []string{"some-pipeline"},
+							"vars":          []string{`{"branch":"master"}`},
+						}.Encode()
+					})
+
+					It("queries with the instance of the pipeline", func() {
+						_, err := client.Do(req)
+						Expect(err).NotTo(HaveOccurred())
+
+						_, pipelineRef, _, _ := dbTeam.FindCheckContainersArgsForCall(0)
+						Expect(pipelineRef).To(Equal(atc.PipelineRef{
+							Name:         "some-pipeline",
+							InstanceVars: atc.InstanceVars{"branch": "master"},
+						}))
+					})
+				})
+
+				Context("when the instance vars are malformed", func() {
+					BeforeEach(func() {
+						req.URL.RawQuery = url.Values{
+							"type":          []string{"check"},
+							"resource_name": []string{"some-resource"},
+							"pipeline_name": []string{"some-pipeline"},
+							"vars":          []string{"{"},
+						}.Encode()
+					})
+
+					It("returns 400 Bad Request", func() {
+						response, err := client.Do(req)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+						Expect(dbTeam.FindCheckContainersCallCount()).To(BeZero())
+					})
+				})
 			})
 		})
 	})
@@ -232,6 +232,7 @@ var _ = Describe("Pipelines API", func() {
 					"vault": {
 						"url": "` + credServer.URL() + `",
 						"path_prefix": "testpath",
+						"namespace": "",
 						"cache": false,
 						"max_lease": 60,
 						"ca_cert": "",
@@ -271,6 +272,7 @@ var _ = Describe("Pipelines API", func() {
           "vault": {
             "url": "` + credServer.URL() + `",
             "path_prefix": "testpath",
+						"namespace": "",
 						"cache": false,
 						"max_lease": 60,
             "ca_cert": "",
@@ -479,6 +479,39 @@ var _ = Describe("Jobs API", func() {
 						})
 					})
 
+					Context("when the job has a schedule", func() {
+						BeforeEach(func() {
+							fakeJob.ConfigReturns(atc.JobConfig{
+								Name:     "some-job",
+								Schedule: &atc.ScheduleConfig{Cron: "0 9 * * *", Location: "America/New_York"},
+							})
+							fakeJob.LastScheduledReturns(time.Date(2018, time.October, 17, 13, 0, 5, 0, time.UTC))
+							fakeJob.PausedReturns(false)
+						})
+
+						It("returns the next time it will run", func() {
+							var job atc.Job
+							err := json.NewDecoder(response.Body).Decode(&job)
+							Expect(err).NotTo(HaveOccurred())
+
+							Expect(job.NextRunTime).To(Equal(time.Date(2018, time.October, 18, 13, 0, 0, 0, time.UTC).Unix()))
+						})
+
+						Context("when the job is paused", func() {
+							BeforeEach(func() {
+								fakeJob.PausedReturns(true)
+							})
+
+							It("does not return a next run time", func() {
+								var job atc.Job
+								err := json.NewDecoder(response.Body).Decode(&job)
+								Expect(err).NotTo(HaveOccurred())
+
+								Expect(job.NextRunTime).To(BeZero())
+							})
+						})
+					})
+
 					Context("when getting the job's builds fails", func() {
 						BeforeEach(func() {
 							fakeJob.FinishedAndNextBuildReturns(nil, nil, errors.New("oh no!"))
@@ -1231,11 +1264,23 @@ var _ = Describe("Jobs API", func() {
 					fakePipeline.JobReturns(fakeJob, true, nil)
 				})
 
+				Context("when the pipeline is archived", func() {
+					BeforeEach(func() {
+						fakePipeline.ArchivedReturns(true)
+						fakeJob.BuildsReturns([]db.Build{}, db.Pagination{}, nil)
+					})
+
+					It("returns the job's builds", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+						Expect(fakeJob.BuildsCallCount()).To(Equal(1))
+					})
+				})
+
 				Context("when no params are passed", func() {
 					It("does not set defaults for since and until", func() {
 						Expect(fakeJob.BuildsCallCount()).To(Equal(1))
 
-						page := fakeJob.BuildsArgsForCall(0)
+						page, _ := fakeJob.BuildsArgsForCall(0)
 						Expect(page).To(Equal(db.Page{
 							Since: 0,
 							Until: 0,
@@ -1252,7 +1297,7 @@ var _ = Describe("Jobs API", func() {
 					It("passes them through", func() {
 						Expect(fakeJob.BuildsCallCount()).To(Equal(1))
 
-						page := fakeJob.BuildsArgsForCall(0)
+						page, _ := fakeJob.BuildsArgsForCall(0)
 						Expect(page).To(Equal(db.Page{
 							Since: 2,
 							Until: 3,
@@ -1410,6 +1455,23 @@ var _ = Describe("Jobs API", func() {
 				fakeaccess.IsAuthenticatedReturns(true)
 			})
 
+			Context("when the pipeline is archived", func() {
+				BeforeEach(func() {
+					fakePipeline.NameReturns("some-pipeline")
+					fakePipeline.ArchivedReturns(true)
+					fakePipeline.JobReturns(fakeJob, true, nil)
+				})
+
+				It("returns 409", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusConflict))
+					Expect(ioutil.ReadAll(response.Body)).To(Equal([]byte("pipeline 'some-pipeline' is archived; set its config to unarchive it")))
+				})
+
+				It("does not trigger the build", func() {
+					Expect(fakeScheduler.TriggerImmediatelyCallCount()).To(Equal(0))
+				})
+			})
+
 			Context("when getting the job succeeds", func() {
 				BeforeEach(func() {
 					fakeJob.NameReturns("some-job")
@@ -1553,6 +1615,172 @@ var _ = Describe("Jobs API", func() {
 		})
 	})
 
+	Describe("POST /api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/builds/:build_name", func() {
+		var response *http.Response
+
+		var fakeScheduler *schedulerfakes.FakeBuildScheduler
+		var buildToRerun *dbfakes.FakeBuild
+
+		BeforeEach(func() {
+			fakeScheduler = new(schedulerfakes.FakeBuildScheduler)
+			fakeSchedulerFactory.BuildSchedulerReturns(fakeScheduler)
+
+			buildToRerun = new(dbfakes.FakeBuild)
+			buildToRerun.IDReturns(41)
+			buildToRerun.NameReturns("42")
+			buildToRerun.StatusReturns(db.BuildStatusFailed)
+
+			fakeScheduler.RerunImmediatelyReturns(new(dbfakes.FakeBuild), nil, nil)
+		})
+
+		JustBeforeEach(func() {
+			request, err := http.NewRequest("POST", server.URL+"/api/v1/teams/some-team/pipelines/some-pipeline/jobs/some-job/builds/42", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		Context("when authorized and authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthorizedReturns(true)
+				fakeaccess.IsAuthenticatedReturns(true)
+			})
+
+			Context("when the job and build are found", func() {
+				BeforeEach(func() {
+					fakeJob.NameReturns("some-job")
+					fakeJob.ConfigReturns(atc.JobConfig{Name: "some-job"})
+					fakeJob.BuildReturns(buildToRerun, true, nil)
+					fakePipeline.JobReturns(fakeJob, true, nil)
+				})
+
+				Context("when the pipeline is archived", func() {
+					BeforeEach(func() {
+						fakePipeline.ArchivedReturns(true)
+					})
+
+					It("returns 409", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusConflict))
+					})
+
+					It("does not rerun the build", func() {
+						Expect(fakeScheduler.RerunImmediatelyCallCount()).To(Equal(0))
+					})
+				})
+
+				It("looks up the build by name", func() {
+					Expect(fakeJob.BuildCallCount()).To(Equal(1))
+					Expect(fakeJob.BuildArgsForCall(0)).To(Equal("42"))
+				})
+
+				Context("when rerunning the build succeeds", func() {
+					BeforeEach(func() {
+						build := new(dbfakes.FakeBuild)
+						build.IDReturns(43)
+						build.NameReturns("42.1")
+						build.JobNameReturns("some-job")
+						build.PipelineNameReturns("a-pipeline")
+						build.TeamNameReturns("some-team")
+						build.StatusReturns(db.BuildStatusPending)
+						build.RerunOfReturns(41)
+						fakeScheduler.RerunImmediatelyReturns(build, nil, nil)
+					})
+
+					It("reruns the build", func() {
+						Expect(fakeScheduler.RerunImmediatelyCallCount()).To(Equal(1))
+
+						_, job, build, _, resourceTypes := fakeScheduler.RerunImmediatelyArgsForCall(0)
+						Expect(job).To(Equal(fakeJob))
+						Expect(build).To(Equal(buildToRerun))
+						Expect(resourceTypes).To(Equal(versionedResourceTypes))
+					})
+
+					It("returns the rerun build", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+						body, err := ioutil.ReadAll(response.Body)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(body).To(MatchJSON(`{
+							"id": 43,
+							"name": "42.1",
+							"job_name": "some-job",
+							"status": "pending",
+							"api_url": "/api/v1/builds/43",
+							"pipeline_name": "a-pipeline",
+							"team_name": "some-team"
+						}`))
+					})
+				})
+
+				Context("when rerunning the build fails", func() {
+					BeforeEach(func() {
+						fakeScheduler.RerunImmediatelyReturns(nil, nil, errors.New("oh no!"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+
+				Context("when the build has not determined its inputs yet", func() {
+					BeforeEach(func() {
+						buildToRerun.StatusReturns(db.BuildStatusPending)
+					})
+
+					It("returns 409 without rerunning the build", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusConflict))
+						Expect(fakeScheduler.RerunImmediatelyCallCount()).To(BeZero())
+					})
+				})
+
+				Context("when manual triggering is disabled", func() {
+					BeforeEach(func() {
+						fakeJob.ConfigReturns(atc.JobConfig{Name: "some-job", DisableManualTrigger: true})
+					})
+
+					It("returns 409 without rerunning the build", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusConflict))
+						Expect(fakeScheduler.RerunImmediatelyCallCount()).To(BeZero())
+					})
+				})
+			})
+
+			Context("when the build is not found", func() {
+				BeforeEach(func() {
+					fakePipeline.JobReturns(fakeJob, true, nil)
+					fakeJob.BuildReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when the job is not found", func() {
+				BeforeEach(func() {
+					fakePipeline.JobReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+		})
+	})
+
 	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/inputs", func() {
 		var response *http.Response
 
@@ -1571,8 +1799,8 @@ var _ = Describe("Jobs API", func() {
 
 			It("looked up the proper pipeline", func() {
 				Expect(dbTeam.PipelineCallCount()).To(Equal(1))
-				pipelineName := dbTeam.PipelineArgsForCall(0)
-				Expect(pipelineName).To(Equal("some-pipeline"))
+				pipelineRef := dbTeam.PipelineArgsForCall(0)
+				Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "some-pipeline"}))
 			})
 
 			Context("when getting the job succeeds", func() {
@@ -16,6 +16,13 @@ func (s *Server) CreateJobBuild(pipeline db.Pipeline) http.Handler {
 
 		jobName := r.FormValue(":job_name")
 
+		if pipeline.Archived() {
+			logger.Debug("pipeline-is-archived")
+			w.WriteHeader(http.StatusConflict)
+			fmt.Fprintf(w, "pipeline '%s' is archived; set its config to unarchive it", pipeline.Name())
+			return
+		}
+
 		job, found, err := pipeline.Job(jobName)
 		if err != nil {
 			logger.Error("failed-to-get-resource-types", err)
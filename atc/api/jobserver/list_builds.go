@@ -50,22 +50,24 @@ func (s *Server) ListJobBuilds(pipeline db.Pipeline) http.Handler {
 			return
 		}
 
+		annotations := r.Form[atc.BuildsQueryAnnotation]
+
 		builds, pagination, err := job.Builds(db.Page{
 			Since: since,
 			Until: until,
 			Limit: limit,
-		})
+		}, annotations)
 		if err != nil {
 			w.WriteHeader(http.StatusNotFound)
 			return
 		}
 
 		if pagination.Next != nil {
-			s.addNextLink(w, teamName, pipeline.Name(), jobName, *pagination.Next)
+			s.addNextLink(w, teamName, pipeline.Name(), jobName, *pagination.Next, annotations)
 		}
 
 		if pagination.Previous != nil {
-			s.addPreviousLink(w, teamName, pipeline.Name(), jobName, *pagination.Previous)
+			s.addPreviousLink(w, teamName, pipeline.Name(), jobName, *pagination.Previous, annotations)
 		}
 
 		w.Header().Set("Content-Type", "application/json")
@@ -84,9 +86,9 @@ func (s *Server) ListJobBuilds(pipeline db.Pipeline) http.Handler {
 	})
 }
 
-func (s *Server) addNextLink(w http.ResponseWriter, teamName, pipelineName, jobName string, page db.Page) {
+func (s *Server) addNextLink(w http.ResponseWriter, teamName, pipelineName, jobName string, page db.Page, annotations []string) {
 	w.Header().Add("Link", fmt.Sprintf(
-		`<%s/api/v1/teams/%s/pipelines/%s/jobs/%s/builds?%s=%d&%s=%d>; rel="%s"`,
+		`<%s/api/v1/teams/%s/pipelines/%s/jobs/%s/builds?%s=%d&%s=%d%s>; rel="%s"`,
 		s.externalURL,
 		teamName,
 		pipelineName,
@@ -95,13 +97,14 @@ func (s *Server) addNextLink(w http.ResponseWriter, teamName, pipelineName, jobN
 		page.Since,
 		atc.PaginationQueryLimit,
 		page.Limit,
+		atc.BuildsAnnotationQuery(annotations),
 		atc.LinkRelNext,
 	))
 }
 
-func (s *Server) addPreviousLink(w http.ResponseWriter, teamName, pipelineName, jobName string, page db.Page) {
+func (s *Server) addPreviousLink(w http.ResponseWriter, teamName, pipelineName, jobName string, page db.Page, annotations []string) {
 	w.Header().Add("Link", fmt.Sprintf(
-		`<%s/api/v1/teams/%s/pipelines/%s/jobs/%s/builds?%s=%d&%s=%d>; rel="%s"`,
+		`<%s/api/v1/teams/%s/pipelines/%s/jobs/%s/builds?%s=%d&%s=%d%s>; rel="%s"`,
 		s.externalURL,
 		teamName,
 		pipelineName,
@@ -110,6 +113,7 @@ func (s *Server) addPreviousLink(w http.ResponseWriter, teamName, pipelineName,
 		page.Until,
 		atc.PaginationQueryLimit,
 		page.Limit,
+		atc.BuildsAnnotationQuery(annotations),
 		atc.LinkRelPrevious,
 	))
 }
@@ -40,8 +40,9 @@ func (s *Server) ListPipelineBuilds(pipeline db.Pipeline) http.Handler {
 		}
 
 		page := db.Page{Until: until, Since: since, Limit: limit}
+		annotations := r.Form[atc.BuildsQueryAnnotation]
 
-		builds, pagination, err = pipeline.Builds(page)
+		builds, pagination, err = pipeline.Builds(page, annotations)
 		if err != nil {
 			logger.Error("failed-to-get-pipeline-builds", err)
 			w.WriteHeader(http.StatusNotFound)
@@ -49,11 +50,11 @@ func (s *Server) ListPipelineBuilds(pipeline db.Pipeline) http.Handler {
 		}
 
 		if pagination.Next != nil {
-			s.addNextLink(w, teamName, pipeline.Name(), *pagination.Next)
+			s.addNextLink(w, teamName, pipeline.Name(), *pagination.Next, annotations)
 		}
 
 		if pagination.Previous != nil {
-			s.addPreviousLink(w, teamName, pipeline.Name(), *pagination.Previous)
+			s.addPreviousLink(w, teamName, pipeline.Name(), *pagination.Previous, annotations)
 		}
 
 		w.Header().Set("Content-Type", "application/json")
@@ -73,9 +74,9 @@ func (s *Server) ListPipelineBuilds(pipeline db.Pipeline) http.Handler {
 	})
 }
 
-func (s *Server) addNextLink(w http.ResponseWriter, teamName, pipelineName string, page db.Page) {
+func (s *Server) addNextLink(w http.ResponseWriter, teamName, pipelineName string, page db.Page, annotations []string) {
 	w.Header().Add("Link", fmt.Sprintf(
-		`<%s/api/v1/teams/%s/pipelines/%s/builds?%s=%d&%s=%d>; rel="%s"`,
+		`<%s/api/v1/teams/%s/pipelines/%s/builds?%s=%d&%s=%d%s>; rel="%s"`,
 		s.externalURL,
 		teamName,
 		pipelineName,
@@ -83,13 +84,14 @@ func (s *Server) addNextLink(w http.ResponseWriter, teamName, pipelineName strin
 		page.Since,
 		atc.PaginationQueryLimit,
 		page.Limit,
+		atc.BuildsAnnotationQuery(annotations),
 		atc.LinkRelNext,
 	))
 }
 
-func (s *Server) addPreviousLink(w http.ResponseWriter, teamName, pipelineName string, page db.Page) {
+func (s *Server) addPreviousLink(w http.ResponseWriter, teamName, pipelineName string, page db.Page, annotations []string) {
 	w.Header().Add("Link", fmt.Sprintf(
-		`<%s/api/v1/teams/%s/pipelines/%s/builds?%s=%d&%s=%d>; rel="%s"`,
+		`<%s/api/v1/teams/%s/pipelines/%s/builds?%s=%d&%s=%d%s>; rel="%s"`,
 		s.externalURL,
 		teamName,
 		pipelineName,
@@ -97,6 +99,7 @@ func (s *Server) addPreviousLink(w http.ResponseWriter, teamName, pipelineName s
 		page.Until,
 		atc.PaginationQueryLimit,
 		page.Limit,
+		atc.BuildsAnnotationQuery(annotations),
 		atc.LinkRelPrevious,
 	))
 }
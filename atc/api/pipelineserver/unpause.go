@@ -1,6 +1,7 @@
 package pipelineserver
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/concourse/concourse/atc/db"
@@ -9,6 +10,13 @@ import (
 func (s *Server) UnpausePipeline(pipelineDB db.Pipeline) http.Handler {
 	logger := s.logger.Session("unpause-pipeline")
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if pipelineDB.Archived() {
+			logger.Debug("pipeline-is-archived")
+			w.WriteHeader(http.StatusConflict)
+			fmt.Fprintf(w, "pipeline '%s' is archived; set its config to unarchive it", pipelineDB.Name())
+			return
+		}
+
 		err := pipelineDB.Unpause()
 		if err != nil {
 			logger.Error("failed-to-unpause-pipeline", err)
@@ -26,6 +26,8 @@ func Build(build db.Build) atc.Build {
 		TeamName:     build.TeamName(),
 		Status:       string(build.Status()),
 		APIURL:       apiURL,
+		Cause:        string(build.Cause()),
+		Annotations:  build.Annotations(),
 	}
 
 	if !build.StartTime().IsZero() {
@@ -0,0 +1,18 @@
+package present
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func Cause(cause db.Cause) atc.Cause {
+	return atc.Cause{
+		VersionedResourceID: cause.VersionedResourceID,
+		BuildID:             cause.BuildID,
+		ParentBuildID:       cause.ParentBuildID,
+		ResourceName:        cause.ResourceName,
+		Version:             cause.Version,
+		JobName:             cause.JobName,
+		BuildName:           cause.BuildName,
+	}
+}
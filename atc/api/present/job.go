@@ -47,6 +47,14 @@ func Job(
 		})
 	}
 
+	var nextRunTime int64
+	if schedule := job.Config().Schedule; schedule != nil && !job.Paused() && !job.LastScheduled().IsZero() {
+		next, err := schedule.Next(job.LastScheduled())
+		if err == nil && !next.IsZero() {
+			nextRunTime = next.Unix()
+		}
+	}
+
 	return atc.Job{
 		ID: job.ID(),
 
@@ -59,6 +67,7 @@ func Job(
 		FinishedBuild:        presentedFinishedBuild,
 		NextBuild:            presentedNextBuild,
 		TransitionBuild:      presentedTransitionBuild,
+		NextRunTime:          nextRunTime,
 
 		Inputs:  sanitizedInputs,
 		Outputs: sanitizedOutputs,
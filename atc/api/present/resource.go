@@ -21,6 +21,11 @@ func Resource(resource db.Resource, showCheckError bool, teamName string) atc.Re
 
 		FailingToCheck: resource.FailingToCheck(),
 		CheckError:     checkErrString,
+
+		PinnedVersion:  resource.PinnedVersion(),
+		PinnedInConfig: len(resource.ConfigPinnedVersion()) != 0,
+		PinComment:     resource.PinComment(),
+		PinnedBy:       resource.PinnedBy(),
 	}
 
 	if !resource.LastChecked().IsZero() {
@@ -2,11 +2,15 @@ package api_test
 
 import (
 	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/google/jsonapi"
@@ -757,6 +761,36 @@ var _ = Describe("Resources API", func() {
 							}`))
 				})
 			})
+
+			Context("when the resource is pinned through the API", func() {
+				BeforeEach(func() {
+					resource1 := new(dbfakes.FakeResource)
+					resource1.PipelineNameReturns("a-pipeline")
+					resource1.NameReturns("resource-1")
+					resource1.TypeReturns("type-1")
+					resource1.PinnedVersionReturns(atc.Version{"ref": "v1"})
+					resource1.PinCommentReturns("broken in v2")
+					resource1.PinnedByReturns("some-user")
+
+					fakePipeline.ResourceReturns(resource1, true, nil)
+				})
+
+				It("returns the pin", func() {
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(body).To(MatchJSON(`
+							{
+								"name": "resource-1",
+								"pipeline_name": "a-pipeline",
+								"team_name": "a-team",
+								"type": "type-1",
+								"pinned_version": {"ref": "v1"},
+								"pin_comment": "broken in v2",
+								"pinned_by": "some-user"
+							}`))
+				})
+			})
 		})
 
 		Context("when authenticated but not authorized", func() {
@@ -851,8 +885,8 @@ var _ = Describe("Resources API", func() {
 
 				It("injects the proper pipelineDB", func() {
 					Expect(dbTeam.PipelineCallCount()).To(Equal(1))
-					pipelineName := dbTeam.PipelineArgsForCall(0)
-					Expect(pipelineName).To(Equal("a-pipeline"))
+					pipelineRef := dbTeam.PipelineArgsForCall(0)
+					Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 				})
 
 				Context("when pausing the resource succeeds", func() {
@@ -932,8 +966,8 @@ var _ = Describe("Resources API", func() {
 
 				It("injects the proper pipelineDB", func() {
 					Expect(dbTeam.PipelineCallCount()).To(Equal(1))
-					pipelineName := dbTeam.PipelineArgsForCall(0)
-					Expect(pipelineName).To(Equal("a-pipeline"))
+					pipelineRef := dbTeam.PipelineArgsForCall(0)
+					Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 				})
 
 				Context("when unpausing the resource succeeds", func() {
@@ -979,6 +1013,201 @@ var _ = Describe("Resources API", func() {
 		})
 	})
 
+	Describe("PUT /api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/pin", func() {
+		var (
+			response     *http.Response
+			fakeResource *dbfakes.FakeResource
+			requestBody  string
+		)
+
+		BeforeEach(func() {
+			fakeResource = new(dbfakes.FakeResource)
+			fakeResource.NameReturns("resource-name")
+
+			fakePipeline.ResourceReturns(fakeResource, true, nil)
+
+			requestBody = `{"version":{"ref":"v1"},"comment":"broken in v2"}`
+		})
+
+		JustBeforeEach(func() {
+			var err error
+
+			request, err := http.NewRequest("PUT", server.URL+"/api/v1/teams/a-team/pipelines/a-pipeline/resources/resource-name/pin", bytes.NewBufferString(requestBody))
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+			})
+
+			Context("when authorized", func() {
+				BeforeEach(func() {
+					fakeaccess.IsAuthorizedReturns(true)
+					fakeaccess.UserNameReturns("some-user")
+				})
+
+				It("pins the resource to the version, recording the comment and user", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+					Expect(fakePipeline.ResourceArgsForCall(0)).To(Equal("resource-name"))
+
+					Expect(fakeResource.PinVersionCallCount()).To(Equal(1))
+					version, comment, pinnedBy := fakeResource.PinVersionArgsForCall(0)
+					Expect(version).To(Equal(atc.Version{"ref": "v1"}))
+					Expect(comment).To(Equal("broken in v2"))
+					Expect(pinnedBy).To(Equal("some-user"))
+				})
+
+				Context("when no version is given", func() {
+					BeforeEach(func() {
+						requestBody = `{"comment":"broken in v2"}`
+					})
+
+					It("returns 400", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+						Expect(fakeResource.PinVersionCallCount()).To(Equal(0))
+					})
+				})
+
+				Context("when the request body is malformed", func() {
+					BeforeEach(func() {
+						requestBody = `{`
+					})
+
+					It("returns 400", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					})
+				})
+
+				Context("when the resource is pinned in the pipeline config", func() {
+					BeforeEach(func() {
+						fakeResource.ConfigPinnedVersionReturns(atc.Version{"ref": "v0"})
+					})
+
+					It("returns 409 without pinning", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusConflict))
+						Expect(fakeResource.PinVersionCallCount()).To(Equal(0))
+					})
+				})
+
+				Context("when resource can not be found", func() {
+					BeforeEach(func() {
+						fakePipeline.ResourceReturns(nil, false, nil)
+					})
+
+					It("returns 404", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+					})
+				})
+
+				Context("when pinning the resource fails", func() {
+					BeforeEach(func() {
+						fakeResource.PinVersionReturns(errors.New("welp"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+			})
+
+			Context("when not authorized", func() {
+				BeforeEach(func() {
+					fakeaccess.IsAuthorizedReturns(false)
+				})
+
+				It("returns 403", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				})
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns Status Unauthorized", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
+	Describe("PUT /api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/unpin", func() {
+		var (
+			response     *http.Response
+			fakeResource *dbfakes.FakeResource
+		)
+
+		BeforeEach(func() {
+			fakeResource = new(dbfakes.FakeResource)
+			fakeResource.NameReturns("resource-name")
+
+			fakePipeline.ResourceReturns(fakeResource, true, nil)
+		})
+
+		JustBeforeEach(func() {
+			var err error
+
+			request, err := http.NewRequest("PUT", server.URL+"/api/v1/teams/a-team/pipelines/a-pipeline/resources/resource-name/unpin", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+			})
+
+			Context("when authorized", func() {
+				BeforeEach(func() {
+					fakeaccess.IsAuthorizedReturns(true)
+				})
+
+				It("unpins the resource", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(fakeResource.UnpinVersionCallCount()).To(Equal(1))
+				})
+
+				Context("when resource can not be found", func() {
+					BeforeEach(func() {
+						fakePipeline.ResourceReturns(nil, false, nil)
+					})
+
+					It("returns 404", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+					})
+				})
+
+				Context("when unpinning the resource fails", func() {
+					BeforeEach(func() {
+						fakeResource.UnpinVersionReturns(errors.New("welp"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns Status Unauthorized", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
 	Describe("POST /api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/check", func() {
 		var fakeScanner *radarfakes.FakeScanner
 		var checkRequestBody atc.CheckRequestBody
@@ -1011,8 +1240,8 @@ var _ = Describe("Resources API", func() {
 
 			It("injects the proper pipelineDB", func() {
 				Expect(dbTeam.PipelineCallCount()).To(Equal(1))
-				pipelineName := dbTeam.PipelineArgsForCall(0)
-				Expect(pipelineName).To(Equal("a-pipeline"))
+				pipelineRef := dbTeam.PipelineArgsForCall(0)
+				Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 			})
 
 			It("tries to scan with no version specified", func() {
@@ -1126,6 +1355,8 @@ var _ = Describe("Resources API", func() {
 			response         *http.Response
 			versionMap       atc.Version
 			fakeResource     *dbfakes.FakeResource
+			webhookPayload   []byte
+			webhookHeaders   http.Header
 		)
 
 		BeforeEach(func() {
@@ -1135,16 +1366,27 @@ var _ = Describe("Resources API", func() {
 
 			fakeResource = new(dbfakes.FakeResource)
 			fakeResource.NameReturns("resource-name")
+
+			webhookPayload = nil
+			webhookHeaders = http.Header{}
 		})
 
 		JustBeforeEach(func() {
-			reqPayload, err := json.Marshal(checkRequestBody)
-			Expect(err).NotTo(HaveOccurred())
+			reqPayload := webhookPayload
+			if reqPayload == nil {
+				var err error
+				reqPayload, err = json.Marshal(checkRequestBody)
+				Expect(err).NotTo(HaveOccurred())
+			}
 
 			request, err := http.NewRequest("POST", server.URL+"/api/v1/teams/a-team/pipelines/a-pipeline/resources/resource-name/check/webhook?webhook_token=fake-token", bytes.NewBuffer(reqPayload))
 			Expect(err).NotTo(HaveOccurred())
 			request.Header.Set("Content-Type", "application/json")
 
+			for name, values := range webhookHeaders {
+				request.Header[name] = values
+			}
+
 			response, err = client.Do(request)
 			Expect(err).NotTo(HaveOccurred())
 		})
@@ -1266,6 +1508,148 @@ var _ = Describe("Resources API", func() {
 					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
 				})
 			})
+			Context("when the resource verifies GitHub signatures", func() {
+				BeforeEach(func() {
+					fakeVariablesFactory.NewVariablesReturns(template.StaticVariables{
+						"webhook-secret": "some-secret",
+					})
+					fakeResource.WebhookSignatureReturns(&atc.WebhookSignatureConfig{
+						Type:   atc.WebhookSignatureGitHub,
+						Secret: "((webhook-secret))",
+					})
+
+					webhookPayload = []byte(`{"ref":"refs/heads/main"}`)
+				})
+
+				Context("when the payload is signed with the secret", func() {
+					BeforeEach(func() {
+						mac := hmac.New(sha256.New, []byte("some-secret"))
+						mac.Write(webhookPayload)
+						webhookHeaders.Set("X-Hub-Signature-256", "sha256="+hex.EncodeToString(mac.Sum(nil)))
+					})
+
+					It("scans the resource", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+						Expect(fakeScanner.ScanFromVersionCallCount()).To(Equal(1))
+					})
+				})
+
+				Context("when the payload is signed with another secret", func() {
+					BeforeEach(func() {
+						mac := hmac.New(sha256.New, []byte("some-other-secret"))
+						mac.Write(webhookPayload)
+						webhookHeaders.Set("X-Hub-Signature-256", "sha256="+hex.EncodeToString(mac.Sum(nil)))
+					})
+
+					It("returns 401 without scanning", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+						Expect(fakeScanner.ScanFromVersionCallCount()).To(Equal(0))
+					})
+				})
+
+				Context("when the payload is not signed", func() {
+					It("returns 401 without scanning", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+						Expect(fakeScanner.ScanFromVersionCallCount()).To(Equal(0))
+					})
+				})
+			})
+
+			Context("when the resource verifies GitLab tokens", func() {
+				BeforeEach(func() {
+					fakeResource.WebhookSignatureReturns(&atc.WebhookSignatureConfig{
+						Type:   atc.WebhookSignatureGitLab,
+						Secret: "some-secret",
+					})
+				})
+
+				Context("when the token matches the secret", func() {
+					BeforeEach(func() {
+						webhookHeaders.Set("X-Gitlab-Token", "some-secret")
+					})
+
+					It("scans the resource", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+						Expect(fakeScanner.ScanFromVersionCallCount()).To(Equal(1))
+					})
+				})
+
+				Context("when the token does not match the secret", func() {
+					BeforeEach(func() {
+						webhookHeaders.Set("X-Gitlab-Token", "some-other-secret")
+					})
+
+					It("returns 401 without scanning", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+						Expect(fakeScanner.ScanFromVersionCallCount()).To(Equal(0))
+					})
+				})
+			})
+
+			Context("when the resource filters webhook payloads", func() {
+				BeforeEach(func() {
+					fakeResource.WebhookFiltersReturns([]atc.WebhookFilter{
+						{Path: "$.ref", Values: []string{"refs/heads/main", "refs/heads/master"}},
+						{Path: "$.commits[0]['author'].name", Values: []string{"some-author"}},
+					})
+				})
+
+				Context("when the payload matches every filter", func() {
+					BeforeEach(func() {
+						webhookPayload = []byte(`{"ref":"refs/heads/master","commits":[{"author":{"name":"some-author"}}]}`)
+					})
+
+					It("scans the resource", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+						Expect(fakeScanner.ScanFromVersionCallCount()).To(Equal(1))
+					})
+				})
+
+				Context("when the payload does not match a filter", func() {
+					BeforeEach(func() {
+						webhookPayload = []byte(`{"ref":"refs/heads/feature","commits":[{"author":{"name":"some-author"}}]}`)
+					})
+
+					It("returns 200 without scanning", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+						Expect(fakeScanner.ScanFromVersionCallCount()).To(Equal(0))
+					})
+				})
+
+				Context("when the payload does not have the filtered path", func() {
+					BeforeEach(func() {
+						webhookPayload = []byte(`{"ref":"refs/heads/main","commits":[]}`)
+					})
+
+					It("returns 200 without scanning", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+						Expect(fakeScanner.ScanFromVersionCallCount()).To(Equal(0))
+					})
+				})
+
+				Context("when the payload is too large", func() {
+					BeforeEach(func() {
+						webhookPayload = []byte(`{"ref":"refs/heads/main","padding":"` + strings.Repeat("x", 25*1024*1024) + `"}`)
+					})
+
+					It("returns 400 without scanning", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+						Expect(fakeScanner.ScanFromVersionCallCount()).To(Equal(0))
+					})
+				})
+
+				Context("when the payload is not JSON", func() {
+					BeforeEach(func() {
+						webhookPayload = []byte(`ref=refs/heads/main`)
+					})
+
+					It("returns 400 without scanning", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+						Expect(fakeScanner.ScanFromVersionCallCount()).To(Equal(0))
+					})
+				})
+			})
+
 			Context("when unauthorized", func() {
 				BeforeEach(func() {
 					fakeResource.WebhookTokenReturns("wrong-token")
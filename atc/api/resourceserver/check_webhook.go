@@ -2,6 +2,7 @@ package resourceserver
 
 import (
 	"fmt"
+	"io/ioutil"
 	"net/http"
 
 	"code.cloudfoundry.org/lager"
@@ -11,6 +12,11 @@ import (
 	"github.com/tedsuo/rata"
 )
 
+// maxWebhookPayloadSize limits how much of a webhook payload is read to verify
+// its signature or match its filters. It matches the largest payload GitHub
+// sends.
+const maxWebhookPayloadSize = 25 * 1024 * 1024
+
 // CheckResourceWebHook defines a handler for process a check resource request via an access token.
 func (s *Server) CheckResourceWebHook(dbPipeline db.Pipeline) http.Handler {
 	logger := s.logger.Session("check-resource-webhook")
@@ -40,11 +46,60 @@ func (s *Server) CheckResourceWebHook(dbPipeline db.Pipeline) http.Handler {
 
 		variables := s.variablesFactory.NewVariables(dbPipeline.TeamName(), dbPipeline.Name())
 		token, err := creds.NewString(variables, pipelineResource.WebhookToken()).Evaluate()
+		if err != nil {
+			logger.Error("failed-to-evaluate-webhook-token", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
 		if token != webhookToken {
 			logger.Info("invalid-token", lager.Data{"error": fmt.Sprintf("invalid token for webhook %s", webhookToken)})
 			w.WriteHeader(http.StatusUnauthorized)
 			return
 		}
+
+		signature := pipelineResource.WebhookSignature()
+		filters := pipelineResource.WebhookFilters()
+
+		if signature != nil || len(filters) > 0 {
+			body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxWebhookPayloadSize))
+			if err != nil {
+				logger.Error("failed-to-read-body", err)
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+
+			if signature != nil {
+				secret, err := creds.NewString(variables, signature.Secret).Evaluate()
+				if err != nil {
+					logger.Error("failed-to-evaluate-webhook-secret", err)
+					w.WriteHeader(http.StatusInternalServerError)
+					return
+				}
+
+				if !verifyWebhookSignature(signature.Type, secret, r, body) {
+					logger.Info("invalid-signature", lager.Data{"type": signature.Type})
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+			}
+
+			if len(filters) > 0 {
+				matched, err := matchWebhookFilters(filters, body)
+				if err != nil {
+					logger.Info("failed-to-filter-payload", lager.Data{"error": err.Error()})
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+
+				if !matched {
+					logger.Debug("payload-filtered")
+					w.WriteHeader(http.StatusOK)
+					return
+				}
+			}
+		}
+
 		var fromVersion atc.Version
 		latestVersion, found, err := dbPipeline.GetLatestVersionedResource(resourceName)
 		if err != nil {
@@ -0,0 +1,66 @@
+package resourceserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/tedsuo/rata"
+)
+
+func (s *Server) PinResourceVersion(dbPipeline db.Pipeline) http.Handler {
+	logger := s.logger.Session("pin-resource-version")
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resourceName := rata.Param(r, "resource_name")
+
+		var reqBody atc.PinRequestBody
+		err := json.NewDecoder(r.Body).Decode(&reqBody)
+		if err != nil {
+			logger.Info("malformed-request", lager.Data{"error": err.Error()})
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		if len(reqBody.Version) == 0 {
+			logger.Info("no-version-specified")
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, "no version specified")
+			return
+		}
+
+		dbResource, found, err := dbPipeline.Resource(resourceName)
+		if err != nil {
+			logger.Error("failed-to-get-resource", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			logger.Debug("resource-not-found", lager.Data{"resource": resourceName})
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		if len(dbResource.ConfigPinnedVersion()) != 0 {
+			logger.Info("resource-pinned-in-config", lager.Data{"resource": resourceName})
+			w.WriteHeader(http.StatusConflict)
+			fmt.Fprintf(w, "resource '%s' is pinned to a version in the pipeline config", resourceName)
+			return
+		}
+
+		acc := accessor.GetAccessor(r)
+
+		err = dbResource.PinVersion(reqBody.Version, reqBody.Comment, acc.UserName())
+		if err != nil {
+			logger.Error("failed-to-pin-resource-version", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	})
+}
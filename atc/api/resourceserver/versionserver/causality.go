@@ -7,6 +7,8 @@ import (
 
 	"code.cloudfoundry.org/lager"
 
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/present"
 	"github.com/concourse/concourse/atc/db"
 )
 
@@ -31,10 +33,15 @@ func (s *Server) GetCausality(pipeline db.Pipeline) http.Handler {
 
 		hLog.Debug("fetched", lager.Data{"length": len(causality)})
 
+		presentedCausality := []atc.Cause{}
+		for _, cause := range causality {
+			presentedCausality = append(presentedCausality, present.Cause(cause))
+		}
+
 		w.Header().Set("Content-Type", "application/json")
 
 		w.WriteHeader(http.StatusOK)
 
-		_ = json.NewEncoder(w).Encode(causality)
+		_ = json.NewEncoder(w).Encode(presentedCausality)
 	})
 }
@@ -0,0 +1,178 @@
+package resourceserver
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+)
+
+// verifyWebhookSignature checks that the request was signed with the secret,
+// the way GitHub or GitLab sign the webhooks they send.
+func verifyWebhookSignature(signatureType string, secret string, r *http.Request, body []byte) bool {
+	switch signatureType {
+	case atc.WebhookSignatureGitHub:
+		if signature := r.Header.Get("X-Hub-Signature-256"); signature != "" {
+			return verifyHMAC(sha256.New, "sha256=", secret, signature, body)
+		}
+
+		return verifyHMAC(sha1.New, "sha1=", secret, r.Header.Get("X-Hub-Signature"), body)
+
+	case atc.WebhookSignatureGitLab:
+		token := r.Header.Get("X-Gitlab-Token")
+		return token != "" && subtle.ConstantTimeCompare([]byte(token), []byte(secret)) == 1
+
+	default:
+		return false
+	}
+}
+
+func verifyHMAC(h func() hash.Hash, prefix string, secret string, signature string, body []byte) bool {
+	if !strings.HasPrefix(signature, prefix) {
+		return false
+	}
+
+	given, err := hex.DecodeString(strings.TrimPrefix(signature, prefix))
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(h, []byte(secret))
+	mac.Write(body)
+
+	return hmac.Equal(given, mac.Sum(nil))
+}
+
+// matchWebhookFilters returns whether the payload satisfies every filter. A
+// filter is satisfied when the value at its path is one of its values.
+func matchWebhookFilters(filters []atc.WebhookFilter, body []byte) (bool, error) {
+	var payload interface{}
+	err := json.Unmarshal(body, &payload)
+	if err != nil {
+		return false, fmt.Errorf("invalid payload: %s", err)
+	}
+
+	for _, filter := range filters {
+		value, found, err := lookupJSONPath(payload, filter.Path)
+		if err != nil {
+			return false, err
+		}
+
+		if !found || !matchesAny(value, filter.Values) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func matchesAny(value interface{}, values []string) bool {
+	var actual string
+	switch v := value.(type) {
+	case string:
+		actual = v
+	case nil:
+		actual = "null"
+	default:
+		payload, err := json.Marshal(v)
+		if err != nil {
+			return false
+		}
+
+		actual = string(payload)
+	}
+
+	for _, expected := range values {
+		if actual == expected {
+			return true
+		}
+	}
+
+	return false
+}
+
+// lookupJSONPath evaluates a JSONPath made up of child and index selectors,
+// e.g. $.ref, $.commits[0].id or $['repository']['full_name'].
+func lookupJSONPath(payload interface{}, path string) (interface{}, bool, error) {
+	if !strings.HasPrefix(path, "$") {
+		return nil, false, fmt.Errorf("invalid path '%s': must start with '$'", path)
+	}
+
+	value := payload
+	rest := path[1:]
+
+	for rest != "" {
+		var key string
+		var index int
+		var isIndex bool
+
+		switch rest[0] {
+		case '.':
+			end := strings.IndexAny(rest[1:], ".[")
+			if end == -1 {
+				end = len(rest) - 1
+			}
+
+			key = rest[1 : end+1]
+			rest = rest[end+1:]
+
+			if key == "" {
+				return nil, false, fmt.Errorf("invalid path '%s': empty key", path)
+			}
+
+		case '[':
+			end := strings.Index(rest, "]")
+			if end == -1 {
+				return nil, false, fmt.Errorf("invalid path '%s': unterminated '['", path)
+			}
+
+			selector := rest[1:end]
+			rest = rest[end+1:]
+
+			if len(selector) >= 2 && selector[0] == '\'' && selector[len(selector)-1] == '\'' {
+				key = selector[1 : len(selector)-1]
+			} else {
+				var err error
+				index, err = strconv.Atoi(selector)
+				if err != nil {
+					return nil, false, fmt.Errorf("invalid path '%s': invalid index '%s'", path, selector)
+				}
+
+				isIndex = true
+			}
+
+		default:
+			return nil, false, fmt.Errorf("invalid path '%s': unexpected '%c'", path, rest[0])
+		}
+
+		if isIndex {
+			list, ok := value.([]interface{})
+			if !ok || index < 0 || index >= len(list) {
+				return nil, false, nil
+			}
+
+			value = list[index]
+		} else {
+			object, ok := value.(map[string]interface{})
+			if !ok {
+				return nil, false, nil
+			}
+
+			value, ok = object[key]
+			if !ok {
+				return nil, false, nil
+			}
+		}
+	}
+
+	return value, true, nil
+}
@@ -11,8 +11,11 @@ import (
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/api/accessor/accessorfakes"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/creds/credsfakes"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
+	flags "github.com/jessevdk/go-flags"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -220,6 +223,26 @@ var _ = Describe("Teams API", func() {
 						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
 					})
 				})
+
+				Context("when the auth grants a role that is not defined", func() {
+					BeforeEach(func() {
+						atcTeam.Auth["operator"] = map[string][]string{
+							"users": []string{"local:someone-else"},
+						}
+					})
+
+					It("returns 400 Bad Request", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+
+						body, err := ioutil.ReadAll(response.Body)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(string(body)).To(ContainSubstring("unknown role 'operator'"))
+					})
+
+					It("does not update provider auth", func() {
+						Expect(fakeTeam.UpdateProviderAuthCallCount()).To(BeZero())
+					})
+				})
 			})
 		}
 
@@ -537,7 +560,7 @@ var _ = Describe("Teams API", func() {
 				It("does not set defaults for since and until", func() {
 					Expect(fakeTeam.BuildsCallCount()).To(Equal(1))
 
-					page := fakeTeam.BuildsArgsForCall(0)
+					page, _ := fakeTeam.BuildsArgsForCall(0)
 					Expect(page).To(Equal(db.Page{
 						Since: 0,
 						Until: 0,
@@ -554,7 +577,7 @@ var _ = Describe("Teams API", func() {
 				It("passes them through", func() {
 					Expect(fakeTeam.BuildsCallCount()).To(Equal(1))
 
-					page := fakeTeam.BuildsArgsForCall(0)
+					page, _ := fakeTeam.BuildsArgsForCall(0)
 					Expect(page).To(Equal(db.Page{
 						Since: 2,
 						Until: 3,
@@ -659,4 +682,222 @@ var _ = Describe("Teams API", func() {
 			})
 		})
 	})
+
+	Describe("PUT /api/v1/teams/:team_name/credential_manager", func() {
+		var (
+			response    *http.Response
+			fakeManager *credsfakes.FakeManager
+			config      atc.CredentialManagerConfig
+		)
+
+		BeforeEach(func() {
+			fakeManager = new(credsfakes.FakeManager)
+
+			fakeManagerFactory := new(credsfakes.FakeManagerFactory)
+			fakeManagerFactory.AddConfigStub = func(group *flags.Group) creds.Manager {
+				group.AddGroup("Fake SSM", "", &struct {
+					AccessKey string `long:"access-key"`
+					SecretKey string `long:"secret-key"`
+				}{})
+
+				return fakeManager
+			}
+			creds.Register("ssm", fakeManagerFactory)
+
+			config = atc.CredentialManagerConfig{
+				Type: "ssm",
+				Config: map[string]interface{}{
+					"access-key": "some-access-key",
+					"secret-key": "some-secret-key",
+				},
+			}
+
+			dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+		})
+
+		JustBeforeEach(func() {
+			request, err := http.NewRequest(
+				"PUT",
+				server.URL+"/api/v1/teams/a-team/credential_manager",
+				jsonEncode(config),
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+			})
+
+			Context("when requester belongs to the team", func() {
+				BeforeEach(func() {
+					fakeaccess.IsAuthorizedReturns(true)
+				})
+
+				It("saves the config for the team", func() {
+					Expect(dbTeamFactory.FindTeamArgsForCall(0)).To(Equal("a-team"))
+					Expect(fakeTeam.SetCredentialManagerConfigCallCount()).To(Equal(1))
+					Expect(fakeTeam.SetCredentialManagerConfigArgsForCall(0)).To(Equal(config))
+				})
+
+				It("returns 204 no content", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+				})
+
+				Context("when the manager type is unknown", func() {
+					BeforeEach(func() {
+						config.Type = "bogus"
+					})
+
+					It("returns 400 with the error", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+
+						body, err := ioutil.ReadAll(response.Body)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(string(body)).To(Equal("credential manager type 'bogus' cannot be configured by a team"))
+
+						Expect(fakeTeam.SetCredentialManagerConfigCallCount()).To(Equal(0))
+					})
+				})
+
+				Context("when the config uses an option a team may not set", func() {
+					BeforeEach(func() {
+						config.Config["team-secret-template"] = "/concourse/other-team/{{.Secret}}"
+					})
+
+					It("returns 400 with the error", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+
+						body, err := ioutil.ReadAll(response.Body)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(string(body)).To(Equal("option 'team-secret-template' cannot be configured by a team"))
+
+						Expect(fakeTeam.SetCredentialManagerConfigCallCount()).To(Equal(0))
+					})
+				})
+
+				Context("when the manager is misconfigured", func() {
+					BeforeEach(func() {
+						fakeManager.ValidateReturns(errors.New("missing url"))
+					})
+
+					It("returns 400 with the error", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+
+						body, err := ioutil.ReadAll(response.Body)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(string(body)).To(Equal("credential manager 'ssm' misconfigured: missing url"))
+
+						Expect(fakeTeam.SetCredentialManagerConfigCallCount()).To(Equal(0))
+					})
+				})
+
+				Context("when the team does not exist", func() {
+					BeforeEach(func() {
+						dbTeamFactory.FindTeamReturns(nil, false, nil)
+					})
+
+					It("returns 404 Not Found", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+					})
+				})
+
+				Context("when saving the config fails", func() {
+					BeforeEach(func() {
+						fakeTeam.SetCredentialManagerConfigReturns(errors.New("disaster"))
+					})
+
+					It("returns 500 Internal Server Error", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+			})
+
+			Context("when requester does not belong to the team", func() {
+				BeforeEach(func() {
+					fakeaccess.IsAuthorizedReturns(false)
+				})
+
+				It("returns 403 Forbidden", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+					Expect(fakeTeam.SetCredentialManagerConfigCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401 Unauthorized", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
+	Describe("DELETE /api/v1/teams/:team_name/credential_manager", func() {
+		var response *http.Response
+
+		BeforeEach(func() {
+			dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+		})
+
+		JustBeforeEach(func() {
+			request, err := http.NewRequest(
+				"DELETE",
+				server.URL+"/api/v1/teams/a-team/credential_manager",
+				nil,
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+			})
+
+			Context("when requester belongs to the team", func() {
+				BeforeEach(func() {
+					fakeaccess.IsAuthorizedReturns(true)
+				})
+
+				It("clears the team's config", func() {
+					Expect(dbTeamFactory.FindTeamArgsForCall(0)).To(Equal("a-team"))
+					Expect(fakeTeam.ClearCredentialManagerConfigCallCount()).To(Equal(1))
+				})
+
+				It("returns 204 no content", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+				})
+
+				Context("when the team does not exist", func() {
+					BeforeEach(func() {
+						dbTeamFactory.FindTeamReturns(nil, false, nil)
+					})
+
+					It("returns 404 Not Found", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+					})
+				})
+			})
+
+			Context("when requester does not belong to the team", func() {
+				BeforeEach(func() {
+					fakeaccess.IsAuthorizedReturns(false)
+				})
+
+				It("returns 403 Forbidden", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+					Expect(fakeTeam.ClearCredentialManagerConfigCallCount()).To(Equal(0))
+				})
+			})
+		})
+	})
 })
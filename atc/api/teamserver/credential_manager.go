@@ -0,0 +1,106 @@
+package teamserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/creds"
+)
+
+// SetCredentialManager configures a team's own credential manager, used in
+// place of the one configured for the whole cluster.
+func (s *Server) SetCredentialManager(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("set-team-credential-manager")
+	acc := accessor.GetAccessor(r)
+
+	teamName := r.FormValue(":team_name")
+	if !acc.IsAdmin() && !acc.IsAuthorized(teamName) {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	var config atc.CredentialManagerConfig
+	err := json.NewDecoder(r.Body).Decode(&config)
+	if err != nil {
+		logger.Error("failed-to-unmarshal-body", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	manager, err := creds.NewTeamManager(config.Type, config.Config)
+	if err != nil {
+		logger.Info("invalid-credential-manager-config", lager.Data{"error": err.Error()})
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, "%s", err)
+		return
+	}
+
+	err = manager.Validate()
+	if err != nil {
+		logger.Info("invalid-credential-manager-config", lager.Data{"error": err.Error()})
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, "credential manager '%s' misconfigured: %s", config.Type, err)
+		return
+	}
+
+	team, found, err := s.teamFactory.FindTeam(teamName)
+	if err != nil {
+		logger.Error("failed-to-get-team", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !found {
+		logger.Info("team-not-found")
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	err = team.SetCredentialManagerConfig(config)
+	if err != nil {
+		logger.Error("failed-to-set-credential-manager-config", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ClearCredentialManager removes a team's own credential manager, so that
+// the team goes back to using the one configured for the whole cluster.
+func (s *Server) ClearCredentialManager(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("clear-team-credential-manager")
+	acc := accessor.GetAccessor(r)
+
+	teamName := r.FormValue(":team_name")
+	if !acc.IsAdmin() && !acc.IsAuthorized(teamName) {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	team, found, err := s.teamFactory.FindTeam(teamName)
+	if err != nil {
+		logger.Error("failed-to-get-team", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !found {
+		logger.Info("team-not-found")
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	err = team.ClearCredentialManagerConfig()
+	if err != nil {
+		logger.Error("failed-to-clear-credential-manager-config", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -38,6 +38,7 @@ func (s *Server) ListTeamBuilds(w http.ResponseWriter, r *http.Request) {
 	}
 
 	page := db.Page{Until: until, Since: since, Limit: limit}
+	annotations := r.Form[atc.BuildsQueryAnnotation]
 
 	team, found, err := s.teamFactory.FindTeam(teamName)
 	if err != nil {
@@ -50,7 +51,7 @@ func (s *Server) ListTeamBuilds(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	builds, pagination, err = team.Builds(page)
+	builds, pagination, err = team.Builds(page, annotations)
 	if err != nil {
 		logger.Error("failed-to-get-team-builds", err)
 		w.WriteHeader(http.StatusNotFound)
@@ -58,11 +59,11 @@ func (s *Server) ListTeamBuilds(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if pagination.Next != nil {
-		s.addNextLink(w, teamName, *pagination.Next)
+		s.addNextLink(w, teamName, *pagination.Next, annotations)
 	}
 
 	if pagination.Previous != nil {
-		s.addPreviousLink(w, teamName, *pagination.Previous)
+		s.addPreviousLink(w, teamName, *pagination.Previous, annotations)
 	}
 
 	w.Header().Set("Content-Type", "application/json")
@@ -81,28 +82,30 @@ func (s *Server) ListTeamBuilds(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-func (s *Server) addNextLink(w http.ResponseWriter, teamName string, page db.Page) {
+func (s *Server) addNextLink(w http.ResponseWriter, teamName string, page db.Page, annotations []string) {
 	w.Header().Add("Link", fmt.Sprintf(
-		`<%s/api/v1/teams/%s/builds?%s=%d&%s=%d>; rel="%s"`,
+		`<%s/api/v1/teams/%s/builds?%s=%d&%s=%d%s>; rel="%s"`,
 		s.externalURL,
 		teamName,
 		atc.PaginationQuerySince,
 		page.Since,
 		atc.PaginationQueryLimit,
 		page.Limit,
+		atc.BuildsAnnotationQuery(annotations),
 		atc.LinkRelNext,
 	))
 }
 
-func (s *Server) addPreviousLink(w http.ResponseWriter, teamName string, page db.Page) {
+func (s *Server) addPreviousLink(w http.ResponseWriter, teamName string, page db.Page, annotations []string) {
 	w.Header().Add("Link", fmt.Sprintf(
-		`<%s/api/v1/teams/%s/builds?%s=%d&%s=%d>; rel="%s"`,
+		`<%s/api/v1/teams/%s/builds?%s=%d&%s=%d%s>; rel="%s"`,
 		s.externalURL,
 		teamName,
 		atc.PaginationQueryUntil,
 		page.Until,
 		atc.PaginationQueryLimit,
 		page.Limit,
+		atc.BuildsAnnotationQuery(annotations),
 		atc.LinkRelPrevious,
 	))
 }
@@ -10,6 +10,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/api/accessor/accessorfakes"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
@@ -715,4 +716,108 @@ var _ = Describe("Versions API", func() {
 			})
 		})
 	})
+
+	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/versions/:resource_version_id/causality", func() {
+		var response *http.Response
+		var stringVersionID string
+
+		JustBeforeEach(func() {
+			var err error
+
+			request, err := http.NewRequest("GET", server.URL+"/api/v1/teams/a-team/pipelines/a-pipeline/resources/some-resource/versions/"+stringVersionID+"/causality", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		BeforeEach(func() {
+			stringVersionID = "123"
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when getting the causality succeeds", func() {
+				BeforeEach(func() {
+					fakePipeline.CausalityReturns([]db.Cause{
+						{
+							VersionedResourceID: 123,
+							BuildID:             1024,
+							ResourceName:        "some-resource",
+							Version:             atc.Version{"ref": "v1"},
+							JobName:             "some-job",
+							BuildName:           "5",
+						},
+						{
+							VersionedResourceID: 124,
+							BuildID:             1025,
+							ParentBuildID:       1024,
+							ResourceName:        "some-other-resource",
+							Version:             atc.Version{"ref": "v2"},
+							JobName:             "some-other-job",
+							BuildName:           "6",
+						},
+					}, nil)
+				})
+
+				It("looks up the given version ID", func() {
+					Expect(fakePipeline.CausalityCallCount()).To(Equal(1))
+					Expect(fakePipeline.CausalityArgsForCall(0)).To(Equal(123))
+				})
+
+				It("returns 200 OK", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+				})
+
+				It("returns the json", func() {
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(body).To(MatchJSON(`[
+						{
+							"versioned_resource_id": 123,
+							"build_id": 1024,
+							"resource_name": "some-resource",
+							"version": {"ref": "v1"},
+							"job_name": "some-job",
+							"build_name": "5"
+						},
+						{
+							"versioned_resource_id": 124,
+							"build_id": 1025,
+							"parent_build_id": 1024,
+							"resource_name": "some-other-resource",
+							"version": {"ref": "v2"},
+							"job_name": "some-other-job",
+							"build_name": "6"
+						}
+					]`))
+				})
+			})
+
+			Context("when the version ID is invalid", func() {
+				BeforeEach(func() {
+					stringVersionID = "hello"
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+			})
+
+			Context("when getting the causality fails", func() {
+				BeforeEach(func() {
+					fakePipeline.CausalityReturns(nil, errors.New("NOPE"))
+				})
+
+				It("returns a 500 internal server error", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
 })
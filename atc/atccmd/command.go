@@ -39,6 +39,7 @@ import (
 	"github.com/concourse/concourse/atc/resource"
 	"github.com/concourse/concourse/atc/scheduler"
 	"github.com/concourse/concourse/atc/syslog"
+	"github.com/concourse/concourse/atc/tracing"
 	"github.com/concourse/concourse/atc/worker"
 	"github.com/concourse/concourse/atc/worker/image"
 	"github.com/concourse/concourse/atc/wrappa"
@@ -104,8 +105,10 @@ type RunCommand struct {
 	GlobalResourceCheckTimeout   time.Duration `long:"global-resource-check-timeout" default:"1h" description:"Time limit on checking for new versions of resources."`
 	ResourceCheckingInterval     time.Duration `long:"resource-checking-interval" default:"1m" description:"Interval on which to check for new versions of resources."`
 	ResourceTypeCheckingInterval time.Duration `long:"resource-type-checking-interval" default:"1m" description:"Interval on which to check for new versions of resource types."`
+	EnableGlobalResources        bool          `long:"enable-global-resources" description:"Check resources with the same type and source once for all pipelines, copying the versions found into each pipeline's resource."`
 
-	ContainerPlacementStrategy        string        `long:"container-placement-strategy" default:"volume-locality" choice:"volume-locality" choice:"random" description:"Method by which a worker is selected during container placement."`
+	ContainerPlacementStrategy        []string      `long:"container-placement-strategy" default:"volume-locality" choice:"volume-locality" choice:"fewest-build-containers" choice:"random" description:"Method by which a worker is selected during container placement. Can be specified multiple times to narrow down the workers with each method in turn."`
+	MaxActiveTasksPerWorker           int           `long:"max-active-tasks-per-worker" default:"0" description:"Maximum number of tasks running on a worker at once. Tasks wait for a worker to free up beyond this limit. 0 means no limit."`
 	BaggageclaimResponseHeaderTimeout time.Duration `long:"baggageclaim-response-header-timeout" default:"1m" description:"How long to wait for Baggageclaim to send the response header."`
 
 	CLIArtifactsDir flag.Dir `long:"cli-artifacts-dir" description:"Directory containing downloadable CLI binaries."`
@@ -121,10 +124,13 @@ type RunCommand struct {
 	} `group:"Static Worker (optional)" namespace:"worker"`
 
 	Metrics struct {
-		HostName   string            `long:"metrics-host-name"   description:"Host string to attach to emitted metrics."`
-		Attributes map[string]string `long:"metrics-attribute"   description:"A key-value attribute to attach to emitted metrics. Can be specified multiple times." value-name:"NAME:VALUE"`
+		HostName      string            `long:"metrics-host-name"   description:"Host string to attach to emitted metrics."`
+		Attributes    map[string]string `long:"metrics-attribute"   description:"A key-value attribute to attach to emitted metrics. Can be specified multiple times." value-name:"NAME:VALUE"`
+		StateInterval time.Duration     `long:"metrics-state-interval" default:"10s" description:"Interval on which to emit metrics describing the state of jobs, workers and resources."`
 	} `group:"Metrics & Diagnostics"`
 
+	Tracing tracing.Config `group:"Tracing" namespace:"tracing"`
+
 	Server struct {
 		XFrameOptions string `long:"x-frame-options" description:"The value to set for X-Frame-Options. If omitted, the header is not set."`
 	} `group:"Web Server"`
@@ -132,10 +138,18 @@ type RunCommand struct {
 	LogDBQueries bool `long:"log-db-queries" description:"Log database queries."`
 
 	GC struct {
-		Interval               time.Duration `long:"interval" default:"30s" description:"Interval on which to perform garbage collection."`
-		OneOffBuildGracePeriod time.Duration `long:"one-off-grace-period" default:"5m" description:"Grace period before reaping one-off task containers"`
+		Interval                 time.Duration `long:"interval" default:"30s" description:"Interval on which to perform garbage collection."`
+		OneOffBuildGracePeriod   time.Duration `long:"one-off-grace-period" default:"5m" description:"Grace period before reaping one-off task containers"`
+		ArtifactRetention        time.Duration `long:"artifact-retention" default:"24h" description:"Length of time to keep the outputs retained as artifacts by tasks."`
+		TaskOutputCacheRetention time.Duration `long:"task-output-cache-retention" default:"168h" description:"Length of time to keep the cached outputs of tasks after they were last used."`
 	} `group:"Garbage Collection" namespace:"gc"`
 
+	BuildEventStore struct {
+		Type              string        `long:"type"               default:"postgres" choice:"postgres" choice:"filesystem" description:"Where to store the events (i.e. logs) of builds. Events of completed builds are moved out of the database when a store other than postgres is configured."`
+		Dir               flag.Dir      `long:"dir"                description:"Directory in which the filesystem store keeps build events. Must be shared by all ATCs, e.g. a network volume."`
+		MigrationInterval time.Duration `long:"migration-interval" default:"30s"      description:"Interval on which to move the events of completed builds out of the database."`
+	} `group:"Build Event Store" namespace:"build-event-store"`
+
 	BuildTrackerInterval time.Duration `long:"build-tracker-interval" default:"10s" description:"Interval on which to run build tracking."`
 
 	TelemetryOptIn bool `long:"telemetry-opt-in" hidden:"true" description:"Enable anonymous concourse version reporting."`
@@ -157,6 +171,8 @@ type RunCommand struct {
 	Auth struct {
 		AuthFlags     skycmd.AuthFlags
 		MainTeamFlags skycmd.AuthTeamFlags `group:"Authentication (Main Team)" namespace:"main-team"`
+
+		RolePolicy flag.File `long:"role-policy" description:"YAML file mapping custom role names to the API actions they are permitted to perform. An action on a job may be limited to one job by appending ':' and the job name, e.g. CreateJobBuild:ship-it. Redefining owner, member, or viewer replaces the built-in role."`
 	} `group:"Authentication"`
 }
 
@@ -341,6 +357,10 @@ func (cmd *RunCommand) Runner(positionalArguments []string) (ifrit.Runner, error
 		return nil, err
 	}
 
+	if err := cmd.Tracing.Prepare(); err != nil {
+		return nil, err
+	}
+
 	lockConn, err := cmd.constructLockConn(retryingDriverName)
 	if err != nil {
 		return nil, err
@@ -375,6 +395,24 @@ func (cmd *RunCommand) Runner(positionalArguments []string) (ifrit.Runner, error
 		),
 	})
 
+	if metric.IsConfigured() {
+		members = append(members, grouper.Member{
+			Name: "periodic-state-metrics",
+			Runner: lockrunner.NewRunner(
+				logger.Session("periodic-state-metrics"),
+				metric.NewStateEmitter(
+					db.NewJobFactory(backendConn, lockFactory),
+					db.NewWorkerFactory(backendConn),
+					db.NewResourceFactory(backendConn, lockFactory),
+				),
+				"state-metrics",
+				lockFactory,
+				clock.NewClock(),
+				cmd.Metrics.StateInterval,
+			),
+		})
+	}
+
 	onReady := func() {
 		logData := lager.Data{
 			"http":  cmd.nonTLSBindAddr(),
@@ -389,6 +427,8 @@ func (cmd *RunCommand) Runner(positionalArguments []string) (ifrit.Runner, error
 	}
 
 	onExit := func() {
+		tracing.Shutdown()
+
 		for _, closer := range []Closer{lockConn, apiConn, backendConn, storage} {
 			closer.Close()
 		}
@@ -448,11 +488,16 @@ func (cmd *RunCommand) constructAPIMembers(
 ) ([]grouper.Member, error) {
 	teamFactory := db.NewTeamFactory(dbConn, lockFactory)
 
-	_, err := teamFactory.CreateDefaultTeamIfNotExists()
+	rolePolicy, err := cmd.rolePolicy()
 	if err != nil {
 		return nil, err
 	}
-	err = cmd.configureAuthForDefaultTeam(teamFactory)
+
+	_, err = teamFactory.CreateDefaultTeamIfNotExists()
+	if err != nil {
+		return nil, err
+	}
+	err = cmd.configureAuthForDefaultTeam(teamFactory, rolePolicy)
 	if err != nil {
 		return nil, err
 	}
@@ -519,11 +564,13 @@ func (cmd *RunCommand) constructAPIMembers(
 		return nil, err
 	}
 
-	variablesFactory, err := cmd.variablesFactory(logger)
+	variablesFactory, err := cmd.variablesFactory(logger, teamFactory)
 	if err != nil {
 		return nil, err
 	}
-	engine := cmd.constructEngine(workerClient, resourceFetcher, resourceFactory, dbResourceCacheFactory, variablesFactory, defaultLimits)
+
+	dbTaskOutputCacheFactory := db.NewTaskOutputCacheFactory(dbConn)
+	engine := cmd.constructEngine(workerClient, resourceFetcher, resourceFactory, dbResourceCacheFactory, dbTaskOutputCacheFactory, variablesFactory, teamFactory, defaultLimits)
 
 	dbResourceConfigCheckSessionFactory := db.NewResourceConfigCheckSessionFactory(dbConn, lockFactory)
 	radarSchedulerFactory := pipelines.NewRadarSchedulerFactory(
@@ -531,6 +578,7 @@ func (cmd *RunCommand) constructAPIMembers(
 		dbResourceConfigCheckSessionFactory,
 		cmd.ResourceTypeCheckingInterval,
 		cmd.ResourceCheckingInterval,
+		cmd.EnableGlobalResources,
 		engine,
 	)
 
@@ -539,6 +587,7 @@ func (cmd *RunCommand) constructAPIMembers(
 		dbResourceConfigCheckSessionFactory,
 		cmd.ResourceTypeCheckingInterval,
 		cmd.ResourceCheckingInterval,
+		cmd.EnableGlobalResources,
 		cmd.ExternalURL.String(),
 		variablesFactory,
 	)
@@ -551,7 +600,7 @@ func (cmd *RunCommand) constructAPIMembers(
 	dbContainerRepository := db.NewContainerRepository(dbConn)
 	gcContainerDestroyer := gc.NewDestroyer(logger, dbContainerRepository, dbVolumeRepository)
 	dbBuildFactory := db.NewBuildFactory(dbConn, lockFactory, cmd.GC.OneOffBuildGracePeriod)
-	accessFactory := accessor.NewAccessFactory(authHandler.PublicKey())
+	accessFactory := accessor.NewAccessFactory(authHandler.PublicKey(), rolePolicy)
 
 	apiHandler, err := cmd.constructAPIHandler(
 		logger,
@@ -574,6 +623,7 @@ func (cmd *RunCommand) constructAPIMembers(
 		variablesFactory,
 		credsManagers,
 		accessFactory,
+		rolePolicy,
 	)
 
 	if err != nil {
@@ -715,11 +765,13 @@ func (cmd *RunCommand) constructBackendMembers(
 		return nil, err
 	}
 
-	variablesFactory, err := cmd.variablesFactory(logger)
+	variablesFactory, err := cmd.variablesFactory(logger, teamFactory)
 	if err != nil {
 		return nil, err
 	}
-	engine := cmd.constructEngine(workerClient, resourceFetcher, resourceFactory, dbResourceCacheFactory, variablesFactory, defaultLimits)
+
+	dbTaskOutputCacheFactory := db.NewTaskOutputCacheFactory(dbConn)
+	engine := cmd.constructEngine(workerClient, resourceFetcher, resourceFactory, dbResourceCacheFactory, dbTaskOutputCacheFactory, variablesFactory, teamFactory, defaultLimits)
 
 	dbResourceConfigCheckSessionFactory := db.NewResourceConfigCheckSessionFactory(dbConn, lockFactory)
 	radarSchedulerFactory := pipelines.NewRadarSchedulerFactory(
@@ -727,6 +779,7 @@ func (cmd *RunCommand) constructBackendMembers(
 		dbResourceConfigCheckSessionFactory,
 		cmd.ResourceTypeCheckingInterval,
 		cmd.ResourceCheckingInterval,
+		cmd.EnableGlobalResources,
 		engine,
 	)
 	dbWorkerLifecycle := db.NewWorkerLifecycle(dbConn)
@@ -780,6 +833,8 @@ func (cmd *RunCommand) constructBackendMembers(
 				gc.NewVolumeCollector(
 					dbVolumeRepository,
 					cmd.GC.Interval*3, // volume missing-since grace period (must be larger than gc.interval so it doesn't race)
+					cmd.GC.ArtifactRetention,
+					cmd.GC.TaskOutputCacheRetention,
 				),
 				gc.NewContainerCollector(
 					dbContainerRepository,
@@ -818,6 +873,22 @@ func (cmd *RunCommand) constructBackendMembers(
 		)},
 	}
 
+	if cmd.BuildEventStore.Type != db.PostgresEventStoreName {
+		members = append(members, grouper.Member{
+			Name: "build-event-collector", Runner: lockrunner.NewRunner(
+				logger.Session("build-event-collector"),
+				gc.NewBuildEventCollector(
+					db.NewBuildEventMigrator(dbConn),
+					100,
+				),
+				"build-event-collector",
+				lockFactory,
+				clock.NewClock(),
+				cmd.BuildEventStore.MigrationInterval,
+			)},
+		)
+	}
+
 	//Syslog Drainer Configuration
 	if syslogDrainConfigured {
 		members = append(members, grouper.Member{
@@ -857,7 +928,7 @@ func workerVersion() (*version.Version, error) {
 	return workerVersion, nil
 }
 
-func (cmd *RunCommand) variablesFactory(logger lager.Logger) (creds.VariablesFactory, error) {
+func (cmd *RunCommand) variablesFactory(logger lager.Logger, teamFactory db.TeamFactory) (creds.VariablesFactory, error) {
 	var variablesFactory creds.VariablesFactory = noop.NewNoopFactory()
 	for name, manager := range cmd.CredentialManagers {
 		if !manager.IsConfigured() {
@@ -887,7 +958,13 @@ func (cmd *RunCommand) variablesFactory(logger lager.Logger) (creds.VariablesFac
 
 		break
 	}
-	return variablesFactory, nil
+
+	return creds.NewTeamVariablesFactory(
+		logger.Session("team-credential-managers"),
+		clock.NewClock(),
+		variablesFactory,
+		teamFactory,
+	), nil
 }
 
 func (cmd *RunCommand) newKey() *encryption.Key {
@@ -1073,6 +1150,13 @@ func (cmd *RunCommand) validate() error {
 		)
 	}
 
+	if cmd.BuildEventStore.Type == db.FileEventStoreName && cmd.BuildEventStore.Dir == "" {
+		errs = multierror.Append(
+			errs,
+			errors.New("must specify --build-event-store-dir to use the filesystem build event store"),
+		)
+	}
+
 	return errs.ErrorOrNil()
 }
 
@@ -1118,6 +1202,10 @@ func (cmd *RunCommand) constructDBConn(
 		dbConn = db.Log(logger.Session("log-conn"), dbConn)
 	}
 
+	if cmd.BuildEventStore.Type == db.FileEventStoreName {
+		dbConn = db.WithEventStore(dbConn, db.NewFileEventStore(cmd.BuildEventStore.Dir.Path()))
+	}
+
 	// Prepare
 	dbConn.SetMaxOpenConns(maxConn)
 
@@ -1146,21 +1234,40 @@ func (cmd *RunCommand) constructWorkerPool(
 	workerProvider worker.WorkerProvider,
 ) worker.Client {
 
-	var strategy worker.ContainerPlacementStrategy
-	switch cmd.ContainerPlacementStrategy {
-	case "random":
-		strategy = worker.NewRandomPlacementStrategy()
-	default:
-		strategy = worker.NewVolumeLocalityPlacementStrategy()
+	nodes := []worker.ContainerPlacementStrategyChainNode{}
+	for _, strategy := range cmd.ContainerPlacementStrategy {
+		switch strategy {
+		case "random":
+			nodes = append(nodes, worker.NewRandomPlacementStrategy())
+		case "fewest-build-containers":
+			nodes = append(nodes, worker.NewFewestBuildContainersPlacementStrategy())
+		default:
+			nodes = append(nodes, worker.NewVolumeLocalityPlacementStrategy())
+		}
 	}
 
 	return worker.NewPool(
+		clock.NewClock(),
 		workerProvider,
-		strategy,
+		worker.NewContainerPlacementStrategyChain(nodes...),
+		cmd.MaxActiveTasksPerWorker,
 	)
 }
 
-func (cmd *RunCommand) configureAuthForDefaultTeam(teamFactory db.TeamFactory) error {
+func (cmd *RunCommand) rolePolicy() (accessor.RolePolicy, error) {
+	if cmd.Auth.RolePolicy == "" {
+		return accessor.DefaultRolePolicy(), nil
+	}
+
+	policy, err := accessor.LoadRolePolicy(cmd.Auth.RolePolicy.Path())
+	if err != nil {
+		return nil, fmt.Errorf("failed to load role policy: %s", err)
+	}
+
+	return policy, nil
+}
+
+func (cmd *RunCommand) configureAuthForDefaultTeam(teamFactory db.TeamFactory, rolePolicy accessor.RolePolicy) error {
 	team, found, err := teamFactory.FindTeam(atc.DefaultTeamName)
 	if err != nil {
 		return err
@@ -1175,6 +1282,11 @@ func (cmd *RunCommand) configureAuthForDefaultTeam(teamFactory db.TeamFactory) e
 		return fmt.Errorf("default team auth not configured: %v", err)
 	}
 
+	err = rolePolicy.ValidateTeamAuth(atc.TeamAuth(auth))
+	if err != nil {
+		return fmt.Errorf("default team auth invalid: %v", err)
+	}
+
 	err = team.UpdateProviderAuth(atc.TeamAuth(auth))
 	if err != nil {
 		return err
@@ -1188,7 +1300,9 @@ func (cmd *RunCommand) constructEngine(
 	resourceFetcher resource.Fetcher,
 	resourceFactory resource.ResourceFactory,
 	dbResourceCacheFactory db.ResourceCacheFactory,
+	dbTaskOutputCacheFactory db.TaskOutputCacheFactory,
 	variablesFactory creds.VariablesFactory,
+	teamFactory db.TeamFactory,
 	defaultLimits atc.ContainerLimits,
 ) engine.Engine {
 	gardenFactory := exec.NewGardenFactory(
@@ -1196,7 +1310,9 @@ func (cmd *RunCommand) constructEngine(
 		resourceFetcher,
 		resourceFactory,
 		dbResourceCacheFactory,
+		dbTaskOutputCacheFactory,
 		variablesFactory,
+		teamFactory,
 		defaultLimits,
 	)
 
@@ -1263,6 +1379,7 @@ func (cmd *RunCommand) constructAPIHandler(
 	variablesFactory creds.VariablesFactory,
 	credsManagers creds.Managers,
 	accessFactory accessor.AccessFactory,
+	rolePolicy accessor.RolePolicy,
 ) (http.Handler, error) {
 
 	checkPipelineAccessHandlerFactory := auth.NewCheckPipelineAccessHandlerFactory(teamFactory)
@@ -1317,6 +1434,7 @@ func (cmd *RunCommand) constructAPIHandler(
 		variablesFactory,
 		credsManagers,
 		containerserver.NewInterceptTimeoutFactory(cmd.InterceptIdleTimeout),
+		rolePolicy,
 	)
 }
 
@@ -22,6 +22,9 @@ type Build struct {
 	StartTime    int64  `json:"start_time,omitempty"`
 	EndTime      int64  `json:"end_time,omitempty"`
 	ReapTime     int64  `json:"reap_time,omitempty"`
+	Cause        string `json:"cause,omitempty"`
+
+	Annotations map[string]string `json:"annotations,omitempty"`
 }
 
 func (b Build) IsRunning() bool {
@@ -59,4 +62,5 @@ type BuildPreparation struct {
 	Inputs              map[string]BuildPreparationStatus `json:"inputs"`
 	InputsSatisfied     BuildPreparationStatus            `json:"inputs_satisfied"`
 	MissingInputReasons MissingInputReasons               `json:"missing_input_reasons"`
+	BlockingReasons     []string                          `json:"blocking_reasons"`
 }
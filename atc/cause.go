@@ -1,6 +1,11 @@
 package atc
 
 type Cause struct {
-	VersionedResource VersionedResource
-	Build             Build
+	VersionedResourceID int     `json:"versioned_resource_id"`
+	BuildID             int     `json:"build_id"`
+	ParentBuildID       int     `json:"parent_build_id,omitempty"`
+	ResourceName        string  `json:"resource_name"`
+	Version             Version `json:"version"`
+	JobName             string  `json:"job_name"`
+	BuildName           string  `json:"build_name"`
 }
@@ -11,6 +11,10 @@ const ConfigVersionHeader = "X-Concourse-Config-Version"
 const DefaultPipelineName = "main"
 const DefaultTeamName = "main"
 
+// RunMessageRegex matches the names of messages which can be run against a
+// resource type, each of which is a script in /opt/resource.
+const RunMessageRegex = "^[a-z0-9][a-z0-9_-]*$"
+
 type Tags []string
 
 type ConfigResponse struct {
@@ -51,14 +55,39 @@ func (groups GroupConfigs) Lookup(name string) (GroupConfig, int, bool) {
 }
 
 type ResourceConfig struct {
-	Name         string  `yaml:"name" json:"name" mapstructure:"name"`
-	WebhookToken string  `yaml:"webhook_token,omitempty" json:"webhook_token" mapstructure:"webhook_token"`
-	Type         string  `yaml:"type" json:"type" mapstructure:"type"`
-	Source       Source  `yaml:"source" json:"source" mapstructure:"source"`
-	CheckEvery   string  `yaml:"check_every,omitempty" json:"check_every" mapstructure:"check_every"`
-	CheckTimeout string  `yaml:"check_timeout,omitempty" json:"check_timeout" mapstructure:"check_timeout"`
-	Tags         Tags    `yaml:"tags,omitempty" json:"tags" mapstructure:"tags"`
-	Version      Version `yaml:"version,omitempty" json:"version" mapstructure:"version"`
+	Name             string                  `yaml:"name" json:"name" mapstructure:"name"`
+	WebhookToken     string                  `yaml:"webhook_token,omitempty" json:"webhook_token" mapstructure:"webhook_token"`
+	WebhookSignature *WebhookSignatureConfig `yaml:"webhook_signature,omitempty" json:"webhook_signature,omitempty" mapstructure:"webhook_signature"`
+	WebhookFilters   []WebhookFilter         `yaml:"webhook_filters,omitempty" json:"webhook_filters,omitempty" mapstructure:"webhook_filters"`
+	Type             string                  `yaml:"type" json:"type" mapstructure:"type"`
+	Source           Source                  `yaml:"source" json:"source" mapstructure:"source"`
+	CheckEvery       string                  `yaml:"check_every,omitempty" json:"check_every" mapstructure:"check_every"`
+	CheckTimeout     string                  `yaml:"check_timeout,omitempty" json:"check_timeout" mapstructure:"check_timeout"`
+	Tags             Tags                    `yaml:"tags,omitempty" json:"tags" mapstructure:"tags"`
+	Version          Version                 `yaml:"version,omitempty" json:"version" mapstructure:"version"`
+}
+
+// CheckEveryNever disables periodic checking of a resource, for resources
+// which are only checked when their webhook is called.
+const CheckEveryNever = "never"
+
+const (
+	WebhookSignatureGitHub = "github"
+	WebhookSignatureGitLab = "gitlab"
+)
+
+// WebhookSignatureConfig configures how requests to a resource's webhook are
+// verified. The secret may be a credential, e.g. ((webhook-secret)).
+type WebhookSignatureConfig struct {
+	Type   string `yaml:"type" json:"type" mapstructure:"type"`
+	Secret string `yaml:"secret" json:"secret" mapstructure:"secret"`
+}
+
+// WebhookFilter matches webhook payloads whose value at the JSONPath Path is
+// one of Values, e.g. only pushes to refs/heads/main.
+type WebhookFilter struct {
+	Path   string   `yaml:"path" json:"path" mapstructure:"path"`
+	Values []string `yaml:"values" json:"values" mapstructure:"values"`
 }
 
 type ResourceType struct {
@@ -235,23 +264,63 @@ type PlanConfig struct {
 	// corresponding resource config, e.g. aws-stemcell
 	Resource string `yaml:"resource,omitempty" json:"resource,omitempty" mapstructure:"resource"`
 
+	// space of a v2 resource to get from or put to, e.g. a branch; the
+	// resource's default space is used if omitted
+	Space string `yaml:"space,omitempty" json:"space,omitempty" mapstructure:"space"`
+
 	// corresponds to a Task plan
 	// name of 'task', e.g. unit, go1.3, go1.4
 	Task string `yaml:"task,omitempty" json:"task,omitempty" mapstructure:"task"`
 	// run task privileged
 	Privileged bool `yaml:"privileged,omitempty" json:"privileged,omitempty" mapstructure:"privileged"`
-	// task config path, e.g. foo/build.yml
+	// task config path, e.g. foo/build.yml, pipeline config path for
+	// 'set_pipeline', or var file path for 'load_var'
 	TaskConfigPath string `yaml:"file,omitempty" json:"file,omitempty" mapstructure:"file"`
 	// inlined task config
 	TaskConfig *TaskConfig `yaml:"config,omitempty" json:"config,omitempty" mapstructure:"config"`
 
-	// used by Get and Put for specifying params to the resource
+	// corresponds to a SetPipeline plan
+	// name of the pipeline to configure, e.g. release-4.2
+	SetPipeline string `yaml:"set_pipeline,omitempty" json:"set_pipeline,omitempty" mapstructure:"set_pipeline"`
+	// variables to interpolate into the pipeline config
+	Vars Params `yaml:"vars,omitempty" json:"vars,omitempty" mapstructure:"vars"`
+	// artifact paths of files containing variables to interpolate into the
+	// pipeline config, e.g. ci/vars/release.yml
+	VarFiles []string `yaml:"var_files,omitempty" json:"var_files,omitempty" mapstructure:"var_files"`
+
+	// corresponds to a LoadVar plan
+	// name of the build-local var to load, e.g. version
+	LoadVar string `yaml:"load_var,omitempty" json:"load_var,omitempty" mapstructure:"load_var"`
+	// format of the var file: raw, json or yaml; detected from the file
+	// extension if omitted
+	Format string `yaml:"format,omitempty" json:"format,omitempty" mapstructure:"format"`
+	// redact the loaded value from the build log
+	Sensitive bool `yaml:"sensitive,omitempty" json:"sensitive,omitempty" mapstructure:"sensitive"`
+
+	// corresponds to a Run plan
+	// name of the message to run, e.g. delete-branch
+	Run string `yaml:"run,omitempty" json:"run,omitempty" mapstructure:"run"`
+	// resource type whose image runs the message, if not using a resource
+	Type string `yaml:"type,omitempty" json:"type,omitempty" mapstructure:"type"`
+	// artifacts to provide to the message and to collect from it
+	Inputs  []string `yaml:"inputs,omitempty" json:"inputs,omitempty" mapstructure:"inputs"`
+	Outputs []string `yaml:"outputs,omitempty" json:"outputs,omitempty" mapstructure:"outputs"`
+
+	// used by Get, Put and Run for specifying params to the resource
 	Params Params `yaml:"params,omitempty" json:"params,omitempty" mapstructure:"params"`
 
 	// used to pass specific inputs/outputs as generic inputs/outputs in task config
 	InputMapping  map[string]string `yaml:"input_mapping,omitempty" json:"input_mapping,omitempty" mapstructure:"input_mapping"`
 	OutputMapping map[string]string `yaml:"output_mapping,omitempty" json:"output_mapping,omitempty" mapstructure:"output_mapping"`
 
+	// used by Task to retain outputs as artifacts of the build, which can be
+	// downloaded after the build has finished
+	Artifacts []string `yaml:"artifacts,omitempty" json:"artifacts,omitempty" mapstructure:"artifacts"`
+
+	// used by Task to reuse the outputs of a previous successful run with the
+	// same config, params, image and inputs instead of running again
+	CacheOutputs bool `yaml:"cache_outputs,omitempty" json:"cache_outputs,omitempty" mapstructure:"cache_outputs"`
+
 	// used to specify an image artifact from a previous build to be used as the image for a subsequent task container
 	ImageArtifactName string `yaml:"image,omitempty" json:"image,omitempty" mapstructure:"image"`
 
@@ -285,6 +354,9 @@ type PlanConfig struct {
 	// repeat the step up to N times, until it works
 	Attempts int `yaml:"attempts,omitempty" json:"attempts,omitempty" mapstructure:"attempts"`
 
+	// run the step once for each value of a var, e.g. once per Go version
+	Across *AcrossConfig `yaml:"across,omitempty" json:"across,omitempty" mapstructure:"across"`
+
 	Version *VersionConfig `yaml:"version,omitempty" json:"version,omitempty" mapstructure:"version"`
 }
 
@@ -305,6 +377,18 @@ func (config PlanConfig) Name() string {
 		return config.Task
 	}
 
+	if config.SetPipeline != "" {
+		return config.SetPipeline
+	}
+
+	if config.LoadVar != "" {
+		return config.LoadVar
+	}
+
+	if config.Run != "" {
+		return config.Run
+	}
+
 	return ""
 }
 
@@ -331,6 +415,16 @@ func (config PlanConfig) Hooks() Hooks {
 	return Hooks{Abort: config.Abort, Failure: config.Failure, Ensure: config.Ensure, Success: config.Success}
 }
 
+// An AcrossConfig runs a step once for each of the values, with the var
+// interpolated as ((var)) in the step's config.
+type AcrossConfig struct {
+	Var    string        `yaml:"var" json:"var" mapstructure:"var"`
+	Values []interface{} `yaml:"values" json:"values" mapstructure:"values"`
+
+	// the number of iterations to run at once; defaults to 1
+	MaxInFlight int `yaml:"max_in_flight,omitempty" json:"max_in_flight,omitempty" mapstructure:"max_in_flight"`
+}
+
 type ResourceConfigs []ResourceConfig
 
 func (resources ResourceConfigs) Lookup(name string) (ResourceConfig, bool) {
@@ -0,0 +1,166 @@
+package creds
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sync"
+
+	"github.com/cloudfoundry/bosh-cli/director/template"
+)
+
+var localVarRegex = regexp.MustCompile(`\(\(\.:([-\w\pL]+)\)\)`)
+
+// LocalVariables are the vars set by steps within a build, e.g. by a
+// `load_var` step. Subsequent steps refer to them as ((.:name)).
+type LocalVariables struct {
+	lock sync.RWMutex
+	vars map[string]interface{}
+}
+
+func NewLocalVariables() *LocalVariables {
+	return &LocalVariables{
+		vars: map[string]interface{}{},
+	}
+}
+
+func (v *LocalVariables) Set(name string, value interface{}) {
+	v.lock.Lock()
+	v.vars[name] = value
+	v.lock.Unlock()
+}
+
+func (v *LocalVariables) Get(name string) (interface{}, bool) {
+	v.lock.RLock()
+	value, found := v.vars[name]
+	v.lock.RUnlock()
+
+	return value, found
+}
+
+// LocalVariableLookup is implemented by Variables which also resolve the vars
+// set within a build, referred to as ((.:name)). Variables which wrap others
+// should implement it by delegating, so that the local vars are not lost.
+type LocalVariableLookup interface {
+	LookupLocalVar(name string) (interface{}, bool)
+}
+
+// BuildVariables resolves ((.:name)) using the build's local vars, and every
+// other var using the credential manager. The credentials it resolves are
+// tracked so that they can be redacted from the build's logs.
+type BuildVariables struct {
+	Variables
+
+	local   *LocalVariables
+	secrets *SecretTracker
+}
+
+func NewBuildVariables(variables Variables, local *LocalVariables, secrets *SecretTracker) *BuildVariables {
+	return &BuildVariables{
+		Variables: variables,
+		local:     local,
+		secrets:   secrets,
+	}
+}
+
+func (v *BuildVariables) Get(varDef template.VariableDefinition) (interface{}, bool, error) {
+	value, found, err := v.Variables.Get(varDef)
+	if err != nil || !found {
+		return value, found, err
+	}
+
+	v.secrets.Track(value)
+
+	return value, true, nil
+}
+
+func (v *BuildVariables) LookupLocalVar(name string) (interface{}, bool) {
+	return v.local.Get(name)
+}
+
+func interpolateLocalVars(lookup LocalVariableLookup, payload []byte) ([]byte, error) {
+	if !localVarRegex.Match(payload) {
+		return payload, nil
+	}
+
+	var node interface{}
+	err := json.Unmarshal(payload, &node)
+	if err != nil {
+		return nil, err
+	}
+
+	node, err = interpolateLocal(lookup, node)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(node)
+}
+
+func interpolateLocal(lookup LocalVariableLookup, node interface{}) (interface{}, error) {
+	switch n := node.(type) {
+	case []interface{}:
+		for i, elem := range n {
+			interpolated, err := interpolateLocal(lookup, elem)
+			if err != nil {
+				return nil, err
+			}
+
+			n[i] = interpolated
+		}
+
+	case map[string]interface{}:
+		for key, elem := range n {
+			interpolated, err := interpolateLocal(lookup, elem)
+			if err != nil {
+				return nil, err
+			}
+
+			n[key] = interpolated
+		}
+
+	case string:
+		// preserve the type of the value when it makes up the whole string
+		if match := localVarRegex.FindStringSubmatch(n); match != nil && match[0] == n {
+			return localVar(lookup, match[1])
+		}
+
+		var err error
+
+		interpolated := localVarRegex.ReplaceAllStringFunc(n, func(match string) string {
+			value, lookupErr := localVar(lookup, localVarRegex.FindStringSubmatch(match)[1])
+			if lookupErr != nil {
+				err = lookupErr
+				return match
+			}
+
+			if str, ok := value.(string); ok {
+				return str
+			}
+
+			payload, marshalErr := json.Marshal(value)
+			if marshalErr != nil {
+				err = marshalErr
+				return match
+			}
+
+			return string(payload)
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return interpolated, nil
+	}
+
+	return node, nil
+}
+
+func localVar(lookup LocalVariableLookup, name string) (interface{}, error) {
+	value, found := lookup.LookupLocalVar(name)
+	if !found {
+		return nil, fmt.Errorf("undefined local var '%s'", name)
+	}
+
+	return value, nil
+}
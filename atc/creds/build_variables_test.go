@@ -0,0 +1,111 @@
+package creds_test
+
+import (
+	"github.com/cloudfoundry/bosh-cli/director/template"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("BuildVariables", func() {
+	var (
+		localVars *creds.LocalVariables
+		secrets   *creds.SecretTracker
+		variables creds.Variables
+	)
+
+	BeforeEach(func() {
+		localVars = creds.NewLocalVariables()
+		localVars.Set("version", "1.2.3")
+		localVars.Set("build", map[string]interface{}{"number": float64(42)})
+
+		secrets = creds.NewSecretTracker()
+
+		variables = creds.NewBuildVariables(template.StaticVariables{
+			"some-param": "some-secret",
+		}, localVars, secrets)
+	})
+
+	It("interpolates local vars alongside credentials", func() {
+		result, err := creds.NewParams(variables, atc.Params{
+			"version": "((.:version))",
+			"tag":     "v((.:version))-((some-param))",
+			"build":   "((.:build))",
+		}).Evaluate()
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(result).To(Equal(atc.Params{
+			"version": "1.2.3",
+			"tag":     "v1.2.3-some-secret",
+			"build":   map[string]interface{}{"number": 42},
+		}))
+	})
+
+	It("interpolates non-string local vars within a string as JSON", func() {
+		result, err := creds.NewSource(variables, atc.Source{
+			"info": "build: ((.:build))",
+		}).Evaluate()
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(result).To(Equal(atc.Source{
+			"info": `build: {"number":42}`,
+		}))
+	})
+
+	It("errors when a local var is not defined", func() {
+		_, err := creds.NewParams(variables, atc.Params{
+			"missing": "((.:missing))",
+		}).Evaluate()
+		Expect(err).To(MatchError("undefined local var 'missing'"))
+	})
+
+	It("sees local vars set after the variables were created", func() {
+		localVars.Set("digest", "sha256:abc")
+
+		result, err := creds.NewParams(variables, atc.Params{
+			"digest": "((.:digest))",
+		}).Evaluate()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(atc.Params{"digest": "sha256:abc"}))
+	})
+
+	It("tracks the credentials it resolves", func() {
+		_, err := creds.NewParams(variables, atc.Params{
+			"param":   "((some-param))",
+			"version": "((.:version))",
+		}).Evaluate()
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(secrets.Redact("some-secret 1.2.3")).To(Equal("((redacted)) 1.2.3"))
+	})
+
+	Context("when wrapped by other variables", func() {
+		It("interpolates local vars through the wrapper", func() {
+			result, err := creds.NewParams(wrappedVariables{variables.(*creds.BuildVariables)}, atc.Params{
+				"tag": "v((.:version))-((some-param))",
+			}).Evaluate()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(atc.Params{"tag": "v1.2.3-some-secret"}))
+		})
+	})
+})
+
+// wrappedVariables wraps variables the way e.g. a redacting wrapper would,
+// delegating local var lookups explicitly rather than by embedding.
+type wrappedVariables struct {
+	wrapped *creds.BuildVariables
+}
+
+func (v wrappedVariables) Get(varDef template.VariableDefinition) (interface{}, bool, error) {
+	return v.wrapped.Get(varDef)
+}
+
+func (v wrappedVariables) List() ([]template.VariableDefinition, error) {
+	return v.wrapped.List()
+}
+
+func (v wrappedVariables) LookupLocalVar(name string) (interface{}, bool) {
+	return v.wrapped.LookupLocalVar(name)
+}
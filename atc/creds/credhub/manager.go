@@ -152,6 +152,8 @@ func (manager CredHubManager) NewVariablesFactory(logger lager.Logger) (creds.Va
 	return NewCredHubFactory(logger, manager.Client, manager.PathPrefix), nil
 }
 
+func (manager CredHubManager) Close(logger lager.Logger) {}
+
 type LazyCredhub struct {
 	url     string
 	options []credhub.Option
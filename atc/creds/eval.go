@@ -13,6 +13,13 @@ func evaluate(variablesResolver Variables, in, out interface{}) error {
 		return err
 	}
 
+	if lookup, ok := variablesResolver.(LocalVariableLookup); ok {
+		byteParams, err = interpolateLocalVars(lookup, byteParams)
+		if err != nil {
+			return err
+		}
+	}
+
 	tpl := template.NewTemplate(byteParams)
 
 	bytes, err := tpl.Evaluate(variablesResolver, nil, template.EvaluateOpts{
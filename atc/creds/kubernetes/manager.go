@@ -71,3 +71,5 @@ func (manager KubernetesManager) NewVariablesFactory(logger lager.Logger) (creds
 
 	return NewKubernetesFactory(logger, clientset, manager.NamespacePrefix), nil
 }
+
+func (manager KubernetesManager) Close(logger lager.Logger) {}
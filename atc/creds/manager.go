@@ -1,10 +1,16 @@
 package creds
 
 import (
+	"fmt"
+	"sort"
+	"strconv"
+
 	"code.cloudfoundry.org/lager"
 	flags "github.com/jessevdk/go-flags"
 )
 
+//go:generate counterfeiter . Manager
+
 type Manager interface {
 	IsConfigured() bool
 	Validate() error
@@ -12,8 +18,14 @@ type Manager interface {
 	Init(lager.Logger) error
 
 	NewVariablesFactory(lager.Logger) (VariablesFactory, error)
+
+	// Close stops anything the manager started in the background, such as
+	// token renewal. The manager's variables must not be used afterwards.
+	Close(lager.Logger)
 }
 
+//go:generate counterfeiter . ManagerFactory
+
 type ManagerFactory interface {
 	AddConfig(*flags.Group) Manager
 }
@@ -35,3 +47,218 @@ func Register(name string, managerFactory ManagerFactory) {
 func ManagerFactories() map[string]ManagerFactory {
 	return managerFactories
 }
+
+// NewManager constructs a manager of the given type from a config such as
+// {"url": "https://vault.example.com", "auth-backend": "approle"}, where each
+// key is the name of the manager's flag without its namespace, e.g. "url" for
+// --vault-url.
+//
+// Booleans enable a flag, lists specify a flag multiple times, and maps
+// specify a flag once per key as "key=value".
+func NewManager(managerType string, config map[string]interface{}) (Manager, error) {
+	factory, found := managerFactories[managerType]
+	if !found {
+		return nil, fmt.Errorf("unknown credential manager type: %s", managerType)
+	}
+
+	parser := flags.NewNamedParser(managerType, flags.None)
+	parser.NamespaceDelimiter = "-"
+
+	manager := factory.AddConfig(parser.Command.Group)
+
+	namespace := ""
+	for _, group := range parser.Groups() {
+		namespace = group.Namespace
+	}
+
+	args, err := managerArgs(namespace, config)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = parser.ParseArgs(args)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s config: %s", managerType, err)
+	}
+
+	return manager, nil
+}
+
+// teamManagerOptions lists the options a team may set for each type of
+// credential manager it may configure for itself. Anything else is rejected:
+// options which read files on the ATC (e.g. --vault-ca-cert), which fall back
+// to the ATC's own credentials (e.g. an instance role, or the in-cluster
+// service account), or which change where a team's secrets are looked up
+// (e.g. --vault-path-prefix) would all let a team read secrets which are not
+// its own. A team may set its vault namespace, which it logs in to with its
+// own credentials.
+var teamManagerOptions = map[string][]string{
+	"vault": {
+		"url",
+		"namespace",
+		"cache",
+		"max-lease",
+		"server-name",
+		"insecure-skip-verify",
+		"client-token",
+		"auth-backend",
+		"auth-backend-max-ttl",
+		"retry-max",
+		"retry-initial",
+		"auth-param",
+	},
+	"credhub": {
+		"url",
+		"insecure-skip-verify",
+		"client-id",
+		"client-secret",
+	},
+	"ssm": {
+		"access-key",
+		"secret-key",
+		"session-token",
+		"region",
+	},
+	"secretsmanager": {
+		"access-key",
+		"secret-key",
+		"session-token",
+		"region",
+	},
+}
+
+// teamManagerRequiredOptions lists the options a team must set, where leaving
+// them out would fall back to the ATC's own credentials.
+var teamManagerRequiredOptions = map[string][]string{
+	"ssm":            {"access-key", "secret-key"},
+	"secretsmanager": {"access-key", "secret-key"},
+}
+
+// teamVaultAuthBackends lists the vault auth backends a team may log in
+// with. The others (e.g. "cert", "aws", "kubernetes") authenticate using
+// something the ATC has rather than the params given.
+var teamVaultAuthBackends = []string{
+	"approle",
+	"github",
+	"ldap",
+	"okta",
+	"radius",
+	"userpass",
+}
+
+// NewTeamManager constructs a manager configured by a team, in the same way as
+// NewManager, after checking that the config only uses options which are safe
+// for a team to set.
+func NewTeamManager(managerType string, config map[string]interface{}) (Manager, error) {
+	options, found := teamManagerOptions[managerType]
+	if !found {
+		return nil, fmt.Errorf("credential manager type '%s' cannot be configured by a team", managerType)
+	}
+
+	for key := range config {
+		if !containsString(options, key) {
+			return nil, fmt.Errorf("option '%s' cannot be configured by a team", key)
+		}
+	}
+
+	for _, key := range teamManagerRequiredOptions[managerType] {
+		if _, found := config[key]; !found {
+			return nil, fmt.Errorf("option '%s' must be configured", key)
+		}
+	}
+
+	if backend, found := config["auth-backend"]; found {
+		name, ok := backend.(string)
+		if !ok || !containsString(teamVaultAuthBackends, name) {
+			return nil, fmt.Errorf("auth backend '%v' cannot be configured by a team", backend)
+		}
+	}
+
+	return NewManager(managerType, config)
+}
+
+func containsString(list []string, str string) bool {
+	for _, elem := range list {
+		if elem == str {
+			return true
+		}
+	}
+
+	return false
+}
+
+func managerArgs(namespace string, config map[string]interface{}) ([]string, error) {
+	keys := []string{}
+	for key := range config {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	args := []string{}
+	for _, key := range keys {
+		flag := "--" + key
+		if namespace != "" {
+			flag = "--" + namespace + "-" + key
+		}
+
+		switch value := config[key].(type) {
+		case bool:
+			if value {
+				args = append(args, flag)
+			}
+
+		case []interface{}:
+			for _, elem := range value {
+				str, err := managerArgValue(key, elem)
+				if err != nil {
+					return nil, err
+				}
+
+				args = append(args, flag+"="+str)
+			}
+
+		case map[string]interface{}:
+			names := []string{}
+			for name := range value {
+				names = append(names, name)
+			}
+
+			sort.Strings(names)
+
+			for _, name := range names {
+				str, err := managerArgValue(key, value[name])
+				if err != nil {
+					return nil, err
+				}
+
+				args = append(args, flag+"="+name+"="+str)
+			}
+
+		default:
+			str, err := managerArgValue(key, value)
+			if err != nil {
+				return nil, err
+			}
+
+			args = append(args, flag+"="+str)
+		}
+	}
+
+	return args, nil
+}
+
+func managerArgValue(key string, value interface{}) (string, error) {
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case int:
+		return strconv.Itoa(v), nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	default:
+		return "", fmt.Errorf("invalid value for '%s': %v", key, value)
+	}
+}
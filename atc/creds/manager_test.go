@@ -0,0 +1,123 @@
+package creds_test
+
+import (
+	"time"
+
+	"github.com/cloudfoundry/bosh-cli/director/template"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/creds/vault"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NewManager", func() {
+	It("configures the manager from its flags", func() {
+		manager, err := creds.NewManager("vault", map[string]interface{}{
+			"url":          "https://vault.example.com",
+			"path-prefix":  "/team-a",
+			"client-token": "some-token",
+			"cache":        true,
+			"max-lease":    "1h",
+			"auth-param":   map[string]interface{}{"role_id": "some-role"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		vaultManager, ok := manager.(*vault.VaultManager)
+		Expect(ok).To(BeTrue())
+
+		Expect(vaultManager.URL).To(Equal("https://vault.example.com"))
+		Expect(vaultManager.PathPrefix).To(Equal("/team-a"))
+		Expect(vaultManager.Auth.ClientToken).To(Equal("some-token"))
+		Expect(vaultManager.Cache).To(BeTrue())
+		Expect(vaultManager.MaxLease).To(Equal(time.Hour))
+		Expect(vaultManager.Auth.Params).To(Equal([]template.VarKV{
+			{Name: "role_id", Value: "some-role"},
+		}))
+	})
+
+	It("applies the flags' defaults", func() {
+		manager, err := creds.NewManager("vault", map[string]interface{}{
+			"url": "https://vault.example.com",
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(manager.(*vault.VaultManager).PathPrefix).To(Equal("/concourse"))
+	})
+
+	It("errors for an unknown flag", func() {
+		_, err := creds.NewManager("vault", map[string]interface{}{
+			"bogus": "value",
+		})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("invalid vault config"))
+	})
+
+	It("errors for an unknown type", func() {
+		_, err := creds.NewManager("bogus", nil)
+		Expect(err).To(MatchError("unknown credential manager type: bogus"))
+	})
+})
+
+var _ = Describe("NewTeamManager", func() {
+	It("configures the manager from the options a team may set", func() {
+		manager, err := creds.NewTeamManager("vault", map[string]interface{}{
+			"url":          "https://vault.example.com",
+			"auth-backend": "approle",
+			"auth-param":   map[string]interface{}{"role_id": "some-role"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		vaultManager := manager.(*vault.VaultManager)
+		Expect(vaultManager.URL).To(Equal("https://vault.example.com"))
+		Expect(vaultManager.PathPrefix).To(Equal("/concourse"))
+		Expect(vaultManager.Auth.Backend).To(Equal("approle"))
+	})
+
+	It("allows a team to set its vault namespace", func() {
+		manager, err := creds.NewTeamManager("vault", map[string]interface{}{
+			"url":          "https://vault.example.com",
+			"namespace":    "team-a",
+			"client-token": "some-token",
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(manager.(*vault.VaultManager).Namespace).To(Equal("team-a"))
+	})
+
+	It("rejects options which read files on the ATC", func() {
+		_, err := creds.NewTeamManager("vault", map[string]interface{}{
+			"url":         "https://vault.example.com",
+			"client-cert": "/etc/concourse/vault-cert.pem",
+		})
+		Expect(err).To(MatchError("option 'client-cert' cannot be configured by a team"))
+	})
+
+	It("rejects options which change where secrets are looked up", func() {
+		_, err := creds.NewTeamManager("vault", map[string]interface{}{
+			"url":         "https://vault.example.com",
+			"path-prefix": "/other-team",
+		})
+		Expect(err).To(MatchError("option 'path-prefix' cannot be configured by a team"))
+	})
+
+	It("rejects auth backends which use the ATC's own credentials", func() {
+		_, err := creds.NewTeamManager("vault", map[string]interface{}{
+			"url":          "https://vault.example.com",
+			"auth-backend": "cert",
+		})
+		Expect(err).To(MatchError("auth backend 'cert' cannot be configured by a team"))
+	})
+
+	It("rejects types which can only use the ATC's own credentials", func() {
+		_, err := creds.NewTeamManager("kubernetes", map[string]interface{}{})
+		Expect(err).To(MatchError("credential manager type 'kubernetes' cannot be configured by a team"))
+	})
+
+	It("requires static credentials for types which would otherwise use the ATC's", func() {
+		_, err := creds.NewTeamManager("ssm", map[string]interface{}{
+			"region": "us-east-1",
+		})
+		Expect(err).To(MatchError("option 'access-key' must be configured"))
+	})
+})
@@ -0,0 +1,91 @@
+package creds
+
+import (
+	"sort"
+	"strings"
+	"sync"
+)
+
+const RedactedValue = "((redacted))"
+
+// MinRedactedLength is the length below which values are not redacted, since
+// short values such as "1" or "true" would otherwise blank out unrelated
+// output.
+const MinRedactedLength = 6
+
+// SecretTracker records the credentials resolved during a build, so that
+// they can be redacted from the build's logs.
+type SecretTracker struct {
+	lock    sync.RWMutex
+	secrets []string
+}
+
+func NewSecretTracker() *SecretTracker {
+	return &SecretTracker{}
+}
+
+// Track records every string within the value, e.g. each field of a
+// credential which is a map. Each line of a multi-line secret is also
+// recorded on its own, as output is often logged line by line.
+func (t *SecretTracker) Track(value interface{}) {
+	switch v := value.(type) {
+	case string:
+		t.track(v)
+
+		if strings.Contains(v, "\n") {
+			for _, line := range strings.Split(v, "\n") {
+				t.track(strings.TrimSpace(line))
+			}
+		}
+
+	case []interface{}:
+		for _, elem := range v {
+			t.Track(elem)
+		}
+
+	case map[string]interface{}:
+		for _, elem := range v {
+			t.Track(elem)
+		}
+
+	case map[interface{}]interface{}:
+		for _, elem := range v {
+			t.Track(elem)
+		}
+	}
+}
+
+func (t *SecretTracker) track(secret string) {
+	if len(secret) < MinRedactedLength {
+		return
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	for _, existing := range t.secrets {
+		if existing == secret {
+			return
+		}
+	}
+
+	t.secrets = append(t.secrets, secret)
+
+	// redact longer secrets first, so that a secret containing another is
+	// redacted whole
+	sort.SliceStable(t.secrets, func(i, j int) bool {
+		return len(t.secrets[i]) > len(t.secrets[j])
+	})
+}
+
+// Redact replaces every tracked secret within the text with ((redacted)).
+func (t *SecretTracker) Redact(text string) string {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	for _, secret := range t.secrets {
+		text = strings.Replace(text, secret, RedactedValue, -1)
+	}
+
+	return text
+}
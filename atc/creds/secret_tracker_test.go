@@ -0,0 +1,59 @@
+package creds_test
+
+import (
+	"github.com/concourse/concourse/atc/creds"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SecretTracker", func() {
+	var secrets *creds.SecretTracker
+
+	BeforeEach(func() {
+		secrets = creds.NewSecretTracker()
+	})
+
+	It("redacts tracked values", func() {
+		secrets.Track("hunter2")
+
+		Expect(secrets.Redact("password: hunter2, again: hunter2")).To(Equal("password: ((redacted)), again: ((redacted))"))
+	})
+
+	It("leaves text without secrets alone", func() {
+		secrets.Track("hunter2")
+
+		Expect(secrets.Redact("nothing to see here")).To(Equal("nothing to see here"))
+	})
+
+	It("redacts every string within maps and lists", func() {
+		secrets.Track(map[string]interface{}{
+			"username": "some-user",
+			"keys":     []interface{}{"some-key-a", "some-key-b"},
+			"port":     float64(443),
+		})
+
+		Expect(secrets.Redact("some-user some-key-a some-key-b 443")).To(Equal("((redacted)) ((redacted)) ((redacted)) 443"))
+	})
+
+	It("redacts each line of a multi-line secret", func() {
+		secrets.Track("-----BEGIN KEY-----\n  abc123\n-----END KEY-----\n")
+
+		Expect(secrets.Redact("line: abc123")).To(Equal("line: ((redacted))"))
+	})
+
+	It("redacts a secret containing another secret whole", func() {
+		secrets.Track("secret")
+		secrets.Track("secret-password")
+
+		Expect(secrets.Redact("secret-password")).To(Equal("((redacted))"))
+	})
+
+	It("ignores values too short to redact without blanking out unrelated text", func() {
+		secrets.Track("")
+		secrets.Track("1")
+		secrets.Track("true")
+
+		Expect(secrets.Redact("attempt 1: true")).To(Equal("attempt 1: true"))
+	})
+})
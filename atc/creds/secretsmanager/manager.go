@@ -161,3 +161,5 @@ func (manager *Manager) NewVariablesFactory(log lager.Logger) (creds.VariablesFa
 
 	return NewSecretsManagerFactory(log, sess, []*template.Template{pipelineSecretTemplate, teamSecretTemplate}), nil
 }
+
+func (manager *Manager) Close(logger lager.Logger) {}
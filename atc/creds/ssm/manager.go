@@ -173,3 +173,5 @@ func (manager *SsmManager) NewVariablesFactory(log lager.Logger) (creds.Variable
 
 	return NewSsmFactory(log, session, []*template.Template{pipelineSecretTemplate, teamSecretTemplate}), nil
 }
+
+func (manager *SsmManager) Close(logger lager.Logger) {}
@@ -0,0 +1,226 @@
+package creds
+
+import (
+	"reflect"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"github.com/cloudfoundry/bosh-cli/director/template"
+	"github.com/concourse/concourse/atc"
+)
+
+//go:generate counterfeiter . TeamManagerConfigs
+
+// TeamManagerConfigs looks up the credential manager configured by a team, if
+// any.
+type TeamManagerConfigs interface {
+	FindCredentialManagerConfig(teamName string) (atc.CredentialManagerConfig, bool, error)
+}
+
+// teamConfigCacheDuration is how long a team's config is used before it is
+// looked up again, so that it is not fetched and decrypted for every build
+// and check. Changes to a team's config take up to this long to apply.
+const teamConfigCacheDuration = time.Minute
+
+type teamVariablesFactory struct {
+	logger  lager.Logger
+	clock   clock.Clock
+	global  VariablesFactory
+	configs TeamManagerConfigs
+
+	teamsL sync.Mutex
+	teams  map[string]*teamState
+}
+
+// teamState is the cached config and configured manager of a team. Its lock
+// is held while the config is looked up and the manager is configured, so
+// that a team whose credential manager is slow to set up only holds up its
+// own lookups.
+type teamState struct {
+	sync.Mutex
+
+	config  *teamConfig
+	factory *teamFactory
+}
+
+type teamConfig struct {
+	config    atc.CredentialManagerConfig
+	found     bool
+	fetchedAt time.Time
+}
+
+type teamFactory struct {
+	config    atc.CredentialManagerConfig
+	manager   Manager
+	variables VariablesFactory
+}
+
+// NewTeamVariablesFactory returns a VariablesFactory which resolves vars using
+// the credential manager configured by the team, falling back to the global
+// one for teams which have not configured their own.
+//
+// Managers are initialized when first used by a team, and reused until the
+// team's config changes, at which point the old manager is closed.
+func NewTeamVariablesFactory(logger lager.Logger, clock clock.Clock, global VariablesFactory, configs TeamManagerConfigs) VariablesFactory {
+	return &teamVariablesFactory{
+		logger:  logger,
+		clock:   clock,
+		global:  global,
+		configs: configs,
+
+		teams: map[string]*teamState{},
+	}
+}
+
+func (factory *teamVariablesFactory) NewVariables(teamName string, pipelineName string) Variables {
+	variablesFactory, err := factory.variablesFactory(teamName)
+	if err != nil {
+		return errVariables{err}
+	}
+
+	return variablesFactory.NewVariables(teamName, pipelineName)
+}
+
+func (factory *teamVariablesFactory) team(teamName string) *teamState {
+	factory.teamsL.Lock()
+	defer factory.teamsL.Unlock()
+
+	team, found := factory.teams[teamName]
+	if !found {
+		team = &teamState{}
+		factory.teams[teamName] = team
+	}
+
+	return team
+}
+
+// variablesFactory returns the factory to create the team's variables with:
+// the one of the team's credential manager, or the global one if the team
+// has not configured one.
+func (factory *teamVariablesFactory) variablesFactory(teamName string) (VariablesFactory, error) {
+	team := factory.team(teamName)
+
+	team.Lock()
+	defer team.Unlock()
+
+	config, found, err := factory.teamConfig(teamName, team)
+	if err != nil {
+		factory.logger.Error("failed-to-find-team-credential-manager", err, lager.Data{"team": teamName})
+		return nil, err
+	}
+
+	if !found {
+		factory.closeTeamFactory(teamName, team)
+		return factory.global, nil
+	}
+
+	variablesFactory, err := factory.teamVariablesFactory(teamName, team, config)
+	if err != nil {
+		factory.logger.Error("failed-to-configure-team-credential-manager", err, lager.Data{"team": teamName})
+		return nil, err
+	}
+
+	return variablesFactory, nil
+}
+
+func (factory *teamVariablesFactory) teamConfig(teamName string, team *teamState) (atc.CredentialManagerConfig, bool, error) {
+	now := factory.clock.Now()
+
+	cached := team.config
+	if cached != nil && now.Sub(cached.fetchedAt) < teamConfigCacheDuration {
+		return cached.config, cached.found, nil
+	}
+
+	config, found, err := factory.configs.FindCredentialManagerConfig(teamName)
+	if err != nil {
+		return atc.CredentialManagerConfig{}, false, err
+	}
+
+	team.config = &teamConfig{
+		config:    config,
+		found:     found,
+		fetchedAt: now,
+	}
+
+	return config, found, nil
+}
+
+func (factory *teamVariablesFactory) teamVariablesFactory(teamName string, team *teamState, config atc.CredentialManagerConfig) (VariablesFactory, error) {
+	existing := team.factory
+	if existing != nil && reflect.DeepEqual(existing.config, config) {
+		return existing.variables, nil
+	}
+
+	factory.closeTeamFactory(teamName, team)
+
+	logger := factory.logger.Session("team-credential-manager", lager.Data{
+		"team": teamName,
+		"type": config.Type,
+	})
+
+	manager, err := NewTeamManager(config.Type, config.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	err = manager.Init(logger)
+	if err != nil {
+		return nil, err
+	}
+
+	err = manager.Validate()
+	if err != nil {
+		manager.Close(logger)
+		return nil, err
+	}
+
+	variablesFactory, err := manager.NewVariablesFactory(logger)
+	if err != nil {
+		manager.Close(logger)
+		return nil, err
+	}
+
+	logger.Info("configured")
+
+	team.factory = &teamFactory{
+		config:    config,
+		manager:   manager,
+		variables: variablesFactory,
+	}
+
+	return variablesFactory, nil
+}
+
+func (factory *teamVariablesFactory) closeTeamFactory(teamName string, team *teamState) {
+	existing := team.factory
+	if existing == nil {
+		return
+	}
+
+	logger := factory.logger.Session("team-credential-manager", lager.Data{
+		"team": teamName,
+		"type": existing.config.Type,
+	})
+
+	existing.manager.Close(logger)
+
+	team.factory = nil
+
+	logger.Info("closed")
+}
+
+// errVariables fails every lookup, so that a team whose credential manager
+// cannot be used does not fall back to the global one.
+type errVariables struct {
+	err error
+}
+
+func (v errVariables) Get(template.VariableDefinition) (interface{}, bool, error) {
+	return nil, false, v.err
+}
+
+func (v errVariables) List() ([]template.VariableDefinition, error) {
+	return nil, v.err
+}
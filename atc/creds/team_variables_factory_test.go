@@ -0,0 +1,241 @@
+package creds_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/cloudfoundry/bosh-cli/director/template"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/creds/credsfakes"
+
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TeamVariablesFactory", func() {
+	var (
+		fakeGlobalFactory  *credsfakes.FakeVariablesFactory
+		fakeConfigs        *credsfakes.FakeTeamManagerConfigs
+		fakeManagerFactory *credsfakes.FakeManagerFactory
+		fakeManager        *credsfakes.FakeManager
+		fakeTeamFactory    *credsfakes.FakeVariablesFactory
+		fakeClock          *fakeclock.FakeClock
+
+		globalVariables creds.Variables
+		teamVariables   creds.Variables
+
+		variablesFactory creds.VariablesFactory
+	)
+
+	BeforeEach(func() {
+		globalVariables = template.StaticVariables{"some-var": "global"}
+		teamVariables = template.StaticVariables{"some-var": "team"}
+
+		fakeGlobalFactory = new(credsfakes.FakeVariablesFactory)
+		fakeGlobalFactory.NewVariablesReturns(globalVariables)
+
+		fakeTeamFactory = new(credsfakes.FakeVariablesFactory)
+		fakeTeamFactory.NewVariablesReturns(teamVariables)
+
+		fakeManager = new(credsfakes.FakeManager)
+		fakeManager.NewVariablesFactoryReturns(fakeTeamFactory, nil)
+
+		fakeManagerFactory = new(credsfakes.FakeManagerFactory)
+		fakeManagerFactory.AddConfigStub = func(group *flags.Group) creds.Manager {
+			group.AddGroup("Fake SSM", "", &struct {
+				AccessKey string `long:"access-key"`
+				SecretKey string `long:"secret-key"`
+			}{})
+
+			return fakeManager
+		}
+
+		// stands in for a type which teams may configure, and which isn't
+		// otherwise used by these tests
+		creds.Register("ssm", fakeManagerFactory)
+
+		fakeConfigs = new(credsfakes.FakeTeamManagerConfigs)
+
+		fakeClock = fakeclock.NewFakeClock(time.Unix(123, 456))
+
+		variablesFactory = creds.NewTeamVariablesFactory(
+			lagertest.NewTestLogger("test"),
+			fakeClock,
+			fakeGlobalFactory,
+			fakeConfigs,
+		)
+	})
+
+	Context("when the team has not configured a credential manager", func() {
+		It("uses the global credential manager", func() {
+			variables := variablesFactory.NewVariables("some-team", "some-pipeline")
+			Expect(variables).To(Equal(globalVariables))
+
+			teamName, pipelineName := fakeGlobalFactory.NewVariablesArgsForCall(0)
+			Expect(teamName).To(Equal("some-team"))
+			Expect(pipelineName).To(Equal("some-pipeline"))
+
+			Expect(fakeConfigs.FindCredentialManagerConfigArgsForCall(0)).To(Equal("some-team"))
+		})
+	})
+
+	Context("when the team has configured a credential manager", func() {
+		var config atc.CredentialManagerConfig
+
+		BeforeEach(func() {
+			config = atc.CredentialManagerConfig{
+				Type: "ssm",
+				Config: map[string]interface{}{
+					"access-key": "some-access-key",
+					"secret-key": "some-secret-key",
+				},
+			}
+
+			fakeConfigs.FindCredentialManagerConfigReturns(config, true, nil)
+		})
+
+		It("uses the team's credential manager", func() {
+			variables := variablesFactory.NewVariables("some-team", "some-pipeline")
+			Expect(variables).To(Equal(teamVariables))
+
+			Expect(fakeGlobalFactory.NewVariablesCallCount()).To(BeZero())
+
+			Expect(fakeManager.InitCallCount()).To(Equal(1))
+			Expect(fakeManager.ValidateCallCount()).To(Equal(1))
+		})
+
+		It("reuses the manager while the config is unchanged", func() {
+			variablesFactory.NewVariables("some-team", "some-pipeline")
+			variablesFactory.NewVariables("some-team", "other-pipeline")
+
+			Expect(fakeManagerFactory.AddConfigCallCount()).To(Equal(1))
+			Expect(fakeTeamFactory.NewVariablesCallCount()).To(Equal(2))
+		})
+
+		It("caches the config rather than looking it up every time", func() {
+			variablesFactory.NewVariables("some-team", "some-pipeline")
+			variablesFactory.NewVariables("some-team", "other-pipeline")
+
+			Expect(fakeConfigs.FindCredentialManagerConfigCallCount()).To(Equal(1))
+
+			fakeClock.Increment(time.Minute)
+
+			variablesFactory.NewVariables("some-team", "some-pipeline")
+
+			Expect(fakeConfigs.FindCredentialManagerConfigCallCount()).To(Equal(2))
+		})
+
+		It("reconfigures the manager and closes the old one when the config changes", func() {
+			variablesFactory.NewVariables("some-team", "some-pipeline")
+
+			fakeConfigs.FindCredentialManagerConfigReturns(atc.CredentialManagerConfig{
+				Type: "ssm",
+				Config: map[string]interface{}{
+					"access-key": "other-access-key",
+					"secret-key": "other-secret-key",
+				},
+			}, true, nil)
+
+			fakeClock.Increment(time.Minute)
+
+			variablesFactory.NewVariables("some-team", "some-pipeline")
+
+			Expect(fakeManagerFactory.AddConfigCallCount()).To(Equal(2))
+			Expect(fakeManager.CloseCallCount()).To(Equal(1))
+		})
+
+		It("closes the manager when the config is cleared", func() {
+			variablesFactory.NewVariables("some-team", "some-pipeline")
+
+			fakeConfigs.FindCredentialManagerConfigReturns(atc.CredentialManagerConfig{}, false, nil)
+
+			fakeClock.Increment(time.Minute)
+
+			variables := variablesFactory.NewVariables("some-team", "some-pipeline")
+			Expect(variables).To(Equal(globalVariables))
+
+			Expect(fakeManager.CloseCallCount()).To(Equal(1))
+		})
+
+		Context("when another team's credential manager is slow to configure", func() {
+			var release chan struct{}
+
+			BeforeEach(func() {
+				release = make(chan struct{})
+
+				fakeConfigs.FindCredentialManagerConfigStub = func(teamName string) (atc.CredentialManagerConfig, bool, error) {
+					if teamName == "slow-team" {
+						<-release
+					}
+
+					return config, true, nil
+				}
+			})
+
+			AfterEach(func() {
+				close(release)
+			})
+
+			It("does not hold up the team's lookups", func() {
+				go variablesFactory.NewVariables("slow-team", "some-pipeline")
+
+				Eventually(fakeConfigs.FindCredentialManagerConfigCallCount).Should(Equal(1))
+
+				done := make(chan creds.Variables)
+				go func() {
+					done <- variablesFactory.NewVariables("some-team", "some-pipeline")
+				}()
+
+				Eventually(done).Should(Receive(Equal(teamVariables)))
+			})
+		})
+
+		Context("when the config uses options a team may not set", func() {
+			BeforeEach(func() {
+				config.Config["pipeline-secret-template"] = "/concourse/other-team/{{.Secret}}"
+				fakeConfigs.FindCredentialManagerConfigReturns(config, true, nil)
+			})
+
+			It("fails every lookup", func() {
+				variables := variablesFactory.NewVariables("some-team", "some-pipeline")
+
+				_, _, err := variables.Get(template.VariableDefinition{Name: "some-var"})
+				Expect(err).To(MatchError("option 'pipeline-secret-template' cannot be configured by a team"))
+
+				Expect(fakeManagerFactory.AddConfigCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the manager is misconfigured", func() {
+			BeforeEach(func() {
+				fakeManager.ValidateReturns(errors.New("nope"))
+			})
+
+			It("fails every lookup rather than falling back", func() {
+				variables := variablesFactory.NewVariables("some-team", "some-pipeline")
+
+				_, _, err := variables.Get(template.VariableDefinition{Name: "some-var"})
+				Expect(err).To(MatchError("nope"))
+
+				Expect(fakeGlobalFactory.NewVariablesCallCount()).To(BeZero())
+			})
+		})
+	})
+
+	Context("when looking up the team's config fails", func() {
+		BeforeEach(func() {
+			fakeConfigs.FindCredentialManagerConfigReturns(atc.CredentialManagerConfig{}, false, errors.New("db down"))
+		})
+
+		It("fails every lookup", func() {
+			variables := variablesFactory.NewVariables("some-team", "some-pipeline")
+
+			_, _, err := variables.Get(template.VariableDefinition{Name: "some-var"})
+			Expect(err).To(MatchError("db down"))
+		})
+	})
+})
@@ -1,6 +1,7 @@
 package vault
 
 import (
+	"net/http"
 	"path"
 	"sync/atomic"
 	"time"
@@ -15,6 +16,7 @@ type APIClient struct {
 	logger lager.Logger
 
 	apiURL     string
+	namespace  string
 	tlsConfig  *vaultapi.TLSConfig
 	authConfig AuthConfig
 
@@ -22,11 +24,12 @@ type APIClient struct {
 }
 
 // NewAPIClient with the associated authorization config and underlying vault client.
-func NewAPIClient(logger lager.Logger, apiURL string, tlsConfig *vaultapi.TLSConfig, authConfig AuthConfig) (*APIClient, error) {
+func NewAPIClient(logger lager.Logger, apiURL string, namespace string, tlsConfig *vaultapi.TLSConfig, authConfig AuthConfig) (*APIClient, error) {
 	ac := &APIClient{
 		logger: logger,
 
 		apiURL:     apiURL,
+		namespace:  namespace,
 		tlsConfig:  tlsConfig,
 		authConfig: authConfig,
 
@@ -161,6 +164,10 @@ func (ac *APIClient) baseClient() (*vaultapi.Client, error) {
 		return nil, err
 	}
 
+	if ac.namespace != "" {
+		client.SetHeaders(http.Header{"X-Vault-Namespace": []string{ac.namespace}})
+	}
+
 	return client, nil
 }
 
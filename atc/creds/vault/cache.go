@@ -20,6 +20,8 @@ type Cache struct {
 	sync.RWMutex
 	cache    map[string]*cachedSecret
 	newItems chan time.Time
+	stop     chan struct{}
+	stopOnce sync.Once
 	sr       SecretReader
 	context  context.Context
 	maxLease time.Duration
@@ -33,6 +35,7 @@ func NewCache(sr SecretReader, maxLease time.Duration) *Cache {
 	c := &Cache{
 		cache:    make(map[string]*cachedSecret),
 		newItems: make(chan time.Time, 100),
+		stop:     make(chan struct{}),
 		sr:       sr,
 		maxLease: maxLease,
 	}
@@ -74,10 +77,19 @@ func (c *Cache) reaperThread() {
 			}
 			nextWakeup = t
 			sleep.Reset(t.Sub(time.Now()))
+		case <-c.stop:
+			return
 		}
 	}
 }
 
+// Close stops the reaper thread.
+func (c *Cache) Close() {
+	c.stopOnce.Do(func() {
+		close(c.stop)
+	})
+}
+
 // Read a secret from the cache or the underlying client if not
 // present.
 func (c *Cache) Read(path string) (*vaultapi.Secret, error) {
@@ -117,7 +129,10 @@ func (c *Cache) Read(path string) (*vaultapi.Secret, error) {
 	c.Unlock()
 
 	// Tell the reaper thread it has new items to cleanup
-	c.newItems <- cs.deadline
+	select {
+	case c.newItems <- cs.deadline:
+	case <-c.stop:
+	}
 
 	return secret, nil
 }
@@ -19,12 +19,17 @@ type VaultManager struct {
 
 	PathPrefix string `long:"path-prefix" default:"/concourse" description:"Path under which to namespace credential lookup."`
 
+	Namespace string `long:"namespace" description:"Vault namespace to use for authentication and secret lookup."`
+
 	Cache    bool          `long:"cache" description:"Cache returned secrets for their lease duration in memory"`
 	MaxLease time.Duration `long:"max-lease" description:"If the cache is enabled, and this is set, override secrets lease duration with a maximum value"`
 
 	TLS    TLS
 	Auth   AuthConfig
 	Client *APIClient
+
+	reAuther *ReAuther
+	cache    *Cache
 }
 
 type TLS struct {
@@ -60,7 +65,7 @@ func (manager *VaultManager) Init(log lager.Logger) error {
 		ClientKey:  manager.TLS.ClientKey,
 	}
 
-	manager.Client, err = NewAPIClient(log, manager.URL, tlsConfig, manager.Auth)
+	manager.Client, err = NewAPIClient(log, manager.URL, manager.Namespace, tlsConfig, manager.Auth)
 	if err != nil {
 		return err
 	}
@@ -77,6 +82,7 @@ func (manager *VaultManager) MarshalJSON() ([]byte, error) {
 	return json.Marshal(&map[string]interface{}{
 		"url":                manager.URL,
 		"path_prefix":        manager.PathPrefix,
+		"namespace":          manager.Namespace,
 		"cache":              manager.Cache,
 		"max_lease":          manager.MaxLease,
 		"ca_cert":            manager.TLS.CACert,
@@ -125,12 +131,24 @@ func (manager VaultManager) Health() (*creds.HealthResponse, error) {
 	return health, nil
 }
 
-func (manager VaultManager) NewVariablesFactory(logger lager.Logger) (creds.VariablesFactory, error) {
-	ra := NewReAuther(manager.Client, manager.Auth.BackendMaxTTL, manager.Auth.RetryInitial, manager.Auth.RetryMax)
+func (manager *VaultManager) NewVariablesFactory(logger lager.Logger) (creds.VariablesFactory, error) {
+	manager.reAuther = NewReAuther(manager.Client, manager.Auth.BackendMaxTTL, manager.Auth.RetryInitial, manager.Auth.RetryMax)
+
 	var sr SecretReader = manager.Client
 	if manager.Cache {
-		sr = NewCache(manager.Client, manager.MaxLease)
+		manager.cache = NewCache(manager.Client, manager.MaxLease)
+		sr = manager.cache
+	}
+
+	return NewVaultFactory(sr, manager.reAuther.LoggedIn(), manager.PathPrefix), nil
+}
+
+func (manager *VaultManager) Close(logger lager.Logger) {
+	if manager.reAuther != nil {
+		manager.reAuther.Close()
 	}
 
-	return NewVaultFactory(sr, ra.LoggedIn(), manager.PathPrefix), nil
+	if manager.cache != nil {
+		manager.cache.Close()
+	}
 }
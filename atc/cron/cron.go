@@ -0,0 +1,263 @@
+// Package cron parses standard five-field cron expressions and computes when
+// they next fire, so that jobs can be triggered on a schedule without
+// periodically checking a time resource.
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxLookahead bounds the search for the next matching time, so that
+// expressions which can never fire (e.g. "0 0 30 2 *") don't loop forever.
+const maxLookahead = 5 * 366 * 24 * time.Hour
+
+var descriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+var monthNames = map[string]int{
+	"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+	"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+}
+
+var dayNames = map[string]int{
+	"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+}
+
+type field struct {
+	name  string
+	min   int
+	max   int
+	names map[string]int
+}
+
+var (
+	minuteField     = field{name: "minute", min: 0, max: 59}
+	hourField       = field{name: "hour", min: 0, max: 23}
+	dayOfMonthField = field{name: "day of month", min: 1, max: 31}
+	monthField      = field{name: "month", min: 1, max: 12, names: monthNames}
+
+	// 7 is accepted as an alias for Sunday and folded into 0 after parsing
+	dayOfWeekField = field{name: "day of week", min: 0, max: 7, names: dayNames}
+)
+
+// Schedule is a parsed cron expression. Each field is a bit set of the values
+// it matches.
+type Schedule struct {
+	minute     uint64
+	hour       uint64
+	dayOfMonth uint64
+	month      uint64
+	dayOfWeek  uint64
+
+	// as with cron(8), when both day fields are restricted a day matches if
+	// either of them does
+	dayOfMonthStar bool
+	dayOfWeekStar  bool
+}
+
+// Parse parses a cron expression of the form "minute hour day-of-month month
+// day-of-week", where each field is '*' or a comma-separated list of values,
+// ranges ("1-5") and steps ("*/15", "0-30/10"). Months and days of the week
+// may also be given by their three-letter names, and the descriptors
+// "@hourly", "@daily", "@weekly", "@monthly" and "@yearly" are supported.
+func Parse(expression string) (Schedule, error) {
+	spec := strings.TrimSpace(expression)
+	if descriptor, ok := descriptors[strings.ToLower(spec)]; ok {
+		spec = descriptor
+	}
+
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return Schedule{}, fmt.Errorf("invalid cron expression '%s': expected 5 fields, got %d", expression, len(fields))
+	}
+
+	var schedule Schedule
+	var err error
+
+	schedule.minute, err = minuteField.parse(fields[0])
+	if err != nil {
+		return Schedule{}, fmt.Errorf("invalid cron expression '%s': %s", expression, err)
+	}
+
+	schedule.hour, err = hourField.parse(fields[1])
+	if err != nil {
+		return Schedule{}, fmt.Errorf("invalid cron expression '%s': %s", expression, err)
+	}
+
+	schedule.dayOfMonth, err = dayOfMonthField.parse(fields[2])
+	if err != nil {
+		return Schedule{}, fmt.Errorf("invalid cron expression '%s': %s", expression, err)
+	}
+
+	schedule.month, err = monthField.parse(fields[3])
+	if err != nil {
+		return Schedule{}, fmt.Errorf("invalid cron expression '%s': %s", expression, err)
+	}
+
+	schedule.dayOfWeek, err = dayOfWeekField.parse(fields[4])
+	if err != nil {
+		return Schedule{}, fmt.Errorf("invalid cron expression '%s': %s", expression, err)
+	}
+
+	if schedule.dayOfWeek&(1<<7) != 0 {
+		schedule.dayOfWeek = schedule.dayOfWeek&^(1<<7) | 1<<0
+	}
+
+	schedule.dayOfMonthStar = strings.HasPrefix(fields[2], "*")
+	schedule.dayOfWeekStar = strings.HasPrefix(fields[4], "*")
+
+	return schedule, nil
+}
+
+// Next returns the first time after t, to the minute, at which the schedule
+// fires in t's location. The zero time is returned if the schedule never
+// fires.
+func (s Schedule) Next(t time.Time) time.Time {
+	loc := t.Location()
+	limit := t.Add(maxLookahead)
+
+	t = t.Truncate(time.Minute).Add(time.Minute)
+
+	for t.Before(limit) {
+		if s.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+			continue
+		}
+
+		if !s.matchesDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+			continue
+		}
+
+		if s.hour&(1<<uint(t.Hour())) == 0 {
+			next := time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+			if !next.After(t) {
+				// the wall clock went back an hour; skip past the repeat
+				next = t.Truncate(time.Hour).Add(time.Hour)
+			}
+
+			t = next
+			continue
+		}
+
+		if s.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+
+		return t
+	}
+
+	return time.Time{}
+}
+
+func (s Schedule) matchesDay(t time.Time) bool {
+	dayOfMonth := s.dayOfMonth&(1<<uint(t.Day())) != 0
+	dayOfWeek := s.dayOfWeek&(1<<uint(t.Weekday())) != 0
+
+	if s.dayOfMonthStar || s.dayOfWeekStar {
+		return dayOfMonth && dayOfWeek
+	}
+
+	return dayOfMonth || dayOfWeek
+}
+
+func (f field) parse(spec string) (uint64, error) {
+	var bits uint64
+
+	for _, part := range strings.Split(spec, ",") {
+		partBits, err := f.parsePart(part)
+		if err != nil {
+			return 0, err
+		}
+
+		bits |= partBits
+	}
+
+	return bits, nil
+}
+
+func (f field) parsePart(part string) (uint64, error) {
+	rangeSpec, step := part, 1
+
+	if i := strings.Index(part, "/"); i != -1 {
+		var err error
+		step, err = strconv.Atoi(part[i+1:])
+		if err != nil || step <= 0 {
+			return 0, fmt.Errorf("invalid step '%s' in %s field", part[i+1:], f.name)
+		}
+
+		rangeSpec = part[:i]
+	}
+
+	var start, end int
+	switch {
+	case rangeSpec == "*":
+		start, end = f.min, f.max
+
+	case strings.Contains(rangeSpec, "-"):
+		bounds := strings.SplitN(rangeSpec, "-", 2)
+
+		var err error
+		start, err = f.value(bounds[0])
+		if err != nil {
+			return 0, err
+		}
+
+		end, err = f.value(bounds[1])
+		if err != nil {
+			return 0, err
+		}
+
+		if start > end {
+			return 0, fmt.Errorf("invalid range '%s' in %s field", rangeSpec, f.name)
+		}
+
+	default:
+		var err error
+		start, err = f.value(rangeSpec)
+		if err != nil {
+			return 0, err
+		}
+
+		end = start
+		if step > 1 {
+			// "5/15" means every 15 starting at 5
+			end = f.max
+		}
+	}
+
+	var bits uint64
+	for v := start; v <= end; v += step {
+		bits |= 1 << uint(v)
+	}
+
+	return bits, nil
+}
+
+func (f field) value(spec string) (int, error) {
+	if v, ok := f.names[strings.ToLower(spec)]; ok {
+		return v, nil
+	}
+
+	v, err := strconv.Atoi(spec)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value '%s' in %s field", spec, f.name)
+	}
+
+	if v < f.min || v > f.max {
+		return 0, fmt.Errorf("%s value %d out of range %d-%d", f.name, v, f.min, f.max)
+	}
+
+	return v, nil
+}
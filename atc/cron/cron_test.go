@@ -0,0 +1,73 @@
+package cron_test
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc/cron"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Schedule", func() {
+	// a Wednesday
+	var from = time.Date(2018, time.October, 17, 10, 30, 15, 0, time.UTC)
+
+	DescribeTable("Next",
+		func(expression string, expected time.Time) {
+			schedule, err := cron.Parse(expression)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(schedule.Next(from)).To(Equal(expected))
+		},
+		Entry("every minute", "* * * * *", time.Date(2018, time.October, 17, 10, 31, 0, 0, time.UTC)),
+		Entry("every 15 minutes", "*/15 * * * *", time.Date(2018, time.October, 17, 10, 45, 0, 0, time.UTC)),
+		Entry("a stepped range", "0-20/10 * * * *", time.Date(2018, time.October, 17, 11, 0, 0, 0, time.UTC)),
+		Entry("a step from a value", "40/5 * * * *", time.Date(2018, time.October, 17, 10, 40, 0, 0, time.UTC)),
+		Entry("a list", "10,35 * * * *", time.Date(2018, time.October, 17, 10, 35, 0, 0, time.UTC)),
+		Entry("daily later today", "0 22 * * *", time.Date(2018, time.October, 17, 22, 0, 0, 0, time.UTC)),
+		Entry("daily tomorrow", "0 9 * * *", time.Date(2018, time.October, 18, 9, 0, 0, 0, time.UTC)),
+		Entry("on weekdays by name", "0 9 * * mon-fri", time.Date(2018, time.October, 18, 9, 0, 0, 0, time.UTC)),
+		Entry("on Sundays as 7", "0 9 * * 7", time.Date(2018, time.October, 21, 9, 0, 0, 0, time.UTC)),
+		Entry("on a day of the month", "0 0 1 * *", time.Date(2018, time.November, 1, 0, 0, 0, 0, time.UTC)),
+		Entry("in a month by name", "0 0 1 feb *", time.Date(2019, time.February, 1, 0, 0, 0, 0, time.UTC)),
+		Entry("either day field when both are restricted", "0 0 1 * fri", time.Date(2018, time.October, 19, 0, 0, 0, 0, time.UTC)),
+		Entry("on a leap day", "0 0 29 2 *", time.Date(2020, time.February, 29, 0, 0, 0, 0, time.UTC)),
+		Entry("a descriptor", "@weekly", time.Date(2018, time.October, 21, 0, 0, 0, 0, time.UTC)),
+		Entry("never", "0 0 30 2 *", time.Time{}),
+	)
+
+	It("fires in the location of the given time", func() {
+		location, err := time.LoadLocation("America/New_York")
+		Expect(err).ToNot(HaveOccurred())
+
+		schedule, err := cron.Parse("0 9 * * *")
+		Expect(err).ToNot(HaveOccurred())
+
+		next := schedule.Next(from.In(location))
+		Expect(next).To(Equal(time.Date(2018, time.October, 17, 9, 0, 0, 0, location)))
+		Expect(next.UTC()).To(Equal(time.Date(2018, time.October, 17, 13, 0, 0, 0, time.UTC)))
+	})
+
+	It("skips hours that don't exist when the clocks go forward", func() {
+		location, err := time.LoadLocation("America/New_York")
+		Expect(err).ToNot(HaveOccurred())
+
+		schedule, err := cron.Parse("30 2 * * *")
+		Expect(err).ToNot(HaveOccurred())
+
+		next := schedule.Next(time.Date(2018, time.March, 10, 12, 0, 0, 0, location))
+		Expect(next).To(Equal(time.Date(2018, time.March, 12, 2, 30, 0, 0, location)))
+	})
+
+	DescribeTable("Parse errors",
+		func(expression string, message string) {
+			_, err := cron.Parse(expression)
+			Expect(err).To(MatchError(message))
+		},
+		Entry("too few fields", "* * * *", "invalid cron expression '* * * *': expected 5 fields, got 4"),
+		Entry("a bad value", "x * * * *", "invalid cron expression 'x * * * *': invalid value 'x' in minute field"),
+		Entry("a value out of range", "* 24 * * *", "invalid cron expression '* 24 * * *': hour value 24 out of range 0-23"),
+		Entry("a backwards range", "* * 5-1 * *", "invalid cron expression '* * 5-1 * *': invalid range '5-1' in day of month field"),
+		Entry("a bad step", "*/0 * * * *", "invalid cron expression '*/0 * * * *': invalid step '0' in minute field"),
+	)
+})
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"code.cloudfoundry.org/lager"
@@ -28,7 +30,16 @@ const (
 	BuildStatusErrored   BuildStatus = "errored"
 )
 
-var buildsQuery = psql.Select("b.id, b.name, b.job_id, b.team_id, b.status, b.manually_triggered, b.scheduled, b.engine, b.engine_metadata, b.public_plan, b.start_time, b.end_time, b.reap_time, j.name, b.pipeline_id, p.name, t.name, b.nonce, b.tracked_by, b.drained").
+// BuildCause records why a job build was created on demand, as opposed to by
+// new versions of its trigger inputs.
+type BuildCause string
+
+const (
+	BuildCauseManual   BuildCause = "manual"
+	BuildCauseSchedule BuildCause = "schedule"
+)
+
+var buildsQuery = psql.Select("b.id, b.name, b.job_id, b.team_id, b.status, b.manually_triggered, b.scheduled, b.engine, b.engine_metadata, b.public_plan, b.start_time, b.end_time, b.reap_time, j.name, b.pipeline_id, p.name, t.name, b.nonce, b.tracked_by, b.drained, b.rerun_of, b.rerun_number, b.event_store, b.annotations, b.cause, b.blocking_reasons").
 	From("builds b").
 	JoinClause("LEFT OUTER JOIN jobs j ON b.job_id = j.id").
 	JoinClause("LEFT OUTER JOIN pipelines p ON b.pipeline_id = p.id").
@@ -54,7 +65,12 @@ type Build interface {
 	ReapTime() time.Time
 	Tracker() string
 	IsManuallyTriggered() bool
+	Cause() BuildCause
 	IsScheduled() bool
+	RerunOf() int
+	RerunNumber() int
+	Annotations() map[string]string
+	BlockingReasons() []string
 	IsRunning() bool
 
 	Reload() (bool, error)
@@ -64,6 +80,7 @@ type Build interface {
 
 	Interceptible() (bool, error)
 	Preparation() (BuildPreparation, bool, error)
+	SetBlockingReasons(reasons []string) error
 
 	Start(string, string, atc.Plan) (bool, error)
 	FinishWithError(cause error) error
@@ -77,12 +94,14 @@ type Build interface {
 	SaveInput(input BuildInput) error
 	SaveOutput(vr VersionedResource) error
 	UseInputs(inputs []BuildInput) error
+	Inputs() ([]BuildInput, error)
 
 	Resources() ([]BuildInput, []BuildOutput, error)
 	GetVersionedResources() (SavedVersionedResources, error)
 	SaveImageResourceVersion(UsedResourceCache) error
 
 	Pipeline() (Pipeline, bool, error)
+	SavePipeline(atc.PipelineRef, atc.Config, ConfigVersion, PipelinePausedState) (Pipeline, bool, error)
 
 	Delete() (bool, error)
 	MarkAsAborted() error
@@ -91,6 +110,8 @@ type Build interface {
 
 	IsDrained() bool
 	SetDrained(bool) error
+
+	SaveAnnotations(map[string]string) error
 }
 
 type build struct {
@@ -108,6 +129,15 @@ type build struct {
 	jobName      string
 
 	isManuallyTriggered bool
+	cause               BuildCause
+
+	rerunOf     int
+	rerunNumber int
+
+	eventStoreName string
+
+	annotations     map[string]string
+	blockingReasons []string
 
 	engine         string
 	engineMetadata string
@@ -135,6 +165,9 @@ func (b *build) PipelineName() string         { return b.pipelineName }
 func (b *build) TeamID() int                  { return b.teamID }
 func (b *build) TeamName() string             { return b.teamName }
 func (b *build) IsManuallyTriggered() bool    { return b.isManuallyTriggered }
+func (b *build) Cause() BuildCause            { return b.cause }
+func (b *build) RerunOf() int                 { return b.rerunOf }
+func (b *build) RerunNumber() int             { return b.rerunNumber }
 func (b *build) Engine() string               { return b.engine }
 func (b *build) EngineMetadata() string       { return b.engineMetadata }
 func (b *build) PublicPlan() *json.RawMessage { return b.publicPlan }
@@ -146,6 +179,12 @@ func (b *build) Tracker() string              { return b.trackedBy }
 func (b *build) IsScheduled() bool            { return b.scheduled }
 func (b *build) IsDrained() bool              { return b.drained }
 
+func (b *build) Annotations() map[string]string { return b.annotations }
+
+// BlockingReasons returns the reasons last recorded by the scheduler for not
+// starting the build.
+func (b *build) BlockingReasons() []string { return b.blockingReasons }
+
 func (b *build) IsRunning() bool {
 	switch b.status {
 	case BuildStatusPending, BuildStatusStarted:
@@ -389,6 +428,33 @@ func (b *build) SetDrained(drained bool) error {
 	return err
 }
 
+// SaveAnnotations merges the annotations into the build's existing ones,
+// replacing the values of any keys which are already present.
+func (b *build) SaveAnnotations(annotations map[string]string) error {
+	annotationsJSON, err := json.Marshal(annotations)
+	if err != nil {
+		return err
+	}
+
+	var merged []byte
+	err = psql.Update("builds").
+		Set("annotations", sq.Expr("annotations || ?::jsonb", string(annotationsJSON))).
+		Where(sq.Eq{"id": b.id}).
+		Suffix("RETURNING annotations").
+		RunWith(b.conn).
+		QueryRow().
+		Scan(&merged)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return ErrBuildDisappeared
+		}
+
+		return err
+	}
+
+	return json.Unmarshal(merged, &b.annotations)
+}
+
 func (b *build) Delete() (bool, error) {
 	rows, err := psql.Delete("builds").
 		Where(sq.Eq{
@@ -488,6 +554,30 @@ func (b *build) Pipeline() (Pipeline, bool, error) {
 	return pipeline, true, nil
 }
 
+// SavePipeline saves a pipeline of the build's team. If the build belongs to a
+// job, the job and the build are recorded as the pipeline's parents in the
+// same transaction.
+func (b *build) SavePipeline(
+	pipelineRef atc.PipelineRef,
+	config atc.Config,
+	from ConfigVersion,
+	pausedState PipelinePausedState,
+) (Pipeline, bool, error) {
+	team := &team{
+		id:          b.teamID,
+		name:        b.teamName,
+		conn:        b.conn,
+		lockFactory: b.lockFactory,
+	}
+
+	var parentBuildID int
+	if b.jobID != 0 {
+		parentBuildID = b.id
+	}
+
+	return team.savePipeline(pipelineRef, config, from, pausedState, b.jobID, parentBuildID)
+}
+
 func (b *build) SaveImageResourceVersion(rc UsedResourceCache) error {
 	_, err := psql.Insert("build_image_resource_caches").
 		Columns("resource_cache_id", "build_id").
@@ -555,6 +645,7 @@ func (b *build) Preparation() (BuildPreparation, bool, error) {
 			Inputs:              map[string]BuildPreparationStatus{},
 			InputsSatisfied:     BuildPreparationStatusNotBlocking,
 			MissingInputReasons: MissingInputReasons{},
+			BlockingReasons:     []string{},
 		}, true, nil
 	}
 
@@ -564,15 +655,16 @@ func (b *build) Preparation() (BuildPreparation, bool, error) {
 		maxInFlightReached bool
 		pipelineID         int
 		jobName            string
+		schedulerReasons   sql.NullString
 	)
-	err := psql.Select("p.paused, j.paused, j.max_in_flight_reached, j.pipeline_id, j.name").
+	err := psql.Select("p.paused, j.paused, j.max_in_flight_reached, j.pipeline_id, j.name, b.blocking_reasons").
 		From("builds b").
 		Join("jobs j ON b.job_id = j.id").
 		Join("pipelines p ON j.pipeline_id = p.id").
 		Where(sq.Eq{"b.id": b.id}).
 		RunWith(b.conn).
 		QueryRow().
-		Scan(&pausedPipeline, &pausedJob, &maxInFlightReached, &pipelineID, &jobName)
+		Scan(&pausedPipeline, &pausedJob, &maxInFlightReached, &pipelineID, &jobName, &schedulerReasons)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return BuildPreparation{}, false, nil
@@ -580,6 +672,25 @@ func (b *build) Preparation() (BuildPreparation, bool, error) {
 		return BuildPreparation{}, false, err
 	}
 
+	blockingReasons := []string{}
+	if pausedPipeline {
+		blockingReasons = append(blockingReasons, BlockingReasonPausedPipeline)
+	}
+
+	if pausedJob {
+		blockingReasons = append(blockingReasons, BlockingReasonPausedJob)
+	}
+
+	if schedulerReasons.Valid {
+		var reasons []string
+		err = json.Unmarshal([]byte(schedulerReasons.String), &reasons)
+		if err != nil {
+			return BuildPreparation{}, false, err
+		}
+
+		blockingReasons = append(blockingReasons, reasons...)
+	}
+
 	pausedPipelineStatus := BuildPreparationStatusNotBlocking
 	if pausedPipeline {
 		pausedPipelineStatus = BuildPreparationStatusBlocking
@@ -595,17 +706,7 @@ func (b *build) Preparation() (BuildPreparation, bool, error) {
 		maxInFlightReachedStatus = BuildPreparationStatusBlocking
 	}
 
-	tf := NewTeamFactory(b.conn, b.lockFactory)
-	t, found, err := tf.FindTeam(b.teamName)
-	if err != nil {
-		return BuildPreparation{}, false, err
-	}
-
-	if !found {
-		return BuildPreparation{}, false, nil
-	}
-
-	pipeline, found, err := t.Pipeline(b.pipelineName)
+	pipeline, found, err := b.Pipeline()
 	if err != nil {
 		return BuildPreparation{}, false, err
 	}
@@ -693,6 +794,15 @@ func (b *build) Preparation() (BuildPreparation, bool, error) {
 		}
 	}
 
+	blockingReasons = append(blockingReasons, missingInputReasons.BlockingReasons()...)
+
+	noWorkerReasons, err := b.noWorkerReasons(job.Config())
+	if err != nil {
+		return BuildPreparation{}, false, err
+	}
+
+	blockingReasons = append(blockingReasons, noWorkerReasons...)
+
 	buildPreparation := BuildPreparation{
 		BuildID:             b.id,
 		PausedPipeline:      pausedPipelineStatus,
@@ -701,11 +811,123 @@ func (b *build) Preparation() (BuildPreparation, bool, error) {
 		Inputs:              inputs,
 		InputsSatisfied:     inputsSatisfiedStatus,
 		MissingInputReasons: missingInputReasons,
+		BlockingReasons:     blockingReasons,
 	}
 
 	return buildPreparation, true, nil
 }
 
+// noWorkerReasons describes each set of step tags in the job which no running
+// worker visible to the build's team can satisfy. It is informational only;
+// the scheduler still starts the build and leaves placement to the workers.
+func (b *build) noWorkerReasons(config atc.JobConfig) ([]string, error) {
+	stepTags := [][]string{}
+	seen := map[string]bool{}
+	for _, plan := range config.Plans() {
+		if len(plan.Tags) == 0 {
+			continue
+		}
+
+		tags := append([]string{}, plan.Tags...)
+		sort.Strings(tags)
+
+		key := strings.Join(tags, ",")
+		if seen[key] {
+			continue
+		}
+
+		seen[key] = true
+		stepTags = append(stepTags, tags)
+	}
+
+	if len(stepTags) == 0 {
+		return nil, nil
+	}
+
+	workers, err := getWorkers(b.conn, workersQuery.
+		Where(sq.Or{
+			sq.Eq{"w.team_id": b.teamID},
+			sq.Eq{"w.team_id": nil},
+		}))
+	if err != nil {
+		return nil, err
+	}
+
+	reasons := []string{}
+	for _, tags := range stepTags {
+		if !anyWorkerHasTags(workers, tags) {
+			reasons = append(reasons, fmt.Sprintf(BlockingReasonNoWorkers, strings.Join(tags, ", ")))
+		}
+	}
+
+	return reasons, nil
+}
+
+func anyWorkerHasTags(workers []Worker, tags []string) bool {
+	for _, worker := range workers {
+		if worker.State() != WorkerStateRunning {
+			continue
+		}
+
+		workerTags := map[string]bool{}
+		for _, tag := range worker.Tags() {
+			workerTags[tag] = true
+		}
+
+		hasAll := true
+		for _, tag := range tags {
+			if !workerTags[tag] {
+				hasAll = false
+				break
+			}
+		}
+
+		if hasAll {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (b *build) SetBlockingReasons(reasons []string) error {
+	var payload interface{}
+	if len(reasons) > 0 {
+		reasonsJSON, err := json.Marshal(reasons)
+		if err != nil {
+			return err
+		}
+
+		payload = string(reasonsJSON)
+	}
+
+	where := sq.And{
+		sq.Eq{
+			"id":     b.id,
+			"status": BuildStatusPending,
+		},
+	}
+
+	if payload == nil {
+		// avoid rewriting the row every time a build with nothing recorded
+		// is considered by the scheduler
+		where = append(where, sq.NotEq{"blocking_reasons": nil})
+	}
+
+	_, err := psql.Update("builds").
+		Set("blocking_reasons", payload).
+		Where(where).
+		RunWith(b.conn).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	b.blockingReasons = reasons
+
+	return nil
+}
+
 func (b *build) Events(from uint) (EventSource, error) {
 	notifier, err := newConditionNotifier(b.conn.Bus(), buildEventsChannel(b.id), func() (bool, error) {
 		return true, nil
@@ -714,14 +936,9 @@ func (b *build) Events(from uint) (EventSource, error) {
 		return nil, err
 	}
 
-	table := fmt.Sprintf("team_build_events_%d", b.teamID)
-	if b.pipelineID != 0 {
-		table = fmt.Sprintf("pipeline_build_events_%d", b.pipelineID)
-	}
-
 	return newBuildEventSource(
 		b.id,
-		table,
+		b.eventsKey(),
 		b.conn,
 		notifier,
 		from,
@@ -837,6 +1054,48 @@ func (b *build) UseInputs(inputs []BuildInput) error {
 	return tx.Commit()
 }
 
+// Inputs returns every input of the build, including those which the build
+// also produced as an output.
+func (b *build) Inputs() ([]BuildInput, error) {
+	rows, err := psql.Select("i.name, r.name, v.type, v.version, v.metadata").
+		From("build_inputs i").
+		Join("versioned_resources v ON v.id = i.versioned_resource_id").
+		Join("resources r ON r.id = v.resource_id").
+		Where(sq.Eq{"i.build_id": b.id}).
+		OrderBy("i.name ASC").
+		RunWith(b.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	inputs := []BuildInput{}
+	for rows.Next() {
+		var input BuildInput
+		var version, metadata string
+		err := rows.Scan(&input.Name, &input.Resource, &input.Type, &version, &metadata)
+		if err != nil {
+			return nil, err
+		}
+
+		err = json.Unmarshal([]byte(version), &input.Version)
+		if err != nil {
+			return nil, err
+		}
+
+		err = json.Unmarshal([]byte(metadata), &input.Metadata)
+		if err != nil {
+			return nil, err
+		}
+
+		inputs = append(inputs, input)
+	}
+
+	return inputs, nil
+}
+
 func (b *build) Resources() ([]BuildInput, []BuildOutput, error) {
 	inputs := []BuildInput{}
 	outputs := []BuildOutput{}
@@ -1018,16 +1277,19 @@ func buildEventSeq(buildid int) string {
 
 func scanBuild(b *build, row scannable, encryptionStrategy encryption.Strategy) error {
 	var (
-		jobID, pipelineID                                                    sql.NullInt64
+		jobID, pipelineID, rerunOf, rerunNumber                              sql.NullInt64
 		engine, engineMetadata, jobName, pipelineName, publicPlan, trackedBy sql.NullString
 		startTime, endTime, reapTime                                         pq.NullTime
-		nonce                                                                sql.NullString
+		nonce, cause                                                         sql.NullString
 		drained                                                              bool
+		eventStoreName                                                       string
+		annotations                                                          []byte
+		blockingReasons                                                      sql.NullString
 
 		status string
 	)
 
-	err := row.Scan(&b.id, &b.name, &jobID, &b.teamID, &status, &b.isManuallyTriggered, &b.scheduled, &engine, &engineMetadata, &publicPlan, &startTime, &endTime, &reapTime, &jobName, &pipelineID, &pipelineName, &b.teamName, &nonce, &trackedBy, &drained)
+	err := row.Scan(&b.id, &b.name, &jobID, &b.teamID, &status, &b.isManuallyTriggered, &b.scheduled, &engine, &engineMetadata, &publicPlan, &startTime, &endTime, &reapTime, &jobName, &pipelineID, &pipelineName, &b.teamName, &nonce, &trackedBy, &drained, &rerunOf, &rerunNumber, &eventStoreName, &annotations, &cause, &blockingReasons)
 	if err != nil {
 		return err
 	}
@@ -1042,7 +1304,25 @@ func scanBuild(b *build, row scannable, encryptionStrategy encryption.Strategy)
 	b.endTime = endTime.Time
 	b.reapTime = reapTime.Time
 	b.trackedBy = trackedBy.String
+	b.cause = BuildCause(cause.String)
 	b.drained = drained
+	b.rerunOf = int(rerunOf.Int64)
+	b.rerunNumber = int(rerunNumber.Int64)
+	b.eventStoreName = eventStoreName
+
+	b.annotations = nil
+	err = json.Unmarshal(annotations, &b.annotations)
+	if err != nil {
+		return err
+	}
+
+	b.blockingReasons = nil
+	if blockingReasons.Valid {
+		err = json.Unmarshal([]byte(blockingReasons.String), &b.blockingReasons)
+		if err != nil {
+			return err
+		}
+	}
 
 	var (
 		noncense                *string
@@ -1069,22 +1349,22 @@ func scanBuild(b *build, row scannable, encryptionStrategy encryption.Strategy)
 	return nil
 }
 
+// saveEvent appends the event to the build's event stream in the database,
+// where the events of builds are kept until they complete.
 func (b *build) saveEvent(tx Tx, event atc.Event) error {
-	payload, err := json.Marshal(event)
-	if err != nil {
-		return err
+	if b.eventStoreName != "" && b.eventStoreName != PostgresEventStoreName {
+		return fmt.Errorf("events of build %d have been moved to event store '%s'", b.id, b.eventStoreName)
 	}
 
-	table := fmt.Sprintf("team_build_events_%d", b.teamID)
-	if b.pipelineID != 0 {
-		table = fmt.Sprintf("pipeline_build_events_%d", b.pipelineID)
+	return newPostgresEventStore(b.conn).Append(tx, b.eventsKey(), event)
+}
+
+func (b *build) eventsKey() BuildEventsKey {
+	return BuildEventsKey{
+		BuildID:    b.id,
+		TeamID:     b.teamID,
+		PipelineID: b.pipelineID,
 	}
-	_, err = psql.Insert(table).
-		Columns("event_id", "build_id", "type", "version", "payload").
-		Values(sq.Expr("nextval('"+buildEventSeq(b.id)+"')"), b.id, string(event.EventType()), string(event.Version()), payload).
-		RunWith(tx).
-		Exec()
-	return err
 }
 
 func createBuild(tx Tx, build *build, vals map[string]interface{}) error {
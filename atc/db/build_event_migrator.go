@@ -0,0 +1,145 @@
+package db
+
+import (
+	"database/sql"
+
+	"code.cloudfoundry.org/lager"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc/event"
+)
+
+//go:generate counterfeiter . BuildEventMigrator
+
+// BuildEventMigrator moves the events of completed builds out of the
+// database and into the configured event store.
+type BuildEventMigrator interface {
+	MigrateCompletedBuilds(logger lager.Logger, limit int) (int, error)
+}
+
+func NewBuildEventMigrator(conn Conn) BuildEventMigrator {
+	return &buildEventMigrator{
+		conn: conn,
+	}
+}
+
+type buildEventMigrator struct {
+	conn Conn
+}
+
+func (migrator *buildEventMigrator) MigrateCompletedBuilds(logger lager.Logger, limit int) (int, error) {
+	target := migrator.conn.EventStore()
+	if target.Name() == PostgresEventStoreName {
+		return 0, nil
+	}
+
+	keys, err := migrator.completedBuilds(limit)
+	if err != nil {
+		return 0, err
+	}
+
+	source := NewPostgresEventStore(migrator.conn)
+
+	migrated := 0
+	for _, key := range keys {
+		logger := logger.Session("migrate", lager.Data{"build": key.BuildID})
+
+		err := migrator.migrate(source, target, key)
+		if err != nil {
+			logger.Error("failed-to-migrate-build-events", err)
+			return migrated, err
+		}
+
+		migrated++
+	}
+
+	return migrated, nil
+}
+
+func (migrator *buildEventMigrator) completedBuilds(limit int) ([]BuildEventsKey, error) {
+	rows, err := psql.Select("id, team_id, pipeline_id").
+		From("builds").
+		Where(sq.Eq{
+			"completed":   true,
+			"event_store": PostgresEventStoreName,
+		}).
+		OrderBy("id ASC").
+		Limit(uint64(limit)).
+		RunWith(migrator.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	keys := []BuildEventsKey{}
+	for rows.Next() {
+		var key BuildEventsKey
+		var pipelineID sql.NullInt64
+
+		err := rows.Scan(&key.BuildID, &key.TeamID, &pipelineID)
+		if err != nil {
+			return nil, err
+		}
+
+		key.PipelineID = int(pipelineID.Int64)
+
+		keys = append(keys, key)
+	}
+
+	return keys, rows.Err()
+}
+
+func (migrator *buildEventMigrator) migrate(source EventStore, target EventStore, key BuildEventsKey) error {
+	events := []event.Envelope{}
+	for {
+		batch, err := source.Get(key, uint(len(events)), 1000)
+		if err != nil {
+			return err
+		}
+
+		events = append(events, batch...)
+
+		if len(batch) < 1000 {
+			break
+		}
+	}
+
+	err := target.Import(key, events)
+	if err != nil {
+		return err
+	}
+
+	// switching stores and removing the events from the database at once
+	// lets clients streaming the build pick up where they were in the new
+	// store
+
+	tx, err := migrator.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	_, err = psql.Update("builds").
+		Set("event_store", target.Name()).
+		Where(sq.Eq{
+			"id":          key.BuildID,
+			"event_store": PostgresEventStoreName,
+		}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Delete(buildEventsTable(key)).
+		Where(sq.Eq{"build_id": key.BuildID}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
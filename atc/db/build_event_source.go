@@ -1,11 +1,9 @@
 package db
 
 import (
-	"encoding/json"
 	"errors"
 	"sync"
 
-	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/event"
 )
 
@@ -21,7 +19,7 @@ type EventSource interface {
 
 func newBuildEventSource(
 	buildID int,
-	table string,
+	key BuildEventsKey,
 	conn Conn,
 	notifier Notifier,
 	from uint,
@@ -30,7 +28,7 @@ func newBuildEventSource(
 
 	source := &buildEventSource{
 		buildID: buildID,
-		table:   table,
+		key:     key,
 
 		conn: conn,
 
@@ -49,7 +47,7 @@ func newBuildEventSource(
 
 type buildEventSource struct {
 	buildID int
-	table   string
+	key     BuildEventsKey
 
 	conn     Conn
 	notifier Notifier
@@ -96,75 +94,58 @@ func (source *buildEventSource) collectEvents(cursor uint) {
 		default:
 		}
 
-		completed := false
-
-		err := source.conn.QueryRow(`
-			SELECT builds.completed
-			FROM builds
-			WHERE builds.id = $1
-		`, source.buildID).Scan(&completed)
+		completed, storeName, err := source.buildState()
 		if err != nil {
 			source.err = err
 			close(source.events)
 			return
 		}
 
-		rows, err := source.conn.Query(`
-			SELECT type, version, payload
-			FROM `+source.table+`
-			WHERE build_id = $1
-			ORDER BY event_id ASC
-			OFFSET $2
-			LIMIT $3
-		`, source.buildID, cursor, batchSize)
+		store, err := eventStoreNamed(source.conn, source.buildID, storeName)
 		if err != nil {
 			source.err = err
 			close(source.events)
 			return
 		}
 
-		rowsReturned := 0
-
-		for rows.Next() {
-			rowsReturned++
+		events, err := store.Get(source.key, cursor, batchSize)
+		if err != nil {
+			source.err = err
+			close(source.events)
+			return
+		}
 
+		for _, ev := range events {
 			cursor++
 
-			var t, v, p string
-			err := rows.Scan(&t, &v, &p)
-			if err != nil {
-				_ = rows.Close()
-
-				source.err = err
-				close(source.events)
-				return
-			}
-
-			data := json.RawMessage(p)
-
-			ev := event.Envelope{
-				Data:    &data,
-				Event:   atc.EventType(t),
-				Version: atc.EventVersion(v),
-			}
-
 			select {
 			case source.events <- ev:
 			case <-source.stop:
-				_ = rows.Close()
-
 				source.err = ErrBuildEventStreamClosed
 				close(source.events)
 				return
 			}
 		}
 
-		if rowsReturned == batchSize {
+		if len(events) == batchSize {
 			// still more events
 			continue
 		}
 
 		if completed {
+			// the events are moved and removed from the previous store in one
+			// transaction, so if they were gone the new store has all of them
+			_, currentStoreName, err := source.buildState()
+			if err != nil {
+				source.err = err
+				close(source.events)
+				return
+			}
+
+			if currentStoreName != storeName {
+				continue
+			}
+
 			source.err = ErrEndOfBuildEventStream
 			close(source.events)
 			return
@@ -179,3 +160,20 @@ func (source *buildEventSource) collectEvents(cursor uint) {
 		}
 	}
 }
+
+// buildState returns whether the build has completed and the name of the
+// store its events are in.
+func (source *buildEventSource) buildState() (bool, string, error) {
+	var completed bool
+	var storeName string
+	err := source.conn.QueryRow(`
+		SELECT builds.completed, builds.event_store
+		FROM builds
+		WHERE builds.id = $1
+	`, source.buildID).Scan(&completed, &storeName)
+	if err != nil {
+		return false, "", err
+	}
+
+	return completed, storeName, nil
+}
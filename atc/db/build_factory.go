@@ -7,13 +7,14 @@ import (
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/concourse/concourse/atc/db/lock"
+	"github.com/lib/pq"
 )
 
 //go:generate counterfeiter . BuildFactory
 
 type BuildFactory interface {
 	Build(int) (Build, bool, error)
-	VisibleBuilds([]string, Page) ([]Build, Pagination, error)
+	VisibleBuilds(teamNames []string, page Page, annotations []string) ([]Build, Pagination, error)
 	PublicBuilds(Page) ([]Build, Pagination, error)
 	GetAllStartedBuilds() ([]Build, error)
 	GetDrainableBuilds() ([]Build, error)
@@ -57,18 +58,18 @@ func (f *buildFactory) Build(buildID int) (Build, bool, error) {
 	return build, true, nil
 }
 
-func (f *buildFactory) VisibleBuilds(teamNames []string, page Page) ([]Build, Pagination, error) {
+func (f *buildFactory) VisibleBuilds(teamNames []string, page Page, annotations []string) ([]Build, Pagination, error) {
 	newBuildsQuery := buildsQuery.
 		Where(sq.Or{
 			sq.Eq{"p.public": true},
 			sq.Eq{"t.name": teamNames},
 		})
 
-	return getBuildsWithPagination(newBuildsQuery, page, f.conn, f.lockFactory)
+	return getBuildsWithPagination(newBuildsQuery, page, annotations, f.conn, f.lockFactory)
 }
 
 func (f *buildFactory) PublicBuilds(page Page) ([]Build, Pagination, error) {
-	return getBuildsWithPagination(buildsQuery.Where(sq.Eq{"p.public": true}), page, f.conn, f.lockFactory)
+	return getBuildsWithPagination(buildsQuery.Where(sq.Eq{"p.public": true}), page, nil, f.conn, f.lockFactory)
 }
 
 func (f *buildFactory) MarkNonInterceptibleBuilds() error {
@@ -131,10 +132,14 @@ func getBuilds(buildsQuery sq.SelectBuilder, conn Conn, lockFactory lock.LockFac
 	return bs, nil
 }
 
-func getBuildsWithPagination(buildsQuery sq.SelectBuilder, page Page, conn Conn, lockFactory lock.LockFactory) ([]Build, Pagination, error) {
+// getBuildsWithPagination returns a page of the builds, only including those
+// with every one of the annotations.
+func getBuildsWithPagination(buildsQuery sq.SelectBuilder, page Page, annotations []string, conn Conn, lockFactory lock.LockFactory) ([]Build, Pagination, error) {
 	var rows *sql.Rows
 	var err error
 
+	buildsQuery = whereAnnotated(buildsQuery, annotations)
+
 	var reverse bool
 	if page.Since == 0 && page.Until == 0 {
 		buildsQuery = buildsQuery.OrderBy("b.id DESC").Limit(uint64(page.Limit))
@@ -176,8 +181,7 @@ func getBuildsWithPagination(buildsQuery sq.SelectBuilder, page Page, conn Conn,
 
 	var minID int
 	var maxID int
-	err = psql.Select("COALESCE(MAX(id), 0)", "COALESCE(MIN(id), 0)").
-		From("builds").
+	err = whereAnnotated(psql.Select("COALESCE(MAX(b.id), 0)", "COALESCE(MIN(b.id), 0)").From("builds b"), annotations).
 		RunWith(conn).
 		QueryRow().
 		Scan(&maxID, &minID)
@@ -206,3 +210,13 @@ func getBuildsWithPagination(buildsQuery sq.SelectBuilder, page Page, conn Conn,
 
 	return builds, pagination, nil
 }
+
+// whereAnnotated restricts the builds to those with every one of the
+// annotations.
+func whereAnnotated(buildsQuery sq.SelectBuilder, annotations []string) sq.SelectBuilder {
+	if len(annotations) == 0 {
+		return buildsQuery
+	}
+
+	return buildsQuery.Where(sq.Expr("b.annotations ??& ?", pq.Array(annotations)))
+}
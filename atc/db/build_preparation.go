@@ -1,6 +1,9 @@
 package db
 
-import "fmt"
+import (
+	"fmt"
+	"sort"
+)
 
 type BuildPreparationStatus string
 
@@ -18,6 +21,23 @@ const (
 	PinnedVersionUnavailable            string = "pinned version %s is not available"
 )
 
+// BlockingReasons describes each missing input, ordered by input name.
+func (mir MissingInputReasons) BlockingReasons() []string {
+	names := make([]string, 0, len(mir))
+	for name := range mir {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	reasons := make([]string, len(names))
+	for i, name := range names {
+		reasons[i] = fmt.Sprintf(BlockingReasonMissingInput, name, mir[name])
+	}
+
+	return reasons
+}
+
 func (mir MissingInputReasons) RegisterPassedConstraint(inputName string) {
 	mir[inputName] = NoVerionsSatisfiedPassedConstraints
 }
@@ -30,6 +50,19 @@ func (mir MissingInputReasons) RegisterPinnedVersionUnavailable(inputName string
 	mir[inputName] = fmt.Sprintf(PinnedVersionUnavailable, version)
 }
 
+// Blocking reasons explain why a pending build has not started yet.
+const (
+	BlockingReasonPausedPipeline   string = "pipeline paused"
+	BlockingReasonPausedJob        string = "job paused"
+	BlockingReasonMissingInput     string = "waiting on input '%s': %s"
+	BlockingReasonMaxInFlight      string = "max_in_flight %d reached"
+	BlockingReasonSerialGroupHeld  string = "waiting on serial group %s held by build %s"
+	BlockingReasonSerialGroupQueue string = "waiting on serial group %s behind build %s"
+	BlockingReasonPendingBuild     string = "waiting behind build %s"
+	BlockingReasonPendingBuildGone string = "pending build disappeared"
+	BlockingReasonNoWorkers        string = "no worker with tags [%s]"
+)
+
 type BuildPreparation struct {
 	BuildID             int
 	PausedPipeline      BuildPreparationStatus
@@ -38,4 +71,5 @@ type BuildPreparation struct {
 	Inputs              map[string]BuildPreparationStatus
 	InputsSatisfied     BuildPreparationStatus
 	MissingInputReasons MissingInputReasons
+	BlockingReasons     []string
 }
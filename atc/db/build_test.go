@@ -65,6 +65,47 @@ var _ = Describe("Build", func() {
 		})
 	})
 
+	Describe("Annotations", func() {
+		var build db.Build
+
+		BeforeEach(func() {
+			var err error
+			build, err = team.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("has no annotations in the beginning", func() {
+			Expect(build.Annotations()).To(BeEmpty())
+		})
+
+		It("merges saved annotations into the existing ones", func() {
+			err := build.SaveAnnotations(map[string]string{
+				"commit-url": "https://example.com/commit/abc",
+				"tests":      "12",
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			err = build.SaveAnnotations(map[string]string{
+				"tests":   "42",
+				"version": "1.2.3",
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			expected := map[string]string{
+				"commit-url": "https://example.com/commit/abc",
+				"tests":      "42",
+				"version":    "1.2.3",
+			}
+
+			Expect(build.Annotations()).To(Equal(expected))
+
+			found, err := build.Reload()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(build.Annotations()).To(Equal(expected))
+		})
+	})
+
 	Describe("Start", func() {
 		var build db.Build
 		var plan atc.Plan
@@ -361,7 +402,7 @@ var _ = Describe("Build", func() {
 			}
 
 			var err error
-			pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, db.ConfigVersion(1), db.PipelineUnpaused)
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, pipelineConfig, db.ConfigVersion(1), db.PipelineUnpaused)
 			Expect(err).ToNot(HaveOccurred())
 
 			var found bool
@@ -371,7 +412,7 @@ var _ = Describe("Build", func() {
 		})
 
 		It("saves the build's input", func() {
-			build, err := job.CreateBuild()
+			build, err := job.CreateBuild(db.BuildCauseManual)
 			Expect(err).ToNot(HaveOccurred())
 
 			versionedResource := db.VersionedResource{
@@ -428,7 +469,7 @@ var _ = Describe("Build", func() {
 			}
 
 			var err error
-			pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, db.ConfigVersion(1), db.PipelineUnpaused)
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, pipelineConfig, db.ConfigVersion(1), db.PipelineUnpaused)
 			Expect(err).ToNot(HaveOccurred())
 
 			var found bool
@@ -438,7 +479,7 @@ var _ = Describe("Build", func() {
 		})
 
 		It("can save a build's output", func() {
-			build, err := job.CreateBuild()
+			build, err := job.CreateBuild(db.BuildCauseManual)
 			Expect(err).ToNot(HaveOccurred())
 
 			versionedResource := db.VersionedResource{
@@ -509,7 +550,7 @@ var _ = Describe("Build", func() {
 			}
 
 			var err error
-			pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, db.ConfigVersion(1), db.PipelineUnpaused)
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, pipelineConfig, db.ConfigVersion(1), db.PipelineUnpaused)
 			Expect(err).ToNot(HaveOccurred())
 
 			var found bool
@@ -519,7 +560,7 @@ var _ = Describe("Build", func() {
 		})
 
 		It("returns build inputs and outputs", func() {
-			build, err := job.CreateBuild()
+			build, err := job.CreateBuild(db.BuildCauseManual)
 			Expect(err).NotTo(HaveOccurred())
 
 			// save a normal 'get'
@@ -546,7 +587,7 @@ var _ = Describe("Build", func() {
 		})
 
 		It("fails to save build output if resource does not exist", func() {
-			build, err := job.CreateBuild()
+			build, err := job.CreateBuild(db.BuildCauseManual)
 			Expect(err).NotTo(HaveOccurred())
 
 			vr := db.VersionedResource{
@@ -578,7 +619,7 @@ var _ = Describe("Build", func() {
 		Context("when a job build", func() {
 			BeforeEach(func() {
 				var err error
-				createdPipeline, _, err = team.SavePipeline("some-pipeline", atc.Config{
+				createdPipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{
 							Name: "some-job",
@@ -591,7 +632,7 @@ var _ = Describe("Build", func() {
 				Expect(err).ToNot(HaveOccurred())
 				Expect(found).To(BeTrue())
 
-				build, err = job.CreateBuild()
+				build, err = job.CreateBuild(db.BuildCauseManual)
 				Expect(err).ToNot(HaveOccurred())
 			})
 
@@ -615,6 +656,85 @@ var _ = Describe("Build", func() {
 		})
 	})
 
+	Describe("SavePipeline", func() {
+		var (
+			parentPipeline db.Pipeline
+			parentJob      db.Job
+			build          db.Build
+		)
+
+		BeforeEach(func() {
+			var err error
+			parentPipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "parent-pipeline"}, atc.Config{
+				Jobs: atc.JobConfigs{
+					{
+						Name: "some-job",
+					},
+				},
+			}, db.ConfigVersion(0), db.PipelineUnpaused)
+			Expect(err).ToNot(HaveOccurred())
+
+			var found bool
+			parentJob, found, err = parentPipeline.Job("some-job")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+		})
+
+		Context("when a job build", func() {
+			BeforeEach(func() {
+				var err error
+				build, err = parentJob.CreateBuild(db.BuildCauseManual)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("records the job and build as the pipeline's parents", func() {
+				pipeline, created, err := build.SavePipeline(atc.PipelineRef{Name: "child-pipeline"}, atc.Config{}, db.ConfigVersion(0), db.PipelineUnpaused)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(created).To(BeTrue())
+				Expect(pipeline.ParentJobID()).To(Equal(parentJob.ID()))
+				Expect(pipeline.ParentBuildID()).To(Equal(build.ID()))
+
+				found, err := pipeline.Reload()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(pipeline.ParentJobID()).To(Equal(parentJob.ID()))
+				Expect(pipeline.ParentBuildID()).To(Equal(build.ID()))
+			})
+
+			Context("when the build is deleted", func() {
+				It("clears the parent build id", func() {
+					pipeline, _, err := build.SavePipeline(atc.PipelineRef{Name: "child-pipeline"}, atc.Config{}, db.ConfigVersion(0), db.PipelineUnpaused)
+					Expect(err).ToNot(HaveOccurred())
+
+					deleted, err := build.Delete()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(deleted).To(BeTrue())
+
+					found, err := pipeline.Reload()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(found).To(BeTrue())
+					Expect(pipeline.ParentJobID()).To(Equal(parentJob.ID()))
+					Expect(pipeline.ParentBuildID()).To(BeZero())
+				})
+			})
+		})
+
+		Context("when a one off build", func() {
+			BeforeEach(func() {
+				var err error
+				build, err = team.CreateOneOffBuild()
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("does not record a parent", func() {
+				pipeline, _, err := build.SavePipeline(atc.PipelineRef{Name: "child-pipeline"}, atc.Config{}, db.ConfigVersion(0), db.PipelineUnpaused)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pipeline.ParentJobID()).To(BeZero())
+				Expect(pipeline.ParentBuildID()).To(BeZero())
+			})
+		})
+	})
+
 	Describe("Preparation", func() {
 		var (
 			build             db.Build
@@ -630,6 +750,7 @@ var _ = Describe("Build", func() {
 				Inputs:              map[string]db.BuildPreparationStatus{},
 				InputsSatisfied:     db.BuildPreparationStatusNotBlocking,
 				MissingInputReasons: db.MissingInputReasons{},
+				BlockingReasons:     []string{},
 			}
 		})
 
@@ -676,7 +797,7 @@ var _ = Describe("Build", func() {
 
 			BeforeEach(func() {
 				var err error
-				pipeline, _, err = team.SavePipeline("some-pipeline", atc.Config{
+				pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, atc.Config{
 					Resources: atc.ResourceConfigs{
 						{
 							Name: "some-resource",
@@ -699,7 +820,7 @@ var _ = Describe("Build", func() {
 				Expect(err).ToNot(HaveOccurred())
 				Expect(found).To(BeTrue())
 
-				build, err = job.CreateBuild()
+				build, err = job.CreateBuild(db.BuildCauseManual)
 				Expect(err).NotTo(HaveOccurred())
 
 				expectedBuildPrep.BuildID = build.ID()
@@ -764,6 +885,7 @@ var _ = Describe("Build", func() {
 						Expect(err).NotTo(HaveOccurred())
 
 						expectedBuildPrep.PausedPipeline = db.BuildPreparationStatusBlocking
+						expectedBuildPrep.BlockingReasons = []string{db.BlockingReasonPausedPipeline}
 					})
 
 					It("returns build preparation with paused pipeline", func() {
@@ -780,6 +902,7 @@ var _ = Describe("Build", func() {
 						Expect(err).NotTo(HaveOccurred())
 
 						expectedBuildPrep.PausedJob = db.BuildPreparationStatusBlocking
+						expectedBuildPrep.BlockingReasons = []string{db.BlockingReasonPausedJob}
 					})
 
 					It("returns build preparation with paused pipeline", func() {
@@ -806,6 +929,91 @@ var _ = Describe("Build", func() {
 					})
 				})
 
+				Context("when the scheduler has recorded blocking reasons", func() {
+					BeforeEach(func() {
+						err := build.SetBlockingReasons([]string{"max_in_flight 2 reached", "no worker with tags [z]"})
+						Expect(err).NotTo(HaveOccurred())
+
+						expectedBuildPrep.BlockingReasons = []string{"max_in_flight 2 reached", "no worker with tags [z]"}
+					})
+
+					It("returns build preparation with the recorded reasons", func() {
+						buildPrep, found, err := build.Preparation()
+						Expect(err).NotTo(HaveOccurred())
+						Expect(found).To(BeTrue())
+						Expect(buildPrep).To(Equal(expectedBuildPrep))
+					})
+
+					Context("when the reasons are cleared", func() {
+						BeforeEach(func() {
+							err := build.SetBlockingReasons(nil)
+							Expect(err).NotTo(HaveOccurred())
+
+							expectedBuildPrep.BlockingReasons = []string{}
+						})
+
+						It("returns build preparation without blocking reasons", func() {
+							buildPrep, found, err := build.Preparation()
+							Expect(err).NotTo(HaveOccurred())
+							Expect(found).To(BeTrue())
+							Expect(buildPrep).To(Equal(expectedBuildPrep))
+						})
+					})
+				})
+
+				Context("when a step has tags", func() {
+					BeforeEach(func() {
+						_, _, err := team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, atc.Config{
+							Resources: atc.ResourceConfigs{
+								{
+									Name: "some-resource",
+									Type: "some-type",
+									Source: atc.Source{
+										"source-config": "some-value",
+									},
+								},
+							},
+							Jobs: atc.JobConfigs{
+								{
+									Name: "some-job",
+									Plan: atc.PlanSequence{
+										{Task: "some-task", Tags: atc.Tags{"some-tag", "other-tag"}},
+									},
+								},
+							},
+						}, pipeline.ConfigVersion(), db.PipelineNoChange)
+						Expect(err).NotTo(HaveOccurred())
+					})
+
+					Context("when no running worker has the tags", func() {
+						It("returns build preparation explaining that no worker has the tags", func() {
+							buildPrep, found, err := build.Preparation()
+							Expect(err).NotTo(HaveOccurred())
+							Expect(found).To(BeTrue())
+							Expect(buildPrep.BlockingReasons).To(ContainElement("no worker with tags [other-tag, some-tag]"))
+						})
+					})
+
+					Context("when a running worker has the tags", func() {
+						BeforeEach(func() {
+							taggedWorker := defaultWorkerPayload
+							taggedWorker.Name = "tagged-worker"
+							taggedWorker.State = "running"
+							taggedWorker.Tags = []string{"some-tag", "other-tag", "extra-tag"}
+
+							_, err := workerFactory.SaveWorker(taggedWorker, 0)
+							Expect(err).NotTo(HaveOccurred())
+						})
+
+						It("returns build preparation without a worker reason", func() {
+							buildPrep, found, err := build.Preparation()
+							Expect(err).NotTo(HaveOccurred())
+							Expect(found).To(BeTrue())
+							Expect(buildPrep.BlockingReasons).NotTo(ContainElement(HavePrefix("no worker with tags")))
+						})
+					})
+				})
+
 				Context("when max running builds is de-reached", func() {
 					BeforeEach(func() {
 						err := job.SetMaxInFlightReached(true)
@@ -874,7 +1082,7 @@ var _ = Describe("Build", func() {
 						},
 					}
 
-					pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, db.ConfigVersion(2), db.PipelineUnpaused)
+					pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, pipelineConfig, db.ConfigVersion(2), db.PipelineUnpaused)
 					Expect(err).ToNot(HaveOccurred())
 
 					err = pipeline.SaveResourceVersions(
@@ -929,6 +1137,13 @@ var _ = Describe("Build", func() {
 						"input5": fmt.Sprintf(db.PinnedVersionUnavailable, `{"version":"v5"}`),
 						"input6": db.NoVerionsSatisfiedPassedConstraints,
 					}
+					expectedBuildPrep.BlockingReasons = []string{
+						"waiting on input 'input2': " + db.NoVersionsAvailable,
+						"waiting on input 'input3': " + db.NoVerionsSatisfiedPassedConstraints,
+						"waiting on input 'input4': " + fmt.Sprintf(db.PinnedVersionUnavailable, `{"version":"v4"}`),
+						"waiting on input 'input5': " + fmt.Sprintf(db.PinnedVersionUnavailable, `{"version":"v5"}`),
+						"waiting on input 'input6': " + db.NoVerionsSatisfiedPassedConstraints,
+					}
 				})
 
 				It("returns blocking inputs satisfied", func() {
@@ -948,7 +1163,7 @@ var _ = Describe("Build", func() {
 			)
 
 			BeforeEach(func() {
-				pipeline, _, err := team.SavePipeline("some-pipeline", atc.Config{
+				pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{
 							Name: "some-job",
@@ -961,7 +1176,7 @@ var _ = Describe("Build", func() {
 				Expect(err).ToNot(HaveOccurred())
 				Expect(found).To(BeTrue())
 
-				build, err = job.CreateBuild()
+				build, err = job.CreateBuild(db.BuildCauseManual)
 				Expect(err).ToNot(HaveOccurred())
 				Expect(build.IsScheduled()).To(BeFalse())
 			})
@@ -1038,14 +1253,14 @@ var _ = Describe("Build", func() {
 			}
 
 			var err error
-			pipeline, _, err := team.SavePipeline("some-pipeline", pipelineConfig, db.ConfigVersion(1), db.PipelineUnpaused)
+			pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, pipelineConfig, db.ConfigVersion(1), db.PipelineUnpaused)
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := pipeline.Job("some-job")
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeTrue())
 
-			build, err = job.CreateBuild()
+			build, err = job.CreateBuild(db.BuildCauseManual)
 			Expect(err).ToNot(HaveOccurred())
 
 			versionedResource := db.VersionedResource{
@@ -139,6 +139,7 @@ type CreatedContainer interface {
 	Destroying() (DestroyingContainer, error)
 	IsHijacked() bool
 	MarkAsHijacked() error
+	MarkTaskFinished() error
 }
 
 type createdContainer struct {
@@ -277,6 +278,33 @@ func (container *createdContainer) MarkAsHijacked() error {
 	return nil
 }
 
+// MarkTaskFinished records that the task running in the container has
+// finished, so that it no longer counts towards its worker's active tasks.
+func (container *createdContainer) MarkTaskFinished() error {
+	rows, err := psql.Update("containers").
+		Set("task_finished", true).
+		Where(sq.Eq{
+			"id":    container.id,
+			"state": atc.ContainerStateCreated,
+		}).
+		RunWith(container.conn).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	affected, err := rows.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return ErrContainerDisappeared
+	}
+
+	return nil
+}
+
 //go:generate counterfeiter . DestroyingContainer
 
 type DestroyingContainer interface {
@@ -27,6 +27,7 @@ const (
 	ContainerTypeGet   ContainerType = "get"
 	ContainerTypePut   ContainerType = "put"
 	ContainerTypeTask  ContainerType = "task"
+	ContainerTypeRun   ContainerType = "run"
 )
 
 func ContainerTypeFromString(containerType string) (ContainerType, error) {
@@ -39,6 +40,8 @@ func ContainerTypeFromString(containerType string) (ContainerType, error) {
 		return ContainerTypePut, nil
 	case "task":
 		return ContainerTypeTask, nil
+	case "run":
+		return ContainerTypeRun, nil
 	default:
 		return "", fmt.Errorf("Unrecognized containerType: %s", containerType)
 	}
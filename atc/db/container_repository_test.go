@@ -195,7 +195,7 @@ var _ = Describe("ContainerRepository", func() {
 
 			BeforeEach(func() {
 				var err error
-				build, err = defaultJob.CreateBuild()
+				build, err = defaultJob.CreateBuild(db.BuildCauseManual)
 				Expect(err).NotTo(HaveOccurred())
 
 				creatingContainer, err = defaultTeam.CreateContainer(
@@ -304,7 +304,7 @@ var _ = Describe("ContainerRepository", func() {
 
 			BeforeEach(func() {
 				var err error
-				build, err = defaultJob.CreateBuild()
+				build, err = defaultJob.CreateBuild(db.BuildCauseManual)
 				Expect(err).NotTo(HaveOccurred())
 
 				creatingTaskContainer, err = defaultTeam.CreateContainer(
@@ -373,7 +373,7 @@ var _ = Describe("ContainerRepository", func() {
 
 			BeforeEach(func() {
 				var err error
-				build, err = defaultJob.CreateBuild()
+				build, err = defaultJob.CreateBuild(db.BuildCauseManual)
 				Expect(err).NotTo(HaveOccurred())
 
 				creatingTaskContainer, err = defaultTeam.CreateContainer(
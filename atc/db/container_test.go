@@ -322,4 +322,39 @@ var _ = Describe("Container", func() {
 			})
 		})
 	})
+
+	Describe("MarkTaskFinished", func() {
+		var createdContainer db.CreatedContainer
+
+		BeforeEach(func() {
+			var err error
+			createdContainer, err = creatingContainer.Created()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("marks the container's task as finished", func() {
+			Expect(createdContainer.MarkTaskFinished()).To(Succeed())
+
+			var finished bool
+			err := psql.Select("task_finished").
+				From("containers").
+				Where(sq.Eq{"id": createdContainer.ID()}).
+				RunWith(dbConn).
+				QueryRow().
+				Scan(&finished)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(finished).To(BeTrue())
+		})
+
+		Context("when the container is being destroyed", func() {
+			BeforeEach(func() {
+				_, err := createdContainer.Destroying()
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("returns an error", func() {
+				Expect(createdContainer.MarkTaskFinished()).To(Equal(db.ErrContainerDisappeared))
+			})
+		})
+	})
 })
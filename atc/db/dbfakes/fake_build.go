@@ -41,6 +41,36 @@ type FakeBuild struct {
 		result2 bool
 		result3 error
 	}
+	AnnotationsStub        func() map[string]string
+	annotationsMutex       sync.RWMutex
+	annotationsArgsForCall []struct {
+	}
+	annotationsReturns struct {
+		result1 map[string]string
+	}
+	annotationsReturnsOnCall map[int]struct {
+		result1 map[string]string
+	}
+	BlockingReasonsStub        func() []string
+	blockingReasonsMutex       sync.RWMutex
+	blockingReasonsArgsForCall []struct {
+	}
+	blockingReasonsReturns struct {
+		result1 []string
+	}
+	blockingReasonsReturnsOnCall map[int]struct {
+		result1 []string
+	}
+	CauseStub        func() db.BuildCause
+	causeMutex       sync.RWMutex
+	causeArgsForCall []struct {
+	}
+	causeReturns struct {
+		result1 db.BuildCause
+	}
+	causeReturnsOnCall map[int]struct {
+		result1 db.BuildCause
+	}
 	DeleteStub        func() (bool, error)
 	deleteMutex       sync.RWMutex
 	deleteArgsForCall []struct {
@@ -140,6 +170,18 @@ type FakeBuild struct {
 	iDReturnsOnCall map[int]struct {
 		result1 int
 	}
+	InputsStub        func() ([]db.BuildInput, error)
+	inputsMutex       sync.RWMutex
+	inputsArgsForCall []struct {
+	}
+	inputsReturns struct {
+		result1 []db.BuildInput
+		result2 error
+	}
+	inputsReturnsOnCall map[int]struct {
+		result1 []db.BuildInput
+		result2 error
+	}
 	InterceptibleStub        func() (bool, error)
 	interceptibleMutex       sync.RWMutex
 	interceptibleArgsForCall []struct {
@@ -312,6 +354,26 @@ type FakeBuild struct {
 		result1 bool
 		result2 error
 	}
+	RerunNumberStub        func() int
+	rerunNumberMutex       sync.RWMutex
+	rerunNumberArgsForCall []struct {
+	}
+	rerunNumberReturns struct {
+		result1 int
+	}
+	rerunNumberReturnsOnCall map[int]struct {
+		result1 int
+	}
+	RerunOfStub        func() int
+	rerunOfMutex       sync.RWMutex
+	rerunOfArgsForCall []struct {
+	}
+	rerunOfReturns struct {
+		result1 int
+	}
+	rerunOfReturnsOnCall map[int]struct {
+		result1 int
+	}
 	ResourcesStub        func() ([]db.BuildInput, []db.BuildOutput, error)
 	resourcesMutex       sync.RWMutex
 	resourcesArgsForCall []struct {
@@ -326,6 +388,17 @@ type FakeBuild struct {
 		result2 []db.BuildOutput
 		result3 error
 	}
+	SaveAnnotationsStub        func(map[string]string) error
+	saveAnnotationsMutex       sync.RWMutex
+	saveAnnotationsArgsForCall []struct {
+		arg1 map[string]string
+	}
+	saveAnnotationsReturns struct {
+		result1 error
+	}
+	saveAnnotationsReturnsOnCall map[int]struct {
+		result1 error
+	}
 	SaveEventStub        func(atc.Event) error
 	saveEventMutex       sync.RWMutex
 	saveEventArgsForCall []struct {
@@ -370,6 +443,24 @@ type FakeBuild struct {
 	saveOutputReturnsOnCall map[int]struct {
 		result1 error
 	}
+	SavePipelineStub        func(atc.PipelineRef, atc.Config, db.ConfigVersion, db.PipelinePausedState) (db.Pipeline, bool, error)
+	savePipelineMutex       sync.RWMutex
+	savePipelineArgsForCall []struct {
+		arg1 atc.PipelineRef
+		arg2 atc.Config
+		arg3 db.ConfigVersion
+		arg4 db.PipelinePausedState
+	}
+	savePipelineReturns struct {
+		result1 db.Pipeline
+		result2 bool
+		result3 error
+	}
+	savePipelineReturnsOnCall map[int]struct {
+		result1 db.Pipeline
+		result2 bool
+		result3 error
+	}
 	ScheduleStub        func() (bool, error)
 	scheduleMutex       sync.RWMutex
 	scheduleArgsForCall []struct {
@@ -382,6 +473,17 @@ type FakeBuild struct {
 		result1 bool
 		result2 error
 	}
+	SetBlockingReasonsStub        func([]string) error
+	setBlockingReasonsMutex       sync.RWMutex
+	setBlockingReasonsArgsForCall []struct {
+		arg1 []string
+	}
+	setBlockingReasonsReturns struct {
+		result1 error
+	}
+	setBlockingReasonsReturnsOnCall map[int]struct {
+		result1 error
+	}
 	SetDrainedStub        func(bool) error
 	setDrainedMutex       sync.RWMutex
 	setDrainedArgsForCall []struct {
@@ -597,6 +699,132 @@ func (fake *FakeBuild) AcquireTrackingLockReturnsOnCall(i int, result1 lock.Lock
 	}{result1, result2, result3}
 }
 
+func (fake *FakeBuild) Annotations() map[string]string {
+	fake.annotationsMutex.Lock()
+	ret, specificReturn := fake.annotationsReturnsOnCall[len(fake.annotationsArgsForCall)]
+	fake.annotationsArgsForCall = append(fake.annotationsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Annotations", []interface{}{})
+	fake.annotationsMutex.Unlock()
+	if fake.AnnotationsStub != nil {
+		return fake.AnnotationsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.annotationsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) AnnotationsCallCount() int {
+	fake.annotationsMutex.RLock()
+	defer fake.annotationsMutex.RUnlock()
+	return len(fake.annotationsArgsForCall)
+}
+
+func (fake *FakeBuild) AnnotationsReturns(result1 map[string]string) {
+	fake.AnnotationsStub = nil
+	fake.annotationsReturns = struct {
+		result1 map[string]string
+	}{result1}
+}
+
+func (fake *FakeBuild) AnnotationsReturnsOnCall(i int, result1 map[string]string) {
+	fake.AnnotationsStub = nil
+	if fake.annotationsReturnsOnCall == nil {
+		fake.annotationsReturnsOnCall = make(map[int]struct {
+			result1 map[string]string
+		})
+	}
+	fake.annotationsReturnsOnCall[i] = struct {
+		result1 map[string]string
+	}{result1}
+}
+
+func (fake *FakeBuild) BlockingReasons() []string {
+	fake.blockingReasonsMutex.Lock()
+	ret, specificReturn := fake.blockingReasonsReturnsOnCall[len(fake.blockingReasonsArgsForCall)]
+	fake.blockingReasonsArgsForCall = append(fake.blockingReasonsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("BlockingReasons", []interface{}{})
+	fake.blockingReasonsMutex.Unlock()
+	if fake.BlockingReasonsStub != nil {
+		return fake.BlockingReasonsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.blockingReasonsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) BlockingReasonsCallCount() int {
+	fake.blockingReasonsMutex.RLock()
+	defer fake.blockingReasonsMutex.RUnlock()
+	return len(fake.blockingReasonsArgsForCall)
+}
+
+func (fake *FakeBuild) BlockingReasonsReturns(result1 []string) {
+	fake.BlockingReasonsStub = nil
+	fake.blockingReasonsReturns = struct {
+		result1 []string
+	}{result1}
+}
+
+func (fake *FakeBuild) BlockingReasonsReturnsOnCall(i int, result1 []string) {
+	fake.BlockingReasonsStub = nil
+	if fake.blockingReasonsReturnsOnCall == nil {
+		fake.blockingReasonsReturnsOnCall = make(map[int]struct {
+			result1 []string
+		})
+	}
+	fake.blockingReasonsReturnsOnCall[i] = struct {
+		result1 []string
+	}{result1}
+}
+
+func (fake *FakeBuild) Cause() db.BuildCause {
+	fake.causeMutex.Lock()
+	ret, specificReturn := fake.causeReturnsOnCall[len(fake.causeArgsForCall)]
+	fake.causeArgsForCall = append(fake.causeArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Cause", []interface{}{})
+	fake.causeMutex.Unlock()
+	if fake.CauseStub != nil {
+		return fake.CauseStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.causeReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) CauseCallCount() int {
+	fake.causeMutex.RLock()
+	defer fake.causeMutex.RUnlock()
+	return len(fake.causeArgsForCall)
+}
+
+func (fake *FakeBuild) CauseReturns(result1 db.BuildCause) {
+	fake.CauseStub = nil
+	fake.causeReturns = struct {
+		result1 db.BuildCause
+	}{result1}
+}
+
+func (fake *FakeBuild) CauseReturnsOnCall(i int, result1 db.BuildCause) {
+	fake.CauseStub = nil
+	if fake.causeReturnsOnCall == nil {
+		fake.causeReturnsOnCall = make(map[int]struct {
+			result1 db.BuildCause
+		})
+	}
+	fake.causeReturnsOnCall[i] = struct {
+		result1 db.BuildCause
+	}{result1}
+}
+
 func (fake *FakeBuild) Delete() (bool, error) {
 	fake.deleteMutex.Lock()
 	ret, specificReturn := fake.deleteReturnsOnCall[len(fake.deleteArgsForCall)]
@@ -1008,6 +1236,51 @@ func (fake *FakeBuild) IDReturnsOnCall(i int, result1 int) {
 	}{result1}
 }
 
+func (fake *FakeBuild) Inputs() ([]db.BuildInput, error) {
+	fake.inputsMutex.Lock()
+	ret, specificReturn := fake.inputsReturnsOnCall[len(fake.inputsArgsForCall)]
+	fake.inputsArgsForCall = append(fake.inputsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Inputs", []interface{}{})
+	fake.inputsMutex.Unlock()
+	if fake.InputsStub != nil {
+		return fake.InputsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.inputsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuild) InputsCallCount() int {
+	fake.inputsMutex.RLock()
+	defer fake.inputsMutex.RUnlock()
+	return len(fake.inputsArgsForCall)
+}
+
+func (fake *FakeBuild) InputsReturns(result1 []db.BuildInput, result2 error) {
+	fake.InputsStub = nil
+	fake.inputsReturns = struct {
+		result1 []db.BuildInput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) InputsReturnsOnCall(i int, result1 []db.BuildInput, result2 error) {
+	fake.InputsStub = nil
+	if fake.inputsReturnsOnCall == nil {
+		fake.inputsReturnsOnCall = make(map[int]struct {
+			result1 []db.BuildInput
+			result2 error
+		})
+	}
+	fake.inputsReturnsOnCall[i] = struct {
+		result1 []db.BuildInput
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeBuild) Interceptible() (bool, error) {
 	fake.interceptibleMutex.Lock()
 	ret, specificReturn := fake.interceptibleReturnsOnCall[len(fake.interceptibleArgsForCall)]
@@ -1698,6 +1971,90 @@ func (fake *FakeBuild) ReloadReturnsOnCall(i int, result1 bool, result2 error) {
 	}{result1, result2}
 }
 
+func (fake *FakeBuild) RerunNumber() int {
+	fake.rerunNumberMutex.Lock()
+	ret, specificReturn := fake.rerunNumberReturnsOnCall[len(fake.rerunNumberArgsForCall)]
+	fake.rerunNumberArgsForCall = append(fake.rerunNumberArgsForCall, struct {
+	}{})
+	fake.recordInvocation("RerunNumber", []interface{}{})
+	fake.rerunNumberMutex.Unlock()
+	if fake.RerunNumberStub != nil {
+		return fake.RerunNumberStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.rerunNumberReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) RerunNumberCallCount() int {
+	fake.rerunNumberMutex.RLock()
+	defer fake.rerunNumberMutex.RUnlock()
+	return len(fake.rerunNumberArgsForCall)
+}
+
+func (fake *FakeBuild) RerunNumberReturns(result1 int) {
+	fake.RerunNumberStub = nil
+	fake.rerunNumberReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeBuild) RerunNumberReturnsOnCall(i int, result1 int) {
+	fake.RerunNumberStub = nil
+	if fake.rerunNumberReturnsOnCall == nil {
+		fake.rerunNumberReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.rerunNumberReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeBuild) RerunOf() int {
+	fake.rerunOfMutex.Lock()
+	ret, specificReturn := fake.rerunOfReturnsOnCall[len(fake.rerunOfArgsForCall)]
+	fake.rerunOfArgsForCall = append(fake.rerunOfArgsForCall, struct {
+	}{})
+	fake.recordInvocation("RerunOf", []interface{}{})
+	fake.rerunOfMutex.Unlock()
+	if fake.RerunOfStub != nil {
+		return fake.RerunOfStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.rerunOfReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) RerunOfCallCount() int {
+	fake.rerunOfMutex.RLock()
+	defer fake.rerunOfMutex.RUnlock()
+	return len(fake.rerunOfArgsForCall)
+}
+
+func (fake *FakeBuild) RerunOfReturns(result1 int) {
+	fake.RerunOfStub = nil
+	fake.rerunOfReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeBuild) RerunOfReturnsOnCall(i int, result1 int) {
+	fake.RerunOfStub = nil
+	if fake.rerunOfReturnsOnCall == nil {
+		fake.rerunOfReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.rerunOfReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
 func (fake *FakeBuild) Resources() ([]db.BuildInput, []db.BuildOutput, error) {
 	fake.resourcesMutex.Lock()
 	ret, specificReturn := fake.resourcesReturnsOnCall[len(fake.resourcesArgsForCall)]
@@ -1746,6 +2103,56 @@ func (fake *FakeBuild) ResourcesReturnsOnCall(i int, result1 []db.BuildInput, re
 	}{result1, result2, result3}
 }
 
+func (fake *FakeBuild) SaveAnnotations(arg1 map[string]string) error {
+	fake.saveAnnotationsMutex.Lock()
+	ret, specificReturn := fake.saveAnnotationsReturnsOnCall[len(fake.saveAnnotationsArgsForCall)]
+	fake.saveAnnotationsArgsForCall = append(fake.saveAnnotationsArgsForCall, struct {
+		arg1 map[string]string
+	}{arg1})
+	fake.recordInvocation("SaveAnnotations", []interface{}{arg1})
+	fake.saveAnnotationsMutex.Unlock()
+	if fake.SaveAnnotationsStub != nil {
+		return fake.SaveAnnotationsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.saveAnnotationsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) SaveAnnotationsCallCount() int {
+	fake.saveAnnotationsMutex.RLock()
+	defer fake.saveAnnotationsMutex.RUnlock()
+	return len(fake.saveAnnotationsArgsForCall)
+}
+
+func (fake *FakeBuild) SaveAnnotationsArgsForCall(i int) map[string]string {
+	fake.saveAnnotationsMutex.RLock()
+	defer fake.saveAnnotationsMutex.RUnlock()
+	argsForCall := fake.saveAnnotationsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuild) SaveAnnotationsReturns(result1 error) {
+	fake.SaveAnnotationsStub = nil
+	fake.saveAnnotationsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) SaveAnnotationsReturnsOnCall(i int, result1 error) {
+	fake.SaveAnnotationsStub = nil
+	if fake.saveAnnotationsReturnsOnCall == nil {
+		fake.saveAnnotationsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.saveAnnotationsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeBuild) SaveEvent(arg1 atc.Event) error {
 	fake.saveEventMutex.Lock()
 	ret, specificReturn := fake.saveEventReturnsOnCall[len(fake.saveEventArgsForCall)]
@@ -1946,6 +2353,65 @@ func (fake *FakeBuild) SaveOutputReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeBuild) SavePipeline(arg1 atc.PipelineRef, arg2 atc.Config, arg3 db.ConfigVersion, arg4 db.PipelinePausedState) (db.Pipeline, bool, error) {
+	fake.savePipelineMutex.Lock()
+	ret, specificReturn := fake.savePipelineReturnsOnCall[len(fake.savePipelineArgsForCall)]
+	fake.savePipelineArgsForCall = append(fake.savePipelineArgsForCall, struct {
+		arg1 atc.PipelineRef
+		arg2 atc.Config
+		arg3 db.ConfigVersion
+		arg4 db.PipelinePausedState
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("SavePipeline", []interface{}{arg1, arg2, arg3, arg4})
+	fake.savePipelineMutex.Unlock()
+	if fake.SavePipelineStub != nil {
+		return fake.SavePipelineStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.savePipelineReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeBuild) SavePipelineCallCount() int {
+	fake.savePipelineMutex.RLock()
+	defer fake.savePipelineMutex.RUnlock()
+	return len(fake.savePipelineArgsForCall)
+}
+
+func (fake *FakeBuild) SavePipelineArgsForCall(i int) (atc.PipelineRef, atc.Config, db.ConfigVersion, db.PipelinePausedState) {
+	fake.savePipelineMutex.RLock()
+	defer fake.savePipelineMutex.RUnlock()
+	argsForCall := fake.savePipelineArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeBuild) SavePipelineReturns(result1 db.Pipeline, result2 bool, result3 error) {
+	fake.SavePipelineStub = nil
+	fake.savePipelineReturns = struct {
+		result1 db.Pipeline
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeBuild) SavePipelineReturnsOnCall(i int, result1 db.Pipeline, result2 bool, result3 error) {
+	fake.SavePipelineStub = nil
+	if fake.savePipelineReturnsOnCall == nil {
+		fake.savePipelineReturnsOnCall = make(map[int]struct {
+			result1 db.Pipeline
+			result2 bool
+			result3 error
+		})
+	}
+	fake.savePipelineReturnsOnCall[i] = struct {
+		result1 db.Pipeline
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeBuild) Schedule() (bool, error) {
 	fake.scheduleMutex.Lock()
 	ret, specificReturn := fake.scheduleReturnsOnCall[len(fake.scheduleArgsForCall)]
@@ -1991,6 +2457,61 @@ func (fake *FakeBuild) ScheduleReturnsOnCall(i int, result1 bool, result2 error)
 	}{result1, result2}
 }
 
+func (fake *FakeBuild) SetBlockingReasons(arg1 []string) error {
+	var arg1Copy []string
+	if arg1 != nil {
+		arg1Copy = make([]string, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.setBlockingReasonsMutex.Lock()
+	ret, specificReturn := fake.setBlockingReasonsReturnsOnCall[len(fake.setBlockingReasonsArgsForCall)]
+	fake.setBlockingReasonsArgsForCall = append(fake.setBlockingReasonsArgsForCall, struct {
+		arg1 []string
+	}{arg1Copy})
+	fake.recordInvocation("SetBlockingReasons", []interface{}{arg1Copy})
+	fake.setBlockingReasonsMutex.Unlock()
+	if fake.SetBlockingReasonsStub != nil {
+		return fake.SetBlockingReasonsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.setBlockingReasonsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) SetBlockingReasonsCallCount() int {
+	fake.setBlockingReasonsMutex.RLock()
+	defer fake.setBlockingReasonsMutex.RUnlock()
+	return len(fake.setBlockingReasonsArgsForCall)
+}
+
+func (fake *FakeBuild) SetBlockingReasonsArgsForCall(i int) []string {
+	fake.setBlockingReasonsMutex.RLock()
+	defer fake.setBlockingReasonsMutex.RUnlock()
+	argsForCall := fake.setBlockingReasonsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuild) SetBlockingReasonsReturns(result1 error) {
+	fake.SetBlockingReasonsStub = nil
+	fake.setBlockingReasonsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) SetBlockingReasonsReturnsOnCall(i int, result1 error) {
+	fake.SetBlockingReasonsStub = nil
+	if fake.setBlockingReasonsReturnsOnCall == nil {
+		fake.setBlockingReasonsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setBlockingReasonsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeBuild) SetDrained(arg1 bool) error {
 	fake.setDrainedMutex.Lock()
 	ret, specificReturn := fake.setDrainedReturnsOnCall[len(fake.setDrainedArgsForCall)]
@@ -2468,6 +2989,12 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.abortNotifierMutex.RUnlock()
 	fake.acquireTrackingLockMutex.RLock()
 	defer fake.acquireTrackingLockMutex.RUnlock()
+	fake.annotationsMutex.RLock()
+	defer fake.annotationsMutex.RUnlock()
+	fake.blockingReasonsMutex.RLock()
+	defer fake.blockingReasonsMutex.RUnlock()
+	fake.causeMutex.RLock()
+	defer fake.causeMutex.RUnlock()
 	fake.deleteMutex.RLock()
 	defer fake.deleteMutex.RUnlock()
 	fake.endTimeMutex.RLock()
@@ -2486,6 +3013,8 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.getVersionedResourcesMutex.RUnlock()
 	fake.iDMutex.RLock()
 	defer fake.iDMutex.RUnlock()
+	fake.inputsMutex.RLock()
+	defer fake.inputsMutex.RUnlock()
 	fake.interceptibleMutex.RLock()
 	defer fake.interceptibleMutex.RUnlock()
 	fake.isDrainedMutex.RLock()
@@ -2518,8 +3047,14 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.reapTimeMutex.RUnlock()
 	fake.reloadMutex.RLock()
 	defer fake.reloadMutex.RUnlock()
+	fake.rerunNumberMutex.RLock()
+	defer fake.rerunNumberMutex.RUnlock()
+	fake.rerunOfMutex.RLock()
+	defer fake.rerunOfMutex.RUnlock()
 	fake.resourcesMutex.RLock()
 	defer fake.resourcesMutex.RUnlock()
+	fake.saveAnnotationsMutex.RLock()
+	defer fake.saveAnnotationsMutex.RUnlock()
 	fake.saveEventMutex.RLock()
 	defer fake.saveEventMutex.RUnlock()
 	fake.saveImageResourceVersionMutex.RLock()
@@ -2528,8 +3063,12 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.saveInputMutex.RUnlock()
 	fake.saveOutputMutex.RLock()
 	defer fake.saveOutputMutex.RUnlock()
+	fake.savePipelineMutex.RLock()
+	defer fake.savePipelineMutex.RUnlock()
 	fake.scheduleMutex.RLock()
 	defer fake.scheduleMutex.RUnlock()
+	fake.setBlockingReasonsMutex.RLock()
+	defer fake.setBlockingReasonsMutex.RUnlock()
 	fake.setDrainedMutex.RLock()
 	defer fake.setDrainedMutex.RUnlock()
 	fake.setInterceptibleMutex.RLock()
@@ -72,11 +72,12 @@ type FakeBuildFactory struct {
 		result2 db.Pagination
 		result3 error
 	}
-	VisibleBuildsStub        func([]string, db.Page) ([]db.Build, db.Pagination, error)
+	VisibleBuildsStub        func([]string, db.Page, []string) ([]db.Build, db.Pagination, error)
 	visibleBuildsMutex       sync.RWMutex
 	visibleBuildsArgsForCall []struct {
 		arg1 []string
 		arg2 db.Page
+		arg3 []string
 	}
 	visibleBuildsReturns struct {
 		result1 []db.Build
@@ -336,22 +337,28 @@ func (fake *FakeBuildFactory) PublicBuildsReturnsOnCall(i int, result1 []db.Buil
 	}{result1, result2, result3}
 }
 
-func (fake *FakeBuildFactory) VisibleBuilds(arg1 []string, arg2 db.Page) ([]db.Build, db.Pagination, error) {
+func (fake *FakeBuildFactory) VisibleBuilds(arg1 []string, arg2 db.Page, arg3 []string) ([]db.Build, db.Pagination, error) {
 	var arg1Copy []string
 	if arg1 != nil {
 		arg1Copy = make([]string, len(arg1))
 		copy(arg1Copy, arg1)
 	}
+	var arg3Copy []string
+	if arg3 != nil {
+		arg3Copy = make([]string, len(arg3))
+		copy(arg3Copy, arg3)
+	}
 	fake.visibleBuildsMutex.Lock()
 	ret, specificReturn := fake.visibleBuildsReturnsOnCall[len(fake.visibleBuildsArgsForCall)]
 	fake.visibleBuildsArgsForCall = append(fake.visibleBuildsArgsForCall, struct {
 		arg1 []string
 		arg2 db.Page
-	}{arg1Copy, arg2})
-	fake.recordInvocation("VisibleBuilds", []interface{}{arg1Copy, arg2})
+		arg3 []string
+	}{arg1Copy, arg2, arg3Copy})
+	fake.recordInvocation("VisibleBuilds", []interface{}{arg1Copy, arg2, arg3Copy})
 	fake.visibleBuildsMutex.Unlock()
 	if fake.VisibleBuildsStub != nil {
-		return fake.VisibleBuildsStub(arg1, arg2)
+		return fake.VisibleBuildsStub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
@@ -366,11 +373,11 @@ func (fake *FakeBuildFactory) VisibleBuildsCallCount() int {
 	return len(fake.visibleBuildsArgsForCall)
 }
 
-func (fake *FakeBuildFactory) VisibleBuildsArgsForCall(i int) ([]string, db.Page) {
+func (fake *FakeBuildFactory) VisibleBuildsArgsForCall(i int) ([]string, db.Page, []string) {
 	fake.visibleBuildsMutex.RLock()
 	defer fake.visibleBuildsMutex.RUnlock()
 	argsForCall := fake.visibleBuildsArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
 func (fake *FakeBuildFactory) VisibleBuildsReturns(result1 []db.Build, result2 db.Pagination, result3 error) {
@@ -72,6 +72,16 @@ type FakeCreatedContainer struct {
 	markAsHijackedReturnsOnCall map[int]struct {
 		result1 error
 	}
+	MarkTaskFinishedStub        func() error
+	markTaskFinishedMutex       sync.RWMutex
+	markTaskFinishedArgsForCall []struct {
+	}
+	markTaskFinishedReturns struct {
+		result1 error
+	}
+	markTaskFinishedReturnsOnCall map[int]struct {
+		result1 error
+	}
 	MetadataStub        func() db.ContainerMetadata
 	metadataMutex       sync.RWMutex
 	metadataArgsForCall []struct {
@@ -364,6 +374,48 @@ func (fake *FakeCreatedContainer) MarkAsHijackedReturnsOnCall(i int, result1 err
 	}{result1}
 }
 
+func (fake *FakeCreatedContainer) MarkTaskFinished() error {
+	fake.markTaskFinishedMutex.Lock()
+	ret, specificReturn := fake.markTaskFinishedReturnsOnCall[len(fake.markTaskFinishedArgsForCall)]
+	fake.markTaskFinishedArgsForCall = append(fake.markTaskFinishedArgsForCall, struct {
+	}{})
+	fake.recordInvocation("MarkTaskFinished", []interface{}{})
+	fake.markTaskFinishedMutex.Unlock()
+	if fake.MarkTaskFinishedStub != nil {
+		return fake.MarkTaskFinishedStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.markTaskFinishedReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeCreatedContainer) MarkTaskFinishedCallCount() int {
+	fake.markTaskFinishedMutex.RLock()
+	defer fake.markTaskFinishedMutex.RUnlock()
+	return len(fake.markTaskFinishedArgsForCall)
+}
+
+func (fake *FakeCreatedContainer) MarkTaskFinishedReturns(result1 error) {
+	fake.MarkTaskFinishedStub = nil
+	fake.markTaskFinishedReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCreatedContainer) MarkTaskFinishedReturnsOnCall(i int, result1 error) {
+	fake.MarkTaskFinishedStub = nil
+	if fake.markTaskFinishedReturnsOnCall == nil {
+		fake.markTaskFinishedReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.markTaskFinishedReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeCreatedContainer) Metadata() db.ContainerMetadata {
 	fake.metadataMutex.Lock()
 	ret, specificReturn := fake.metadataReturnsOnCall[len(fake.metadataArgsForCall)]
@@ -505,6 +557,8 @@ func (fake *FakeCreatedContainer) Invocations() map[string][][]interface{} {
 	defer fake.isHijackedMutex.RUnlock()
 	fake.markAsHijackedMutex.RLock()
 	defer fake.markAsHijackedMutex.RUnlock()
+	fake.markTaskFinishedMutex.RLock()
+	defer fake.markTaskFinishedMutex.RUnlock()
 	fake.metadataMutex.RLock()
 	defer fake.metadataMutex.RUnlock()
 	fake.stateMutex.RLock()
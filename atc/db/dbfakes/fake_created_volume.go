@@ -66,6 +66,18 @@ type FakeCreatedVolume struct {
 	handleReturnsOnCall map[int]struct {
 		result1 string
 	}
+	InitializeArtifactStub        func(int, string) error
+	initializeArtifactMutex       sync.RWMutex
+	initializeArtifactArgsForCall []struct {
+		arg1 int
+		arg2 string
+	}
+	initializeArtifactReturns struct {
+		result1 error
+	}
+	initializeArtifactReturnsOnCall map[int]struct {
+		result1 error
+	}
 	InitializeResourceCacheStub        func(db.UsedResourceCache) error
 	initializeResourceCacheMutex       sync.RWMutex
 	initializeResourceCacheArgsForCall []struct {
@@ -77,6 +89,17 @@ type FakeCreatedVolume struct {
 	initializeResourceCacheReturnsOnCall map[int]struct {
 		result1 error
 	}
+	InitializeStreamedResourceCacheStub        func(db.UsedResourceCache) error
+	initializeStreamedResourceCacheMutex       sync.RWMutex
+	initializeStreamedResourceCacheArgsForCall []struct {
+		arg1 db.UsedResourceCache
+	}
+	initializeStreamedResourceCacheReturns struct {
+		result1 error
+	}
+	initializeStreamedResourceCacheReturnsOnCall map[int]struct {
+		result1 error
+	}
 	InitializeTaskCacheStub        func(int, string, string) error
 	initializeTaskCacheMutex       sync.RWMutex
 	initializeTaskCacheArgsForCall []struct {
@@ -90,6 +113,18 @@ type FakeCreatedVolume struct {
 	initializeTaskCacheReturnsOnCall map[int]struct {
 		result1 error
 	}
+	InitializeTaskOutputCacheStub        func(int, string) error
+	initializeTaskOutputCacheMutex       sync.RWMutex
+	initializeTaskOutputCacheArgsForCall []struct {
+		arg1 int
+		arg2 string
+	}
+	initializeTaskOutputCacheReturns struct {
+		result1 error
+	}
+	initializeTaskOutputCacheReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ParentHandleStub        func() string
 	parentHandleMutex       sync.RWMutex
 	parentHandleArgsForCall []struct {
@@ -400,6 +435,57 @@ func (fake *FakeCreatedVolume) HandleReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeCreatedVolume) InitializeArtifact(arg1 int, arg2 string) error {
+	fake.initializeArtifactMutex.Lock()
+	ret, specificReturn := fake.initializeArtifactReturnsOnCall[len(fake.initializeArtifactArgsForCall)]
+	fake.initializeArtifactArgsForCall = append(fake.initializeArtifactArgsForCall, struct {
+		arg1 int
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("InitializeArtifact", []interface{}{arg1, arg2})
+	fake.initializeArtifactMutex.Unlock()
+	if fake.InitializeArtifactStub != nil {
+		return fake.InitializeArtifactStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.initializeArtifactReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeCreatedVolume) InitializeArtifactCallCount() int {
+	fake.initializeArtifactMutex.RLock()
+	defer fake.initializeArtifactMutex.RUnlock()
+	return len(fake.initializeArtifactArgsForCall)
+}
+
+func (fake *FakeCreatedVolume) InitializeArtifactArgsForCall(i int) (int, string) {
+	fake.initializeArtifactMutex.RLock()
+	defer fake.initializeArtifactMutex.RUnlock()
+	argsForCall := fake.initializeArtifactArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCreatedVolume) InitializeArtifactReturns(result1 error) {
+	fake.InitializeArtifactStub = nil
+	fake.initializeArtifactReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCreatedVolume) InitializeArtifactReturnsOnCall(i int, result1 error) {
+	fake.InitializeArtifactStub = nil
+	if fake.initializeArtifactReturnsOnCall == nil {
+		fake.initializeArtifactReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.initializeArtifactReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeCreatedVolume) InitializeResourceCache(arg1 db.UsedResourceCache) error {
 	fake.initializeResourceCacheMutex.Lock()
 	ret, specificReturn := fake.initializeResourceCacheReturnsOnCall[len(fake.initializeResourceCacheArgsForCall)]
@@ -450,6 +536,56 @@ func (fake *FakeCreatedVolume) InitializeResourceCacheReturnsOnCall(i int, resul
 	}{result1}
 }
 
+func (fake *FakeCreatedVolume) InitializeStreamedResourceCache(arg1 db.UsedResourceCache) error {
+	fake.initializeStreamedResourceCacheMutex.Lock()
+	ret, specificReturn := fake.initializeStreamedResourceCacheReturnsOnCall[len(fake.initializeStreamedResourceCacheArgsForCall)]
+	fake.initializeStreamedResourceCacheArgsForCall = append(fake.initializeStreamedResourceCacheArgsForCall, struct {
+		arg1 db.UsedResourceCache
+	}{arg1})
+	fake.recordInvocation("InitializeStreamedResourceCache", []interface{}{arg1})
+	fake.initializeStreamedResourceCacheMutex.Unlock()
+	if fake.InitializeStreamedResourceCacheStub != nil {
+		return fake.InitializeStreamedResourceCacheStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.initializeStreamedResourceCacheReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeCreatedVolume) InitializeStreamedResourceCacheCallCount() int {
+	fake.initializeStreamedResourceCacheMutex.RLock()
+	defer fake.initializeStreamedResourceCacheMutex.RUnlock()
+	return len(fake.initializeStreamedResourceCacheArgsForCall)
+}
+
+func (fake *FakeCreatedVolume) InitializeStreamedResourceCacheArgsForCall(i int) db.UsedResourceCache {
+	fake.initializeStreamedResourceCacheMutex.RLock()
+	defer fake.initializeStreamedResourceCacheMutex.RUnlock()
+	argsForCall := fake.initializeStreamedResourceCacheArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCreatedVolume) InitializeStreamedResourceCacheReturns(result1 error) {
+	fake.InitializeStreamedResourceCacheStub = nil
+	fake.initializeStreamedResourceCacheReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCreatedVolume) InitializeStreamedResourceCacheReturnsOnCall(i int, result1 error) {
+	fake.InitializeStreamedResourceCacheStub = nil
+	if fake.initializeStreamedResourceCacheReturnsOnCall == nil {
+		fake.initializeStreamedResourceCacheReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.initializeStreamedResourceCacheReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeCreatedVolume) InitializeTaskCache(arg1 int, arg2 string, arg3 string) error {
 	fake.initializeTaskCacheMutex.Lock()
 	ret, specificReturn := fake.initializeTaskCacheReturnsOnCall[len(fake.initializeTaskCacheArgsForCall)]
@@ -502,6 +638,57 @@ func (fake *FakeCreatedVolume) InitializeTaskCacheReturnsOnCall(i int, result1 e
 	}{result1}
 }
 
+func (fake *FakeCreatedVolume) InitializeTaskOutputCache(arg1 int, arg2 string) error {
+	fake.initializeTaskOutputCacheMutex.Lock()
+	ret, specificReturn := fake.initializeTaskOutputCacheReturnsOnCall[len(fake.initializeTaskOutputCacheArgsForCall)]
+	fake.initializeTaskOutputCacheArgsForCall = append(fake.initializeTaskOutputCacheArgsForCall, struct {
+		arg1 int
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("InitializeTaskOutputCache", []interface{}{arg1, arg2})
+	fake.initializeTaskOutputCacheMutex.Unlock()
+	if fake.InitializeTaskOutputCacheStub != nil {
+		return fake.InitializeTaskOutputCacheStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.initializeTaskOutputCacheReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeCreatedVolume) InitializeTaskOutputCacheCallCount() int {
+	fake.initializeTaskOutputCacheMutex.RLock()
+	defer fake.initializeTaskOutputCacheMutex.RUnlock()
+	return len(fake.initializeTaskOutputCacheArgsForCall)
+}
+
+func (fake *FakeCreatedVolume) InitializeTaskOutputCacheArgsForCall(i int) (int, string) {
+	fake.initializeTaskOutputCacheMutex.RLock()
+	defer fake.initializeTaskOutputCacheMutex.RUnlock()
+	argsForCall := fake.initializeTaskOutputCacheArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCreatedVolume) InitializeTaskOutputCacheReturns(result1 error) {
+	fake.InitializeTaskOutputCacheStub = nil
+	fake.initializeTaskOutputCacheReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCreatedVolume) InitializeTaskOutputCacheReturnsOnCall(i int, result1 error) {
+	fake.InitializeTaskOutputCacheStub = nil
+	if fake.initializeTaskOutputCacheReturnsOnCall == nil {
+		fake.initializeTaskOutputCacheReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.initializeTaskOutputCacheReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeCreatedVolume) ParentHandle() string {
 	fake.parentHandleMutex.Lock()
 	ret, specificReturn := fake.parentHandleReturnsOnCall[len(fake.parentHandleArgsForCall)]
@@ -821,10 +1008,16 @@ func (fake *FakeCreatedVolume) Invocations() map[string][][]interface{} {
 	defer fake.destroyingMutex.RUnlock()
 	fake.handleMutex.RLock()
 	defer fake.handleMutex.RUnlock()
+	fake.initializeArtifactMutex.RLock()
+	defer fake.initializeArtifactMutex.RUnlock()
 	fake.initializeResourceCacheMutex.RLock()
 	defer fake.initializeResourceCacheMutex.RUnlock()
+	fake.initializeStreamedResourceCacheMutex.RLock()
+	defer fake.initializeStreamedResourceCacheMutex.RUnlock()
 	fake.initializeTaskCacheMutex.RLock()
 	defer fake.initializeTaskCacheMutex.RUnlock()
+	fake.initializeTaskOutputCacheMutex.RLock()
+	defer fake.initializeTaskOutputCacheMutex.RUnlock()
 	fake.parentHandleMutex.RLock()
 	defer fake.parentHandleMutex.RUnlock()
 	fake.pathMutex.RLock()
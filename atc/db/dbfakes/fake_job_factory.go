@@ -8,6 +8,18 @@ import (
 )
 
 type FakeJobFactory struct {
+	InFlightBuildCountsStub        func() ([]db.JobBuildCounts, error)
+	inFlightBuildCountsMutex       sync.RWMutex
+	inFlightBuildCountsArgsForCall []struct {
+	}
+	inFlightBuildCountsReturns struct {
+		result1 []db.JobBuildCounts
+		result2 error
+	}
+	inFlightBuildCountsReturnsOnCall map[int]struct {
+		result1 []db.JobBuildCounts
+		result2 error
+	}
 	VisibleJobsStub        func([]string) (db.Dashboard, error)
 	visibleJobsMutex       sync.RWMutex
 	visibleJobsArgsForCall []struct {
@@ -25,6 +37,51 @@ type FakeJobFactory struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeJobFactory) InFlightBuildCounts() ([]db.JobBuildCounts, error) {
+	fake.inFlightBuildCountsMutex.Lock()
+	ret, specificReturn := fake.inFlightBuildCountsReturnsOnCall[len(fake.inFlightBuildCountsArgsForCall)]
+	fake.inFlightBuildCountsArgsForCall = append(fake.inFlightBuildCountsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("InFlightBuildCounts", []interface{}{})
+	fake.inFlightBuildCountsMutex.Unlock()
+	if fake.InFlightBuildCountsStub != nil {
+		return fake.InFlightBuildCountsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.inFlightBuildCountsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeJobFactory) InFlightBuildCountsCallCount() int {
+	fake.inFlightBuildCountsMutex.RLock()
+	defer fake.inFlightBuildCountsMutex.RUnlock()
+	return len(fake.inFlightBuildCountsArgsForCall)
+}
+
+func (fake *FakeJobFactory) InFlightBuildCountsReturns(result1 []db.JobBuildCounts, result2 error) {
+	fake.InFlightBuildCountsStub = nil
+	fake.inFlightBuildCountsReturns = struct {
+		result1 []db.JobBuildCounts
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeJobFactory) InFlightBuildCountsReturnsOnCall(i int, result1 []db.JobBuildCounts, result2 error) {
+	fake.InFlightBuildCountsStub = nil
+	if fake.inFlightBuildCountsReturnsOnCall == nil {
+		fake.inFlightBuildCountsReturnsOnCall = make(map[int]struct {
+			result1 []db.JobBuildCounts
+			result2 error
+		})
+	}
+	fake.inFlightBuildCountsReturnsOnCall[i] = struct {
+		result1 []db.JobBuildCounts
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeJobFactory) VisibleJobs(arg1 []string) (db.Dashboard, error) {
 	var arg1Copy []string
 	if arg1 != nil {
@@ -86,6 +143,8 @@ func (fake *FakeJobFactory) VisibleJobsReturnsOnCall(i int, result1 db.Dashboard
 func (fake *FakeJobFactory) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.inFlightBuildCountsMutex.RLock()
+	defer fake.inFlightBuildCountsMutex.RUnlock()
 	fake.visibleJobsMutex.RLock()
 	defer fake.visibleJobsMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
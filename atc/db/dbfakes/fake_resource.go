@@ -10,6 +10,16 @@ import (
 )
 
 type FakeResource struct {
+	APIPinnedVersionStub        func() atc.Version
+	aPIPinnedVersionMutex       sync.RWMutex
+	aPIPinnedVersionArgsForCall []struct {
+	}
+	aPIPinnedVersionReturns struct {
+		result1 atc.Version
+	}
+	aPIPinnedVersionReturnsOnCall map[int]struct {
+		result1 atc.Version
+	}
 	CheckErrorStub        func() error
 	checkErrorMutex       sync.RWMutex
 	checkErrorArgsForCall []struct {
@@ -40,6 +50,16 @@ type FakeResource struct {
 	checkTimeoutReturnsOnCall map[int]struct {
 		result1 string
 	}
+	ConfigPinnedVersionStub        func() atc.Version
+	configPinnedVersionMutex       sync.RWMutex
+	configPinnedVersionArgsForCall []struct {
+	}
+	configPinnedVersionReturns struct {
+		result1 atc.Version
+	}
+	configPinnedVersionReturnsOnCall map[int]struct {
+		result1 atc.Version
+	}
 	FailingToCheckStub        func() bool
 	failingToCheckMutex       sync.RWMutex
 	failingToCheckArgsForCall []struct {
@@ -70,6 +90,21 @@ type FakeResource struct {
 	lastCheckedReturnsOnCall map[int]struct {
 		result1 time.Time
 	}
+	LatestSpaceVersionStub        func(string) (atc.Version, bool, error)
+	latestSpaceVersionMutex       sync.RWMutex
+	latestSpaceVersionArgsForCall []struct {
+		arg1 string
+	}
+	latestSpaceVersionReturns struct {
+		result1 atc.Version
+		result2 bool
+		result3 error
+	}
+	latestSpaceVersionReturnsOnCall map[int]struct {
+		result1 atc.Version
+		result2 bool
+		result3 error
+	}
 	NameStub        func() string
 	nameMutex       sync.RWMutex
 	nameArgsForCall []struct {
@@ -100,6 +135,39 @@ type FakeResource struct {
 	pausedReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	PinCommentStub        func() string
+	pinCommentMutex       sync.RWMutex
+	pinCommentArgsForCall []struct {
+	}
+	pinCommentReturns struct {
+		result1 string
+	}
+	pinCommentReturnsOnCall map[int]struct {
+		result1 string
+	}
+	PinVersionStub        func(atc.Version, string, string) error
+	pinVersionMutex       sync.RWMutex
+	pinVersionArgsForCall []struct {
+		arg1 atc.Version
+		arg2 string
+		arg3 string
+	}
+	pinVersionReturns struct {
+		result1 error
+	}
+	pinVersionReturnsOnCall map[int]struct {
+		result1 error
+	}
+	PinnedByStub        func() string
+	pinnedByMutex       sync.RWMutex
+	pinnedByArgsForCall []struct {
+	}
+	pinnedByReturns struct {
+		result1 string
+	}
+	pinnedByReturnsOnCall map[int]struct {
+		result1 string
+	}
 	PinnedVersionStub        func() atc.Version
 	pinnedVersionMutex       sync.RWMutex
 	pinnedVersionArgsForCall []struct {
@@ -132,6 +200,16 @@ type FakeResource struct {
 		result1 bool
 		result2 error
 	}
+	ResourceConfigIDStub        func() int
+	resourceConfigIDMutex       sync.RWMutex
+	resourceConfigIDArgsForCall []struct {
+	}
+	resourceConfigIDReturns struct {
+		result1 int
+	}
+	resourceConfigIDReturnsOnCall map[int]struct {
+		result1 int
+	}
 	SetResourceConfigStub        func(int) error
 	setResourceConfigMutex       sync.RWMutex
 	setResourceConfigArgsForCall []struct {
@@ -193,6 +271,36 @@ type FakeResource struct {
 	unpauseReturnsOnCall map[int]struct {
 		result1 error
 	}
+	UnpinVersionStub        func() error
+	unpinVersionMutex       sync.RWMutex
+	unpinVersionArgsForCall []struct {
+	}
+	unpinVersionReturns struct {
+		result1 error
+	}
+	unpinVersionReturnsOnCall map[int]struct {
+		result1 error
+	}
+	WebhookFiltersStub        func() []atc.WebhookFilter
+	webhookFiltersMutex       sync.RWMutex
+	webhookFiltersArgsForCall []struct {
+	}
+	webhookFiltersReturns struct {
+		result1 []atc.WebhookFilter
+	}
+	webhookFiltersReturnsOnCall map[int]struct {
+		result1 []atc.WebhookFilter
+	}
+	WebhookSignatureStub        func() *atc.WebhookSignatureConfig
+	webhookSignatureMutex       sync.RWMutex
+	webhookSignatureArgsForCall []struct {
+	}
+	webhookSignatureReturns struct {
+		result1 *atc.WebhookSignatureConfig
+	}
+	webhookSignatureReturnsOnCall map[int]struct {
+		result1 *atc.WebhookSignatureConfig
+	}
 	WebhookTokenStub        func() string
 	webhookTokenMutex       sync.RWMutex
 	webhookTokenArgsForCall []struct {
@@ -207,6 +315,48 @@ type FakeResource struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeResource) APIPinnedVersion() atc.Version {
+	fake.aPIPinnedVersionMutex.Lock()
+	ret, specificReturn := fake.aPIPinnedVersionReturnsOnCall[len(fake.aPIPinnedVersionArgsForCall)]
+	fake.aPIPinnedVersionArgsForCall = append(fake.aPIPinnedVersionArgsForCall, struct {
+	}{})
+	fake.recordInvocation("APIPinnedVersion", []interface{}{})
+	fake.aPIPinnedVersionMutex.Unlock()
+	if fake.APIPinnedVersionStub != nil {
+		return fake.APIPinnedVersionStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.aPIPinnedVersionReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeResource) APIPinnedVersionCallCount() int {
+	fake.aPIPinnedVersionMutex.RLock()
+	defer fake.aPIPinnedVersionMutex.RUnlock()
+	return len(fake.aPIPinnedVersionArgsForCall)
+}
+
+func (fake *FakeResource) APIPinnedVersionReturns(result1 atc.Version) {
+	fake.APIPinnedVersionStub = nil
+	fake.aPIPinnedVersionReturns = struct {
+		result1 atc.Version
+	}{result1}
+}
+
+func (fake *FakeResource) APIPinnedVersionReturnsOnCall(i int, result1 atc.Version) {
+	fake.APIPinnedVersionStub = nil
+	if fake.aPIPinnedVersionReturnsOnCall == nil {
+		fake.aPIPinnedVersionReturnsOnCall = make(map[int]struct {
+			result1 atc.Version
+		})
+	}
+	fake.aPIPinnedVersionReturnsOnCall[i] = struct {
+		result1 atc.Version
+	}{result1}
+}
+
 func (fake *FakeResource) CheckError() error {
 	fake.checkErrorMutex.Lock()
 	ret, specificReturn := fake.checkErrorReturnsOnCall[len(fake.checkErrorArgsForCall)]
@@ -333,6 +483,48 @@ func (fake *FakeResource) CheckTimeoutReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeResource) ConfigPinnedVersion() atc.Version {
+	fake.configPinnedVersionMutex.Lock()
+	ret, specificReturn := fake.configPinnedVersionReturnsOnCall[len(fake.configPinnedVersionArgsForCall)]
+	fake.configPinnedVersionArgsForCall = append(fake.configPinnedVersionArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ConfigPinnedVersion", []interface{}{})
+	fake.configPinnedVersionMutex.Unlock()
+	if fake.ConfigPinnedVersionStub != nil {
+		return fake.ConfigPinnedVersionStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.configPinnedVersionReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeResource) ConfigPinnedVersionCallCount() int {
+	fake.configPinnedVersionMutex.RLock()
+	defer fake.configPinnedVersionMutex.RUnlock()
+	return len(fake.configPinnedVersionArgsForCall)
+}
+
+func (fake *FakeResource) ConfigPinnedVersionReturns(result1 atc.Version) {
+	fake.ConfigPinnedVersionStub = nil
+	fake.configPinnedVersionReturns = struct {
+		result1 atc.Version
+	}{result1}
+}
+
+func (fake *FakeResource) ConfigPinnedVersionReturnsOnCall(i int, result1 atc.Version) {
+	fake.ConfigPinnedVersionStub = nil
+	if fake.configPinnedVersionReturnsOnCall == nil {
+		fake.configPinnedVersionReturnsOnCall = make(map[int]struct {
+			result1 atc.Version
+		})
+	}
+	fake.configPinnedVersionReturnsOnCall[i] = struct {
+		result1 atc.Version
+	}{result1}
+}
+
 func (fake *FakeResource) FailingToCheck() bool {
 	fake.failingToCheckMutex.Lock()
 	ret, specificReturn := fake.failingToCheckReturnsOnCall[len(fake.failingToCheckArgsForCall)]
@@ -459,6 +651,62 @@ func (fake *FakeResource) LastCheckedReturnsOnCall(i int, result1 time.Time) {
 	}{result1}
 }
 
+func (fake *FakeResource) LatestSpaceVersion(arg1 string) (atc.Version, bool, error) {
+	fake.latestSpaceVersionMutex.Lock()
+	ret, specificReturn := fake.latestSpaceVersionReturnsOnCall[len(fake.latestSpaceVersionArgsForCall)]
+	fake.latestSpaceVersionArgsForCall = append(fake.latestSpaceVersionArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("LatestSpaceVersion", []interface{}{arg1})
+	fake.latestSpaceVersionMutex.Unlock()
+	if fake.LatestSpaceVersionStub != nil {
+		return fake.LatestSpaceVersionStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.latestSpaceVersionReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeResource) LatestSpaceVersionCallCount() int {
+	fake.latestSpaceVersionMutex.RLock()
+	defer fake.latestSpaceVersionMutex.RUnlock()
+	return len(fake.latestSpaceVersionArgsForCall)
+}
+
+func (fake *FakeResource) LatestSpaceVersionArgsForCall(i int) string {
+	fake.latestSpaceVersionMutex.RLock()
+	defer fake.latestSpaceVersionMutex.RUnlock()
+	argsForCall := fake.latestSpaceVersionArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeResource) LatestSpaceVersionReturns(result1 atc.Version, result2 bool, result3 error) {
+	fake.LatestSpaceVersionStub = nil
+	fake.latestSpaceVersionReturns = struct {
+		result1 atc.Version
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeResource) LatestSpaceVersionReturnsOnCall(i int, result1 atc.Version, result2 bool, result3 error) {
+	fake.LatestSpaceVersionStub = nil
+	if fake.latestSpaceVersionReturnsOnCall == nil {
+		fake.latestSpaceVersionReturnsOnCall = make(map[int]struct {
+			result1 atc.Version
+			result2 bool
+			result3 error
+		})
+	}
+	fake.latestSpaceVersionReturnsOnCall[i] = struct {
+		result1 atc.Version
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeResource) Name() string {
 	fake.nameMutex.Lock()
 	ret, specificReturn := fake.nameReturnsOnCall[len(fake.nameArgsForCall)]
@@ -585,6 +833,142 @@ func (fake *FakeResource) PausedReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *FakeResource) PinComment() string {
+	fake.pinCommentMutex.Lock()
+	ret, specificReturn := fake.pinCommentReturnsOnCall[len(fake.pinCommentArgsForCall)]
+	fake.pinCommentArgsForCall = append(fake.pinCommentArgsForCall, struct {
+	}{})
+	fake.recordInvocation("PinComment", []interface{}{})
+	fake.pinCommentMutex.Unlock()
+	if fake.PinCommentStub != nil {
+		return fake.PinCommentStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.pinCommentReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeResource) PinCommentCallCount() int {
+	fake.pinCommentMutex.RLock()
+	defer fake.pinCommentMutex.RUnlock()
+	return len(fake.pinCommentArgsForCall)
+}
+
+func (fake *FakeResource) PinCommentReturns(result1 string) {
+	fake.PinCommentStub = nil
+	fake.pinCommentReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeResource) PinCommentReturnsOnCall(i int, result1 string) {
+	fake.PinCommentStub = nil
+	if fake.pinCommentReturnsOnCall == nil {
+		fake.pinCommentReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.pinCommentReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeResource) PinVersion(arg1 atc.Version, arg2 string, arg3 string) error {
+	fake.pinVersionMutex.Lock()
+	ret, specificReturn := fake.pinVersionReturnsOnCall[len(fake.pinVersionArgsForCall)]
+	fake.pinVersionArgsForCall = append(fake.pinVersionArgsForCall, struct {
+		arg1 atc.Version
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("PinVersion", []interface{}{arg1, arg2, arg3})
+	fake.pinVersionMutex.Unlock()
+	if fake.PinVersionStub != nil {
+		return fake.PinVersionStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.pinVersionReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeResource) PinVersionCallCount() int {
+	fake.pinVersionMutex.RLock()
+	defer fake.pinVersionMutex.RUnlock()
+	return len(fake.pinVersionArgsForCall)
+}
+
+func (fake *FakeResource) PinVersionArgsForCall(i int) (atc.Version, string, string) {
+	fake.pinVersionMutex.RLock()
+	defer fake.pinVersionMutex.RUnlock()
+	argsForCall := fake.pinVersionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeResource) PinVersionReturns(result1 error) {
+	fake.PinVersionStub = nil
+	fake.pinVersionReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeResource) PinVersionReturnsOnCall(i int, result1 error) {
+	fake.PinVersionStub = nil
+	if fake.pinVersionReturnsOnCall == nil {
+		fake.pinVersionReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.pinVersionReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeResource) PinnedBy() string {
+	fake.pinnedByMutex.Lock()
+	ret, specificReturn := fake.pinnedByReturnsOnCall[len(fake.pinnedByArgsForCall)]
+	fake.pinnedByArgsForCall = append(fake.pinnedByArgsForCall, struct {
+	}{})
+	fake.recordInvocation("PinnedBy", []interface{}{})
+	fake.pinnedByMutex.Unlock()
+	if fake.PinnedByStub != nil {
+		return fake.PinnedByStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.pinnedByReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeResource) PinnedByCallCount() int {
+	fake.pinnedByMutex.RLock()
+	defer fake.pinnedByMutex.RUnlock()
+	return len(fake.pinnedByArgsForCall)
+}
+
+func (fake *FakeResource) PinnedByReturns(result1 string) {
+	fake.PinnedByStub = nil
+	fake.pinnedByReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeResource) PinnedByReturnsOnCall(i int, result1 string) {
+	fake.PinnedByStub = nil
+	if fake.pinnedByReturnsOnCall == nil {
+		fake.pinnedByReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.pinnedByReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeResource) PinnedVersion() atc.Version {
 	fake.pinnedVersionMutex.Lock()
 	ret, specificReturn := fake.pinnedVersionReturnsOnCall[len(fake.pinnedVersionArgsForCall)]
@@ -714,6 +1098,48 @@ func (fake *FakeResource) ReloadReturnsOnCall(i int, result1 bool, result2 error
 	}{result1, result2}
 }
 
+func (fake *FakeResource) ResourceConfigID() int {
+	fake.resourceConfigIDMutex.Lock()
+	ret, specificReturn := fake.resourceConfigIDReturnsOnCall[len(fake.resourceConfigIDArgsForCall)]
+	fake.resourceConfigIDArgsForCall = append(fake.resourceConfigIDArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ResourceConfigID", []interface{}{})
+	fake.resourceConfigIDMutex.Unlock()
+	if fake.ResourceConfigIDStub != nil {
+		return fake.ResourceConfigIDStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.resourceConfigIDReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeResource) ResourceConfigIDCallCount() int {
+	fake.resourceConfigIDMutex.RLock()
+	defer fake.resourceConfigIDMutex.RUnlock()
+	return len(fake.resourceConfigIDArgsForCall)
+}
+
+func (fake *FakeResource) ResourceConfigIDReturns(result1 int) {
+	fake.ResourceConfigIDStub = nil
+	fake.resourceConfigIDReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeResource) ResourceConfigIDReturnsOnCall(i int, result1 int) {
+	fake.ResourceConfigIDStub = nil
+	if fake.resourceConfigIDReturnsOnCall == nil {
+		fake.resourceConfigIDReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.resourceConfigIDReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
 func (fake *FakeResource) SetResourceConfig(arg1 int) error {
 	fake.setResourceConfigMutex.Lock()
 	ret, specificReturn := fake.setResourceConfigReturnsOnCall[len(fake.setResourceConfigArgsForCall)]
@@ -974,6 +1400,132 @@ func (fake *FakeResource) UnpauseReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeResource) UnpinVersion() error {
+	fake.unpinVersionMutex.Lock()
+	ret, specificReturn := fake.unpinVersionReturnsOnCall[len(fake.unpinVersionArgsForCall)]
+	fake.unpinVersionArgsForCall = append(fake.unpinVersionArgsForCall, struct {
+	}{})
+	fake.recordInvocation("UnpinVersion", []interface{}{})
+	fake.unpinVersionMutex.Unlock()
+	if fake.UnpinVersionStub != nil {
+		return fake.UnpinVersionStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.unpinVersionReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeResource) UnpinVersionCallCount() int {
+	fake.unpinVersionMutex.RLock()
+	defer fake.unpinVersionMutex.RUnlock()
+	return len(fake.unpinVersionArgsForCall)
+}
+
+func (fake *FakeResource) UnpinVersionReturns(result1 error) {
+	fake.UnpinVersionStub = nil
+	fake.unpinVersionReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeResource) UnpinVersionReturnsOnCall(i int, result1 error) {
+	fake.UnpinVersionStub = nil
+	if fake.unpinVersionReturnsOnCall == nil {
+		fake.unpinVersionReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.unpinVersionReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeResource) WebhookFilters() []atc.WebhookFilter {
+	fake.webhookFiltersMutex.Lock()
+	ret, specificReturn := fake.webhookFiltersReturnsOnCall[len(fake.webhookFiltersArgsForCall)]
+	fake.webhookFiltersArgsForCall = append(fake.webhookFiltersArgsForCall, struct {
+	}{})
+	fake.recordInvocation("WebhookFilters", []interface{}{})
+	fake.webhookFiltersMutex.Unlock()
+	if fake.WebhookFiltersStub != nil {
+		return fake.WebhookFiltersStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.webhookFiltersReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeResource) WebhookFiltersCallCount() int {
+	fake.webhookFiltersMutex.RLock()
+	defer fake.webhookFiltersMutex.RUnlock()
+	return len(fake.webhookFiltersArgsForCall)
+}
+
+func (fake *FakeResource) WebhookFiltersReturns(result1 []atc.WebhookFilter) {
+	fake.WebhookFiltersStub = nil
+	fake.webhookFiltersReturns = struct {
+		result1 []atc.WebhookFilter
+	}{result1}
+}
+
+func (fake *FakeResource) WebhookFiltersReturnsOnCall(i int, result1 []atc.WebhookFilter) {
+	fake.WebhookFiltersStub = nil
+	if fake.webhookFiltersReturnsOnCall == nil {
+		fake.webhookFiltersReturnsOnCall = make(map[int]struct {
+			result1 []atc.WebhookFilter
+		})
+	}
+	fake.webhookFiltersReturnsOnCall[i] = struct {
+		result1 []atc.WebhookFilter
+	}{result1}
+}
+
+func (fake *FakeResource) WebhookSignature() *atc.WebhookSignatureConfig {
+	fake.webhookSignatureMutex.Lock()
+	ret, specificReturn := fake.webhookSignatureReturnsOnCall[len(fake.webhookSignatureArgsForCall)]
+	fake.webhookSignatureArgsForCall = append(fake.webhookSignatureArgsForCall, struct {
+	}{})
+	fake.recordInvocation("WebhookSignature", []interface{}{})
+	fake.webhookSignatureMutex.Unlock()
+	if fake.WebhookSignatureStub != nil {
+		return fake.WebhookSignatureStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.webhookSignatureReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeResource) WebhookSignatureCallCount() int {
+	fake.webhookSignatureMutex.RLock()
+	defer fake.webhookSignatureMutex.RUnlock()
+	return len(fake.webhookSignatureArgsForCall)
+}
+
+func (fake *FakeResource) WebhookSignatureReturns(result1 *atc.WebhookSignatureConfig) {
+	fake.WebhookSignatureStub = nil
+	fake.webhookSignatureReturns = struct {
+		result1 *atc.WebhookSignatureConfig
+	}{result1}
+}
+
+func (fake *FakeResource) WebhookSignatureReturnsOnCall(i int, result1 *atc.WebhookSignatureConfig) {
+	fake.WebhookSignatureStub = nil
+	if fake.webhookSignatureReturnsOnCall == nil {
+		fake.webhookSignatureReturnsOnCall = make(map[int]struct {
+			result1 *atc.WebhookSignatureConfig
+		})
+	}
+	fake.webhookSignatureReturnsOnCall[i] = struct {
+		result1 *atc.WebhookSignatureConfig
+	}{result1}
+}
+
 func (fake *FakeResource) WebhookToken() string {
 	fake.webhookTokenMutex.Lock()
 	ret, specificReturn := fake.webhookTokenReturnsOnCall[len(fake.webhookTokenArgsForCall)]
@@ -1019,30 +1571,44 @@ func (fake *FakeResource) WebhookTokenReturnsOnCall(i int, result1 string) {
 func (fake *FakeResource) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.aPIPinnedVersionMutex.RLock()
+	defer fake.aPIPinnedVersionMutex.RUnlock()
 	fake.checkErrorMutex.RLock()
 	defer fake.checkErrorMutex.RUnlock()
 	fake.checkEveryMutex.RLock()
 	defer fake.checkEveryMutex.RUnlock()
 	fake.checkTimeoutMutex.RLock()
 	defer fake.checkTimeoutMutex.RUnlock()
+	fake.configPinnedVersionMutex.RLock()
+	defer fake.configPinnedVersionMutex.RUnlock()
 	fake.failingToCheckMutex.RLock()
 	defer fake.failingToCheckMutex.RUnlock()
 	fake.iDMutex.RLock()
 	defer fake.iDMutex.RUnlock()
 	fake.lastCheckedMutex.RLock()
 	defer fake.lastCheckedMutex.RUnlock()
+	fake.latestSpaceVersionMutex.RLock()
+	defer fake.latestSpaceVersionMutex.RUnlock()
 	fake.nameMutex.RLock()
 	defer fake.nameMutex.RUnlock()
 	fake.pauseMutex.RLock()
 	defer fake.pauseMutex.RUnlock()
 	fake.pausedMutex.RLock()
 	defer fake.pausedMutex.RUnlock()
+	fake.pinCommentMutex.RLock()
+	defer fake.pinCommentMutex.RUnlock()
+	fake.pinVersionMutex.RLock()
+	defer fake.pinVersionMutex.RUnlock()
+	fake.pinnedByMutex.RLock()
+	defer fake.pinnedByMutex.RUnlock()
 	fake.pinnedVersionMutex.RLock()
 	defer fake.pinnedVersionMutex.RUnlock()
 	fake.pipelineNameMutex.RLock()
 	defer fake.pipelineNameMutex.RUnlock()
 	fake.reloadMutex.RLock()
 	defer fake.reloadMutex.RUnlock()
+	fake.resourceConfigIDMutex.RLock()
+	defer fake.resourceConfigIDMutex.RUnlock()
 	fake.setResourceConfigMutex.RLock()
 	defer fake.setResourceConfigMutex.RUnlock()
 	fake.sourceMutex.RLock()
@@ -1055,6 +1621,12 @@ func (fake *FakeResource) Invocations() map[string][][]interface{} {
 	defer fake.typeMutex.RUnlock()
 	fake.unpauseMutex.RLock()
 	defer fake.unpauseMutex.RUnlock()
+	fake.unpinVersionMutex.RLock()
+	defer fake.unpinVersionMutex.RUnlock()
+	fake.webhookFiltersMutex.RLock()
+	defer fake.webhookFiltersMutex.RUnlock()
+	fake.webhookSignatureMutex.RLock()
+	defer fake.webhookSignatureMutex.RUnlock()
 	fake.webhookTokenMutex.RLock()
 	defer fake.webhookTokenMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
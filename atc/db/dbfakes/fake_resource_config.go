@@ -6,6 +6,7 @@ import (
 	time "time"
 
 	lager "code.cloudfoundry.org/lager"
+	atc "github.com/concourse/concourse/atc"
 	db "github.com/concourse/concourse/atc/db"
 	lock "github.com/concourse/concourse/atc/db/lock"
 )
@@ -58,6 +59,32 @@ type FakeResourceConfig struct {
 	iDReturnsOnCall map[int]struct {
 		result1 int
 	}
+	LatestVersionStub        func() (atc.Version, bool, error)
+	latestVersionMutex       sync.RWMutex
+	latestVersionArgsForCall []struct {
+	}
+	latestVersionReturns struct {
+		result1 atc.Version
+		result2 bool
+		result3 error
+	}
+	latestVersionReturnsOnCall map[int]struct {
+		result1 atc.Version
+		result2 bool
+		result3 error
+	}
+	LatestVersionsStub        func() (map[string]atc.Version, error)
+	latestVersionsMutex       sync.RWMutex
+	latestVersionsArgsForCall []struct {
+	}
+	latestVersionsReturns struct {
+		result1 map[string]atc.Version
+		result2 error
+	}
+	latestVersionsReturnsOnCall map[int]struct {
+		result1 map[string]atc.Version
+		result2 error
+	}
 	OriginBaseResourceTypeStub        func() *db.UsedBaseResourceType
 	originBaseResourceTypeMutex       sync.RWMutex
 	originBaseResourceTypeArgsForCall []struct {
@@ -68,6 +95,54 @@ type FakeResourceConfig struct {
 	originBaseResourceTypeReturnsOnCall map[int]struct {
 		result1 *db.UsedBaseResourceType
 	}
+	SaveResourceVersionsStub        func(string, []atc.Version) error
+	saveResourceVersionsMutex       sync.RWMutex
+	saveResourceVersionsArgsForCall []struct {
+		arg1 string
+		arg2 []atc.Version
+	}
+	saveResourceVersionsReturns struct {
+		result1 error
+	}
+	saveResourceVersionsReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SaveVersionsStub        func(string, []atc.Version) error
+	saveVersionsMutex       sync.RWMutex
+	saveVersionsArgsForCall []struct {
+		arg1 string
+		arg2 []atc.Version
+	}
+	saveVersionsReturns struct {
+		result1 error
+	}
+	saveVersionsReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SetDefaultSpaceStub        func(string) error
+	setDefaultSpaceMutex       sync.RWMutex
+	setDefaultSpaceArgsForCall []struct {
+		arg1 string
+	}
+	setDefaultSpaceReturns struct {
+		result1 error
+	}
+	setDefaultSpaceReturnsOnCall map[int]struct {
+		result1 error
+	}
+	VersionsAfterStub        func(atc.Version) ([]atc.Version, error)
+	versionsAfterMutex       sync.RWMutex
+	versionsAfterArgsForCall []struct {
+		arg1 atc.Version
+	}
+	versionsAfterReturns struct {
+		result1 []atc.Version
+		result2 error
+	}
+	versionsAfterReturnsOnCall map[int]struct {
+		result1 []atc.Version
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -256,6 +331,99 @@ func (fake *FakeResourceConfig) IDReturnsOnCall(i int, result1 int) {
 	}{result1}
 }
 
+func (fake *FakeResourceConfig) LatestVersion() (atc.Version, bool, error) {
+	fake.latestVersionMutex.Lock()
+	ret, specificReturn := fake.latestVersionReturnsOnCall[len(fake.latestVersionArgsForCall)]
+	fake.latestVersionArgsForCall = append(fake.latestVersionArgsForCall, struct {
+	}{})
+	fake.recordInvocation("LatestVersion", []interface{}{})
+	fake.latestVersionMutex.Unlock()
+	if fake.LatestVersionStub != nil {
+		return fake.LatestVersionStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.latestVersionReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeResourceConfig) LatestVersionCallCount() int {
+	fake.latestVersionMutex.RLock()
+	defer fake.latestVersionMutex.RUnlock()
+	return len(fake.latestVersionArgsForCall)
+}
+
+func (fake *FakeResourceConfig) LatestVersionReturns(result1 atc.Version, result2 bool, result3 error) {
+	fake.LatestVersionStub = nil
+	fake.latestVersionReturns = struct {
+		result1 atc.Version
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeResourceConfig) LatestVersionReturnsOnCall(i int, result1 atc.Version, result2 bool, result3 error) {
+	fake.LatestVersionStub = nil
+	if fake.latestVersionReturnsOnCall == nil {
+		fake.latestVersionReturnsOnCall = make(map[int]struct {
+			result1 atc.Version
+			result2 bool
+			result3 error
+		})
+	}
+	fake.latestVersionReturnsOnCall[i] = struct {
+		result1 atc.Version
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeResourceConfig) LatestVersions() (map[string]atc.Version, error) {
+	fake.latestVersionsMutex.Lock()
+	ret, specificReturn := fake.latestVersionsReturnsOnCall[len(fake.latestVersionsArgsForCall)]
+	fake.latestVersionsArgsForCall = append(fake.latestVersionsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("LatestVersions", []interface{}{})
+	fake.latestVersionsMutex.Unlock()
+	if fake.LatestVersionsStub != nil {
+		return fake.LatestVersionsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.latestVersionsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeResourceConfig) LatestVersionsCallCount() int {
+	fake.latestVersionsMutex.RLock()
+	defer fake.latestVersionsMutex.RUnlock()
+	return len(fake.latestVersionsArgsForCall)
+}
+
+func (fake *FakeResourceConfig) LatestVersionsReturns(result1 map[string]atc.Version, result2 error) {
+	fake.LatestVersionsStub = nil
+	fake.latestVersionsReturns = struct {
+		result1 map[string]atc.Version
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResourceConfig) LatestVersionsReturnsOnCall(i int, result1 map[string]atc.Version, result2 error) {
+	fake.LatestVersionsStub = nil
+	if fake.latestVersionsReturnsOnCall == nil {
+		fake.latestVersionsReturnsOnCall = make(map[int]struct {
+			result1 map[string]atc.Version
+			result2 error
+		})
+	}
+	fake.latestVersionsReturnsOnCall[i] = struct {
+		result1 map[string]atc.Version
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeResourceConfig) OriginBaseResourceType() *db.UsedBaseResourceType {
 	fake.originBaseResourceTypeMutex.Lock()
 	ret, specificReturn := fake.originBaseResourceTypeReturnsOnCall[len(fake.originBaseResourceTypeArgsForCall)]
@@ -298,6 +466,221 @@ func (fake *FakeResourceConfig) OriginBaseResourceTypeReturnsOnCall(i int, resul
 	}{result1}
 }
 
+func (fake *FakeResourceConfig) SaveResourceVersions(arg1 string, arg2 []atc.Version) error {
+	var arg2Copy []atc.Version
+	if arg2 != nil {
+		arg2Copy = make([]atc.Version, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.saveResourceVersionsMutex.Lock()
+	ret, specificReturn := fake.saveResourceVersionsReturnsOnCall[len(fake.saveResourceVersionsArgsForCall)]
+	fake.saveResourceVersionsArgsForCall = append(fake.saveResourceVersionsArgsForCall, struct {
+		arg1 string
+		arg2 []atc.Version
+	}{arg1, arg2Copy})
+	fake.recordInvocation("SaveResourceVersions", []interface{}{arg1, arg2Copy})
+	fake.saveResourceVersionsMutex.Unlock()
+	if fake.SaveResourceVersionsStub != nil {
+		return fake.SaveResourceVersionsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.saveResourceVersionsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeResourceConfig) SaveResourceVersionsCallCount() int {
+	fake.saveResourceVersionsMutex.RLock()
+	defer fake.saveResourceVersionsMutex.RUnlock()
+	return len(fake.saveResourceVersionsArgsForCall)
+}
+
+func (fake *FakeResourceConfig) SaveResourceVersionsArgsForCall(i int) (string, []atc.Version) {
+	fake.saveResourceVersionsMutex.RLock()
+	defer fake.saveResourceVersionsMutex.RUnlock()
+	argsForCall := fake.saveResourceVersionsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeResourceConfig) SaveResourceVersionsReturns(result1 error) {
+	fake.SaveResourceVersionsStub = nil
+	fake.saveResourceVersionsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeResourceConfig) SaveResourceVersionsReturnsOnCall(i int, result1 error) {
+	fake.SaveResourceVersionsStub = nil
+	if fake.saveResourceVersionsReturnsOnCall == nil {
+		fake.saveResourceVersionsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.saveResourceVersionsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeResourceConfig) SaveVersions(arg1 string, arg2 []atc.Version) error {
+	var arg2Copy []atc.Version
+	if arg2 != nil {
+		arg2Copy = make([]atc.Version, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.saveVersionsMutex.Lock()
+	ret, specificReturn := fake.saveVersionsReturnsOnCall[len(fake.saveVersionsArgsForCall)]
+	fake.saveVersionsArgsForCall = append(fake.saveVersionsArgsForCall, struct {
+		arg1 string
+		arg2 []atc.Version
+	}{arg1, arg2Copy})
+	fake.recordInvocation("SaveVersions", []interface{}{arg1, arg2Copy})
+	fake.saveVersionsMutex.Unlock()
+	if fake.SaveVersionsStub != nil {
+		return fake.SaveVersionsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.saveVersionsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeResourceConfig) SaveVersionsCallCount() int {
+	fake.saveVersionsMutex.RLock()
+	defer fake.saveVersionsMutex.RUnlock()
+	return len(fake.saveVersionsArgsForCall)
+}
+
+func (fake *FakeResourceConfig) SaveVersionsArgsForCall(i int) (string, []atc.Version) {
+	fake.saveVersionsMutex.RLock()
+	defer fake.saveVersionsMutex.RUnlock()
+	argsForCall := fake.saveVersionsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeResourceConfig) SaveVersionsReturns(result1 error) {
+	fake.SaveVersionsStub = nil
+	fake.saveVersionsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeResourceConfig) SaveVersionsReturnsOnCall(i int, result1 error) {
+	fake.SaveVersionsStub = nil
+	if fake.saveVersionsReturnsOnCall == nil {
+		fake.saveVersionsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.saveVersionsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeResourceConfig) SetDefaultSpace(arg1 string) error {
+	fake.setDefaultSpaceMutex.Lock()
+	ret, specificReturn := fake.setDefaultSpaceReturnsOnCall[len(fake.setDefaultSpaceArgsForCall)]
+	fake.setDefaultSpaceArgsForCall = append(fake.setDefaultSpaceArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("SetDefaultSpace", []interface{}{arg1})
+	fake.setDefaultSpaceMutex.Unlock()
+	if fake.SetDefaultSpaceStub != nil {
+		return fake.SetDefaultSpaceStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.setDefaultSpaceReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeResourceConfig) SetDefaultSpaceCallCount() int {
+	fake.setDefaultSpaceMutex.RLock()
+	defer fake.setDefaultSpaceMutex.RUnlock()
+	return len(fake.setDefaultSpaceArgsForCall)
+}
+
+func (fake *FakeResourceConfig) SetDefaultSpaceArgsForCall(i int) string {
+	fake.setDefaultSpaceMutex.RLock()
+	defer fake.setDefaultSpaceMutex.RUnlock()
+	argsForCall := fake.setDefaultSpaceArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeResourceConfig) SetDefaultSpaceReturns(result1 error) {
+	fake.SetDefaultSpaceStub = nil
+	fake.setDefaultSpaceReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeResourceConfig) SetDefaultSpaceReturnsOnCall(i int, result1 error) {
+	fake.SetDefaultSpaceStub = nil
+	if fake.setDefaultSpaceReturnsOnCall == nil {
+		fake.setDefaultSpaceReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setDefaultSpaceReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeResourceConfig) VersionsAfter(arg1 atc.Version) ([]atc.Version, error) {
+	fake.versionsAfterMutex.Lock()
+	ret, specificReturn := fake.versionsAfterReturnsOnCall[len(fake.versionsAfterArgsForCall)]
+	fake.versionsAfterArgsForCall = append(fake.versionsAfterArgsForCall, struct {
+		arg1 atc.Version
+	}{arg1})
+	fake.recordInvocation("VersionsAfter", []interface{}{arg1})
+	fake.versionsAfterMutex.Unlock()
+	if fake.VersionsAfterStub != nil {
+		return fake.VersionsAfterStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.versionsAfterReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeResourceConfig) VersionsAfterCallCount() int {
+	fake.versionsAfterMutex.RLock()
+	defer fake.versionsAfterMutex.RUnlock()
+	return len(fake.versionsAfterArgsForCall)
+}
+
+func (fake *FakeResourceConfig) VersionsAfterArgsForCall(i int) atc.Version {
+	fake.versionsAfterMutex.RLock()
+	defer fake.versionsAfterMutex.RUnlock()
+	argsForCall := fake.versionsAfterArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeResourceConfig) VersionsAfterReturns(result1 []atc.Version, result2 error) {
+	fake.VersionsAfterStub = nil
+	fake.versionsAfterReturns = struct {
+		result1 []atc.Version
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResourceConfig) VersionsAfterReturnsOnCall(i int, result1 []atc.Version, result2 error) {
+	fake.VersionsAfterStub = nil
+	if fake.versionsAfterReturnsOnCall == nil {
+		fake.versionsAfterReturnsOnCall = make(map[int]struct {
+			result1 []atc.Version
+			result2 error
+		})
+	}
+	fake.versionsAfterReturnsOnCall[i] = struct {
+		result1 []atc.Version
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeResourceConfig) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -309,8 +692,20 @@ func (fake *FakeResourceConfig) Invocations() map[string][][]interface{} {
 	defer fake.createdByResourceCacheMutex.RUnlock()
 	fake.iDMutex.RLock()
 	defer fake.iDMutex.RUnlock()
+	fake.latestVersionMutex.RLock()
+	defer fake.latestVersionMutex.RUnlock()
+	fake.latestVersionsMutex.RLock()
+	defer fake.latestVersionsMutex.RUnlock()
 	fake.originBaseResourceTypeMutex.RLock()
 	defer fake.originBaseResourceTypeMutex.RUnlock()
+	fake.saveResourceVersionsMutex.RLock()
+	defer fake.saveResourceVersionsMutex.RUnlock()
+	fake.saveVersionsMutex.RLock()
+	defer fake.saveVersionsMutex.RUnlock()
+	fake.setDefaultSpaceMutex.RLock()
+	defer fake.setDefaultSpaceMutex.RUnlock()
+	fake.versionsAfterMutex.RLock()
+	defer fake.versionsAfterMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -8,6 +8,18 @@ import (
 )
 
 type FakeResourceFactory struct {
+	LastSuccessfulChecksStub        func() ([]db.ResourceLastSuccessfulCheck, error)
+	lastSuccessfulChecksMutex       sync.RWMutex
+	lastSuccessfulChecksArgsForCall []struct {
+	}
+	lastSuccessfulChecksReturns struct {
+		result1 []db.ResourceLastSuccessfulCheck
+		result2 error
+	}
+	lastSuccessfulChecksReturnsOnCall map[int]struct {
+		result1 []db.ResourceLastSuccessfulCheck
+		result2 error
+	}
 	VisibleResourcesStub        func([]string) ([]db.Resource, error)
 	visibleResourcesMutex       sync.RWMutex
 	visibleResourcesArgsForCall []struct {
@@ -25,6 +37,51 @@ type FakeResourceFactory struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeResourceFactory) LastSuccessfulChecks() ([]db.ResourceLastSuccessfulCheck, error) {
+	fake.lastSuccessfulChecksMutex.Lock()
+	ret, specificReturn := fake.lastSuccessfulChecksReturnsOnCall[len(fake.lastSuccessfulChecksArgsForCall)]
+	fake.lastSuccessfulChecksArgsForCall = append(fake.lastSuccessfulChecksArgsForCall, struct {
+	}{})
+	fake.recordInvocation("LastSuccessfulChecks", []interface{}{})
+	fake.lastSuccessfulChecksMutex.Unlock()
+	if fake.LastSuccessfulChecksStub != nil {
+		return fake.LastSuccessfulChecksStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.lastSuccessfulChecksReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeResourceFactory) LastSuccessfulChecksCallCount() int {
+	fake.lastSuccessfulChecksMutex.RLock()
+	defer fake.lastSuccessfulChecksMutex.RUnlock()
+	return len(fake.lastSuccessfulChecksArgsForCall)
+}
+
+func (fake *FakeResourceFactory) LastSuccessfulChecksReturns(result1 []db.ResourceLastSuccessfulCheck, result2 error) {
+	fake.LastSuccessfulChecksStub = nil
+	fake.lastSuccessfulChecksReturns = struct {
+		result1 []db.ResourceLastSuccessfulCheck
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResourceFactory) LastSuccessfulChecksReturnsOnCall(i int, result1 []db.ResourceLastSuccessfulCheck, result2 error) {
+	fake.LastSuccessfulChecksStub = nil
+	if fake.lastSuccessfulChecksReturnsOnCall == nil {
+		fake.lastSuccessfulChecksReturnsOnCall = make(map[int]struct {
+			result1 []db.ResourceLastSuccessfulCheck
+			result2 error
+		})
+	}
+	fake.lastSuccessfulChecksReturnsOnCall[i] = struct {
+		result1 []db.ResourceLastSuccessfulCheck
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeResourceFactory) VisibleResources(arg1 []string) ([]db.Resource, error) {
 	var arg1Copy []string
 	if arg1 != nil {
@@ -86,6 +143,8 @@ func (fake *FakeResourceFactory) VisibleResourcesReturnsOnCall(i int, result1 []
 func (fake *FakeResourceFactory) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.lastSuccessfulChecksMutex.RLock()
+	defer fake.lastSuccessfulChecksMutex.RUnlock()
 	fake.visibleResourcesMutex.RLock()
 	defer fake.visibleResourcesMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
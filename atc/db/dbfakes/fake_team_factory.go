@@ -34,6 +34,21 @@ type FakeTeamFactory struct {
 		result1 db.Team
 		result2 error
 	}
+	FindCredentialManagerConfigStub        func(string) (atc.CredentialManagerConfig, bool, error)
+	findCredentialManagerConfigMutex       sync.RWMutex
+	findCredentialManagerConfigArgsForCall []struct {
+		arg1 string
+	}
+	findCredentialManagerConfigReturns struct {
+		result1 atc.CredentialManagerConfig
+		result2 bool
+		result3 error
+	}
+	findCredentialManagerConfigReturnsOnCall map[int]struct {
+		result1 atc.CredentialManagerConfig
+		result2 bool
+		result3 error
+	}
 	FindTeamStub        func(string) (db.Team, bool, error)
 	findTeamMutex       sync.RWMutex
 	findTeamArgsForCall []struct {
@@ -174,6 +189,62 @@ func (fake *FakeTeamFactory) CreateTeamReturnsOnCall(i int, result1 db.Team, res
 	}{result1, result2}
 }
 
+func (fake *FakeTeamFactory) FindCredentialManagerConfig(arg1 string) (atc.CredentialManagerConfig, bool, error) {
+	fake.findCredentialManagerConfigMutex.Lock()
+	ret, specificReturn := fake.findCredentialManagerConfigReturnsOnCall[len(fake.findCredentialManagerConfigArgsForCall)]
+	fake.findCredentialManagerConfigArgsForCall = append(fake.findCredentialManagerConfigArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("FindCredentialManagerConfig", []interface{}{arg1})
+	fake.findCredentialManagerConfigMutex.Unlock()
+	if fake.FindCredentialManagerConfigStub != nil {
+		return fake.FindCredentialManagerConfigStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.findCredentialManagerConfigReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeamFactory) FindCredentialManagerConfigCallCount() int {
+	fake.findCredentialManagerConfigMutex.RLock()
+	defer fake.findCredentialManagerConfigMutex.RUnlock()
+	return len(fake.findCredentialManagerConfigArgsForCall)
+}
+
+func (fake *FakeTeamFactory) FindCredentialManagerConfigArgsForCall(i int) string {
+	fake.findCredentialManagerConfigMutex.RLock()
+	defer fake.findCredentialManagerConfigMutex.RUnlock()
+	argsForCall := fake.findCredentialManagerConfigArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeamFactory) FindCredentialManagerConfigReturns(result1 atc.CredentialManagerConfig, result2 bool, result3 error) {
+	fake.FindCredentialManagerConfigStub = nil
+	fake.findCredentialManagerConfigReturns = struct {
+		result1 atc.CredentialManagerConfig
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeamFactory) FindCredentialManagerConfigReturnsOnCall(i int, result1 atc.CredentialManagerConfig, result2 bool, result3 error) {
+	fake.FindCredentialManagerConfigStub = nil
+	if fake.findCredentialManagerConfigReturnsOnCall == nil {
+		fake.findCredentialManagerConfigReturnsOnCall = make(map[int]struct {
+			result1 atc.CredentialManagerConfig
+			result2 bool
+			result3 error
+		})
+	}
+	fake.findCredentialManagerConfigReturnsOnCall[i] = struct {
+		result1 atc.CredentialManagerConfig
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeTeamFactory) FindTeam(arg1 string) (db.Team, bool, error) {
 	fake.findTeamMutex.Lock()
 	ret, specificReturn := fake.findTeamReturnsOnCall[len(fake.findTeamArgsForCall)]
@@ -332,6 +403,8 @@ func (fake *FakeTeamFactory) Invocations() map[string][][]interface{} {
 	defer fake.createDefaultTeamIfNotExistsMutex.RUnlock()
 	fake.createTeamMutex.RLock()
 	defer fake.createTeamMutex.RUnlock()
+	fake.findCredentialManagerConfigMutex.RLock()
+	defer fake.findCredentialManagerConfigMutex.RUnlock()
 	fake.findTeamMutex.RLock()
 	defer fake.findTeamMutex.RUnlock()
 	fake.getByIDMutex.RLock()
@@ -95,6 +95,22 @@ type FakeVolumeRepository struct {
 		result2 db.CreatedVolume
 		result3 error
 	}
+	FindBuildArtifactVolumeStub        func(int, string) (db.CreatedVolume, bool, error)
+	findBuildArtifactVolumeMutex       sync.RWMutex
+	findBuildArtifactVolumeArgsForCall []struct {
+		arg1 int
+		arg2 string
+	}
+	findBuildArtifactVolumeReturns struct {
+		result1 db.CreatedVolume
+		result2 bool
+		result3 error
+	}
+	findBuildArtifactVolumeReturnsOnCall map[int]struct {
+		result1 db.CreatedVolume
+		result2 bool
+		result3 error
+	}
 	FindContainerVolumeStub        func(int, string, db.CreatingContainer, string) (db.CreatingVolume, db.CreatedVolume, error)
 	findContainerVolumeMutex       sync.RWMutex
 	findContainerVolumeArgsForCall []struct {
@@ -160,6 +176,22 @@ type FakeVolumeRepository struct {
 		result2 db.CreatedVolume
 		result3 error
 	}
+	FindStreamedResourceCacheVolumeStub        func(string, db.UsedResourceCache) (db.CreatedVolume, bool, error)
+	findStreamedResourceCacheVolumeMutex       sync.RWMutex
+	findStreamedResourceCacheVolumeArgsForCall []struct {
+		arg1 string
+		arg2 db.UsedResourceCache
+	}
+	findStreamedResourceCacheVolumeReturns struct {
+		result1 db.CreatedVolume
+		result2 bool
+		result3 error
+	}
+	findStreamedResourceCacheVolumeReturnsOnCall map[int]struct {
+		result1 db.CreatedVolume
+		result2 bool
+		result3 error
+	}
 	FindTaskCacheVolumeStub        func(int, *db.UsedWorkerTaskCache) (db.CreatingVolume, db.CreatedVolume, error)
 	findTaskCacheVolumeMutex       sync.RWMutex
 	findTaskCacheVolumeArgsForCall []struct {
@@ -241,6 +273,32 @@ type FakeVolumeRepository struct {
 		result1 int
 		result2 error
 	}
+	RemoveExpiredArtifactsStub        func(time.Duration) (int, error)
+	removeExpiredArtifactsMutex       sync.RWMutex
+	removeExpiredArtifactsArgsForCall []struct {
+		arg1 time.Duration
+	}
+	removeExpiredArtifactsReturns struct {
+		result1 int
+		result2 error
+	}
+	removeExpiredArtifactsReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
+	RemoveExpiredTaskOutputCachesStub        func(time.Duration) (int, error)
+	removeExpiredTaskOutputCachesMutex       sync.RWMutex
+	removeExpiredTaskOutputCachesArgsForCall []struct {
+		arg1 time.Duration
+	}
+	removeExpiredTaskOutputCachesReturns struct {
+		result1 int
+		result2 error
+	}
+	removeExpiredTaskOutputCachesReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
 	RemoveMissingVolumesStub        func(time.Duration) (int, error)
 	removeMissingVolumesMutex       sync.RWMutex
 	removeMissingVolumesArgsForCall []struct {
@@ -254,6 +312,18 @@ type FakeVolumeRepository struct {
 		result1 int
 		result2 error
 	}
+	RemoveTaskOutputCacheUsesOfFinishedBuildsStub        func() (int, error)
+	removeTaskOutputCacheUsesOfFinishedBuildsMutex       sync.RWMutex
+	removeTaskOutputCacheUsesOfFinishedBuildsArgsForCall []struct {
+	}
+	removeTaskOutputCacheUsesOfFinishedBuildsReturns struct {
+		result1 int
+		result2 error
+	}
+	removeTaskOutputCacheUsesOfFinishedBuildsReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
 	UpdateVolumesMissingSinceStub        func(string, []string) error
 	updateVolumesMissingSinceMutex       sync.RWMutex
 	updateVolumesMissingSinceArgsForCall []struct {
@@ -590,6 +660,63 @@ func (fake *FakeVolumeRepository) FindBaseResourceTypeVolumeReturnsOnCall(i int,
 	}{result1, result2, result3}
 }
 
+func (fake *FakeVolumeRepository) FindBuildArtifactVolume(arg1 int, arg2 string) (db.CreatedVolume, bool, error) {
+	fake.findBuildArtifactVolumeMutex.Lock()
+	ret, specificReturn := fake.findBuildArtifactVolumeReturnsOnCall[len(fake.findBuildArtifactVolumeArgsForCall)]
+	fake.findBuildArtifactVolumeArgsForCall = append(fake.findBuildArtifactVolumeArgsForCall, struct {
+		arg1 int
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("FindBuildArtifactVolume", []interface{}{arg1, arg2})
+	fake.findBuildArtifactVolumeMutex.Unlock()
+	if fake.FindBuildArtifactVolumeStub != nil {
+		return fake.FindBuildArtifactVolumeStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.findBuildArtifactVolumeReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeVolumeRepository) FindBuildArtifactVolumeCallCount() int {
+	fake.findBuildArtifactVolumeMutex.RLock()
+	defer fake.findBuildArtifactVolumeMutex.RUnlock()
+	return len(fake.findBuildArtifactVolumeArgsForCall)
+}
+
+func (fake *FakeVolumeRepository) FindBuildArtifactVolumeArgsForCall(i int) (int, string) {
+	fake.findBuildArtifactVolumeMutex.RLock()
+	defer fake.findBuildArtifactVolumeMutex.RUnlock()
+	argsForCall := fake.findBuildArtifactVolumeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeVolumeRepository) FindBuildArtifactVolumeReturns(result1 db.CreatedVolume, result2 bool, result3 error) {
+	fake.FindBuildArtifactVolumeStub = nil
+	fake.findBuildArtifactVolumeReturns = struct {
+		result1 db.CreatedVolume
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeVolumeRepository) FindBuildArtifactVolumeReturnsOnCall(i int, result1 db.CreatedVolume, result2 bool, result3 error) {
+	fake.FindBuildArtifactVolumeStub = nil
+	if fake.findBuildArtifactVolumeReturnsOnCall == nil {
+		fake.findBuildArtifactVolumeReturnsOnCall = make(map[int]struct {
+			result1 db.CreatedVolume
+			result2 bool
+			result3 error
+		})
+	}
+	fake.findBuildArtifactVolumeReturnsOnCall[i] = struct {
+		result1 db.CreatedVolume
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeVolumeRepository) FindContainerVolume(arg1 int, arg2 string, arg3 db.CreatingContainer, arg4 string) (db.CreatingVolume, db.CreatedVolume, error) {
 	fake.findContainerVolumeMutex.Lock()
 	ret, specificReturn := fake.findContainerVolumeReturnsOnCall[len(fake.findContainerVolumeArgsForCall)]
@@ -819,6 +946,63 @@ func (fake *FakeVolumeRepository) FindResourceCertsVolumeReturnsOnCall(i int, re
 	}{result1, result2, result3}
 }
 
+func (fake *FakeVolumeRepository) FindStreamedResourceCacheVolume(arg1 string, arg2 db.UsedResourceCache) (db.CreatedVolume, bool, error) {
+	fake.findStreamedResourceCacheVolumeMutex.Lock()
+	ret, specificReturn := fake.findStreamedResourceCacheVolumeReturnsOnCall[len(fake.findStreamedResourceCacheVolumeArgsForCall)]
+	fake.findStreamedResourceCacheVolumeArgsForCall = append(fake.findStreamedResourceCacheVolumeArgsForCall, struct {
+		arg1 string
+		arg2 db.UsedResourceCache
+	}{arg1, arg2})
+	fake.recordInvocation("FindStreamedResourceCacheVolume", []interface{}{arg1, arg2})
+	fake.findStreamedResourceCacheVolumeMutex.Unlock()
+	if fake.FindStreamedResourceCacheVolumeStub != nil {
+		return fake.FindStreamedResourceCacheVolumeStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.findStreamedResourceCacheVolumeReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeVolumeRepository) FindStreamedResourceCacheVolumeCallCount() int {
+	fake.findStreamedResourceCacheVolumeMutex.RLock()
+	defer fake.findStreamedResourceCacheVolumeMutex.RUnlock()
+	return len(fake.findStreamedResourceCacheVolumeArgsForCall)
+}
+
+func (fake *FakeVolumeRepository) FindStreamedResourceCacheVolumeArgsForCall(i int) (string, db.UsedResourceCache) {
+	fake.findStreamedResourceCacheVolumeMutex.RLock()
+	defer fake.findStreamedResourceCacheVolumeMutex.RUnlock()
+	argsForCall := fake.findStreamedResourceCacheVolumeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeVolumeRepository) FindStreamedResourceCacheVolumeReturns(result1 db.CreatedVolume, result2 bool, result3 error) {
+	fake.FindStreamedResourceCacheVolumeStub = nil
+	fake.findStreamedResourceCacheVolumeReturns = struct {
+		result1 db.CreatedVolume
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeVolumeRepository) FindStreamedResourceCacheVolumeReturnsOnCall(i int, result1 db.CreatedVolume, result2 bool, result3 error) {
+	fake.FindStreamedResourceCacheVolumeStub = nil
+	if fake.findStreamedResourceCacheVolumeReturnsOnCall == nil {
+		fake.findStreamedResourceCacheVolumeReturnsOnCall = make(map[int]struct {
+			result1 db.CreatedVolume
+			result2 bool
+			result3 error
+		})
+	}
+	fake.findStreamedResourceCacheVolumeReturnsOnCall[i] = struct {
+		result1 db.CreatedVolume
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeVolumeRepository) FindTaskCacheVolume(arg1 int, arg2 *db.UsedWorkerTaskCache) (db.CreatingVolume, db.CreatedVolume, error) {
 	fake.findTaskCacheVolumeMutex.Lock()
 	ret, specificReturn := fake.findTaskCacheVolumeReturnsOnCall[len(fake.findTaskCacheVolumeArgsForCall)]
@@ -1139,6 +1323,112 @@ func (fake *FakeVolumeRepository) RemoveDestroyingVolumesReturnsOnCall(i int, re
 	}{result1, result2}
 }
 
+func (fake *FakeVolumeRepository) RemoveExpiredArtifacts(arg1 time.Duration) (int, error) {
+	fake.removeExpiredArtifactsMutex.Lock()
+	ret, specificReturn := fake.removeExpiredArtifactsReturnsOnCall[len(fake.removeExpiredArtifactsArgsForCall)]
+	fake.removeExpiredArtifactsArgsForCall = append(fake.removeExpiredArtifactsArgsForCall, struct {
+		arg1 time.Duration
+	}{arg1})
+	fake.recordInvocation("RemoveExpiredArtifacts", []interface{}{arg1})
+	fake.removeExpiredArtifactsMutex.Unlock()
+	if fake.RemoveExpiredArtifactsStub != nil {
+		return fake.RemoveExpiredArtifactsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.removeExpiredArtifactsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeVolumeRepository) RemoveExpiredArtifactsCallCount() int {
+	fake.removeExpiredArtifactsMutex.RLock()
+	defer fake.removeExpiredArtifactsMutex.RUnlock()
+	return len(fake.removeExpiredArtifactsArgsForCall)
+}
+
+func (fake *FakeVolumeRepository) RemoveExpiredArtifactsArgsForCall(i int) time.Duration {
+	fake.removeExpiredArtifactsMutex.RLock()
+	defer fake.removeExpiredArtifactsMutex.RUnlock()
+	argsForCall := fake.removeExpiredArtifactsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeVolumeRepository) RemoveExpiredArtifactsReturns(result1 int, result2 error) {
+	fake.RemoveExpiredArtifactsStub = nil
+	fake.removeExpiredArtifactsReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeVolumeRepository) RemoveExpiredArtifactsReturnsOnCall(i int, result1 int, result2 error) {
+	fake.RemoveExpiredArtifactsStub = nil
+	if fake.removeExpiredArtifactsReturnsOnCall == nil {
+		fake.removeExpiredArtifactsReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.removeExpiredArtifactsReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeVolumeRepository) RemoveExpiredTaskOutputCaches(arg1 time.Duration) (int, error) {
+	fake.removeExpiredTaskOutputCachesMutex.Lock()
+	ret, specificReturn := fake.removeExpiredTaskOutputCachesReturnsOnCall[len(fake.removeExpiredTaskOutputCachesArgsForCall)]
+	fake.removeExpiredTaskOutputCachesArgsForCall = append(fake.removeExpiredTaskOutputCachesArgsForCall, struct {
+		arg1 time.Duration
+	}{arg1})
+	fake.recordInvocation("RemoveExpiredTaskOutputCaches", []interface{}{arg1})
+	fake.removeExpiredTaskOutputCachesMutex.Unlock()
+	if fake.RemoveExpiredTaskOutputCachesStub != nil {
+		return fake.RemoveExpiredTaskOutputCachesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.removeExpiredTaskOutputCachesReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeVolumeRepository) RemoveExpiredTaskOutputCachesCallCount() int {
+	fake.removeExpiredTaskOutputCachesMutex.RLock()
+	defer fake.removeExpiredTaskOutputCachesMutex.RUnlock()
+	return len(fake.removeExpiredTaskOutputCachesArgsForCall)
+}
+
+func (fake *FakeVolumeRepository) RemoveExpiredTaskOutputCachesArgsForCall(i int) time.Duration {
+	fake.removeExpiredTaskOutputCachesMutex.RLock()
+	defer fake.removeExpiredTaskOutputCachesMutex.RUnlock()
+	argsForCall := fake.removeExpiredTaskOutputCachesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeVolumeRepository) RemoveExpiredTaskOutputCachesReturns(result1 int, result2 error) {
+	fake.RemoveExpiredTaskOutputCachesStub = nil
+	fake.removeExpiredTaskOutputCachesReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeVolumeRepository) RemoveExpiredTaskOutputCachesReturnsOnCall(i int, result1 int, result2 error) {
+	fake.RemoveExpiredTaskOutputCachesStub = nil
+	if fake.removeExpiredTaskOutputCachesReturnsOnCall == nil {
+		fake.removeExpiredTaskOutputCachesReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.removeExpiredTaskOutputCachesReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeVolumeRepository) RemoveMissingVolumes(arg1 time.Duration) (int, error) {
 	fake.removeMissingVolumesMutex.Lock()
 	ret, specificReturn := fake.removeMissingVolumesReturnsOnCall[len(fake.removeMissingVolumesArgsForCall)]
@@ -1192,6 +1482,51 @@ func (fake *FakeVolumeRepository) RemoveMissingVolumesReturnsOnCall(i int, resul
 	}{result1, result2}
 }
 
+func (fake *FakeVolumeRepository) RemoveTaskOutputCacheUsesOfFinishedBuilds() (int, error) {
+	fake.removeTaskOutputCacheUsesOfFinishedBuildsMutex.Lock()
+	ret, specificReturn := fake.removeTaskOutputCacheUsesOfFinishedBuildsReturnsOnCall[len(fake.removeTaskOutputCacheUsesOfFinishedBuildsArgsForCall)]
+	fake.removeTaskOutputCacheUsesOfFinishedBuildsArgsForCall = append(fake.removeTaskOutputCacheUsesOfFinishedBuildsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("RemoveTaskOutputCacheUsesOfFinishedBuilds", []interface{}{})
+	fake.removeTaskOutputCacheUsesOfFinishedBuildsMutex.Unlock()
+	if fake.RemoveTaskOutputCacheUsesOfFinishedBuildsStub != nil {
+		return fake.RemoveTaskOutputCacheUsesOfFinishedBuildsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.removeTaskOutputCacheUsesOfFinishedBuildsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeVolumeRepository) RemoveTaskOutputCacheUsesOfFinishedBuildsCallCount() int {
+	fake.removeTaskOutputCacheUsesOfFinishedBuildsMutex.RLock()
+	defer fake.removeTaskOutputCacheUsesOfFinishedBuildsMutex.RUnlock()
+	return len(fake.removeTaskOutputCacheUsesOfFinishedBuildsArgsForCall)
+}
+
+func (fake *FakeVolumeRepository) RemoveTaskOutputCacheUsesOfFinishedBuildsReturns(result1 int, result2 error) {
+	fake.RemoveTaskOutputCacheUsesOfFinishedBuildsStub = nil
+	fake.removeTaskOutputCacheUsesOfFinishedBuildsReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeVolumeRepository) RemoveTaskOutputCacheUsesOfFinishedBuildsReturnsOnCall(i int, result1 int, result2 error) {
+	fake.RemoveTaskOutputCacheUsesOfFinishedBuildsStub = nil
+	if fake.removeTaskOutputCacheUsesOfFinishedBuildsReturnsOnCall == nil {
+		fake.removeTaskOutputCacheUsesOfFinishedBuildsReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.removeTaskOutputCacheUsesOfFinishedBuildsReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeVolumeRepository) UpdateVolumesMissingSince(arg1 string, arg2 []string) error {
 	var arg2Copy []string
 	if arg2 != nil {
@@ -1263,6 +1598,8 @@ func (fake *FakeVolumeRepository) Invocations() map[string][][]interface{} {
 	defer fake.destroyFailedVolumesMutex.RUnlock()
 	fake.findBaseResourceTypeVolumeMutex.RLock()
 	defer fake.findBaseResourceTypeVolumeMutex.RUnlock()
+	fake.findBuildArtifactVolumeMutex.RLock()
+	defer fake.findBuildArtifactVolumeMutex.RUnlock()
 	fake.findContainerVolumeMutex.RLock()
 	defer fake.findContainerVolumeMutex.RUnlock()
 	fake.findCreatedVolumeMutex.RLock()
@@ -1271,6 +1608,8 @@ func (fake *FakeVolumeRepository) Invocations() map[string][][]interface{} {
 	defer fake.findResourceCacheVolumeMutex.RUnlock()
 	fake.findResourceCertsVolumeMutex.RLock()
 	defer fake.findResourceCertsVolumeMutex.RUnlock()
+	fake.findStreamedResourceCacheVolumeMutex.RLock()
+	defer fake.findStreamedResourceCacheVolumeMutex.RUnlock()
 	fake.findTaskCacheVolumeMutex.RLock()
 	defer fake.findTaskCacheVolumeMutex.RUnlock()
 	fake.findVolumesForContainerMutex.RLock()
@@ -1283,8 +1622,14 @@ func (fake *FakeVolumeRepository) Invocations() map[string][][]interface{} {
 	defer fake.getTeamVolumesMutex.RUnlock()
 	fake.removeDestroyingVolumesMutex.RLock()
 	defer fake.removeDestroyingVolumesMutex.RUnlock()
+	fake.removeExpiredArtifactsMutex.RLock()
+	defer fake.removeExpiredArtifactsMutex.RUnlock()
+	fake.removeExpiredTaskOutputCachesMutex.RLock()
+	defer fake.removeExpiredTaskOutputCachesMutex.RUnlock()
 	fake.removeMissingVolumesMutex.RLock()
 	defer fake.removeMissingVolumesMutex.RUnlock()
+	fake.removeTaskOutputCacheUsesOfFinishedBuildsMutex.RLock()
+	defer fake.removeTaskOutputCacheUsesOfFinishedBuildsMutex.RUnlock()
 	fake.updateVolumesMissingSinceMutex.RLock()
 	defer fake.updateVolumesMissingSinceMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -0,0 +1,202 @@
+package db
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/event"
+)
+
+const PostgresEventStoreName = "postgres"
+
+// BuildEventsKey identifies the event stream of a single build within an
+// EventStore.
+type BuildEventsKey struct {
+	BuildID    int
+	TeamID     int
+	PipelineID int
+}
+
+//go:generate counterfeiter . EventStore
+
+// EventStore persists the events of completed builds. Running builds always
+// save their events to the database, within the transaction that changes the
+// build's state, and are moved to the configured store once completed. Each
+// build records the name of the store its events are in.
+//
+// Only the database and a filesystem store are implemented; the filesystem
+// store may be backed by a network volume shared between ATCs.
+type EventStore interface {
+	Name() string
+
+	// Import saves the complete event stream of a completed build.
+	Import(key BuildEventsKey, events []event.Envelope) error
+
+	// Get returns at most limit events of the build, starting at cursor.
+	Get(key BuildEventsKey, cursor uint, limit int) ([]event.Envelope, error)
+
+	Delete(keys []BuildEventsKey) error
+}
+
+// NewPostgresEventStore returns the EventStore that keeps events in the
+// build_events tables of each team and pipeline.
+func NewPostgresEventStore(conn Conn) EventStore {
+	return newPostgresEventStore(conn)
+}
+
+func newPostgresEventStore(conn Conn) *postgresEventStore {
+	return &postgresEventStore{
+		conn: conn,
+	}
+}
+
+type postgresEventStore struct {
+	conn Conn
+}
+
+func (store *postgresEventStore) Name() string {
+	return PostgresEventStoreName
+}
+
+// Append saves an event at the end of a running build's event stream, within
+// the transaction that changes the build's state.
+func (store *postgresEventStore) Append(tx Tx, key BuildEventsKey, event atc.Event) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Insert(buildEventsTable(key)).
+		Columns("event_id", "build_id", "type", "version", "payload").
+		Values(sq.Expr("nextval('"+buildEventSeq(key.BuildID)+"')"), key.BuildID, string(event.EventType()), string(event.Version()), payload).
+		RunWith(tx).
+		Exec()
+	return err
+}
+
+func (store *postgresEventStore) Import(key BuildEventsKey, events []event.Envelope) error {
+	tx, err := store.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	for i, ev := range events {
+		var payload []byte
+		if ev.Data != nil {
+			payload = *ev.Data
+		}
+
+		_, err = psql.Insert(buildEventsTable(key)).
+			Columns("event_id", "build_id", "type", "version", "payload").
+			Values(i, key.BuildID, string(ev.Event), string(ev.Version), string(payload)).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (store *postgresEventStore) Get(key BuildEventsKey, cursor uint, limit int) ([]event.Envelope, error) {
+	rows, err := store.conn.Query(`
+		SELECT type, version, payload
+		FROM `+buildEventsTable(key)+`
+		WHERE build_id = $1
+		ORDER BY event_id ASC
+		OFFSET $2
+		LIMIT $3
+	`, key.BuildID, cursor, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	events := []event.Envelope{}
+	for rows.Next() {
+		var t, v, p string
+		err := rows.Scan(&t, &v, &p)
+		if err != nil {
+			return nil, err
+		}
+
+		data := json.RawMessage(p)
+
+		events = append(events, event.Envelope{
+			Data:    &data,
+			Event:   atc.EventType(t),
+			Version: atc.EventVersion(v),
+		})
+	}
+
+	return events, rows.Err()
+}
+
+func (store *postgresEventStore) Delete(keys []BuildEventsKey) error {
+	if len(keys) == 0 {
+		return nil
+	}
+
+	buildIDs := make([]interface{}, len(keys))
+	indexStrings := make([]string, len(keys))
+	for i, key := range keys {
+		buildIDs[i] = key.BuildID
+		indexStrings[i] = "$" + strconv.Itoa(i+1)
+	}
+
+	_, err := store.conn.Exec(`
+		DELETE FROM build_events
+		WHERE build_id IN (`+strings.Join(indexStrings, ",")+`)
+	`, buildIDs...)
+	return err
+}
+
+// WithEventStore returns a Conn which moves the events of completed builds to
+// store rather than keeping them in the database.
+func WithEventStore(conn Conn, store EventStore) Conn {
+	return &eventStoreConn{
+		Conn:  conn,
+		store: store,
+	}
+}
+
+type eventStoreConn struct {
+	Conn
+
+	store EventStore
+}
+
+func (c *eventStoreConn) EventStore() EventStore {
+	return c.store
+}
+
+// eventStoreNamed returns the store a build's events are in, which must be
+// either the database or the configured store.
+func eventStoreNamed(conn Conn, buildID int, name string) (EventStore, error) {
+	if name == "" || name == PostgresEventStoreName {
+		return NewPostgresEventStore(conn), nil
+	}
+
+	store := conn.EventStore()
+	if store.Name() != name {
+		return nil, fmt.Errorf("events of build %d are in event store '%s', which is not configured", buildID, name)
+	}
+
+	return store, nil
+}
+
+func buildEventsTable(key BuildEventsKey) string {
+	if key.PipelineID != 0 {
+		return fmt.Sprintf("pipeline_build_events_%d", key.PipelineID)
+	}
+
+	return fmt.Sprintf("team_build_events_%d", key.TeamID)
+}
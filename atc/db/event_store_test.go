@@ -0,0 +1,249 @@
+package db_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/event"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("EventStore", func() {
+	var (
+		storeDir  string
+		fileStore db.EventStore
+		fileConn  db.Conn
+
+		fileBuildFactory db.BuildFactory
+		fileTeam         db.Team
+	)
+
+	BeforeEach(func() {
+		var err error
+		storeDir, err = ioutil.TempDir("", "event-store")
+		Expect(err).ToNot(HaveOccurred())
+
+		fileStore = db.NewFileEventStore(storeDir)
+		fileConn = db.WithEventStore(dbConn, fileStore)
+
+		fileBuildFactory = db.NewBuildFactory(fileConn, lockFactory, 5*time.Minute)
+		fileTeam = db.NewTeamFactory(fileConn, lockFactory).GetByID(defaultTeam.ID())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(storeDir)).To(Succeed())
+	})
+
+	buildEventRows := func(build db.Build) int {
+		var count int
+		err := psql.Select("COUNT(*)").
+			From("build_events").
+			Where("build_id = ?", build.ID()).
+			RunWith(dbConn).
+			QueryRow().
+			Scan(&count)
+		Expect(err).ToNot(HaveOccurred())
+		return count
+	}
+
+	Describe("the filesystem store", func() {
+		var key db.BuildEventsKey
+
+		BeforeEach(func() {
+			key = db.BuildEventsKey{BuildID: 42, TeamID: defaultTeam.ID()}
+
+			err := fileStore.Import(key, []event.Envelope{
+				envelope(event.Log{Payload: "some "}),
+				envelope(event.Log{Payload: "log"}),
+				envelope(event.Log{Payload: "more log"}),
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("keeps a build's events in a single file with an index", func() {
+			files, err := ioutil.ReadDir(filepath.Join(storeDir, "42"))
+			Expect(err).ToNot(HaveOccurred())
+
+			names := []string{}
+			for _, file := range files {
+				names = append(names, file.Name())
+			}
+
+			Expect(names).To(ConsistOf("events", "index"))
+		})
+
+		It("returns the events from an offset", func() {
+			events, err := fileStore.Get(key, 1, 10)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(events).To(Equal([]event.Envelope{
+				envelope(event.Log{Payload: "log"}),
+				envelope(event.Log{Payload: "more log"}),
+			}))
+		})
+
+		It("returns at most the limit", func() {
+			events, err := fileStore.Get(key, 0, 2)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(events).To(Equal([]event.Envelope{
+				envelope(event.Log{Payload: "some "}),
+				envelope(event.Log{Payload: "log"}),
+			}))
+		})
+
+		It("returns no events past the end", func() {
+			events, err := fileStore.Get(key, 3, 10)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(events).To(BeEmpty())
+		})
+
+		It("returns no events for builds it does not have", func() {
+			events, err := fileStore.Get(db.BuildEventsKey{BuildID: 43, TeamID: defaultTeam.ID()}, 0, 10)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(events).To(BeEmpty())
+		})
+
+		It("deletes the events of builds", func() {
+			err := fileStore.Delete([]db.BuildEventsKey{key})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = os.Stat(filepath.Join(storeDir, "42"))
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		})
+
+		It("keeps the events of running builds in the database", func() {
+			build, err := fileTeam.CreateOneOffBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			events, err := build.Events(0)
+			Expect(err).ToNot(HaveOccurred())
+
+			defer db.Close(events)
+
+			err = build.SaveEvent(event.Log{Payload: "some log"})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(events.Next()).To(Equal(envelope(event.Log{Payload: "some log"})))
+
+			Expect(buildEventRows(build)).To(Equal(1))
+
+			_, err = os.Stat(filepath.Join(storeDir, strconv.Itoa(build.ID())))
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		})
+	})
+
+	Describe("BuildEventMigrator", func() {
+		var (
+			migrator db.BuildEventMigrator
+
+			completedBuild db.Build
+			runningBuild   db.Build
+		)
+
+		BeforeEach(func() {
+			migrator = db.NewBuildEventMigrator(fileConn)
+
+			var err error
+			completedBuild, err = defaultTeam.CreateOneOffBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			err = completedBuild.SaveEvent(event.Log{Payload: "completed"})
+			Expect(err).ToNot(HaveOccurred())
+
+			err = completedBuild.Finish(db.BuildStatusSucceeded)
+			Expect(err).ToNot(HaveOccurred())
+
+			runningBuild, err = defaultTeam.CreateOneOffBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			err = runningBuild.SaveEvent(event.Log{Payload: "running"})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("moves the events of completed builds out of the database", func() {
+			migrated, err := migrator.MigrateCompletedBuilds(logger, 10)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(migrated).To(Equal(1))
+
+			Expect(buildEventRows(completedBuild)).To(BeZero())
+			Expect(buildEventRows(runningBuild)).To(Equal(1))
+
+			build, found, err := fileBuildFactory.Build(completedBuild.ID())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			events, err := build.Events(0)
+			Expect(err).ToNot(HaveOccurred())
+
+			defer db.Close(events)
+
+			Expect(events.Next()).To(Equal(envelope(event.Log{Payload: "completed"})))
+
+			ev, err := events.Next()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ev.Event).To(Equal(event.EventTypeStatus))
+
+			_, err = events.Next()
+			Expect(err).To(Equal(db.ErrEndOfBuildEventStream))
+		})
+
+		It("continues streams of the build being read while it is migrated", func() {
+			events, err := completedBuild.Events(0)
+			Expect(err).ToNot(HaveOccurred())
+
+			defer db.Close(events)
+
+			Expect(events.Next()).To(Equal(envelope(event.Log{Payload: "completed"})))
+
+			_, err = migrator.MigrateCompletedBuilds(logger, 10)
+			Expect(err).ToNot(HaveOccurred())
+
+			ev, err := events.Next()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ev.Event).To(Equal(event.EventTypeStatus))
+
+			_, err = events.Next()
+			Expect(err).To(Equal(db.ErrEndOfBuildEventStream))
+		})
+
+		It("refuses to save more events for a migrated build", func() {
+			_, err := migrator.MigrateCompletedBuilds(logger, 10)
+			Expect(err).ToNot(HaveOccurred())
+
+			build, found, err := fileBuildFactory.Build(completedBuild.ID())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			err = build.SaveEvent(event.Log{Payload: "late"})
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("does not migrate a build twice", func() {
+			_, err := migrator.MigrateCompletedBuilds(logger, 10)
+			Expect(err).ToNot(HaveOccurred())
+
+			migrated, err := migrator.MigrateCompletedBuilds(logger, 10)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(migrated).To(BeZero())
+		})
+
+		Context("when the configured store is postgres", func() {
+			BeforeEach(func() {
+				migrator = db.NewBuildEventMigrator(dbConn)
+			})
+
+			It("does nothing", func() {
+				migrated, err := migrator.MigrateCompletedBuilds(logger, 10)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(migrated).To(BeZero())
+
+				Expect(buildEventRows(completedBuild)).To(Equal(2))
+			})
+		})
+	})
+})
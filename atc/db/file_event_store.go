@@ -0,0 +1,165 @@
+package db
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/concourse/concourse/atc/event"
+)
+
+const FileEventStoreName = "filesystem"
+
+// offsetSize is the size of each entry of a build's event index.
+const offsetSize = 8
+
+// NewFileEventStore returns an EventStore that keeps the events of completed
+// builds on disk, under dir. The events of each build are written to a single
+// file, one per line, alongside an index of the offset at which each event
+// starts so that streaming from any position does not read the whole file.
+//
+// The directory must be shared by all ATCs, e.g. by mounting a network
+// volume.
+func NewFileEventStore(dir string) EventStore {
+	return &fileEventStore{
+		dir: dir,
+	}
+}
+
+type fileEventStore struct {
+	dir string
+}
+
+func (store *fileEventStore) Name() string {
+	return FileEventStoreName
+}
+
+func (store *fileEventStore) Import(key BuildEventsKey, events []event.Envelope) error {
+	payload := new(bytes.Buffer)
+	index := make([]byte, offsetSize*len(events))
+
+	encoder := json.NewEncoder(payload)
+	for i, ev := range events {
+		binary.BigEndian.PutUint64(index[i*offsetSize:], uint64(payload.Len()))
+
+		err := encoder.Encode(ev)
+		if err != nil {
+			return err
+		}
+	}
+
+	dir := store.buildDir(key)
+
+	err := os.MkdirAll(dir, 0755)
+	if err != nil {
+		return err
+	}
+
+	err = writeEventsFile(dir, "events", payload.Bytes())
+	if err != nil {
+		return err
+	}
+
+	// the index is written last, so that its presence means the events are
+	// complete
+	return writeEventsFile(dir, "index", index)
+}
+
+func (store *fileEventStore) Get(key BuildEventsKey, cursor uint, limit int) ([]event.Envelope, error) {
+	index, err := os.Open(filepath.Join(store.buildDir(key), "index"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []event.Envelope{}, nil
+		}
+
+		return nil, err
+	}
+
+	defer index.Close()
+
+	offset := make([]byte, offsetSize)
+	_, err = index.ReadAt(offset, int64(cursor)*offsetSize)
+	if err != nil {
+		if err == io.EOF {
+			return []event.Envelope{}, nil
+		}
+
+		return nil, err
+	}
+
+	file, err := os.Open(filepath.Join(store.buildDir(key), "events"))
+	if err != nil {
+		return nil, err
+	}
+
+	defer file.Close()
+
+	_, err = file.Seek(int64(binary.BigEndian.Uint64(offset)), io.SeekStart)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bufio.NewReader(file))
+
+	events := []event.Envelope{}
+	for len(events) < limit {
+		var ev event.Envelope
+		err := decoder.Decode(&ev)
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+
+			return nil, err
+		}
+
+		events = append(events, ev)
+	}
+
+	return events, nil
+}
+
+func (store *fileEventStore) Delete(keys []BuildEventsKey) error {
+	for _, key := range keys {
+		err := os.RemoveAll(store.buildDir(key))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (store *fileEventStore) buildDir(key BuildEventsKey) string {
+	return filepath.Join(store.dir, strconv.Itoa(key.BuildID))
+}
+
+// writeEventsFile writes the file through a temporary file, so that readers only
+// ever see it fully written.
+func writeEventsFile(dir string, name string, payload []byte) error {
+	tmp, err := ioutil.TempFile(dir, "."+name+"-")
+	if err != nil {
+		return err
+	}
+
+	_, err = tmp.Write(payload)
+	if err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+
+	err = tmp.Close()
+	if err != nil {
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+
+	return os.Rename(tmp.Name(), filepath.Join(dir, name))
+}
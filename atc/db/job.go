@@ -4,11 +4,13 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db/algorithm"
 	"github.com/concourse/concourse/atc/db/lock"
+	"github.com/lib/pq"
 )
 
 //go:generate counterfeiter . Job
@@ -24,14 +26,17 @@ type Job interface {
 	TeamName() string
 	Config() atc.JobConfig
 	Tags() []string
+	LastScheduled() time.Time
 
 	Reload() (bool, error)
 
 	Pause() error
 	Unpause() error
 
-	CreateBuild() (Build, error)
-	Builds(page Page) ([]Build, Pagination, error)
+	CreateBuild(cause BuildCause) (Build, error)
+	CreateScheduledBuild(scheduledFor time.Time) (Build, error)
+	RerunBuild(buildToRerun Build) (Build, error)
+	Builds(page Page, annotations []string) ([]Build, Pagination, error)
 	Build(name string) (Build, bool, error)
 	FinishedAndNextBuild() (Build, Build, error)
 	UpdateFirstLoggedBuildID(newFirstLoggedBuildID int) error
@@ -51,7 +56,7 @@ type Job interface {
 	ClearTaskCache(string, string) (int64, error)
 }
 
-var jobsQuery = psql.Select("j.id", "j.name", "j.config", "j.paused", "j.first_logged_build_id", "j.pipeline_id", "p.name", "p.team_id", "t.name", "j.nonce", "array_to_json(j.tags)").
+var jobsQuery = psql.Select("j.id", "j.name", "j.config", "j.paused", "j.first_logged_build_id", "j.pipeline_id", "p.name", "p.team_id", "t.name", "j.nonce", "array_to_json(j.tags)", "j.last_scheduled").
 	From("jobs j, pipelines p").
 	LeftJoin("teams t ON p.team_id = t.id").
 	Where(sq.Expr("j.pipeline_id = p.id"))
@@ -77,6 +82,7 @@ type job struct {
 	teamName           string
 	config             atc.JobConfig
 	tags               []string
+	lastScheduled      time.Time
 
 	conn        Conn
 	lockFactory lock.LockFactory
@@ -105,6 +111,11 @@ func (j *job) TeamName() string        { return j.teamName }
 func (j *job) Config() atc.JobConfig   { return j.config }
 func (j *job) Tags() []string          { return j.tags }
 
+// LastScheduled returns when the job's schedule last triggered a build, or
+// when the schedule was configured if it hasn't triggered one yet. It is zero
+// for jobs without a schedule.
+func (j *job) LastScheduled() time.Time { return j.lastScheduled }
+
 func (j *job) Reload() (bool, error) {
 	row := jobsQuery.Where(sq.Eq{"j.id": j.id}).
 		RunWith(j.conn).
@@ -183,8 +194,10 @@ func (j *job) UpdateFirstLoggedBuildID(newFirstLoggedBuildID int) error {
 	return nil
 }
 
-func (j *job) Builds(page Page) ([]Build, Pagination, error) {
-	query := buildsQuery.Where(sq.Eq{"j.id": j.id})
+// Builds returns a page of the job's builds, only including those with every
+// one of the annotations.
+func (j *job) Builds(page Page, annotations []string) ([]Build, Pagination, error) {
+	query := whereAnnotated(buildsQuery.Where(sq.Eq{"j.id": j.id}), annotations)
 
 	limit := uint64(page.Limit)
 
@@ -226,13 +239,13 @@ func (j *job) Builds(page Page) ([]Build, Pagination, error) {
 	}
 
 	var maxID, minID int
-	err = psql.Select("COALESCE(MAX(b.id), 0) as maxID", "COALESCE(MIN(b.id), 0) as minID").
+	err = whereAnnotated(psql.Select("COALESCE(MAX(b.id), 0) as maxID", "COALESCE(MIN(b.id), 0) as minID").
 		From("builds b").
 		Join("jobs j ON b.job_id = j.id").
 		Where(sq.Eq{
 			"j.name":        j.name,
 			"j.pipeline_id": j.pipelineID,
-		}).
+		}), annotations).
 		RunWith(j.conn).
 		QueryRow().
 		Scan(&maxID, &minID)
@@ -544,7 +557,35 @@ func (j *job) GetPendingBuilds() ([]Build, error) {
 	return builds, nil
 }
 
-func (j *job) CreateBuild() (Build, error) {
+// CreateBuild creates a pending build for the job. Regardless of their cause,
+// these builds look for new versions of their inputs before they start.
+// Builds triggered by the job's schedule are created by CreateScheduledBuild.
+func (j *job) CreateBuild(cause BuildCause) (Build, error) {
+	tx, err := j.conn.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Rollback(tx)
+
+	build, err := j.createPendingBuild(tx, cause)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return build, nil
+}
+
+// CreateScheduledBuild creates a pending build for the job's schedule firing
+// at the given time, and records it as the time the schedule last triggered so
+// that the next one is counted from it rather than from when the build was
+// created.
+func (j *job) CreateScheduledBuild(scheduledFor time.Time) (Build, error) {
 	tx, err := j.conn.Begin()
 	if err != nil {
 		return nil, err
@@ -552,6 +593,29 @@ func (j *job) CreateBuild() (Build, error) {
 
 	defer Rollback(tx)
 
+	build, err := j.createPendingBuild(tx, BuildCauseSchedule)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = psql.Update("jobs").
+		Set("last_scheduled", scheduledFor).
+		Where(sq.Eq{"id": j.id}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return build, nil
+}
+
+func (j *job) createPendingBuild(tx Tx, cause BuildCause) (*build, error) {
 	buildName, err := j.getNewBuildName(tx)
 	if err != nil {
 		return nil, err
@@ -564,12 +628,85 @@ func (j *job) CreateBuild() (Build, error) {
 		"pipeline_id":        j.pipelineID,
 		"team_id":            j.teamID,
 		"status":             BuildStatusPending,
+		"manually_triggered": cause == BuildCauseManual,
+		"cause":              string(cause),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = updateNextBuildForJob(tx, j.id)
+	if err != nil {
+		return nil, err
+	}
+
+	return build, nil
+}
+
+// RerunBuild creates a pending build which will run with exactly the same
+// inputs as the given build, named after the original build, e.g. 42.1.
+func (j *job) RerunBuild(buildToRerun Build) (Build, error) {
+	tx, err := j.conn.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Rollback(tx)
+
+	// a rerun of a rerun is numbered after the original build
+	rerunOf := buildToRerun.RerunOf()
+	if rerunOf == 0 {
+		rerunOf = buildToRerun.ID()
+	}
+
+	var originalName string
+	err = psql.Select("name").
+		From("builds").
+		Where(sq.Eq{"id": rerunOf}).
+		Suffix("FOR UPDATE").
+		RunWith(tx).
+		QueryRow().
+		Scan(&originalName)
+	if err != nil {
+		return nil, err
+	}
+
+	var rerunNumber int
+	err = psql.Select("COALESCE(MAX(rerun_number), 0) + 1").
+		From("builds").
+		Where(sq.Eq{"rerun_of": rerunOf}).
+		RunWith(tx).
+		QueryRow().
+		Scan(&rerunNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	build := &build{conn: j.conn, lockFactory: j.lockFactory}
+	err = createBuild(tx, build, map[string]interface{}{
+		"name":               fmt.Sprintf("%s.%d", originalName, rerunNumber),
+		"job_id":             j.id,
+		"pipeline_id":        j.pipelineID,
+		"team_id":            j.teamID,
+		"status":             BuildStatusPending,
 		"manually_triggered": true,
+		"rerun_of":           rerunOf,
+		"rerun_number":       rerunNumber,
 	})
 	if err != nil {
 		return nil, err
 	}
 
+	_, err = tx.Exec(`
+		INSERT INTO build_inputs (build_id, versioned_resource_id, name)
+		SELECT $1, versioned_resource_id, name
+		FROM build_inputs
+		WHERE build_id = $2
+	`, build.id, buildToRerun.ID())
+	if err != nil {
+		return nil, err
+	}
+
 	err = updateNextBuildForJob(tx, j.id)
 	if err != nil {
 		return nil, err
@@ -866,13 +1003,14 @@ func (j *job) finishedBuild() (Build, error) {
 
 func scanJob(j *job, row scannable) error {
 	var (
-		configBlob []byte
-		nonce      sql.NullString
-		tagsBlob   []byte
-		tags       []string
+		configBlob    []byte
+		nonce         sql.NullString
+		tagsBlob      []byte
+		tags          []string
+		lastScheduled pq.NullTime
 	)
 
-	err := row.Scan(&j.id, &j.name, &configBlob, &j.paused, &j.firstLoggedBuildID, &j.pipelineID, &j.pipelineName, &j.teamID, &j.teamName, &nonce, &tagsBlob)
+	err := row.Scan(&j.id, &j.name, &configBlob, &j.paused, &j.firstLoggedBuildID, &j.pipelineID, &j.pipelineName, &j.teamID, &j.teamName, &nonce, &tagsBlob, &lastScheduled)
 	if err != nil {
 		return err
 	}
@@ -900,6 +1038,8 @@ func scanJob(j *job, row scannable) error {
 	json.Unmarshal(tagsBlob, &tags)
 	j.tags = tags
 
+	j.lastScheduled = lastScheduled.Time
+
 	return nil
 }
 
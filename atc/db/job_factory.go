@@ -9,6 +9,18 @@ import (
 
 type JobFactory interface {
 	VisibleJobs([]string) (Dashboard, error)
+	InFlightBuildCounts() ([]JobBuildCounts, error)
+}
+
+// JobBuildCounts is the number of builds of a job which are running or
+// waiting to run.
+type JobBuildCounts struct {
+	TeamName     string
+	PipelineName string
+	JobName      string
+
+	Running int
+	Pending int
 }
 
 type jobFactory struct {
@@ -105,6 +117,43 @@ func (j *jobFactory) VisibleJobs(teamNames []string) (Dashboard, error) {
 	return dashboard, nil
 }
 
+func (j *jobFactory) InFlightBuildCounts() ([]JobBuildCounts, error) {
+	rows, err := psql.Select(
+		"t.name",
+		"p.name",
+		"j.name",
+		"COUNT(*) FILTER (WHERE b.status = 'started')",
+		"COUNT(*) FILTER (WHERE b.status = 'pending')",
+	).
+		From("builds b").
+		Join("jobs j ON j.id = b.job_id").
+		Join("pipelines p ON p.id = j.pipeline_id").
+		Join("teams t ON t.id = p.team_id").
+		Where(sq.Eq{"b.status": []BuildStatus{BuildStatusStarted, BuildStatusPending}}).
+		GroupBy("t.name", "p.name", "j.name").
+		OrderBy("t.name", "p.name", "j.name").
+		RunWith(j.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	counts := []JobBuildCounts{}
+	for rows.Next() {
+		var c JobBuildCounts
+		err := rows.Scan(&c.TeamName, &c.PipelineName, &c.JobName, &c.Running, &c.Pending)
+		if err != nil {
+			return nil, err
+		}
+
+		counts = append(counts, c)
+	}
+
+	return counts, nil
+}
+
 func (j *jobFactory) getBuildsFrom(col string, jobIDs []int) (map[int]Build, error) {
 	rows, err := buildsQuery.
 		Where(sq.Eq{"j.id": jobIDs}).
@@ -19,7 +19,7 @@ var _ = Describe("Job Factory", func() {
 			otherTeam, err := teamFactory.CreateTeam(atc.Team{Name: "other-team"})
 			Expect(err).NotTo(HaveOccurred())
 
-			publicPipeline, _, err := otherTeam.SavePipeline("public-pipeline", atc.Config{
+			publicPipeline, _, err := otherTeam.SavePipeline(atc.PipelineRef{Name: "public-pipeline"}, atc.Config{
 				Jobs: atc.JobConfigs{
 					{Name: "public-pipeline-job"},
 				},
@@ -27,7 +27,7 @@ var _ = Describe("Job Factory", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(publicPipeline.Expose()).To(Succeed())
 
-			_, _, err = otherTeam.SavePipeline("private-pipeline", atc.Config{
+			_, _, err = otherTeam.SavePipeline(atc.PipelineRef{Name: "private-pipeline"}, atc.Config{
 				Jobs: atc.JobConfigs{
 					{Name: "private-pipeline-job"},
 				},
@@ -49,13 +49,13 @@ var _ = Describe("Job Factory", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeTrue())
 
-			transitionBuild, err := job.CreateBuild()
+			transitionBuild, err := job.CreateBuild(db.BuildCauseManual)
 			Expect(err).ToNot(HaveOccurred())
 
 			err = transitionBuild.Finish(db.BuildStatusSucceeded)
 			Expect(err).ToNot(HaveOccurred())
 
-			finishedBuild, err := job.CreateBuild()
+			finishedBuild, err := job.CreateBuild(db.BuildCauseManual)
 			Expect(err).ToNot(HaveOccurred())
 
 			err = finishedBuild.Finish(db.BuildStatusSucceeded)
@@ -65,7 +65,7 @@ var _ = Describe("Job Factory", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeTrue())
 
-			nextBuild, err := job.CreateBuild()
+			nextBuild, err := job.CreateBuild(db.BuildCauseManual)
 			Expect(err).ToNot(HaveOccurred())
 
 			visibleJobs, err := jobFactory.VisibleJobs([]string{"default-team"})
@@ -77,4 +77,45 @@ var _ = Describe("Job Factory", func() {
 			Expect(visibleJobs[0].TransitionBuild.ID()).To(Equal(transitionBuild.ID()))
 		})
 	})
+
+	Describe("InFlightBuildCounts", func() {
+		BeforeEach(func() {
+			job, found, err := defaultPipeline.Job("some-job")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			runningBuild, err := job.CreateBuild(db.BuildCauseManual)
+			Expect(err).ToNot(HaveOccurred())
+
+			started, err := runningBuild.Start("exec.v2", `{}`, atc.Plan{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(started).To(BeTrue())
+
+			_, err = job.CreateBuild(db.BuildCauseManual)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = job.CreateBuild(db.BuildCauseManual)
+			Expect(err).ToNot(HaveOccurred())
+
+			finishedBuild, err := job.CreateBuild(db.BuildCauseManual)
+			Expect(err).ToNot(HaveOccurred())
+
+			err = finishedBuild.Finish(db.BuildStatusSucceeded)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("counts the running and pending builds of each job", func() {
+			counts, err := jobFactory.InFlightBuildCounts()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(counts).To(Equal([]db.JobBuildCounts{
+				{
+					TeamName:     "default-team",
+					PipelineName: "default-pipeline",
+					JobName:      "some-job",
+					Running:      1,
+					Pending:      2,
+				},
+			}))
+		})
+	})
 })
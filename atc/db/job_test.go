@@ -23,7 +23,7 @@ var _ = Describe("Job", func() {
 		Expect(err).ToNot(HaveOccurred())
 
 		var created bool
-		pipeline, created, err = team.SavePipeline("fake-pipeline", atc.Config{
+		pipeline, created, err = team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, atc.Config{
 			Jobs: atc.JobConfigs{
 				{
 					Name: "some-job",
@@ -127,7 +127,7 @@ var _ = Describe("Job", func() {
 		BeforeEach(func() {
 			var created bool
 			var err error
-			otherPipeline, created, err = team.SavePipeline("other-pipeline", atc.Config{
+			otherPipeline, created, err = team.SavePipeline(atc.PipelineRef{Name: "other-pipeline"}, atc.Config{
 				Jobs: atc.JobConfigs{
 					{Name: "some-job"},
 				},
@@ -148,13 +148,13 @@ var _ = Describe("Job", func() {
 			Expect(next).To(BeNil())
 			Expect(finished).To(BeNil())
 
-			finishedBuild, err := job.CreateBuild()
+			finishedBuild, err := job.CreateBuild(db.BuildCauseManual)
 			Expect(err).NotTo(HaveOccurred())
 
 			err = finishedBuild.Finish(db.BuildStatusSucceeded)
 			Expect(err).NotTo(HaveOccurred())
 
-			otherFinishedBuild, err := otherJob.CreateBuild()
+			otherFinishedBuild, err := otherJob.CreateBuild(db.BuildCauseManual)
 			Expect(err).NotTo(HaveOccurred())
 
 			err = otherFinishedBuild.Finish(db.BuildStatusSucceeded)
@@ -166,14 +166,14 @@ var _ = Describe("Job", func() {
 			Expect(next).To(BeNil())
 			Expect(finished.ID()).To(Equal(finishedBuild.ID()))
 
-			nextBuild, err := job.CreateBuild()
+			nextBuild, err := job.CreateBuild(db.BuildCauseManual)
 			Expect(err).NotTo(HaveOccurred())
 
 			started, err := nextBuild.Start("some-engine", `{"id":"1"}`, atc.Plan{})
 			Expect(err).NotTo(HaveOccurred())
 			Expect(started).To(BeTrue())
 
-			otherNextBuild, err := otherJob.CreateBuild()
+			otherNextBuild, err := otherJob.CreateBuild(db.BuildCauseManual)
 			Expect(err).NotTo(HaveOccurred())
 
 			otherStarted, err := otherNextBuild.Start("some-engine", `{"id":"1"}`, atc.Plan{})
@@ -186,7 +186,7 @@ var _ = Describe("Job", func() {
 			Expect(next.ID()).To(Equal(nextBuild.ID()))
 			Expect(finished.ID()).To(Equal(finishedBuild.ID()))
 
-			anotherRunningBuild, err := job.CreateBuild()
+			anotherRunningBuild, err := job.CreateBuild(db.BuildCauseManual)
 			Expect(err).NotTo(HaveOccurred())
 
 			finished, next, err = job.FinishedAndNextBuild()
@@ -263,10 +263,10 @@ var _ = Describe("Job", func() {
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeTrue())
 
-				build, err := someJob.CreateBuild()
+				build, err := someJob.CreateBuild(db.BuildCauseManual)
 				Expect(err).NotTo(HaveOccurred())
 
-				_, err = someOtherJob.CreateBuild()
+				_, err = someOtherJob.CreateBuild(db.BuildCauseManual)
 				Expect(err).NotTo(HaveOccurred())
 
 				builds[i] = build
@@ -275,7 +275,7 @@ var _ = Describe("Job", func() {
 
 		Context("when there are no builds to be found", func() {
 			It("returns the builds, with previous/next pages", func() {
-				buildsPage, pagination, err := someOtherJob.Builds(db.Page{})
+				buildsPage, pagination, err := someOtherJob.Builds(db.Page{}, nil)
 				Expect(err).ToNot(HaveOccurred())
 				Expect(buildsPage).To(Equal([]db.Build{}))
 				Expect(pagination).To(Equal(db.Pagination{}))
@@ -284,7 +284,7 @@ var _ = Describe("Job", func() {
 
 		Context("with no since/until", func() {
 			It("returns the first page, with the given limit, and a next page", func() {
-				buildsPage, pagination, err := someJob.Builds(db.Page{Limit: 2})
+				buildsPage, pagination, err := someJob.Builds(db.Page{Limit: 2}, nil)
 				Expect(err).ToNot(HaveOccurred())
 				Expect(buildsPage).To(Equal([]db.Build{builds[9], builds[8]}))
 				Expect(pagination.Previous).To(BeNil())
@@ -294,7 +294,7 @@ var _ = Describe("Job", func() {
 
 		Context("with a since that places it in the middle of the builds", func() {
 			It("returns the builds, with previous/next pages", func() {
-				buildsPage, pagination, err := someJob.Builds(db.Page{Since: builds[6].ID(), Limit: 2})
+				buildsPage, pagination, err := someJob.Builds(db.Page{Since: builds[6].ID(), Limit: 2}, nil)
 				Expect(err).ToNot(HaveOccurred())
 				Expect(buildsPage).To(Equal([]db.Build{builds[5], builds[4]}))
 				Expect(pagination.Previous).To(Equal(&db.Page{Until: builds[5].ID(), Limit: 2}))
@@ -304,7 +304,7 @@ var _ = Describe("Job", func() {
 
 		Context("with a since that places it at the end of the builds", func() {
 			It("returns the builds, with previous/next pages", func() {
-				buildsPage, pagination, err := someJob.Builds(db.Page{Since: builds[2].ID(), Limit: 2})
+				buildsPage, pagination, err := someJob.Builds(db.Page{Since: builds[2].ID(), Limit: 2}, nil)
 				Expect(err).ToNot(HaveOccurred())
 				Expect(buildsPage).To(Equal([]db.Build{builds[1], builds[0]}))
 				Expect(pagination.Previous).To(Equal(&db.Page{Until: builds[1].ID(), Limit: 2}))
@@ -314,7 +314,7 @@ var _ = Describe("Job", func() {
 
 		Context("with an until that places it in the middle of the builds", func() {
 			It("returns the builds, with previous/next pages", func() {
-				buildsPage, pagination, err := someJob.Builds(db.Page{Until: builds[6].ID(), Limit: 2})
+				buildsPage, pagination, err := someJob.Builds(db.Page{Until: builds[6].ID(), Limit: 2}, nil)
 				Expect(err).ToNot(HaveOccurred())
 				Expect(buildsPage).To(Equal([]db.Build{builds[8], builds[7]}))
 				Expect(pagination.Previous).To(Equal(&db.Page{Until: builds[8].ID(), Limit: 2}))
@@ -324,13 +324,42 @@ var _ = Describe("Job", func() {
 
 		Context("with a until that places it at the beginning of the builds", func() {
 			It("returns the builds, with previous/next pages", func() {
-				buildsPage, pagination, err := someJob.Builds(db.Page{Until: builds[7].ID(), Limit: 2})
+				buildsPage, pagination, err := someJob.Builds(db.Page{Until: builds[7].ID(), Limit: 2}, nil)
 				Expect(err).ToNot(HaveOccurred())
 				Expect(buildsPage).To(Equal([]db.Build{builds[9], builds[8]}))
 				Expect(pagination.Previous).To(BeNil())
 				Expect(pagination.Next).To(Equal(&db.Page{Since: builds[8].ID(), Limit: 2}))
 			})
 		})
+
+		Context("with annotations", func() {
+			BeforeEach(func() {
+				Expect(builds[3].SaveAnnotations(map[string]string{"version": "1.0.0"})).To(Succeed())
+				Expect(builds[5].SaveAnnotations(map[string]string{"version": "1.1.0"})).To(Succeed())
+			})
+
+			buildIDs := func(builds []db.Build) []int {
+				ids := []int{}
+				for _, build := range builds {
+					ids = append(ids, build.ID())
+				}
+				return ids
+			}
+
+			It("only returns the builds with the annotations, paginated among themselves", func() {
+				buildsPage, pagination, err := someJob.Builds(db.Page{Limit: 1}, []string{"version"})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(buildIDs(buildsPage)).To(Equal([]int{builds[5].ID()}))
+				Expect(pagination.Previous).To(BeNil())
+				Expect(pagination.Next).To(Equal(&db.Page{Since: builds[5].ID(), Limit: 1}))
+
+				buildsPage, pagination, err = someJob.Builds(*pagination.Next, []string{"version"})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(buildIDs(buildsPage)).To(Equal([]int{builds[3].ID()}))
+				Expect(pagination.Previous).To(Equal(&db.Page{Until: builds[3].ID(), Limit: 1}))
+				Expect(pagination.Next).To(BeNil())
+			})
+		})
 	})
 
 	Describe("Build", func() {
@@ -339,12 +368,12 @@ var _ = Describe("Job", func() {
 		Context("when a build exists", func() {
 			BeforeEach(func() {
 				var err error
-				firstBuild, err = job.CreateBuild()
+				firstBuild, err = job.CreateBuild(db.BuildCauseManual)
 				Expect(err).NotTo(HaveOccurred())
 			})
 
 			It("finds the latest build", func() {
-				secondBuild, err := job.CreateBuild()
+				secondBuild, err := job.CreateBuild(db.BuildCauseManual)
 				Expect(err).NotTo(HaveOccurred())
 
 				build, found, err := job.Build("latest")
@@ -386,15 +415,15 @@ var _ = Describe("Job", func() {
 
 			BeforeEach(func() {
 				var err error
-				_, err = job.CreateBuild()
+				_, err = job.CreateBuild(db.BuildCauseManual)
 				Expect(err).NotTo(HaveOccurred())
 
-				startedBuild, err = job.CreateBuild()
+				startedBuild, err = job.CreateBuild(db.BuildCauseManual)
 				Expect(err).NotTo(HaveOccurred())
 				_, err = startedBuild.Start("", "{}", atc.Plan{})
 				Expect(err).NotTo(HaveOccurred())
 
-				scheduledBuild, err = job.CreateBuild()
+				scheduledBuild, err = job.CreateBuild(db.BuildCauseManual)
 				Expect(err).NotTo(HaveOccurred())
 
 				scheduled, err := scheduledBuild.Schedule()
@@ -402,7 +431,7 @@ var _ = Describe("Job", func() {
 				Expect(scheduled).To(BeTrue())
 
 				for _, s := range []db.BuildStatus{db.BuildStatusSucceeded, db.BuildStatusFailed, db.BuildStatusErrored, db.BuildStatusAborted} {
-					finishedBuild, err := job.CreateBuild()
+					finishedBuild, err := job.CreateBuild(db.BuildCauseManual)
 					Expect(err).NotTo(HaveOccurred())
 
 					scheduled, err = finishedBuild.Schedule()
@@ -417,7 +446,7 @@ var _ = Describe("Job", func() {
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeTrue())
 
-				_, err = otherJob.CreateBuild()
+				_, err = otherJob.CreateBuild(db.BuildCauseManual)
 				Expect(err).NotTo(HaveOccurred())
 			})
 
@@ -439,14 +468,14 @@ var _ = Describe("Job", func() {
 
 			BeforeEach(func() {
 				var err error
-				_, err = job.CreateBuild()
+				_, err = job.CreateBuild(db.BuildCauseManual)
 				Expect(err).NotTo(HaveOccurred())
 
 				otherSerialJob, found, err := pipeline.Job("other-serial-group-job")
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeTrue())
 
-				serialGroupBuild, err = otherSerialJob.CreateBuild()
+				serialGroupBuild, err = otherSerialJob.CreateBuild(db.BuildCauseManual)
 				Expect(err).NotTo(HaveOccurred())
 
 				scheduled, err := serialGroupBuild.Schedule()
@@ -457,7 +486,7 @@ var _ = Describe("Job", func() {
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeTrue())
 
-				differentSerialGroupBuild, err := differentSerialJob.CreateBuild()
+				differentSerialGroupBuild, err := differentSerialJob.CreateBuild(db.BuildCauseManual)
 				Expect(err).NotTo(HaveOccurred())
 
 				scheduled, err = differentSerialGroupBuild.Schedule()
@@ -494,10 +523,10 @@ var _ = Describe("Job", func() {
 			var actualBuild db.Build
 
 			BeforeEach(func() {
-				_, err := job1.CreateBuild()
+				_, err := job1.CreateBuild(db.BuildCauseManual)
 				Expect(err).NotTo(HaveOccurred())
 
-				actualBuild, err = job2.CreateBuild()
+				actualBuild, err = job2.CreateBuild(db.BuildCauseManual)
 				Expect(err).NotTo(HaveOccurred())
 
 				err = job2.SaveNextInputMapping(nil)
@@ -513,13 +542,13 @@ var _ = Describe("Job", func() {
 		})
 
 		It("should return the next most pending build in a group of jobs", func() {
-			buildOne, err := job1.CreateBuild()
+			buildOne, err := job1.CreateBuild(db.BuildCauseManual)
 			Expect(err).NotTo(HaveOccurred())
 
-			buildTwo, err := job1.CreateBuild()
+			buildTwo, err := job1.CreateBuild(db.BuildCauseManual)
 			Expect(err).NotTo(HaveOccurred())
 
-			buildThree, err := job2.CreateBuild()
+			buildThree, err := job2.CreateBuild(db.BuildCauseManual)
 			Expect(err).NotTo(HaveOccurred())
 
 			err = job1.SaveNextInputMapping(nil)
@@ -605,7 +634,7 @@ var _ = Describe("Job", func() {
 			Expect(found).To(BeTrue())
 
 			// save metadata for v1
-			build, err := job.CreateBuild()
+			build, err := job.CreateBuild(db.BuildCauseManual)
 			Expect(err).ToNot(HaveOccurred())
 			err = build.SaveInput(db.BuildInput{
 				Name: "some-input",
@@ -637,7 +666,7 @@ var _ = Describe("Job", func() {
 				Resources: atc.ResourceConfigs{resourceConfig},
 			}
 
-			pipeline2, _, err = team.SavePipeline("some-pipeline-2", config, 1, db.PipelineUnpaused)
+			pipeline2, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline-2"}, config, 1, db.PipelineUnpaused)
 			Expect(err).ToNot(HaveOccurred())
 
 			job2, found, err = pipeline2.Job("some-job")
@@ -867,7 +896,7 @@ var _ = Describe("Job", func() {
 		})
 
 		It("fails to save build input if resource does not exist", func() {
-			build, err := job.CreateBuild()
+			build, err := job.CreateBuild(db.BuildCauseManual)
 			Expect(err).NotTo(HaveOccurred())
 
 			vr := db.VersionedResource{
@@ -886,7 +915,7 @@ var _ = Describe("Job", func() {
 		})
 
 		It("updates metadata of existing versioned resources", func() {
-			build, err := job.CreateBuild()
+			build, err := job.CreateBuild(db.BuildCauseManual)
 			Expect(err).NotTo(HaveOccurred())
 
 			err = build.SaveInput(db.BuildInput{
@@ -933,7 +962,7 @@ var _ = Describe("Job", func() {
 		})
 
 		It("does not clobber metadata of existing versioned resources", func() {
-			build, err := job.CreateBuild()
+			build, err := job.CreateBuild(db.BuildCauseManual)
 			Expect(err).NotTo(HaveOccurred())
 
 			withMetadata := vr1
@@ -991,10 +1020,10 @@ var _ = Describe("Job", func() {
 				},
 			}
 			var err error
-			otherPipeline, _, err = team.SavePipeline("some-other-pipeline", pipelineConfig, db.ConfigVersion(1), db.PipelineUnpaused)
+			otherPipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-other-pipeline"}, pipelineConfig, db.ConfigVersion(1), db.PipelineUnpaused)
 			Expect(err).ToNot(HaveOccurred())
 
-			build1DB, err = job.CreateBuild()
+			build1DB, err = job.CreateBuild(db.BuildCauseManual)
 			Expect(err).ToNot(HaveOccurred())
 
 			Expect(build1DB.ID()).NotTo(BeZero())
@@ -1026,7 +1055,7 @@ var _ = Describe("Job", func() {
 
 		Context("and another build for a different pipeline is created with the same job name", func() {
 			BeforeEach(func() {
-				otherBuild, err := otherJob.CreateBuild()
+				otherBuild, err := otherJob.CreateBuild(db.BuildCauseManual)
 				Expect(err).NotTo(HaveOccurred())
 
 				Expect(otherBuild.ID()).NotTo(BeZero())
@@ -1118,7 +1147,7 @@ var _ = Describe("Job", func() {
 
 			BeforeEach(func() {
 				var err error
-				build2DB, err = job.CreateBuild()
+				build2DB, err = job.CreateBuild(db.BuildCauseManual)
 				Expect(err).NotTo(HaveOccurred())
 
 				Expect(build2DB.ID()).NotTo(BeZero())
@@ -1149,7 +1178,7 @@ var _ = Describe("Job", func() {
 	Describe("EnsurePendingBuildExists", func() {
 		Context("when only a started build exists", func() {
 			BeforeEach(func() {
-				build1, err := job.CreateBuild()
+				build1, err := job.CreateBuild(db.BuildCauseManual)
 				Expect(err).NotTo(HaveOccurred())
 
 				started, err := build1.Start("some-engine", `{"some":"metadata"}`, atc.Plan{})
@@ -1188,6 +1217,194 @@ var _ = Describe("Job", func() {
 		})
 	})
 
+	Describe("CreateBuild", func() {
+		It("creates a pending build recording its cause", func() {
+			build, err := job.CreateBuild(db.BuildCauseManual)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(build.Status()).To(Equal(db.BuildStatusPending))
+			Expect(build.IsManuallyTriggered()).To(BeTrue())
+			Expect(build.Cause()).To(Equal(db.BuildCauseManual))
+
+			found, err := build.Reload()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(build.Cause()).To(Equal(db.BuildCauseManual))
+		})
+	})
+
+	Describe("CreateScheduledBuild", func() {
+		It("creates a pending build caused by the schedule", func() {
+			build, err := job.CreateScheduledBuild(time.Now())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(build.Status()).To(Equal(db.BuildStatusPending))
+			Expect(build.IsManuallyTriggered()).To(BeFalse())
+			Expect(build.Cause()).To(Equal(db.BuildCauseSchedule))
+		})
+	})
+
+	Describe("LastScheduled", func() {
+		var scheduledJob db.Job
+
+		saveConfig := func(schedule *atc.ScheduleConfig) {
+			var err error
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "scheduled-pipeline"}, atc.Config{
+				Jobs: atc.JobConfigs{
+					{
+						Name:     "scheduled-job",
+						Schedule: schedule,
+					},
+				},
+			}, pipeline.ConfigVersion(), db.PipelineUnpaused)
+			Expect(err).ToNot(HaveOccurred())
+
+			var found bool
+			scheduledJob, found, err = pipeline.Job("scheduled-job")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+		}
+
+		BeforeEach(func() {
+			var err error
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "scheduled-pipeline"}, atc.Config{
+				Jobs: atc.JobConfigs{{Name: "scheduled-job"}},
+			}, db.ConfigVersion(0), db.PipelineUnpaused)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("is zero for jobs without a schedule", func() {
+			saveConfig(nil)
+			Expect(scheduledJob.LastScheduled()).To(BeZero())
+		})
+
+		Context("when the job is given a schedule", func() {
+			var configuredAt time.Time
+
+			BeforeEach(func() {
+				saveConfig(&atc.ScheduleConfig{Cron: "0 9 * * *"})
+				configuredAt = scheduledJob.LastScheduled()
+			})
+
+			It("starts the schedule's clock", func() {
+				Expect(configuredAt).To(BeTemporally("~", time.Now(), time.Minute))
+			})
+
+			It("keeps the clock running when the config is saved again", func() {
+				saveConfig(&atc.ScheduleConfig{Cron: "0 9 * * *"})
+				Expect(scheduledJob.LastScheduled()).To(Equal(configuredAt))
+			})
+
+			It("restarts the clock when the schedule changes", func() {
+				scheduledFor := configuredAt.Add(-time.Hour)
+				_, err := scheduledJob.CreateScheduledBuild(scheduledFor)
+				Expect(err).NotTo(HaveOccurred())
+
+				saveConfig(&atc.ScheduleConfig{Cron: "0 10 * * *"})
+				Expect(scheduledJob.LastScheduled()).To(BeTemporally(">=", configuredAt))
+			})
+
+			It("is set to the time the schedule fired by builds caused by it", func() {
+				scheduledFor := configuredAt.Add(time.Hour).Truncate(time.Second)
+				_, err := scheduledJob.CreateScheduledBuild(scheduledFor)
+				Expect(err).NotTo(HaveOccurred())
+
+				found, err := scheduledJob.Reload()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(scheduledJob.LastScheduled()).To(BeTemporally("==", scheduledFor))
+			})
+
+			It("is not advanced by manual builds", func() {
+				_, err := scheduledJob.CreateBuild(db.BuildCauseManual)
+				Expect(err).NotTo(HaveOccurred())
+
+				found, err := scheduledJob.Reload()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(scheduledJob.LastScheduled()).To(Equal(configuredAt))
+			})
+
+			It("is cleared when the schedule is removed", func() {
+				saveConfig(nil)
+				Expect(scheduledJob.LastScheduled()).To(BeZero())
+			})
+		})
+	})
+
+	Describe("RerunBuild", func() {
+		var originalBuild db.Build
+		var versionedResource db.VersionedResource
+
+		BeforeEach(func() {
+			var err error
+			originalBuild, err = job.CreateBuild(db.BuildCauseManual)
+			Expect(err).NotTo(HaveOccurred())
+
+			versionedResource = db.VersionedResource{
+				Resource: "some-resource",
+				Type:     "some-type",
+				Version:  db.ResourceVersion{"ref": "v1"},
+				Metadata: []db.ResourceMetadataField{},
+			}
+
+			err = originalBuild.SaveInput(db.BuildInput{
+				Name:              "some-input",
+				VersionedResource: versionedResource,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			started, err := originalBuild.Start("some-engine", `{"some":"metadata"}`, atc.Plan{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(started).To(BeTrue())
+		})
+
+		It("creates a pending build named after the original build", func() {
+			rerunBuild, err := job.RerunBuild(originalBuild)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(rerunBuild.Name()).To(Equal(originalBuild.Name() + ".1"))
+			Expect(rerunBuild.Status()).To(Equal(db.BuildStatusPending))
+			Expect(rerunBuild.RerunOf()).To(Equal(originalBuild.ID()))
+			Expect(rerunBuild.RerunNumber()).To(Equal(1))
+
+			pendingBuilds, err := job.GetPendingBuilds()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pendingBuilds).To(HaveLen(1))
+			Expect(pendingBuilds[0].ID()).To(Equal(rerunBuild.ID()))
+		})
+
+		It("uses the inputs of the original build", func() {
+			rerunBuild, err := job.RerunBuild(originalBuild)
+			Expect(err).NotTo(HaveOccurred())
+
+			inputs, err := rerunBuild.Inputs()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(inputs).To(Equal([]db.BuildInput{
+				{
+					Name:              "some-input",
+					VersionedResource: versionedResource,
+				},
+			}))
+		})
+
+		It("numbers further reruns after the original build", func() {
+			firstRerun, err := job.RerunBuild(originalBuild)
+			Expect(err).NotTo(HaveOccurred())
+
+			secondRerun, err := job.RerunBuild(firstRerun)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(secondRerun.Name()).To(Equal(originalBuild.Name() + ".2"))
+			Expect(secondRerun.RerunOf()).To(Equal(originalBuild.ID()))
+
+			build, found, err := job.Build(originalBuild.Name() + ".2")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(build.ID()).To(Equal(secondRerun.ID()))
+		})
+	})
+
 	Describe("Clear worker task cache", func() {
 		Context("when worker task cache exists", func() {
 			var (
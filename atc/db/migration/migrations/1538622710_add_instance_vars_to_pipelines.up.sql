@@ -0,0 +1,9 @@
+BEGIN;
+  ALTER TABLE pipelines
+    ADD COLUMN instance_vars jsonb;
+
+  ALTER TABLE pipelines
+    DROP CONSTRAINT pipelines_name_team_id;
+
+  CREATE UNIQUE INDEX pipelines_name_team_id_instance_vars ON pipelines (name, team_id, COALESCE(instance_vars, '{}'::jsonb));
+COMMIT;
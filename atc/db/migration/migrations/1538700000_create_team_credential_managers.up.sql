@@ -0,0 +1,8 @@
+BEGIN;
+  CREATE TABLE team_credential_managers (
+    id serial PRIMARY KEY,
+    team_id integer NOT NULL UNIQUE REFERENCES teams (id) ON DELETE CASCADE,
+    config text NOT NULL,
+    nonce text
+  );
+COMMIT;
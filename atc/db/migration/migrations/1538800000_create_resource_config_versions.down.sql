@@ -0,0 +1,6 @@
+BEGIN;
+  DROP TABLE resource_config_versions;
+
+  ALTER TABLE resource_configs
+    DROP COLUMN last_checked;
+COMMIT;
@@ -0,0 +1,13 @@
+BEGIN;
+  ALTER TABLE resource_configs
+    ADD COLUMN last_checked timestamp with time zone DEFAULT '1970-01-01 00:00:00' NOT NULL;
+
+  CREATE TABLE resource_config_versions (
+    id serial PRIMARY KEY,
+    resource_config_id integer NOT NULL REFERENCES resource_configs (id) ON DELETE CASCADE,
+    version text NOT NULL,
+    check_order integer DEFAULT 0 NOT NULL
+  );
+
+  CREATE UNIQUE INDEX resource_config_versions_resource_config_id_version ON resource_config_versions (resource_config_id, md5(version));
+COMMIT;
@@ -0,0 +1,8 @@
+BEGIN;
+  DROP INDEX volumes_build_artifact_id;
+
+  ALTER TABLE volumes
+    DROP COLUMN build_artifact_id;
+
+  DROP TABLE build_artifacts;
+COMMIT;
@@ -0,0 +1,14 @@
+BEGIN;
+  CREATE TABLE build_artifacts (
+    id serial PRIMARY KEY,
+    build_id integer NOT NULL REFERENCES builds (id) ON DELETE CASCADE,
+    name text NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    UNIQUE (build_id, name)
+  );
+
+  ALTER TABLE volumes
+    ADD COLUMN build_artifact_id integer REFERENCES build_artifacts (id) ON DELETE SET NULL;
+
+  CREATE INDEX volumes_build_artifact_id ON volumes (build_artifact_id);
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+  ALTER TABLE resources
+    DROP COLUMN api_pinned_version,
+    DROP COLUMN pin_comment,
+    DROP COLUMN pinned_by;
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+  ALTER TABLE resources
+    ADD COLUMN api_pinned_version jsonb,
+    ADD COLUMN pin_comment text,
+    ADD COLUMN pinned_by text;
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+  DROP INDEX IF EXISTS builds_rerun_of_idx;
+
+  ALTER TABLE builds
+    DROP COLUMN rerun_of,
+    DROP COLUMN rerun_number;
+COMMIT;
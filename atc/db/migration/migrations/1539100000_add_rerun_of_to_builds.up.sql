@@ -0,0 +1,7 @@
+BEGIN;
+  ALTER TABLE builds
+    ADD COLUMN rerun_of integer REFERENCES builds (id) ON DELETE CASCADE,
+    ADD COLUMN rerun_number integer;
+
+  CREATE INDEX builds_rerun_of_idx ON builds (rerun_of);
+COMMIT;
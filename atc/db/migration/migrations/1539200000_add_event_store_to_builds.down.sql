@@ -0,0 +1,5 @@
+BEGIN;
+  DROP INDEX IF EXISTS builds_event_store_idx;
+
+  ALTER TABLE builds DROP COLUMN event_store;
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+  ALTER TABLE builds ADD COLUMN event_store text NOT NULL DEFAULT 'postgres';
+
+  CREATE INDEX builds_event_store_idx ON builds (id) WHERE completed AND event_store = 'postgres';
+COMMIT;
@@ -0,0 +1,3 @@
+BEGIN;
+  ALTER TABLE containers DROP COLUMN task_finished;
+COMMIT;
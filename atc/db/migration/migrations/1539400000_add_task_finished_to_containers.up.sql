@@ -0,0 +1,3 @@
+BEGIN;
+  ALTER TABLE containers ADD COLUMN task_finished boolean NOT NULL DEFAULT false;
+COMMIT;
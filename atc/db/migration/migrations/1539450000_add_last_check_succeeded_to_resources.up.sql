@@ -0,0 +1,4 @@
+BEGIN;
+  ALTER TABLE resources
+    ADD COLUMN last_check_succeeded timestamp with time zone;
+COMMIT;
@@ -0,0 +1,12 @@
+BEGIN;
+  DROP TABLE task_output_cache_uses;
+
+  DROP INDEX volumes_task_output_cache_output_id;
+
+  ALTER TABLE volumes
+    DROP COLUMN task_output_cache_output_id;
+
+  DROP TABLE task_output_cache_outputs;
+
+  DROP TABLE task_output_caches;
+COMMIT;
@@ -0,0 +1,30 @@
+BEGIN;
+  CREATE TABLE task_output_caches (
+    id serial PRIMARY KEY,
+    team_id integer NOT NULL REFERENCES teams (id) ON DELETE CASCADE,
+    key text NOT NULL,
+    last_used timestamp with time zone DEFAULT now() NOT NULL,
+    UNIQUE (team_id, key)
+  );
+
+  CREATE TABLE task_output_cache_outputs (
+    id serial PRIMARY KEY,
+    task_output_cache_id integer NOT NULL REFERENCES task_output_caches (id) ON DELETE CASCADE,
+    name text NOT NULL,
+    UNIQUE (task_output_cache_id, name)
+  );
+
+  ALTER TABLE volumes
+    ADD COLUMN task_output_cache_output_id integer REFERENCES task_output_cache_outputs (id) ON DELETE SET NULL;
+
+  CREATE INDEX volumes_task_output_cache_output_id ON volumes (task_output_cache_output_id);
+
+  CREATE TABLE task_output_cache_uses (
+    id serial PRIMARY KEY,
+    build_id integer NOT NULL REFERENCES builds (id) ON DELETE CASCADE,
+    volume_id integer NOT NULL REFERENCES volumes (id) ON DELETE CASCADE,
+    UNIQUE (build_id, volume_id)
+  );
+
+  CREATE INDEX task_output_cache_uses_volume_id ON task_output_cache_uses (volume_id);
+COMMIT;
@@ -0,0 +1,8 @@
+BEGIN;
+  DROP INDEX volumes_worker_streamed_resource_cache_unique;
+
+  ALTER TABLE volumes
+    DROP COLUMN worker_streamed_resource_cache_id;
+
+  DROP TABLE worker_streamed_resource_caches;
+COMMIT;
@@ -0,0 +1,13 @@
+BEGIN;
+  CREATE TABLE worker_streamed_resource_caches (
+    id serial PRIMARY KEY,
+    resource_cache_id integer NOT NULL REFERENCES resource_caches (id) ON DELETE CASCADE,
+    worker_name text NOT NULL REFERENCES workers (name) ON DELETE CASCADE,
+    UNIQUE (resource_cache_id, worker_name)
+  );
+
+  ALTER TABLE volumes
+    ADD COLUMN worker_streamed_resource_cache_id integer REFERENCES worker_streamed_resource_caches (id) ON DELETE SET NULL;
+
+  CREATE UNIQUE INDEX volumes_worker_streamed_resource_cache_unique ON volumes (worker_streamed_resource_cache_id);
+COMMIT;
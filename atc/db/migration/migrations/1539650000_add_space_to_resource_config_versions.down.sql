@@ -0,0 +1,16 @@
+BEGIN;
+  DELETE FROM resource_config_versions v
+  USING resource_configs c
+  WHERE v.resource_config_id = c.id
+  AND v.space != c.default_space;
+
+  DROP INDEX resource_config_versions_resource_config_id_space_version;
+
+  ALTER TABLE resource_config_versions
+    DROP COLUMN space;
+
+  CREATE UNIQUE INDEX resource_config_versions_resource_config_id_version ON resource_config_versions (resource_config_id, md5(version));
+
+  ALTER TABLE resource_configs
+    DROP COLUMN default_space;
+COMMIT;
@@ -0,0 +1,11 @@
+BEGIN;
+  ALTER TABLE resource_configs
+    ADD COLUMN default_space text NOT NULL DEFAULT '';
+
+  ALTER TABLE resource_config_versions
+    ADD COLUMN space text NOT NULL DEFAULT '';
+
+  DROP INDEX resource_config_versions_resource_config_id_version;
+
+  CREATE UNIQUE INDEX resource_config_versions_resource_config_id_space_version ON resource_config_versions (resource_config_id, space, md5(version));
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+  ALTER TABLE task_output_caches DROP COLUMN annotations;
+
+  ALTER TABLE builds DROP COLUMN annotations;
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+  ALTER TABLE builds ADD COLUMN annotations jsonb NOT NULL DEFAULT '{}';
+
+  ALTER TABLE task_output_caches ADD COLUMN annotations jsonb NOT NULL DEFAULT '{}';
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+  ALTER TABLE jobs DROP COLUMN schedule;
+
+  ALTER TABLE jobs DROP COLUMN last_scheduled;
+
+  ALTER TABLE builds DROP COLUMN cause;
+COMMIT;
@@ -0,0 +1,13 @@
+BEGIN;
+  ALTER TABLE builds ADD COLUMN cause text;
+
+  UPDATE builds
+    SET cause = 'manual'
+    WHERE manually_triggered
+      AND job_id IS NOT NULL
+      AND rerun_of IS NULL;
+
+  ALTER TABLE jobs ADD COLUMN last_scheduled timestamp with time zone;
+
+  ALTER TABLE jobs ADD COLUMN schedule text;
+COMMIT;
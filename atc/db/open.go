@@ -24,6 +24,7 @@ import (
 type Conn interface {
 	Bus() NotificationsBus
 	EncryptionStrategy() encryption.Strategy
+	EventStore() EventStore
 
 	Ping() error
 	Driver() driver.Driver
@@ -121,6 +122,8 @@ var encryptedColumns = map[string]string{
 	"jobs":           "config",
 	"resource_types": "config",
 	"builds":         "engine_metadata",
+
+	"team_credential_managers": "config",
 }
 
 func encryptPlaintext(logger lager.Logger, sqlDB *sql.DB, key *encryption.Key) error {
@@ -347,6 +350,10 @@ func (db *db) EncryptionStrategy() encryption.Strategy {
 	return db.encryption
 }
 
+func (db *db) EventStore() EventStore {
+	return NewPostgresEventStore(db)
+}
+
 func (db *db) Close() error {
 	var errs error
 	dbErr := db.DB.Close()
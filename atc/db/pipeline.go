@@ -27,19 +27,33 @@ func (e ErrResourceNotFound) Error() string {
 //go:generate counterfeiter . Pipeline
 
 type Cause struct {
-	VersionedResourceID int `json:"versioned_resource_id"`
-	BuildID             int `json:"build_id"`
+	VersionedResourceID int
+	BuildID             int
+
+	// ParentBuildID is the build which produced the version, or 0 for the
+	// version the causality was requested for.
+	ParentBuildID int
+
+	ResourceName string
+	Version      atc.Version
+	JobName      string
+	BuildName    string
 }
 
 type Pipeline interface {
 	ID() int
 	Name() string
+	InstanceVars() atc.InstanceVars
+	PipelineRef() atc.PipelineRef
 	TeamID() int
 	TeamName() string
 	Groups() atc.GroupConfigs
 	ConfigVersion() ConfigVersion
 	Public() bool
 	Paused() bool
+	Archived() bool
+	ParentJobID() int
+	ParentBuildID() int
 	ScopedName(string) string
 
 	CheckPaused() (bool, error)
@@ -61,7 +75,7 @@ type Pipeline interface {
 	EnableVersionedResource(versionedResourceID int) error
 	GetBuildsWithVersionAsInput(versionedResourceID int) ([]Build, error)
 	GetBuildsWithVersionAsOutput(versionedResourceID int) ([]Build, error)
-	Builds(page Page) ([]Build, Pagination, error)
+	Builds(page Page, annotations []string) ([]Build, Pagination, error)
 
 	DeleteBuildEventsByBuildIDs(buildIDs []int) error
 
@@ -101,6 +115,8 @@ type Pipeline interface {
 	Pause() error
 	Unpause() error
 
+	Archive() error
+
 	Destroy() error
 	Rename(string) error
 
@@ -110,12 +126,16 @@ type Pipeline interface {
 type pipeline struct {
 	id            int
 	name          string
+	instanceVars  atc.InstanceVars
 	teamID        int
 	teamName      string
 	groups        atc.GroupConfigs
 	configVersion ConfigVersion
 	paused        bool
 	public        bool
+	archived      bool
+	parentJobID   int
+	parentBuildID int
 
 	cacheIndex int
 	versionsDB *algorithm.VersionsDB
@@ -132,12 +152,16 @@ type PipelinePausedState string
 var pipelinesQuery = psql.Select(`
 		p.id,
 		p.name,
+		p.instance_vars,
 		p.groups,
 		p.version,
 		p.team_id,
 		t.name,
 		p.paused,
-		p.public
+		p.public,
+		p.archived,
+		p.parent_job_id,
+		p.parent_build_id
 	`).
 	From("pipelines p").
 	LeftJoin("teams t ON p.team_id = t.id")
@@ -171,14 +195,22 @@ func newPipeline(conn Conn, lockFactory lock.LockFactory) *pipeline {
 	}
 }
 
-func (p *pipeline) ID() int                      { return p.id }
-func (p *pipeline) Name() string                 { return p.name }
-func (p *pipeline) TeamID() int                  { return p.teamID }
-func (p *pipeline) TeamName() string             { return p.teamName }
-func (p *pipeline) Groups() atc.GroupConfigs     { return p.groups }
-func (p *pipeline) ConfigVersion() ConfigVersion { return p.configVersion }
-func (p *pipeline) Public() bool                 { return p.public }
-func (p *pipeline) Paused() bool                 { return p.paused }
+func (p *pipeline) ID() int                        { return p.id }
+func (p *pipeline) Name() string                   { return p.name }
+func (p *pipeline) InstanceVars() atc.InstanceVars { return p.instanceVars }
+func (p *pipeline) TeamID() int                    { return p.teamID }
+func (p *pipeline) TeamName() string               { return p.teamName }
+func (p *pipeline) Groups() atc.GroupConfigs       { return p.groups }
+func (p *pipeline) ConfigVersion() ConfigVersion   { return p.configVersion }
+func (p *pipeline) Public() bool                   { return p.public }
+func (p *pipeline) Paused() bool                   { return p.paused }
+func (p *pipeline) Archived() bool                 { return p.archived }
+func (p *pipeline) ParentJobID() int               { return p.parentJobID }
+func (p *pipeline) ParentBuildID() int             { return p.parentBuildID }
+
+func (p *pipeline) PipelineRef() atc.PipelineRef {
+	return atc.PipelineRef{Name: p.name, InstanceVars: p.instanceVars}
+}
 
 func (p *pipeline) ScopedName(n string) string {
 	return p.name + ":" + n
@@ -186,12 +218,12 @@ func (p *pipeline) ScopedName(n string) string {
 
 func (p *pipeline) Causality(versionedResourceID int) ([]Cause, error) {
 	rows, err := p.conn.Query(`
-		WITH RECURSIVE causality(versioned_resource_id, build_id) AS (
-				SELECT bi.versioned_resource_id, bi.build_id
+		WITH RECURSIVE causality(versioned_resource_id, build_id, parent_build_id) AS (
+				SELECT bi.versioned_resource_id, bi.build_id, NULL::integer
 				FROM build_inputs bi
 				WHERE bi.versioned_resource_id = $1
 			UNION
-				SELECT bi.versioned_resource_id, bi.build_id
+				SELECT bi.versioned_resource_id, bi.build_id, t.build_id
 				FROM causality t
 				INNER JOIN build_outputs bo ON bo.build_id = t.build_id
 				INNER JOIN build_inputs bi ON bi.versioned_resource_id = bo.versioned_resource_id
@@ -205,27 +237,40 @@ func (p *pipeline) Causality(versionedResourceID int) ([]Cause, error) {
 					AND obo.versioned_resource_id = bi.versioned_resource_id
 				)
 		)
-		SELECT c.versioned_resource_id, c.build_id
+		SELECT c.versioned_resource_id, c.build_id, c.parent_build_id, r.name, v.version, j.name, b.name
 		FROM causality c
 		INNER JOIN builds b ON b.id = c.build_id
+		INNER JOIN jobs j ON j.id = b.job_id
+		INNER JOIN versioned_resources v ON v.id = c.versioned_resource_id
+		INNER JOIN resources r ON r.id = v.resource_id
 		ORDER BY b.start_time ASC, c.versioned_resource_id ASC
 	`, versionedResourceID)
 	if err != nil {
 		return nil, err
 	}
 
+	defer Close(rows)
+
 	var causality []Cause
 	for rows.Next() {
-		var vrID, buildID int
-		err := rows.Scan(&vrID, &buildID)
+		var cause Cause
+		var parentBuildID sql.NullInt64
+		var version string
+		err := rows.Scan(&cause.VersionedResourceID, &cause.BuildID, &parentBuildID, &cause.ResourceName, &version, &cause.JobName, &cause.BuildName)
+		if err != nil {
+			return nil, err
+		}
+
+		if parentBuildID.Valid {
+			cause.ParentBuildID = int(parentBuildID.Int64)
+		}
+
+		err = json.Unmarshal([]byte(version), &cause.Version)
 		if err != nil {
 			return nil, err
 		}
 
-		causality = append(causality, Cause{
-			VersionedResourceID: vrID,
-			BuildID:             buildID,
-		})
+		causality = append(causality, cause)
 	}
 
 	return causality, nil
@@ -278,8 +323,8 @@ func (p *pipeline) CreateJobBuild(jobName string) (Build, error) {
 
 	var buildID int
 	err = psql.Insert("builds").
-		Columns("name", "job_id", "team_id", "status", "manually_triggered").
-		Values(buildName, jobID, p.teamID, "pending", true).
+		Columns("name", "job_id", "team_id", "status", "manually_triggered", "cause").
+		Values(buildName, jobID, p.teamID, "pending", true, string(BuildCauseManual)).
 		Suffix("RETURNING id").
 		RunWith(tx).
 		QueryRow().
@@ -318,6 +363,7 @@ func (p *pipeline) SetResourceCheckError(resource Resource, cause error) error {
 	if cause == nil {
 		_, err = psql.Update("resources").
 			Set("check_error", nil).
+			Set("last_check_succeeded", sq.Expr("now()")).
 			Where(sq.Eq{"id": resource.ID()}).
 			RunWith(p.conn).
 			Exec()
@@ -394,12 +440,12 @@ func (p *pipeline) SaveResourceVersions(config atc.ResourceConfig, versions []at
 			return err
 		}
 
-		_, _, err = p.saveVersionedResource(tx, resourceID, vr)
+		_, _, err = saveVersionedResource(tx, resourceID, vr)
 		if err != nil {
 			return err
 		}
 
-		err = p.incrementCheckOrderWhenNewerVersion(tx, resourceID, vr.Type, string(versionJSON))
+		err = incrementCheckOrderWhenNewerVersion(tx, resourceID, vr.Type, string(versionJSON))
 		if err != nil {
 			return err
 		}
@@ -773,8 +819,8 @@ func (p *pipeline) Resource(name string) (Resource, bool, error) {
 	return resource, true, nil
 }
 
-func (p *pipeline) Builds(page Page) ([]Build, Pagination, error) {
-	return getBuildsWithPagination(buildsQuery.Where(sq.Eq{"b.pipeline_id": p.id}), page, p.conn, p.lockFactory)
+func (p *pipeline) Builds(page Page, annotations []string) ([]Build, Pagination, error) {
+	return getBuildsWithPagination(buildsQuery.Where(sq.Eq{"b.pipeline_id": p.id}), page, annotations, p.conn, p.lockFactory)
 }
 
 func (p *pipeline) Resources() (Resources, error) {
@@ -840,12 +886,20 @@ func (p *pipeline) ResourceType(name string) (ResourceType, bool, error) {
 	return resourceType, true, nil
 }
 
+// Job returns the pipeline's active job with the given name. Archiving a
+// pipeline deactivates all of its jobs; they are still returned for an
+// archived pipeline so that their builds remain browsable.
 func (p *pipeline) Job(name string) (Job, bool, error) {
-	row := jobsQuery.Where(sq.Eq{
+	where := sq.Eq{
 		"j.name":        name,
-		"j.active":      true,
 		"j.pipeline_id": p.id,
-	}).RunWith(p.conn).QueryRow()
+	}
+
+	if !p.archived {
+		where["j.active"] = true
+	}
+
+	row := jobsQuery.Where(where).RunWith(p.conn).QueryRow()
 
 	job := &job{conn: p.conn, lockFactory: p.lockFactory}
 	err := scanJob(job, row)
@@ -951,6 +1005,68 @@ func (p *pipeline) Unpause() error {
 	return err
 }
 
+// Archive pauses the pipeline and deactivates all of its jobs, resources, and
+// resource types, effectively clearing its config. Its build history is kept.
+// Saving a new config for the pipeline will unarchive it.
+func (p *pipeline) Archive() error {
+	tx, err := p.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	_, err = psql.Update("pipelines").
+		Set("archived", true).
+		Set("paused", true).
+		Set("groups", nil).
+		Set("version", sq.Expr("nextval('config_version_seq')")).
+		Where(sq.Eq{
+			"id": p.id,
+		}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`
+		DELETE FROM jobs_serial_groups
+		WHERE job_id in (
+			SELECT j.id
+			FROM jobs j
+			WHERE j.pipeline_id = $1
+		)
+	`, p.id)
+	if err != nil {
+		return err
+	}
+
+	for _, table := range []string{"jobs", "resources", "resource_types"} {
+		_, err = psql.Update(table).
+			Set("active", false).
+			Where(sq.Eq{
+				"pipeline_id": p.id,
+			}).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	p.archived = true
+	p.paused = true
+	p.groups = nil
+
+	return nil
+}
+
 func (p *pipeline) Hide() error {
 	_, err := psql.Update("pipelines").
 		Set("public", false).
@@ -1207,7 +1323,25 @@ func (p *pipeline) DeleteBuildEventsByBuildIDs(buildIDs []int) error {
 	}
 
 	err = tx.Commit()
-	return err
+	if err != nil {
+		return err
+	}
+
+	store := p.conn.EventStore()
+	if store.Name() == PostgresEventStoreName {
+		return nil
+	}
+
+	keys := make([]BuildEventsKey, len(buildIDs))
+	for i, buildID := range buildIDs {
+		keys[i] = BuildEventsKey{
+			BuildID:    buildID,
+			TeamID:     p.teamID,
+			PipelineID: p.id,
+		}
+	}
+
+	return store.Delete(keys)
 }
 
 func (p *pipeline) AcquireSchedulingLock(logger lager.Logger, interval time.Duration) (lock.Lock, bool, error) {
@@ -1281,7 +1415,7 @@ func (p *pipeline) saveOutput(buildID int, vr VersionedResource) error {
 		return err
 	}
 
-	svr, created, err := p.saveVersionedResource(tx, resourceID, vr)
+	svr, created, err := saveVersionedResource(tx, resourceID, vr)
 	if err != nil {
 		return err
 	}
@@ -1293,7 +1427,7 @@ func (p *pipeline) saveOutput(buildID int, vr VersionedResource) error {
 			return err
 		}
 
-		err = p.incrementCheckOrderWhenNewerVersion(tx, resourceID, vr.Type, string(versionJSON))
+		err = incrementCheckOrderWhenNewerVersion(tx, resourceID, vr.Type, string(versionJSON))
 		if err != nil {
 			return err
 		}
@@ -1355,7 +1489,7 @@ func (p *pipeline) saveInputTx(tx Tx, buildID int, input BuildInput) error {
 		return err
 	}
 
-	svr, _, err := p.saveVersionedResource(tx, resourceID, input.VersionedResource)
+	svr, _, err := saveVersionedResource(tx, resourceID, input.VersionedResource)
 	if err != nil {
 		return err
 	}
@@ -1375,7 +1509,7 @@ func (p *pipeline) saveInputTx(tx Tx, buildID int, input BuildInput) error {
 	return swallowUniqueViolation(err)
 }
 
-func (p *pipeline) saveVersionedResource(tx Tx, resourceID int, vr VersionedResource) (SavedVersionedResource, bool, error) {
+func saveVersionedResource(tx Tx, resourceID int, vr VersionedResource) (SavedVersionedResource, bool, error) {
 	versionJSON, err := json.Marshal(vr.Version)
 	if err != nil {
 		return SavedVersionedResource{}, false, err
@@ -1461,7 +1595,7 @@ func (p *pipeline) saveVersionedResource(tx Tx, resourceID int, vr VersionedReso
 	}, created, nil
 }
 
-func (p *pipeline) incrementCheckOrderWhenNewerVersion(tx Tx, resourceID int, resourceType string, version string) error {
+func incrementCheckOrderWhenNewerVersion(tx Tx, resourceID int, resourceType string, version string) error {
 	_, err := tx.Exec(`
 		WITH max_checkorder AS (
 			SELECT max(check_order) co
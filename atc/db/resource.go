@@ -28,39 +28,59 @@ type Resource interface {
 	CheckError() error
 	Paused() bool
 	WebhookToken() string
+	WebhookSignature() *atc.WebhookSignatureConfig
+	WebhookFilters() []atc.WebhookFilter
 	PinnedVersion() atc.Version
+	ConfigPinnedVersion() atc.Version
+	APIPinnedVersion() atc.Version
+	PinComment() string
+	PinnedBy() string
 	FailingToCheck() bool
+	ResourceConfigID() int
 
 	SetResourceConfig(int) error
+	LatestSpaceVersion(space string) (atc.Version, bool, error)
 
 	Pause() error
 	Unpause() error
 
+	PinVersion(version atc.Version, comment string, pinnedBy string) error
+	UnpinVersion() error
+
 	Reload() (bool, error)
 }
 
-var resourcesQuery = psql.Select("r.id, r.name, r.config, r.check_error, r.paused, r.last_checked, r.pipeline_id, r.nonce, p.name, t.name").
+var resourcesQuery = psql.Select("r.id, r.name, r.config, r.check_error, r.paused, r.last_checked, r.pipeline_id, r.nonce, r.api_pinned_version, r.pin_comment, r.pinned_by, r.resource_config_id, p.name, t.name").
 	From("resources r").
 	Join("pipelines p ON p.id = r.pipeline_id").
 	Join("teams t ON t.id = p.team_id").
 	Where(sq.Eq{"r.active": true})
 
 type resource struct {
-	id            int
-	name          string
-	pipelineID    int
-	pipelineName  string
-	teamName      string
-	type_         string
-	source        atc.Source
-	checkEvery    string
-	checkTimeout  string
-	lastChecked   time.Time
-	tags          atc.Tags
-	checkError    error
-	paused        bool
-	webhookToken  string
-	pinnedVersion atc.Version
+	id           int
+	name         string
+	pipelineID   int
+	pipelineName string
+	teamName     string
+	type_        string
+	source       atc.Source
+	checkEvery   string
+	checkTimeout string
+	lastChecked  time.Time
+	tags         atc.Tags
+	checkError   error
+	paused       bool
+	webhookToken string
+
+	webhookSignature *atc.WebhookSignatureConfig
+	webhookFilters   []atc.WebhookFilter
+
+	configPinnedVersion atc.Version
+	apiPinnedVersion    atc.Version
+	pinComment          string
+	pinnedBy            string
+
+	resourceConfigID int
 
 	conn Conn
 }
@@ -90,13 +110,15 @@ func (resources Resources) Configs() atc.ResourceConfigs {
 
 	for _, r := range resources {
 		configs = append(configs, atc.ResourceConfig{
-			Name:         r.Name(),
-			WebhookToken: r.WebhookToken(),
-			Type:         r.Type(),
-			Source:       r.Source(),
-			CheckEvery:   r.CheckEvery(),
-			Tags:         r.Tags(),
-			Version:      r.PinnedVersion(),
+			Name:             r.Name(),
+			WebhookToken:     r.WebhookToken(),
+			WebhookSignature: r.WebhookSignature(),
+			WebhookFilters:   r.WebhookFilters(),
+			Type:             r.Type(),
+			Source:           r.Source(),
+			CheckEvery:       r.CheckEvery(),
+			Tags:             r.Tags(),
+			Version:          r.ConfigPinnedVersion(),
 		})
 	}
 
@@ -111,21 +133,39 @@ func (resources Resources) PinnedVersions() map[string]atc.Version {
 	return pinnedVersions
 }
 
-func (r *resource) ID() int                    { return r.id }
-func (r *resource) Name() string               { return r.name }
-func (r *resource) PipelineID() int            { return r.pipelineID }
-func (r *resource) PipelineName() string       { return r.pipelineName }
-func (r *resource) TeamName() string           { return r.teamName }
-func (r *resource) Type() string               { return r.type_ }
-func (r *resource) Source() atc.Source         { return r.source }
-func (r *resource) CheckEvery() string         { return r.checkEvery }
-func (r *resource) CheckTimeout() string       { return r.checkTimeout }
-func (r *resource) LastChecked() time.Time     { return r.lastChecked }
-func (r *resource) Tags() atc.Tags             { return r.tags }
-func (r *resource) CheckError() error          { return r.checkError }
-func (r *resource) Paused() bool               { return r.paused }
-func (r *resource) WebhookToken() string       { return r.webhookToken }
-func (r *resource) PinnedVersion() atc.Version { return r.pinnedVersion }
+func (r *resource) ID() int                                       { return r.id }
+func (r *resource) Name() string                                  { return r.name }
+func (r *resource) PipelineID() int                               { return r.pipelineID }
+func (r *resource) PipelineName() string                          { return r.pipelineName }
+func (r *resource) TeamName() string                              { return r.teamName }
+func (r *resource) Type() string                                  { return r.type_ }
+func (r *resource) Source() atc.Source                            { return r.source }
+func (r *resource) CheckEvery() string                            { return r.checkEvery }
+func (r *resource) CheckTimeout() string                          { return r.checkTimeout }
+func (r *resource) LastChecked() time.Time                        { return r.lastChecked }
+func (r *resource) Tags() atc.Tags                                { return r.tags }
+func (r *resource) CheckError() error                             { return r.checkError }
+func (r *resource) Paused() bool                                  { return r.paused }
+func (r *resource) WebhookToken() string                          { return r.webhookToken }
+func (r *resource) WebhookSignature() *atc.WebhookSignatureConfig { return r.webhookSignature }
+func (r *resource) WebhookFilters() []atc.WebhookFilter           { return r.webhookFilters }
+func (r *resource) ConfigPinnedVersion() atc.Version              { return r.configPinnedVersion }
+func (r *resource) APIPinnedVersion() atc.Version                 { return r.apiPinnedVersion }
+func (r *resource) PinComment() string                            { return r.pinComment }
+func (r *resource) PinnedBy() string                              { return r.pinnedBy }
+func (r *resource) ResourceConfigID() int                         { return r.resourceConfigID }
+
+// PinnedVersion returns the version the resource is pinned to, if any. A
+// version pinned in the pipeline config takes precedence over one pinned
+// through the API.
+func (r *resource) PinnedVersion() atc.Version {
+	if len(r.configPinnedVersion) != 0 {
+		return r.configPinnedVersion
+	}
+
+	return r.apiPinnedVersion
+}
+
 func (r *resource) FailingToCheck() bool {
 	return r.checkError != nil
 }
@@ -170,6 +210,39 @@ func (r *resource) Unpause() error {
 	return err
 }
 
+func (r *resource) PinVersion(version atc.Version, comment string, pinnedBy string) error {
+	versionJSON, err := json.Marshal(version)
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Update("resources").
+		Set("api_pinned_version", versionJSON).
+		Set("pin_comment", comment).
+		Set("pinned_by", pinnedBy).
+		Where(sq.Eq{
+			"id": r.id,
+		}).
+		RunWith(r.conn).
+		Exec()
+
+	return err
+}
+
+func (r *resource) UnpinVersion() error {
+	_, err := psql.Update("resources").
+		Set("api_pinned_version", nil).
+		Set("pin_comment", nil).
+		Set("pinned_by", nil).
+		Where(sq.Eq{
+			"id": r.id,
+		}).
+		RunWith(r.conn).
+		Exec()
+
+	return err
+}
+
 func (r *resource) SetResourceConfig(resourceConfigID int) error {
 	_, err := psql.Update("resources").
 		Set("resource_config_id", resourceConfigID).
@@ -184,19 +257,66 @@ func (r *resource) SetResourceConfig(resourceConfigID int) error {
 	return err
 }
 
+// LatestSpaceVersion returns the latest version checked in the given space of
+// the resource's config. The pipeline's version history only tracks the
+// default space, so versions of other spaces are only found here.
+func (r *resource) LatestSpaceVersion(space string) (atc.Version, bool, error) {
+	var versionJSON string
+	err := psql.Select("v.version").
+		From("resource_config_versions v").
+		Join("resources r ON r.resource_config_id = v.resource_config_id").
+		Where(sq.Eq{
+			"r.id":    r.id,
+			"v.space": space,
+		}).
+		OrderBy("v.check_order DESC").
+		Limit(1).
+		RunWith(r.conn).
+		QueryRow().
+		Scan(&versionJSON)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	var version atc.Version
+	err = json.Unmarshal([]byte(versionJSON), &version)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return version, true, nil
+}
+
 func scanResource(r *resource, row scannable) error {
 	var (
-		configBlob      []byte
-		checkErr, nonce sql.NullString
-		lastChecked     pq.NullTime
+		configBlob, apiPinnedVersion []byte
+		checkErr, nonce              sql.NullString
+		pinComment, pinnedBy         sql.NullString
+		lastChecked                  pq.NullTime
+		resourceConfigID             sql.NullInt64
 	)
 
-	err := row.Scan(&r.id, &r.name, &configBlob, &checkErr, &r.paused, &lastChecked, &r.pipelineID, &nonce, &r.pipelineName, &r.teamName)
+	err := row.Scan(&r.id, &r.name, &configBlob, &checkErr, &r.paused, &lastChecked, &r.pipelineID, &nonce, &apiPinnedVersion, &pinComment, &pinnedBy, &resourceConfigID, &r.pipelineName, &r.teamName)
 	if err != nil {
 		return err
 	}
 
+	r.apiPinnedVersion = nil
+	if apiPinnedVersion != nil {
+		err = json.Unmarshal(apiPinnedVersion, &r.apiPinnedVersion)
+		if err != nil {
+			return err
+		}
+	}
+
+	r.pinComment = pinComment.String
+	r.pinnedBy = pinnedBy.String
+
 	r.lastChecked = lastChecked.Time
+	r.resourceConfigID = int(resourceConfigID.Int64)
 
 	es := r.conn.EncryptionStrategy()
 
@@ -222,7 +342,9 @@ func scanResource(r *resource, row scannable) error {
 	r.checkTimeout = config.CheckTimeout
 	r.tags = config.Tags
 	r.webhookToken = config.WebhookToken
-	r.pinnedVersion = config.Version
+	r.webhookSignature = config.WebhookSignature
+	r.webhookFilters = config.WebhookFilters
+	r.configPinnedVersion = config.Version
 
 	if checkErr.Valid {
 		r.checkError = errors.New(checkErr.String)
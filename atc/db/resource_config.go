@@ -2,6 +2,7 @@ package db
 
 import (
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"time"
 
@@ -45,6 +46,13 @@ type ResourceConfig interface {
 		interval time.Duration,
 		immediate bool,
 	) (lock.Lock, bool, error)
+
+	SetDefaultSpace(string) error
+	SaveVersions(string, []atc.Version) error
+	SaveResourceVersions(string, []atc.Version) error
+	LatestVersion() (atc.Version, bool, error)
+	LatestVersions() (map[string]atc.Version, error)
+	VersionsAfter(atc.Version) ([]atc.Version, error)
 }
 
 type resourceConfig struct {
@@ -133,7 +141,7 @@ func (r *resourceConfig) checkIfResourceConfigIntervalUpdated(
 	updated, err := checkIfRowsUpdated(tx, `
 			UPDATE resource_configs
 			SET last_checked = now()
-			WHERE id == $1
+			WHERE id = $1
 		`+condition, params...)
 	if err != nil {
 		return false, err
@@ -151,6 +159,251 @@ func (r *resourceConfig) checkIfResourceConfigIntervalUpdated(
 	return true, nil
 }
 
+// SetDefaultSpace records which space of the resource config is its default.
+// Resources which don't have spaces only have the default space, named "".
+func (r *resourceConfig) SetDefaultSpace(space string) error {
+	_, err := psql.Update("resource_configs").
+		Set("default_space", space).
+		Where(sq.Eq{"id": r.id}).
+		Where(sq.NotEq{"default_space": space}).
+		RunWith(r.conn).
+		Exec()
+	return err
+}
+
+// SaveVersions saves versions found in a space by checking the resource
+// config, in order, so that they are shared by every resource using the
+// config. A version which was already saved becomes the latest version of
+// its space.
+func (r *resourceConfig) SaveVersions(space string, versions []atc.Version) error {
+	tx, err := r.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	err = r.saveVersions(tx, space, versions)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// SaveResourceVersions saves versions found in the default space by checking
+// the resource config, as SaveVersions does, and adds them to the version
+// history of every resource using the config, so that every pipeline sees
+// them as soon as one of them has checked.
+func (r *resourceConfig) SaveResourceVersions(space string, versions []atc.Version) error {
+	tx, err := r.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	err = r.saveVersions(tx, space, versions)
+	if err != nil {
+		return err
+	}
+
+	rows, err := resourcesQuery.
+		Where(sq.Eq{"r.resource_config_id": r.id}).
+		RunWith(tx).
+		Query()
+	if err != nil {
+		return err
+	}
+
+	resources := []*resource{}
+	for rows.Next() {
+		res := &resource{conn: r.conn}
+		err = scanResource(res, rows)
+		if err != nil {
+			Close(rows)
+			return err
+		}
+
+		resources = append(resources, res)
+	}
+
+	Close(rows)
+
+	pipelineIDs := map[int]bool{}
+	for _, res := range resources {
+		for _, version := range versions {
+			vr := VersionedResource{
+				Resource: res.name,
+				Type:     res.type_,
+				Version:  ResourceVersion(version),
+			}
+
+			versionJSON, err := json.Marshal(vr.Version)
+			if err != nil {
+				return err
+			}
+
+			_, _, err = saveVersionedResource(tx, res.id, vr)
+			if err != nil {
+				return err
+			}
+
+			err = incrementCheckOrderWhenNewerVersion(tx, res.id, vr.Type, string(versionJSON))
+			if err != nil {
+				return err
+			}
+		}
+
+		pipelineIDs[res.pipelineID] = true
+	}
+
+	for pipelineID := range pipelineIDs {
+		err = bumpCacheIndex(tx, pipelineID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *resourceConfig) saveVersions(tx Tx, space string, versions []atc.Version) error {
+	for _, version := range versions {
+		versionJSON, err := json.Marshal(version)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Exec(`
+			INSERT INTO resource_config_versions (resource_config_id, space, version, check_order)
+			SELECT $1, $2, $3, COALESCE(MAX(check_order), 0) + 1
+			FROM resource_config_versions
+			WHERE resource_config_id = $1
+			AND space = $2
+			ON CONFLICT (resource_config_id, space, md5(version)) DO UPDATE SET check_order = EXCLUDED.check_order
+		`, r.id, space, string(versionJSON))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// LatestVersion returns the latest version of the default space.
+func (r *resourceConfig) LatestVersion() (atc.Version, bool, error) {
+	var versionJSON string
+	err := psql.Select("v.version").
+		From("resource_config_versions v").
+		Join("resource_configs c ON c.id = v.resource_config_id").
+		Where(sq.Eq{"v.resource_config_id": r.id}).
+		Where(sq.Expr("v.space = c.default_space")).
+		OrderBy("v.check_order DESC").
+		Limit(1).
+		RunWith(r.conn).
+		QueryRow().
+		Scan(&versionJSON)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	var version atc.Version
+	err = json.Unmarshal([]byte(versionJSON), &version)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return version, true, nil
+}
+
+// LatestVersions returns the latest version of each space, keyed by space.
+func (r *resourceConfig) LatestVersions() (map[string]atc.Version, error) {
+	rows, err := psql.Select("DISTINCT ON (space) space, version").
+		From("resource_config_versions").
+		Where(sq.Eq{"resource_config_id": r.id}).
+		OrderBy("space", "check_order DESC").
+		RunWith(r.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	versions := map[string]atc.Version{}
+	for rows.Next() {
+		var space, versionJSON string
+		err = rows.Scan(&space, &versionJSON)
+		if err != nil {
+			return nil, err
+		}
+
+		var version atc.Version
+		err = json.Unmarshal([]byte(versionJSON), &version)
+		if err != nil {
+			return nil, err
+		}
+
+		versions[space] = version
+	}
+
+	return versions, nil
+}
+
+// VersionsAfter returns the versions of the default space saved after the
+// given version, oldest first. All of its versions are returned if the given
+// version is nil or has not been saved.
+func (r *resourceConfig) VersionsAfter(version atc.Version) ([]atc.Version, error) {
+	versionJSON, err := json.Marshal(version)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.conn.Query(`
+		SELECT v.version
+		FROM resource_config_versions v
+		JOIN resource_configs c ON c.id = v.resource_config_id
+		WHERE v.resource_config_id = $1
+		AND v.space = c.default_space
+		AND v.check_order > COALESCE((
+			SELECT check_order
+			FROM resource_config_versions
+			WHERE resource_config_id = $1
+			AND space = c.default_space
+			AND md5(version) = md5($2::text)
+		), 0)
+		ORDER BY v.check_order ASC
+	`, r.id, string(versionJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	versions := []atc.Version{}
+	for rows.Next() {
+		var versionJSON string
+		err = rows.Scan(&versionJSON)
+		if err != nil {
+			return nil, err
+		}
+
+		var version atc.Version
+		err = json.Unmarshal([]byte(versionJSON), &version)
+		if err != nil {
+			return nil, err
+		}
+
+		versions = append(versions, version)
+	}
+
+	return versions, nil
+}
+
 func (r *ResourceConfigDescriptor) findOrCreate(logger lager.Logger, tx Tx, lockFactory lock.LockFactory, conn Conn) (ResourceConfig, error) {
 	rc := &resourceConfig{
 		lockFactory: lockFactory,
@@ -20,7 +20,7 @@ var _ = Describe("ResourceConfigFactory", func() {
 		Expect(err).NotTo(HaveOccurred())
 		Expect(found).To(BeTrue())
 
-		build, err = job.CreateBuild()
+		build, err = job.CreateBuild(db.BuildCauseManual)
 		Expect(err).NotTo(HaveOccurred())
 	})
 
@@ -0,0 +1,173 @@
+package db_test
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/db"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ResourceConfig", func() {
+	var resourceConfig db.ResourceConfig
+
+	BeforeEach(func() {
+		session, err := resourceConfigCheckSessionFactory.FindOrCreateResourceConfigCheckSession(
+			logger,
+			"some-base-resource-type",
+			atc.Source{"some": "source"},
+			creds.VersionedResourceTypes{},
+			db.ContainerOwnerExpiries{
+				GraceTime: 5 * time.Second,
+				Min:       10 * time.Second,
+				Max:       10 * time.Second,
+			},
+		)
+		Expect(err).ToNot(HaveOccurred())
+
+		resourceConfig = session.ResourceConfig()
+	})
+
+	Describe("SaveVersions", func() {
+		BeforeEach(func() {
+			Expect(resourceConfig.SaveVersions("", []atc.Version{
+				{"ref": "v1"},
+				{"ref": "v2"},
+				{"ref": "v3"},
+			})).To(Succeed())
+		})
+
+		It("saves the versions in order", func() {
+			latest, found, err := resourceConfig.LatestVersion()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(latest).To(Equal(atc.Version{"ref": "v3"}))
+
+			versions, err := resourceConfig.VersionsAfter(nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(versions).To(Equal([]atc.Version{
+				{"ref": "v1"},
+				{"ref": "v2"},
+				{"ref": "v3"},
+			}))
+		})
+
+		It("makes a re-saved version the latest", func() {
+			Expect(resourceConfig.SaveVersions("", []atc.Version{{"ref": "v1"}})).To(Succeed())
+
+			latest, found, err := resourceConfig.LatestVersion()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(latest).To(Equal(atc.Version{"ref": "v1"}))
+		})
+
+		It("returns the versions saved after a given version", func() {
+			versions, err := resourceConfig.VersionsAfter(atc.Version{"ref": "v1"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(versions).To(Equal([]atc.Version{
+				{"ref": "v2"},
+				{"ref": "v3"},
+			}))
+		})
+
+		It("returns every version when the given version was not saved", func() {
+			versions, err := resourceConfig.VersionsAfter(atc.Version{"ref": "bogus"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(versions).To(HaveLen(3))
+		})
+	})
+
+	Describe("SaveResourceVersions", func() {
+		BeforeEach(func() {
+			Expect(defaultResource.SetResourceConfig(resourceConfig.ID())).To(Succeed())
+
+			Expect(resourceConfig.SaveResourceVersions("", []atc.Version{
+				{"ref": "v1"},
+				{"ref": "v2"},
+			})).To(Succeed())
+		})
+
+		It("saves the versions to the resource config", func() {
+			versions, err := resourceConfig.VersionsAfter(nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(versions).To(Equal([]atc.Version{
+				{"ref": "v1"},
+				{"ref": "v2"},
+			}))
+		})
+
+		It("saves the versions to the resources using the config", func() {
+			latestVR, found, err := defaultPipeline.GetLatestVersionedResource(defaultResource.Name())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(latestVR.Version).To(Equal(db.ResourceVersion{"ref": "v2"}))
+		})
+	})
+
+	Describe("saving versions in spaces", func() {
+		BeforeEach(func() {
+			Expect(resourceConfig.SetDefaultSpace("main")).To(Succeed())
+			Expect(resourceConfig.SaveVersions("main", []atc.Version{{"ref": "m1"}, {"ref": "m2"}})).To(Succeed())
+			Expect(resourceConfig.SaveVersions("other", []atc.Version{{"ref": "o1"}, {"ref": "m1"}})).To(Succeed())
+		})
+
+		It("keeps the versions of each space separately", func() {
+			latest, err := resourceConfig.LatestVersions()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(latest).To(Equal(map[string]atc.Version{
+				"main":  {"ref": "m2"},
+				"other": {"ref": "m1"},
+			}))
+		})
+
+		It("uses the default space for the latest version and history", func() {
+			latest, found, err := resourceConfig.LatestVersion()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(latest).To(Equal(atc.Version{"ref": "m2"}))
+
+			versions, err := resourceConfig.VersionsAfter(nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(versions).To(Equal([]atc.Version{
+				{"ref": "m1"},
+				{"ref": "m2"},
+			}))
+		})
+	})
+
+	Describe("LatestVersion", func() {
+		It("is not found when no versions are saved", func() {
+			_, found, err := resourceConfig.LatestVersion()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+	})
+
+	Describe("AcquireResourceConfigCheckingLockWithIntervalCheck", func() {
+		It("is acquired once per interval", func() {
+			lock, acquired, err := resourceConfig.AcquireResourceConfigCheckingLockWithIntervalCheck(logger, time.Hour, false)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(acquired).To(BeTrue())
+			Expect(lock.Release()).To(Succeed())
+
+			_, acquired, err = resourceConfig.AcquireResourceConfigCheckingLockWithIntervalCheck(logger, time.Hour, false)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(acquired).To(BeFalse())
+		})
+
+		It("is acquired within the interval when immediate", func() {
+			lock, acquired, err := resourceConfig.AcquireResourceConfigCheckingLockWithIntervalCheck(logger, time.Hour, false)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(acquired).To(BeTrue())
+			Expect(lock.Release()).To(Succeed())
+
+			lock, acquired, err = resourceConfig.AcquireResourceConfigCheckingLockWithIntervalCheck(logger, time.Hour, true)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(acquired).To(BeTrue())
+			Expect(lock.Release()).To(Succeed())
+		})
+	})
+})
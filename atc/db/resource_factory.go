@@ -1,6 +1,8 @@
 package db
 
 import (
+	"time"
+
 	sq "github.com/Masterminds/squirrel"
 	"github.com/concourse/concourse/atc/db/lock"
 )
@@ -9,6 +11,17 @@ import (
 
 type ResourceFactory interface {
 	VisibleResources([]string) ([]Resource, error)
+	LastSuccessfulChecks() ([]ResourceLastSuccessfulCheck, error)
+}
+
+// ResourceLastSuccessfulCheck is when an active resource was last checked
+// successfully.
+type ResourceLastSuccessfulCheck struct {
+	TeamName     string
+	PipelineName string
+	ResourceName string
+
+	LastSuccessfulCheck time.Time
 }
 
 type resourceFactory struct {
@@ -55,3 +68,33 @@ func (r *resourceFactory) VisibleResources(teamNames []string) ([]Resource, erro
 
 	return resources, nil
 }
+
+func (r *resourceFactory) LastSuccessfulChecks() ([]ResourceLastSuccessfulCheck, error) {
+	rows, err := psql.Select("t.name", "p.name", "r.name", "r.last_check_succeeded").
+		From("resources r").
+		Join("pipelines p ON p.id = r.pipeline_id").
+		Join("teams t ON t.id = p.team_id").
+		Where(sq.Eq{"r.active": true}).
+		Where(sq.NotEq{"r.last_check_succeeded": nil}).
+		OrderBy("t.name", "p.name", "r.name").
+		RunWith(r.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	checks := []ResourceLastSuccessfulCheck{}
+	for rows.Next() {
+		var c ResourceLastSuccessfulCheck
+		err := rows.Scan(&c.TeamName, &c.PipelineName, &c.ResourceName, &c.LastSuccessfulCheck)
+		if err != nil {
+			return nil, err
+		}
+
+		checks = append(checks, c)
+	}
+
+	return checks, nil
+}
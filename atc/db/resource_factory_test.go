@@ -1,6 +1,9 @@
 package db_test
 
 import (
+	"errors"
+	"time"
+
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	. "github.com/onsi/ginkgo"
@@ -19,7 +22,7 @@ var _ = Describe("Resource Factory", func() {
 			otherTeam, err := teamFactory.CreateTeam(atc.Team{Name: "other-team"})
 			Expect(err).NotTo(HaveOccurred())
 
-			publicPipeline, _, err := otherTeam.SavePipeline("public-pipeline", atc.Config{
+			publicPipeline, _, err := otherTeam.SavePipeline(atc.PipelineRef{Name: "public-pipeline"}, atc.Config{
 				Resources: atc.ResourceConfigs{
 					{Name: "public-pipeline-resource"},
 				},
@@ -27,7 +30,7 @@ var _ = Describe("Resource Factory", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(publicPipeline.Expose()).To(Succeed())
 
-			_, _, err = otherTeam.SavePipeline("private-pipeline", atc.Config{
+			_, _, err = otherTeam.SavePipeline(atc.PipelineRef{Name: "private-pipeline"}, atc.Config{
 				Resources: atc.ResourceConfigs{
 					{Name: "private-pipeline-resource"},
 				},
@@ -52,4 +55,38 @@ var _ = Describe("Resource Factory", func() {
 			Expect(visibleResources[1].TeamName()).To(Equal("other-team"))
 		})
 	})
+
+	Describe("LastSuccessfulChecks", func() {
+		It("does not return resources which have never been checked successfully", func() {
+			checks, err := resourceFactory.LastSuccessfulChecks()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(checks).To(BeEmpty())
+		})
+
+		Context("when a resource has been checked successfully", func() {
+			BeforeEach(func() {
+				err := defaultPipeline.SetResourceCheckError(defaultResource, nil)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("returns when it was checked", func() {
+				checks, err := resourceFactory.LastSuccessfulChecks()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(checks).To(HaveLen(1))
+				Expect(checks[0].TeamName).To(Equal("default-team"))
+				Expect(checks[0].PipelineName).To(Equal(defaultPipeline.Name()))
+				Expect(checks[0].ResourceName).To(Equal(defaultResource.Name()))
+				Expect(checks[0].LastSuccessfulCheck).To(BeTemporally("~", time.Now(), time.Minute))
+			})
+
+			It("keeps it when a later check fails", func() {
+				err := defaultPipeline.SetResourceCheckError(defaultResource, errors.New("nope"))
+				Expect(err).ToNot(HaveOccurred())
+
+				checks, err := resourceFactory.LastSuccessfulChecks()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(checks).To(HaveLen(1))
+			})
+		})
+	})
 })
@@ -17,7 +17,7 @@ var _ = Describe("Resource", func() {
 		)
 
 		pipeline, created, err = defaultTeam.SavePipeline(
-			"pipeline-with-resources",
+			atc.PipelineRef{Name: "pipeline-with-resources"},
 			atc.Config{
 				Resources: atc.ResourceConfigs{
 					{
@@ -181,4 +181,114 @@ var _ = Describe("Resource", func() {
 		})
 	})
 
+	Describe("PinVersion", func() {
+		var resource db.Resource
+
+		BeforeEach(func() {
+			var (
+				found bool
+				err   error
+			)
+
+			resource, found, err = pipeline.Resource("some-other-resource")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(resource.PinnedVersion()).To(BeNil())
+
+			err = resource.PinVersion(atc.Version{"ref": "v1"}, "broken in v2", "some-user")
+			Expect(err).ToNot(HaveOccurred())
+
+			found, err = resource.Reload()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+		})
+
+		It("pins the resource to the version", func() {
+			Expect(resource.APIPinnedVersion()).To(Equal(atc.Version{"ref": "v1"}))
+			Expect(resource.PinnedVersion()).To(Equal(atc.Version{"ref": "v1"}))
+			Expect(resource.ConfigPinnedVersion()).To(BeNil())
+		})
+
+		It("records the comment and who pinned it", func() {
+			Expect(resource.PinComment()).To(Equal("broken in v2"))
+			Expect(resource.PinnedBy()).To(Equal("some-user"))
+		})
+
+		It("does not change the pipeline's config", func() {
+			resources, err := pipeline.Resources()
+			Expect(err).ToNot(HaveOccurred())
+
+			config, found := resources.Lookup("some-other-resource")
+			Expect(found).To(BeTrue())
+			Expect(resources.Configs()).To(ContainElement(atc.ResourceConfig{
+				Name:   "some-other-resource",
+				Type:   "git",
+				Source: atc.Source{"some": "other-repository"},
+			}))
+			Expect(config.PinnedVersion()).To(Equal(atc.Version{"ref": "v1"}))
+		})
+
+		Context("when the pipeline is reconfigured", func() {
+			BeforeEach(func() {
+				_, _, err := defaultTeam.SavePipeline(
+					atc.PipelineRef{Name: "pipeline-with-resources"},
+					atc.Config{
+						Resources: atc.ResourceConfigs{
+							{
+								Name:   "some-other-resource",
+								Type:   "git",
+								Source: atc.Source{"some": "other-repository"},
+							},
+						},
+					},
+					pipeline.ConfigVersion(),
+					db.PipelineNoChange,
+				)
+				Expect(err).ToNot(HaveOccurred())
+
+				found, err := resource.Reload()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+			})
+
+			It("keeps the pin", func() {
+				Expect(resource.PinnedVersion()).To(Equal(atc.Version{"ref": "v1"}))
+			})
+		})
+
+		Context("when unpinned", func() {
+			BeforeEach(func() {
+				err := resource.UnpinVersion()
+				Expect(err).ToNot(HaveOccurred())
+
+				found, err := resource.Reload()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+			})
+
+			It("clears the pin", func() {
+				Expect(resource.PinnedVersion()).To(BeNil())
+				Expect(resource.APIPinnedVersion()).To(BeNil())
+				Expect(resource.PinComment()).To(BeEmpty())
+				Expect(resource.PinnedBy()).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("PinnedVersion", func() {
+		It("prefers the version pinned in the config", func() {
+			resource, found, err := pipeline.Resource("some-resource")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			err = resource.PinVersion(atc.Version{"ref": "other"}, "", "some-user")
+			Expect(err).ToNot(HaveOccurred())
+
+			found, err = resource.Reload()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			Expect(resource.PinnedVersion()).To(Equal(atc.Version{"ref": "abcdef"}))
+		})
+	})
 })
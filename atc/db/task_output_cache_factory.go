@@ -0,0 +1,183 @@
+package db
+
+import (
+	"database/sql"
+	"encoding/json"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+// UsedTaskOutputCache records that a task with the given key succeeded for a
+// team. Volumes holds the outputs of that run which are still available,
+// keyed by output name, and Annotations the annotations the run recorded.
+type UsedTaskOutputCache struct {
+	ID          int
+	Volumes     map[string]CreatedVolume
+	Annotations map[string]string
+}
+
+//go:generate counterfeiter . TaskOutputCacheFactory
+
+type TaskOutputCacheFactory interface {
+	Find(teamID int, key string) (*UsedTaskOutputCache, bool, error)
+	Create(teamID int, key string, annotations map[string]string) (*UsedTaskOutputCache, error)
+	Use(buildID int, cache *UsedTaskOutputCache) (bool, error)
+}
+
+type taskOutputCacheFactory struct {
+	conn Conn
+}
+
+func NewTaskOutputCacheFactory(conn Conn) TaskOutputCacheFactory {
+	return &taskOutputCacheFactory{
+		conn: conn,
+	}
+}
+
+// Find looks up the task output cache for the key, marking it as used so
+// that it is not expired.
+func (f *taskOutputCacheFactory) Find(teamID int, key string) (*UsedTaskOutputCache, bool, error) {
+	var id int
+	var annotationsJSON []byte
+	err := psql.Update("task_output_caches").
+		Set("last_used", sq.Expr("now()")).
+		Where(sq.Eq{
+			"team_id": teamID,
+			"key":     key,
+		}).
+		Suffix("RETURNING id, annotations").
+		RunWith(f.conn).
+		QueryRow().
+		Scan(&id, &annotationsJSON)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, false, nil
+		}
+
+		return nil, false, err
+	}
+
+	var annotations map[string]string
+	err = json.Unmarshal(annotationsJSON, &annotations)
+	if err != nil {
+		return nil, false, err
+	}
+
+	rows, err := psql.Select(append([]string{"toco.name"}, volumeColumns...)...).
+		From("volumes v").
+		Join("task_output_cache_outputs toco ON toco.id = v.task_output_cache_output_id").
+		LeftJoin("workers w ON v.worker_name = w.name").
+		LeftJoin("containers c ON v.container_id = c.id").
+		LeftJoin("volumes pv ON v.parent_id = pv.id").
+		LeftJoin("worker_resource_caches wrc ON wrc.id = v.worker_resource_cache_id").
+		Where(sq.Eq{
+			"toco.task_output_cache_id": id,
+			"v.state":                   VolumeStateCreated,
+		}).
+		RunWith(f.conn).
+		Query()
+	if err != nil {
+		return nil, false, err
+	}
+
+	defer Close(rows)
+
+	volumes := map[string]CreatedVolume{}
+	for rows.Next() {
+		var name string
+		_, createdVolume, _, _, err := scanVolume(namedVolumeScanner{rows, &name}, f.conn)
+		if err != nil {
+			return nil, false, err
+		}
+
+		volumes[name] = createdVolume
+	}
+
+	return &UsedTaskOutputCache{
+		ID:          id,
+		Volumes:     volumes,
+		Annotations: annotations,
+	}, true, nil
+}
+
+// Create records the key as having succeeded for the team, along with the
+// annotations of the run. Its outputs are attached afterwards with
+// CreatedVolume.InitializeTaskOutputCache.
+func (f *taskOutputCacheFactory) Create(teamID int, key string, annotations map[string]string) (*UsedTaskOutputCache, error) {
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+
+	annotationsJSON, err := json.Marshal(annotations)
+	if err != nil {
+		return nil, err
+	}
+
+	var id int
+	err = psql.Insert("task_output_caches").
+		Columns("team_id", "key", "annotations").
+		Values(teamID, key, string(annotationsJSON)).
+		Suffix("ON CONFLICT (team_id, key) DO UPDATE SET last_used = now(), annotations = EXCLUDED.annotations RETURNING id").
+		RunWith(f.conn).
+		QueryRow().
+		Scan(&id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &UsedTaskOutputCache{
+		ID:          id,
+		Volumes:     map[string]CreatedVolume{},
+		Annotations: annotations,
+	}, nil
+}
+
+// Use records that the build reuses the cached output volumes, keeping them
+// around until the build finishes even if the cache is replaced or expires
+// in the meantime. It returns false if any of the volumes is no longer
+// available.
+func (f *taskOutputCacheFactory) Use(buildID int, cache *UsedTaskOutputCache) (bool, error) {
+	tx, err := f.conn.Begin()
+	if err != nil {
+		return false, err
+	}
+
+	defer Rollback(tx)
+
+	for _, volume := range cache.Volumes {
+		result, err := tx.Exec(`
+			INSERT INTO task_output_cache_uses (build_id, volume_id)
+			SELECT $1, id FROM volumes WHERE handle = $2 AND state = $3
+			ON CONFLICT (build_id, volume_id) DO UPDATE SET build_id = EXCLUDED.build_id
+		`, buildID, volume.Handle(), VolumeStateCreated)
+		if err != nil {
+			return false, err
+		}
+
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return false, err
+		}
+
+		if affected == 0 {
+			return false, nil
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// namedVolumeScanner scans a leading name column before the volume columns.
+type namedVolumeScanner struct {
+	scanner sq.RowScanner
+	name    *string
+}
+
+func (s namedVolumeScanner) Scan(dest ...interface{}) error {
+	return s.scanner.Scan(append([]interface{}{s.name}, dest...)...)
+}
@@ -33,13 +33,13 @@ type Team interface {
 	Rename(string) error
 
 	SavePipeline(
-		pipelineName string,
+		pipelineRef atc.PipelineRef,
 		config atc.Config,
 		from ConfigVersion,
 		pausedState PipelinePausedState,
 	) (Pipeline, bool, error)
 
-	Pipeline(pipelineName string) (Pipeline, bool, error)
+	Pipeline(pipelineRef atc.PipelineRef) (Pipeline, bool, error)
 	Pipelines() ([]Pipeline, error)
 	PublicPipelines() ([]Pipeline, error)
 	VisiblePipelines() ([]Pipeline, error)
@@ -47,14 +47,14 @@ type Team interface {
 
 	CreateOneOffBuild() (Build, error)
 	PrivateAndPublicBuilds(Page) ([]Build, Pagination, error)
-	Builds(page Page) ([]Build, Pagination, error)
+	Builds(page Page, annotations []string) ([]Build, Pagination, error)
 
 	SaveWorker(atcWorker atc.Worker, ttl time.Duration) (Worker, error)
 	Workers() ([]Worker, error)
 
 	FindContainerByHandle(string) (Container, bool, error)
 	FindContainersByMetadata(ContainerMetadata) ([]Container, error)
-	FindCheckContainers(lager.Logger, string, string, creds.VariablesFactory) ([]Container, error)
+	FindCheckContainers(lager.Logger, atc.PipelineRef, string, creds.VariablesFactory) ([]Container, error)
 
 	FindCreatedContainerByHandle(string) (CreatedContainer, bool, error)
 
@@ -65,6 +65,9 @@ type Team interface {
 	CreateContainer(workerName string, owner ContainerOwner, meta ContainerMetadata) (CreatingContainer, error)
 
 	UpdateProviderAuth(auth atc.TeamAuth) error
+
+	SetCredentialManagerConfig(atc.CredentialManagerConfig) error
+	ClearCredentialManagerConfig() error
 }
 
 type team struct {
@@ -275,8 +278,8 @@ func (t *team) FindContainersByMetadata(metadata ContainerMetadata) ([]Container
 	return containers, nil
 }
 
-func (t *team) FindCheckContainers(logger lager.Logger, pipelineName string, resourceName string, variablesFactory creds.VariablesFactory) ([]Container, error) {
-	pipeline, found, err := t.Pipeline(pipelineName)
+func (t *team) FindCheckContainers(logger lager.Logger, pipelineRef atc.PipelineRef, resourceName string, variablesFactory creds.VariablesFactory) ([]Container, error) {
+	pipeline, found, err := t.Pipeline(pipelineRef)
 	if err != nil {
 		return nil, err
 	}
@@ -374,10 +377,23 @@ func (t *team) FindCreatedContainerByHandle(
 }
 
 func (t *team) SavePipeline(
-	pipelineName string,
+	pipelineRef atc.PipelineRef,
 	config atc.Config,
 	from ConfigVersion,
 	pausedState PipelinePausedState,
+) (Pipeline, bool, error) {
+	return t.savePipeline(pipelineRef, config, from, pausedState, 0, 0)
+}
+
+// savePipeline saves the pipeline and, if parentJobID is set, records the job
+// and build which set it within the same transaction.
+func (t *team) savePipeline(
+	pipelineRef atc.PipelineRef,
+	config atc.Config,
+	from ConfigVersion,
+	pausedState PipelinePausedState,
+	parentJobID int,
+	parentBuildID int,
 ) (Pipeline, bool, error) {
 	groupsPayload, err := json.Marshal(config.Groups)
 	if err != nil {
@@ -391,6 +407,16 @@ func (t *team) SavePipeline(
 		}
 	}
 
+	refEq, err := pipelineRefEq("", pipelineRef)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var instanceVars interface{}
+	if len(pipelineRef.InstanceVars) != 0 {
+		instanceVars = refEq["instance_vars"]
+	}
+
 	var created bool
 	var existingConfig int
 
@@ -401,12 +427,13 @@ func (t *team) SavePipeline(
 
 	defer Rollback(tx)
 
-	err = tx.QueryRow(`
-		SELECT COUNT(1)
-		FROM pipelines
-		WHERE name = $1
-	  AND team_id = $2
-	`, pipelineName, t.id).Scan(&existingConfig)
+	err = psql.Select("COUNT(1)").
+		From("pipelines").
+		Where(refEq).
+		Where(sq.Eq{"team_id": t.id}).
+		RunWith(tx).
+		QueryRow().
+		Scan(&existingConfig)
 	if err != nil {
 		return nil, false, err
 	}
@@ -419,12 +446,18 @@ func (t *team) SavePipeline(
 
 		err = psql.Insert("pipelines").
 			SetMap(map[string]interface{}{
-				"name":     pipelineName,
-				"groups":   groupsPayload,
-				"version":  sq.Expr("nextval('config_version_seq')"),
-				"ordering": sq.Expr("currval('pipelines_id_seq')"),
-				"paused":   pausedState.Bool(),
-				"team_id":  t.id,
+				"name":          pipelineRef.Name,
+				"instance_vars": instanceVars,
+				"groups":        groupsPayload,
+				"version":       sq.Expr("nextval('config_version_seq')"),
+				"paused":        pausedState.Bool(),
+				"team_id":       t.id,
+
+				// instances of the same pipeline are ordered together
+				"ordering": sq.Expr(`COALESCE(
+					(SELECT MIN(ordering) FROM pipelines WHERE name = ? AND team_id = ?),
+					currval('pipelines_id_seq')
+				)`, pipelineRef.Name, t.id),
 			}).
 			Suffix("RETURNING id").
 			RunWith(tx).
@@ -438,8 +471,9 @@ func (t *team) SavePipeline(
 		update := psql.Update("pipelines").
 			Set("groups", groupsPayload).
 			Set("version", sq.Expr("nextval('config_version_seq')")).
+			Set("archived", false).
+			Where(refEq).
 			Where(sq.Eq{
-				"name":    pipelineName,
 				"version": from,
 				"team_id": t.id,
 			}).
@@ -531,6 +565,18 @@ func (t *team) SavePipeline(
 		return nil, false, err
 	}
 
+	if parentJobID != 0 {
+		_, err = psql.Update("pipelines").
+			Set("parent_job_id", parentJobID).
+			Set("parent_build_id", parentBuildID).
+			Where(sq.Eq{"id": pipelineID}).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return nil, false, err
+		}
+	}
+
 	pipeline := newPipeline(t.conn, t.lockFactory)
 
 	err = scanPipeline(
@@ -552,15 +598,20 @@ func (t *team) SavePipeline(
 	return pipeline, created, nil
 }
 
-func (t *team) Pipeline(pipelineName string) (Pipeline, bool, error) {
+func (t *team) Pipeline(pipelineRef atc.PipelineRef) (Pipeline, bool, error) {
+	refEq, err := pipelineRefEq("p.", pipelineRef)
+	if err != nil {
+		return nil, false, err
+	}
+
 	pipeline := newPipeline(t.conn, t.lockFactory)
 
-	err := scanPipeline(
+	err = scanPipeline(
 		pipeline,
 		pipelinesQuery.
+			Where(refEq).
 			Where(sq.Eq{
 				"p.team_id": t.id,
-				"p.name":    pipelineName,
 			}).
 			RunWith(t.conn).
 			QueryRow(),
@@ -580,7 +631,7 @@ func (t *team) Pipelines() ([]Pipeline, error) {
 		Where(sq.Eq{
 			"team_id": t.id,
 		}).
-		OrderBy("ordering").
+		OrderBy("ordering", "id").
 		RunWith(t.conn).
 		Query()
 	if err != nil {
@@ -601,7 +652,7 @@ func (t *team) PublicPipelines() ([]Pipeline, error) {
 			"team_id": t.id,
 			"public":  true,
 		}).
-		OrderBy("team_id ASC", "ordering ASC").
+		OrderBy("team_id ASC", "ordering ASC", "id ASC").
 		RunWith(t.conn).
 		Query()
 	if err != nil {
@@ -619,7 +670,7 @@ func (t *team) PublicPipelines() ([]Pipeline, error) {
 func (t *team) VisiblePipelines() ([]Pipeline, error) {
 	rows, err := pipelinesQuery.
 		Where(sq.Eq{"team_id": t.id}).
-		OrderBy("team_id ASC", "ordering ASC").
+		OrderBy("team_id ASC", "ordering ASC", "id ASC").
 		RunWith(t.conn).
 		Query()
 	if err != nil {
@@ -634,7 +685,7 @@ func (t *team) VisiblePipelines() ([]Pipeline, error) {
 	rows, err = pipelinesQuery.
 		Where(sq.NotEq{"team_id": t.id}).
 		Where(sq.Eq{"public": true}).
-		OrderBy("team_id ASC", "ordering ASC").
+		OrderBy("team_id ASC", "ordering ASC", "id ASC").
 		RunWith(t.conn).
 		Query()
 	if err != nil {
@@ -711,11 +762,11 @@ func (t *team) PrivateAndPublicBuilds(page Page) ([]Build, Pagination, error) {
 	newBuildsQuery := buildsQuery.
 		Where(sq.Or{sq.Eq{"p.public": true}, sq.Eq{"t.id": t.id}})
 
-	return getBuildsWithPagination(newBuildsQuery, page, t.conn, t.lockFactory)
+	return getBuildsWithPagination(newBuildsQuery, page, nil, t.conn, t.lockFactory)
 }
 
-func (t *team) Builds(page Page) ([]Build, Pagination, error) {
-	return getBuildsWithPagination(buildsQuery.Where(sq.Eq{"t.id": t.id}), page, t.conn, t.lockFactory)
+func (t *team) Builds(page Page, annotations []string) ([]Build, Pagination, error) {
+	return getBuildsWithPagination(buildsQuery.Where(sq.Eq{"t.id": t.id}), page, annotations, t.conn, t.lockFactory)
 }
 
 func (t *team) SaveWorker(atcWorker atc.Worker, ttl time.Duration) (Worker, error) {
@@ -765,6 +816,35 @@ func (t *team) UpdateProviderAuth(auth atc.TeamAuth) error {
 	return tx.Commit()
 }
 
+func (t *team) SetCredentialManagerConfig(config atc.CredentialManagerConfig) error {
+	configPayload, err := json.Marshal(config)
+	if err != nil {
+		return err
+	}
+
+	encryptedPayload, nonce, err := t.conn.EncryptionStrategy().Encrypt(configPayload)
+	if err != nil {
+		return err
+	}
+
+	_, err = t.conn.Exec(`
+		INSERT INTO team_credential_managers (team_id, config, nonce)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (team_id) DO UPDATE SET config = EXCLUDED.config, nonce = EXCLUDED.nonce
+	`, t.id, encryptedPayload, nonce)
+
+	return err
+}
+
+func (t *team) ClearCredentialManagerConfig() error {
+	_, err := psql.Delete("team_credential_managers").
+		Where(sq.Eq{"team_id": t.id}).
+		RunWith(t.conn).
+		Exec()
+
+	return err
+}
+
 func (t *team) saveJob(tx Tx, job atc.JobConfig, pipelineID int, groups []string) error {
 	configPayload, err := json.Marshal(job)
 	if err != nil {
@@ -777,11 +857,30 @@ func (t *team) saveJob(tx Tx, job atc.JobConfig, pipelineID int, groups []string
 		return err
 	}
 
+	// the schedule's clock starts when it's configured, or re-configured, so
+	// that it doesn't trigger a build for every time it would have fired in the
+	// past
+	var schedule sql.NullString
+	if job.Schedule != nil {
+		schedulePayload, err := json.Marshal(job.Schedule)
+		if err != nil {
+			return err
+		}
+
+		schedule = sql.NullString{String: string(schedulePayload), Valid: true}
+	}
+
 	updated, err := checkIfRowsUpdated(tx, `
 		UPDATE jobs
-		SET config = $3, interruptible = $4, active = true, nonce = $5, tags = $6
+		SET config = $3, interruptible = $4, active = true, nonce = $5, tags = $6,
+			last_scheduled = CASE
+				WHEN $7::text IS NULL THEN NULL
+				WHEN schedule IS NOT DISTINCT FROM $7::text THEN COALESCE(last_scheduled, now())
+				ELSE now()
+			END,
+			schedule = $7
 		WHERE name = $1 AND pipeline_id = $2
-	`, job.Name, pipelineID, encryptedPayload, job.Interruptible, nonce, "{"+strings.Join(groups, ",")+"}")
+	`, job.Name, pipelineID, encryptedPayload, job.Interruptible, nonce, "{"+strings.Join(groups, ",")+"}", schedule)
 	if err != nil {
 		return err
 	}
@@ -791,9 +890,9 @@ func (t *team) saveJob(tx Tx, job atc.JobConfig, pipelineID int, groups []string
 	}
 
 	_, err = tx.Exec(`
-		INSERT INTO jobs (name, pipeline_id, config, interruptible, active, nonce, tags)
-		VALUES ($1, $2, $3, $4, true, $5, $6)
-	`, job.Name, pipelineID, encryptedPayload, job.Interruptible, nonce, "{"+strings.Join(groups, ",")+"}")
+		INSERT INTO jobs (name, pipeline_id, config, interruptible, active, nonce, tags, schedule, last_scheduled)
+		VALUES ($1, $2, $3, $4, true, $5, $6, $7, CASE WHEN $7::text IS NOT NULL THEN now() END)
+	`, job.Name, pipelineID, encryptedPayload, job.Interruptible, nonce, "{"+strings.Join(groups, ",")+"}", schedule)
 
 	return swallowUniqueViolation(err)
 }
@@ -936,12 +1035,28 @@ func (t *team) findContainer(whereClause sq.Sqlizer) (CreatingContainer, Created
 }
 
 func scanPipeline(p *pipeline, scan scannable) error {
-	var groups sql.NullString
-	err := scan.Scan(&p.id, &p.name, &groups, &p.configVersion, &p.teamID, &p.teamName, &p.paused, &p.public)
+	var groups, instanceVars sql.NullString
+	var parentJobID, parentBuildID sql.NullInt64
+	err := scan.Scan(&p.id, &p.name, &instanceVars, &groups, &p.configVersion, &p.teamID, &p.teamName, &p.paused, &p.public, &p.archived, &parentJobID, &parentBuildID)
 	if err != nil {
 		return err
 	}
 
+	if instanceVars.Valid {
+		err = json.Unmarshal([]byte(instanceVars.String), &p.instanceVars)
+		if err != nil {
+			return err
+		}
+	}
+
+	if parentJobID.Valid {
+		p.parentJobID = int(parentJobID.Int64)
+	}
+
+	if parentBuildID.Valid {
+		p.parentBuildID = int(parentBuildID.Int64)
+	}
+
 	if groups.Valid {
 		var pipelineGroups atc.GroupConfigs
 		err = json.Unmarshal([]byte(groups.String), &pipelineGroups)
@@ -999,3 +1114,23 @@ func (t *team) queryTeam(tx Tx, query string, params ...interface{}) error {
 
 	return nil
 }
+
+// pipelineRefEq matches the pipeline with the given name and instance vars.
+// Plain pipelines are matched by requiring the instance vars to be null.
+func pipelineRefEq(prefix string, ref atc.PipelineRef) (sq.Eq, error) {
+	eq := sq.Eq{
+		prefix + "name":          ref.Name,
+		prefix + "instance_vars": nil,
+	}
+
+	if len(ref.InstanceVars) != 0 {
+		payload, err := json.Marshal(ref.InstanceVars)
+		if err != nil {
+			return nil, err
+		}
+
+		eq[prefix+"instance_vars"] = string(payload)
+	}
+
+	return eq, nil
+}
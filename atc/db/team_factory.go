@@ -19,6 +19,8 @@ type TeamFactory interface {
 	GetTeams() ([]Team, error)
 	GetByID(teamID int) Team
 	CreateDefaultTeamIfNotExists() (Team, error)
+
+	FindCredentialManagerConfig(teamName string) (atc.CredentialManagerConfig, bool, error)
 }
 
 type teamFactory struct {
@@ -165,6 +167,45 @@ func (factory *teamFactory) CreateDefaultTeamIfNotExists() (Team, error) {
 	)
 }
 
+func (factory *teamFactory) FindCredentialManagerConfig(teamName string) (atc.CredentialManagerConfig, bool, error) {
+	var (
+		configBlob []byte
+		nonce      sql.NullString
+	)
+
+	err := psql.Select("m.config, m.nonce").
+		From("team_credential_managers m").
+		Join("teams t ON t.id = m.team_id").
+		Where(sq.Eq{"LOWER(t.name)": strings.ToLower(teamName)}).
+		RunWith(factory.conn).
+		QueryRow().
+		Scan(&configBlob, &nonce)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return atc.CredentialManagerConfig{}, false, nil
+		}
+		return atc.CredentialManagerConfig{}, false, err
+	}
+
+	var noncense *string
+	if nonce.Valid {
+		noncense = &nonce.String
+	}
+
+	decryptedConfig, err := factory.conn.EncryptionStrategy().Decrypt(string(configBlob), noncense)
+	if err != nil {
+		return atc.CredentialManagerConfig{}, false, err
+	}
+
+	var config atc.CredentialManagerConfig
+	err = json.Unmarshal(decryptedConfig, &config)
+	if err != nil {
+		return atc.CredentialManagerConfig{}, false, err
+	}
+
+	return config, true, nil
+}
+
 func (factory *teamFactory) scanTeam(t *team, rows scannable) error {
 	var providerAuth sql.NullString
 
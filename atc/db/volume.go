@@ -53,6 +53,8 @@ const (
 	VolumeTypeResourceType  VolumeType = "resource-type"
 	VolumeTypeResourceCerts VolumeType = "resource-certs"
 	VolumeTypeTaskCache     VolumeType = "task-cache"
+	VolumeTypeArtifact      VolumeType = "artifact"
+	VolumeTypeTaskOutput    VolumeType = "task-output"
 	VolumeTypeUknown        VolumeType = "unknown" // for migration to life
 )
 
@@ -150,6 +152,9 @@ type CreatedVolume interface {
 	WorkerName() string
 	InitializeResourceCache(UsedResourceCache) error
 	InitializeTaskCache(int, string, string) error
+	InitializeArtifact(int, string) error
+	InitializeTaskOutputCache(int, string) error
+	InitializeStreamedResourceCache(UsedResourceCache) error
 	ContainerHandle() string
 	ParentHandle() string
 	ResourceType() (*VolumeResourceType, error)
@@ -385,6 +390,59 @@ func (volume *createdVolume) InitializeResourceCache(resourceCache UsedResourceC
 	return nil
 }
 
+// InitializeStreamedResourceCache marks the volume as a copy of the resource
+// cache on its worker, so that it is reused rather than streamed again.
+func (volume *createdVolume) InitializeStreamedResourceCache(resourceCache UsedResourceCache) error {
+	tx, err := volume.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	workerStreamedResourceCache, err := WorkerStreamedResourceCache{
+		WorkerName:    volume.WorkerName(),
+		ResourceCache: resourceCache,
+	}.FindOrCreate(tx)
+	if err != nil {
+		return err
+	}
+
+	rows, err := psql.Update("volumes").
+		Set("worker_streamed_resource_cache_id", workerStreamedResourceCache.ID).
+		Set("team_id", nil).
+		Where(sq.Eq{"id": volume.id}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code.Name() == pqUniqueViolationErrCode {
+			// another copy was streamed concurrently - leave this one owned by
+			// the container so it just expires when the container is GCed
+			return nil
+		}
+
+		return err
+	}
+
+	affected, err := rows.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return ErrVolumeMissing
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	volume.typ = VolumeTypeResource
+
+	return nil
+}
+
 func (volume *createdVolume) InitializeTaskCache(jobID int, stepName string, path string) error {
 	tx, err := volume.conn.Begin()
 	if err != nil {
@@ -445,6 +503,111 @@ func (volume *createdVolume) InitializeTaskCache(jobID int, stepName string, pat
 	return nil
 }
 
+// InitializeArtifact retains the volume as the named artifact of the build,
+// keeping it around after its container is gone.
+func (volume *createdVolume) InitializeArtifact(buildID int, name string) error {
+	tx, err := volume.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	var artifactID int
+	err = psql.Insert("build_artifacts").
+		Columns("build_id", "name").
+		Values(buildID, name).
+		Suffix("ON CONFLICT (build_id, name) DO UPDATE SET created_at = now() RETURNING id").
+		RunWith(tx).
+		QueryRow().
+		Scan(&artifactID)
+	if err != nil {
+		return err
+	}
+
+	// release the volume of a previous attempt for gc
+	_, err = psql.Update("volumes").
+		Set("build_artifact_id", nil).
+		Where(sq.Eq{"build_artifact_id": artifactID}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	rows, err := psql.Update("volumes").
+		Set("build_artifact_id", artifactID).
+		Where(sq.Eq{"id": volume.id}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	affected, err := rows.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return ErrVolumeMissing
+	}
+
+	return tx.Commit()
+}
+
+func (volume *createdVolume) InitializeTaskOutputCache(cacheID int, name string) error {
+	tx, err := volume.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	var outputID int
+	err = psql.Insert("task_output_cache_outputs").
+		Columns("task_output_cache_id", "name").
+		Values(cacheID, name).
+		Suffix("ON CONFLICT (task_output_cache_id, name) DO UPDATE SET name = EXCLUDED.name RETURNING id").
+		RunWith(tx).
+		QueryRow().
+		Scan(&outputID)
+	if err != nil {
+		return err
+	}
+
+	// release the volume of a previous run for gc; builds which reused it
+	// keep it around until they finish
+	_, err = psql.Update("volumes").
+		Set("task_output_cache_output_id", nil).
+		Where(sq.Eq{"task_output_cache_output_id": outputID}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	rows, err := psql.Update("volumes").
+		Set("task_output_cache_output_id", outputID).
+		Where(sq.Eq{"id": volume.id}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	affected, err := rows.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return ErrVolumeMissing
+	}
+
+	return tx.Commit()
+}
+
 func (volume *createdVolume) CreateChildForContainer(container CreatingContainer, mountPath string) (CreatingVolume, error) {
 	tx, err := volume.conn.Begin()
 	if err != nil {
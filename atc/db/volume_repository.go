@@ -22,6 +22,7 @@ type VolumeRepository interface {
 	CreateBaseResourceTypeVolume(int, *UsedWorkerBaseResourceType) (CreatingVolume, error)
 
 	FindResourceCacheVolume(string, UsedResourceCache) (CreatedVolume, bool, error)
+	FindStreamedResourceCacheVolume(string, UsedResourceCache) (CreatedVolume, bool, error)
 
 	FindTaskCacheVolume(teamID int, uwtc *UsedWorkerTaskCache) (CreatingVolume, CreatedVolume, error)
 	CreateTaskCacheVolume(teamID int, uwtc *UsedWorkerTaskCache) (CreatingVolume, error)
@@ -37,11 +38,15 @@ type VolumeRepository interface {
 	GetDestroyingVolumes(workerName string) ([]string, error)
 
 	FindCreatedVolume(handle string) (CreatedVolume, bool, error)
+	FindBuildArtifactVolume(buildID int, name string) (CreatedVolume, bool, error)
 
 	RemoveDestroyingVolumes(workerName string, handles []string) (int, error)
 
 	UpdateVolumesMissingSince(workerName string, handles []string) error
 	RemoveMissingVolumes(time.Duration) (int, error)
+	RemoveExpiredArtifacts(time.Duration) (int, error)
+	RemoveExpiredTaskOutputCaches(time.Duration) (int, error)
+	RemoveTaskOutputCacheUsesOfFinishedBuilds() (int, error)
 }
 
 type volumeRepository struct {
@@ -161,6 +166,66 @@ func (repository *volumeRepository) RemoveMissingVolumes(gracePeriod time.Durati
 	return int(affected), nil
 }
 
+func (repository *volumeRepository) RemoveExpiredArtifacts(retention time.Duration) (int, error) {
+	result, err := psql.Delete("build_artifacts").
+		Where(sq.Gt{
+			"NOW() - created_at": fmt.Sprintf("%.0f seconds", retention.Seconds()),
+		}).
+		RunWith(repository.conn).
+		Exec()
+	if err != nil {
+		return 0, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return int(affected), nil
+}
+
+func (repository *volumeRepository) RemoveExpiredTaskOutputCaches(retention time.Duration) (int, error) {
+	result, err := psql.Delete("task_output_caches").
+		Where(sq.Gt{
+			"NOW() - last_used": fmt.Sprintf("%.0f seconds", retention.Seconds()),
+		}).
+		RunWith(repository.conn).
+		Exec()
+	if err != nil {
+		return 0, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return int(affected), nil
+}
+
+// RemoveTaskOutputCacheUsesOfFinishedBuilds releases the cached task output
+// volumes reused by builds which have finished.
+func (repository *volumeRepository) RemoveTaskOutputCacheUsesOfFinishedBuilds() (int, error) {
+	result, err := psql.Delete("task_output_cache_uses u USING builds b").
+		Where(sq.And{
+			sq.Expr("u.build_id = b.id"),
+			sq.Expr("b.interceptible = false"),
+		}).
+		RunWith(repository.conn).
+		Exec()
+	if err != nil {
+		return 0, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return int(affected), nil
+}
+
 func (repository *volumeRepository) RemoveDestroyingVolumes(workerName string, handles []string) (int, error) {
 	rows, err := psql.Delete("volumes").
 		Where(
@@ -390,6 +455,33 @@ func (repository *volumeRepository) FindResourceCacheVolume(workerName string, r
 	return createdVolume, true, nil
 }
 
+func (repository *volumeRepository) FindStreamedResourceCacheVolume(workerName string, resourceCache UsedResourceCache) (CreatedVolume, bool, error) {
+	workerStreamedResourceCache, found, err := WorkerStreamedResourceCache{
+		WorkerName:    workerName,
+		ResourceCache: resourceCache,
+	}.Find(repository.conn)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if !found {
+		return nil, false, nil
+	}
+
+	_, createdVolume, err := repository.findVolume(0, workerName, map[string]interface{}{
+		"v.worker_streamed_resource_cache_id": workerStreamedResourceCache.ID,
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	if createdVolume == nil {
+		return nil, false, nil
+	}
+
+	return createdVolume, true, nil
+}
+
 func (repository *volumeRepository) FindCreatedVolume(handle string) (CreatedVolume, bool, error) {
 	_, createdVolume, err := repository.findVolume(0, "", map[string]interface{}{
 		"v.handle": handle,
@@ -405,6 +497,33 @@ func (repository *volumeRepository) FindCreatedVolume(handle string) (CreatedVol
 	return createdVolume, true, nil
 }
 
+func (repository *volumeRepository) FindBuildArtifactVolume(buildID int, name string) (CreatedVolume, bool, error) {
+	row := psql.Select(volumeColumns...).
+		From("volumes v").
+		Join("build_artifacts ba ON ba.id = v.build_artifact_id").
+		LeftJoin("workers w ON v.worker_name = w.name").
+		LeftJoin("containers c ON v.container_id = c.id").
+		LeftJoin("volumes pv ON v.parent_id = pv.id").
+		LeftJoin("worker_resource_caches wrc ON wrc.id = v.worker_resource_cache_id").
+		Where(sq.Eq{
+			"ba.build_id": buildID,
+			"ba.name":     name,
+			"v.state":     VolumeStateCreated,
+		}).
+		RunWith(repository.conn).
+		QueryRow()
+
+	_, createdVolume, _, _, err := scanVolume(row, repository.conn)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	return createdVolume, true, nil
+}
+
 func (repository *volumeRepository) GetOrphanedVolumes() ([]CreatedVolume, error) {
 	query, args, err := psql.Select(volumeColumns...).
 		From("volumes v").
@@ -415,22 +534,28 @@ func (repository *volumeRepository) GetOrphanedVolumes() ([]CreatedVolume, error
 		Where(
 			sq.Or{
 				sq.Eq{
-					"v.worker_resource_cache_id":     nil,
-					"v.worker_base_resource_type_id": nil,
-					"v.container_id":                 nil,
-					"v.worker_task_cache_id":         nil,
-					"v.worker_resource_certs_id":     nil,
+					"v.worker_resource_cache_id":          nil,
+					"v.worker_base_resource_type_id":      nil,
+					"v.container_id":                      nil,
+					"v.worker_task_cache_id":              nil,
+					"v.worker_resource_certs_id":          nil,
+					"v.build_artifact_id":                 nil,
+					"v.task_output_cache_output_id":       nil,
+					"v.worker_streamed_resource_cache_id": nil,
 				},
 				sq.And{
 					sq.NotEq{
 						"v.worker_base_resource_type_id": nil,
 					},
 					sq.Eq{
-						"v.worker_resource_cache_id": nil,
-						"v.team_id":                  nil,
-						"v.container_id":             nil,
-						"v.worker_task_cache_id":     nil,
-						"v.worker_resource_certs_id": nil,
+						"v.worker_resource_cache_id":          nil,
+						"v.team_id":                           nil,
+						"v.container_id":                      nil,
+						"v.worker_task_cache_id":              nil,
+						"v.worker_resource_certs_id":          nil,
+						"v.build_artifact_id":                 nil,
+						"v.task_output_cache_output_id":       nil,
+						"v.worker_streamed_resource_cache_id": nil,
 					},
 				},
 			},
@@ -441,6 +566,8 @@ func (repository *volumeRepository) GetOrphanedVolumes() ([]CreatedVolume, error
 			sq.Eq{"w.state": string(WorkerStateLanding)},
 			sq.Eq{"w.state": string(WorkerStateRetiring)},
 		}).
+		// cached task outputs are kept while builds which reused them run
+		Where("NOT EXISTS (SELECT 1 FROM task_output_cache_uses u WHERE u.volume_id = v.id)").
 		ToSql()
 	if err != nil {
 		return nil, err
@@ -614,7 +741,10 @@ var volumeColumns = []string{
 	`case
 	when v.worker_base_resource_type_id is not NULL then 'resource-type'
 	when v.worker_resource_cache_id is not NULL then 'resource'
+	when v.worker_streamed_resource_cache_id is not NULL then 'resource'
 	when v.container_id is not NULL then 'container'
+	when v.build_artifact_id is not NULL then 'artifact'
+	when v.task_output_cache_output_id is not NULL then 'task-output'
 	when v.worker_task_cache_id is not NULL then 'task-cache'
 	when v.worker_resource_certs_id is not NULL then 'resource-certs'
 	else 'unknown'
@@ -511,6 +511,119 @@ var _ = Describe("VolumeFactory", func() {
 		})
 	})
 
+	Describe("streamed resource caches", func() {
+		var (
+			usedResourceCache db.UsedResourceCache
+			creatingContainer db.CreatingContainer
+			streamedVolume    db.CreatedVolume
+		)
+
+		BeforeEach(func() {
+			build, err := defaultPipeline.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+
+			usedResourceCache, err = resourceCacheFactory.FindOrCreateResourceCache(
+				logger,
+				db.ForBuild(build.ID()),
+				"some-base-resource-type",
+				atc.Version{"some": "version"},
+				atc.Source{"some": "source"},
+				atc.Params{"some": "params"},
+				creds.VersionedResourceTypes{},
+			)
+			Expect(err).ToNot(HaveOccurred())
+
+			creatingContainer, err = defaultTeam.CreateContainer(otherWorker.Name(), db.NewBuildStepContainerOwner(build.ID(), "some-plan"), db.ContainerMetadata{})
+			Expect(err).ToNot(HaveOccurred())
+
+			creatingVolume, err := volumeRepository.CreateContainerVolume(defaultTeam.ID(), otherWorker.Name(), creatingContainer, "streamed-resource-cache:some-input")
+			Expect(err).NotTo(HaveOccurred())
+
+			streamedVolume, err = creatingVolume.Created()
+			Expect(err).NotTo(HaveOccurred())
+
+			err = streamedVolume.InitializeStreamedResourceCache(usedResourceCache)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("finds the copy on the worker it was streamed to", func() {
+			createdVolume, found, err := volumeRepository.FindStreamedResourceCacheVolume(otherWorker.Name(), usedResourceCache)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(createdVolume.Handle()).To(Equal(streamedVolume.Handle()))
+			Expect(createdVolume.Type()).To(Equal(db.VolumeTypeResource))
+		})
+
+		It("does not find the copy on other workers", func() {
+			_, found, err := volumeRepository.FindStreamedResourceCacheVolume(defaultWorker.Name(), usedResourceCache)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+
+		It("keeps the first copy when another one is streamed", func() {
+			creatingVolume, err := volumeRepository.CreateContainerVolume(defaultTeam.ID(), otherWorker.Name(), creatingContainer, "streamed-resource-cache:some-other-input")
+			Expect(err).NotTo(HaveOccurred())
+
+			otherVolume, err := creatingVolume.Created()
+			Expect(err).NotTo(HaveOccurred())
+
+			err = otherVolume.InitializeStreamedResourceCache(usedResourceCache)
+			Expect(err).NotTo(HaveOccurred())
+
+			createdVolume, found, err := volumeRepository.FindStreamedResourceCacheVolume(otherWorker.Name(), usedResourceCache)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(createdVolume.Handle()).To(Equal(streamedVolume.Handle()))
+		})
+
+		Context("once the container it was streamed for is gone", func() {
+			BeforeEach(func() {
+				_, err := psql.Delete("containers").
+					Where(sq.Eq{"handle": creatingContainer.Handle()}).
+					RunWith(dbConn).
+					Exec()
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("does not orphan the copy", func() {
+				createdVolumes, err := volumeRepository.GetOrphanedVolumes()
+				Expect(err).ToNot(HaveOccurred())
+
+				for _, v := range createdVolumes {
+					Expect(v.Handle()).ToNot(Equal(streamedVolume.Handle()))
+				}
+			})
+
+			Context("when the resource cache is removed", func() {
+				BeforeEach(func() {
+					_, err := psql.Delete("resource_cache_uses").
+						Where(sq.Eq{"resource_cache_id": usedResourceCache.ID()}).
+						RunWith(dbConn).
+						Exec()
+					Expect(err).ToNot(HaveOccurred())
+
+					_, err = psql.Delete("resource_caches").
+						Where(sq.Eq{"id": usedResourceCache.ID()}).
+						RunWith(dbConn).
+						Exec()
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("orphans the copy", func() {
+					createdVolumes, err := volumeRepository.GetOrphanedVolumes()
+					Expect(err).ToNot(HaveOccurred())
+
+					var handles []string
+					for _, v := range createdVolumes {
+						handles = append(handles, v.Handle())
+					}
+
+					Expect(handles).To(ContainElement(streamedVolume.Handle()))
+				})
+			})
+		})
+	})
+
 	Describe("RemoveDestroyingVolumes", func() {
 		var failedErr error
 		var numDeleted int
@@ -738,6 +851,276 @@ var _ = Describe("VolumeFactory", func() {
 		})
 	})
 
+	Describe("build artifacts", func() {
+		var (
+			build  db.Build
+			volume db.CreatedVolume
+		)
+
+		BeforeEach(func() {
+			var err error
+			build, err = defaultTeam.CreateOneOffBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			creatingContainer, err := defaultTeam.CreateContainer(defaultWorker.Name(), db.NewBuildStepContainerOwner(build.ID(), "some-plan"), db.ContainerMetadata{})
+			Expect(err).ToNot(HaveOccurred())
+
+			creatingVolume, err := volumeRepository.CreateContainerVolume(defaultTeam.ID(), defaultWorker.Name(), creatingContainer, "some-output")
+			Expect(err).ToNot(HaveOccurred())
+
+			volume, err = creatingVolume.Created()
+			Expect(err).ToNot(HaveOccurred())
+
+			err = volume.InitializeArtifact(build.ID(), "some-artifact")
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = psql.Delete("containers").
+				Where(sq.Eq{"handle": creatingContainer.Handle()}).
+				RunWith(dbConn).
+				Exec()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("finds the artifact's volume", func() {
+			foundVolume, found, err := volumeRepository.FindBuildArtifactVolume(build.ID(), "some-artifact")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(foundVolume.Handle()).To(Equal(volume.Handle()))
+			Expect(foundVolume.Type()).To(Equal(db.VolumeTypeArtifact))
+		})
+
+		It("does not find other artifacts", func() {
+			_, found, err := volumeRepository.FindBuildArtifactVolume(build.ID(), "some-other-artifact")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+
+		It("does not orphan the artifact's volume once its container is gone", func() {
+			createdVolumes, err := volumeRepository.GetOrphanedVolumes()
+			Expect(err).ToNot(HaveOccurred())
+
+			for _, v := range createdVolumes {
+				Expect(v.Handle()).ToNot(Equal(volume.Handle()))
+			}
+		})
+
+		Context("when the artifact has not expired", func() {
+			It("keeps the artifact", func() {
+				removed, err := volumeRepository.RemoveExpiredArtifacts(time.Hour)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(removed).To(Equal(0))
+
+				_, found, err := volumeRepository.FindBuildArtifactVolume(build.ID(), "some-artifact")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+			})
+		})
+
+		Context("when the artifact has expired", func() {
+			BeforeEach(func() {
+				_, err := psql.Update("build_artifacts").
+					Set("created_at", time.Now().Add(-2*time.Hour)).
+					RunWith(dbConn).
+					Exec()
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("removes the artifact, orphaning its volume", func() {
+				removed, err := volumeRepository.RemoveExpiredArtifacts(time.Hour)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(removed).To(Equal(1))
+
+				_, found, err := volumeRepository.FindBuildArtifactVolume(build.ID(), "some-artifact")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeFalse())
+
+				createdVolumes, err := volumeRepository.GetOrphanedVolumes()
+				Expect(err).ToNot(HaveOccurred())
+
+				handles := []string{}
+				for _, v := range createdVolumes {
+					handles = append(handles, v.Handle())
+				}
+
+				Expect(handles).To(ContainElement(volume.Handle()))
+			})
+		})
+	})
+
+	Describe("task output caches", func() {
+		var (
+			taskOutputCacheFactory db.TaskOutputCacheFactory
+			volume                 db.CreatedVolume
+		)
+
+		BeforeEach(func() {
+			taskOutputCacheFactory = db.NewTaskOutputCacheFactory(dbConn)
+
+			build, err := defaultTeam.CreateOneOffBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			creatingContainer, err := defaultTeam.CreateContainer(defaultWorker.Name(), db.NewBuildStepContainerOwner(build.ID(), "some-plan"), db.ContainerMetadata{})
+			Expect(err).ToNot(HaveOccurred())
+
+			creatingVolume, err := volumeRepository.CreateContainerVolume(defaultTeam.ID(), defaultWorker.Name(), creatingContainer, "some-output")
+			Expect(err).ToNot(HaveOccurred())
+
+			volume, err = creatingVolume.Created()
+			Expect(err).ToNot(HaveOccurred())
+
+			cache, err := taskOutputCacheFactory.Create(defaultTeam.ID(), "some-key", map[string]string{"version": "1.2.3"})
+			Expect(err).ToNot(HaveOccurred())
+
+			err = volume.InitializeTaskOutputCache(cache.ID, "some-output")
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = psql.Delete("containers").
+				Where(sq.Eq{"handle": creatingContainer.Handle()}).
+				RunWith(dbConn).
+				Exec()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("finds the cache with its output volumes", func() {
+			cache, found, err := taskOutputCacheFactory.Find(defaultTeam.ID(), "some-key")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(cache.Volumes).To(HaveLen(1))
+			Expect(cache.Volumes["some-output"].Handle()).To(Equal(volume.Handle()))
+			Expect(cache.Volumes["some-output"].Type()).To(Equal(db.VolumeTypeTaskOutput))
+			Expect(cache.Annotations).To(Equal(map[string]string{"version": "1.2.3"}))
+		})
+
+		It("does not find caches for other keys", func() {
+			_, found, err := taskOutputCacheFactory.Find(defaultTeam.ID(), "some-other-key")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+
+		It("does not orphan the cached output's volume once its container is gone", func() {
+			createdVolumes, err := volumeRepository.GetOrphanedVolumes()
+			Expect(err).ToNot(HaveOccurred())
+
+			for _, v := range createdVolumes {
+				Expect(v.Handle()).ToNot(Equal(volume.Handle()))
+			}
+		})
+
+		Context("when a build reuses the cached outputs", func() {
+			var build db.Build
+
+			BeforeEach(func() {
+				var err error
+				build, err = defaultTeam.CreateOneOffBuild()
+				Expect(err).ToNot(HaveOccurred())
+
+				cache, found, err := taskOutputCacheFactory.Find(defaultTeam.ID(), "some-key")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+
+				used, err := taskOutputCacheFactory.Use(build.ID(), cache)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(used).To(BeTrue())
+
+				newCache, err := taskOutputCacheFactory.Create(defaultTeam.ID(), "some-key", nil)
+				Expect(err).ToNot(HaveOccurred())
+
+				creatingContainer, err := defaultTeam.CreateContainer(defaultWorker.Name(), db.NewBuildStepContainerOwner(build.ID(), "some-other-plan"), db.ContainerMetadata{})
+				Expect(err).ToNot(HaveOccurred())
+
+				creatingVolume, err := volumeRepository.CreateContainerVolume(defaultTeam.ID(), defaultWorker.Name(), creatingContainer, "some-output")
+				Expect(err).ToNot(HaveOccurred())
+
+				newVolume, err := creatingVolume.Created()
+				Expect(err).ToNot(HaveOccurred())
+
+				err = newVolume.InitializeTaskOutputCache(newCache.ID, "some-output")
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("does not orphan the replaced volume while the build is running", func() {
+				createdVolumes, err := volumeRepository.GetOrphanedVolumes()
+				Expect(err).ToNot(HaveOccurred())
+
+				for _, v := range createdVolumes {
+					Expect(v.Handle()).ToNot(Equal(volume.Handle()))
+				}
+			})
+
+			Context("once the build has finished", func() {
+				BeforeEach(func() {
+					err := build.Finish(db.BuildStatusSucceeded)
+					Expect(err).ToNot(HaveOccurred())
+
+					_, err = psql.Update("builds").
+						Set("interceptible", false).
+						Where(sq.Eq{"id": build.ID()}).
+						RunWith(dbConn).
+						Exec()
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("orphans the replaced volume", func() {
+					removed, err := volumeRepository.RemoveTaskOutputCacheUsesOfFinishedBuilds()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(removed).To(Equal(1))
+
+					createdVolumes, err := volumeRepository.GetOrphanedVolumes()
+					Expect(err).ToNot(HaveOccurred())
+
+					handles := []string{}
+					for _, v := range createdVolumes {
+						handles = append(handles, v.Handle())
+					}
+
+					Expect(handles).To(ContainElement(volume.Handle()))
+				})
+			})
+		})
+
+		Context("when the cache has not expired", func() {
+			It("keeps the cache", func() {
+				removed, err := volumeRepository.RemoveExpiredTaskOutputCaches(time.Hour)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(removed).To(Equal(0))
+
+				_, found, err := taskOutputCacheFactory.Find(defaultTeam.ID(), "some-key")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+			})
+		})
+
+		Context("when the cache has not been used for longer than the retention", func() {
+			BeforeEach(func() {
+				_, err := psql.Update("task_output_caches").
+					Set("last_used", time.Now().Add(-2*time.Hour)).
+					RunWith(dbConn).
+					Exec()
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("removes the cache, orphaning its volumes", func() {
+				removed, err := volumeRepository.RemoveExpiredTaskOutputCaches(time.Hour)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(removed).To(Equal(1))
+
+				_, found, err := taskOutputCacheFactory.Find(defaultTeam.ID(), "some-key")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeFalse())
+
+				createdVolumes, err := volumeRepository.GetOrphanedVolumes()
+				Expect(err).ToNot(HaveOccurred())
+
+				handles := []string{}
+				for _, v := range createdVolumes {
+					handles = append(handles, v.Handle())
+				}
+
+				Expect(handles).To(ContainElement(volume.Handle()))
+			})
+		})
+	})
+
 	Describe("UpdateVolumesMissingSince", func() {
 		var (
 			today        time.Time
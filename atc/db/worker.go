@@ -38,6 +38,8 @@ type Worker interface {
 	HTTPSProxyURL() string
 	NoProxy() string
 	ActiveContainers() int
+	BuildContainers() (int, error)
+	ActiveTasks() (int, error)
 	ResourceTypes() []atc.WorkerResourceType
 	Platform() string
 	Tags() []string
@@ -116,6 +118,49 @@ func (worker *worker) Reload() (bool, error) {
 	return true, nil
 }
 
+// BuildContainers counts the containers on the worker which belong to builds.
+func (worker *worker) BuildContainers() (int, error) {
+	var count int
+	err := psql.Select("COUNT(*)").
+		From("containers").
+		Where(sq.And{
+			sq.Eq{"worker_name": worker.name},
+			sq.NotEq{"build_id": nil},
+		}).
+		RunWith(worker.conn).
+		QueryRow().
+		Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// ActiveTasks counts the task containers on the worker whose builds are
+// running and whose tasks have not finished yet.
+func (worker *worker) ActiveTasks() (int, error) {
+	var count int
+	err := psql.Select("COUNT(*)").
+		From("containers c").
+		Join("builds b ON b.id = c.build_id").
+		Where(sq.Eq{
+			"c.worker_name":   worker.name,
+			"c.meta_type":     string(ContainerTypeTask),
+			"c.state":         []string{atc.ContainerStateCreating, atc.ContainerStateCreated},
+			"c.task_finished": false,
+			"b.status":        string(BuildStatusStarted),
+		}).
+		RunWith(worker.conn).
+		QueryRow().
+		Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
 func (worker *worker) Land() error {
 	cSQL, _, err := sq.Case("state").
 		When("'landed'::worker_state", "'landed'::worker_state").
@@ -0,0 +1,72 @@
+package db
+
+import (
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+// WorkerStreamedResourceCache is a copy of a resource cache which was streamed
+// to a worker rather than fetched on it. Unlike WorkerResourceCache it does not
+// require the worker to support the resource's base type.
+type WorkerStreamedResourceCache struct {
+	WorkerName    string
+	ResourceCache UsedResourceCache
+}
+
+type UsedWorkerStreamedResourceCache struct {
+	ID int
+}
+
+func (workerStreamedResourceCache WorkerStreamedResourceCache) FindOrCreate(tx Tx) (*UsedWorkerStreamedResourceCache, error) {
+	var id int
+	err := psql.Insert("worker_streamed_resource_caches").
+		Columns(
+			"resource_cache_id",
+			"worker_name",
+		).
+		Values(
+			workerStreamedResourceCache.ResourceCache.ID(),
+			workerStreamedResourceCache.WorkerName,
+		).
+		Suffix(`
+			ON CONFLICT (resource_cache_id, worker_name) DO UPDATE SET
+				resource_cache_id = ?,
+				worker_name = ?
+			RETURNING id
+		`, workerStreamedResourceCache.ResourceCache.ID(), workerStreamedResourceCache.WorkerName).
+		RunWith(tx).
+		QueryRow().
+		Scan(&id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &UsedWorkerStreamedResourceCache{
+		ID: id,
+	}, nil
+}
+
+func (workerStreamedResourceCache WorkerStreamedResourceCache) Find(runner sq.Runner) (*UsedWorkerStreamedResourceCache, bool, error) {
+	var id int
+	err := psql.Select("id").
+		From("worker_streamed_resource_caches").
+		Where(sq.Eq{
+			"resource_cache_id": workerStreamedResourceCache.ResourceCache.ID(),
+			"worker_name":       workerStreamedResourceCache.WorkerName,
+		}).
+		RunWith(runner).
+		QueryRow().
+		Scan(&id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, false, nil
+		}
+
+		return nil, false, err
+	}
+
+	return &UsedWorkerStreamedResourceCache{
+		ID: id,
+	}, true, nil
+}
@@ -205,4 +205,93 @@ var _ = Describe("Worker", func() {
 		})
 	})
 
+	Describe("BuildContainers", func() {
+		It("counts the containers which belong to builds", func() {
+			build, err := defaultTeam.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = defaultTeam.CreateContainer(
+				defaultWorker.Name(),
+				NewBuildStepContainerOwner(build.ID(), "some-plan"),
+				ContainerMetadata{Type: ContainerTypeTask},
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			count, err := defaultWorker.BuildContainers()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(count).To(Equal(1))
+		})
+	})
+
+	Describe("ActiveTasks", func() {
+		var build Build
+
+		createTaskContainer := func(planID atc.PlanID) CreatingContainer {
+			container, err := defaultTeam.CreateContainer(
+				defaultWorker.Name(),
+				NewBuildStepContainerOwner(build.ID(), planID),
+				ContainerMetadata{Type: ContainerTypeTask},
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			return container
+		}
+
+		activeTasks := func() int {
+			count, err := defaultWorker.ActiveTasks()
+			Expect(err).NotTo(HaveOccurred())
+
+			return count
+		}
+
+		BeforeEach(func() {
+			var err error
+			build, err = defaultTeam.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+
+			started, err := build.Start("exec.v2", "{}", atc.Plan{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(started).To(BeTrue())
+		})
+
+		It("counts the task containers of running builds", func() {
+			createTaskContainer("some-plan")
+
+			_, err := createTaskContainer("other-plan").Created()
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = defaultTeam.CreateContainer(
+				defaultWorker.Name(),
+				NewBuildStepContainerOwner(build.ID(), "get-plan"),
+				ContainerMetadata{Type: ContainerTypeGet},
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(activeTasks()).To(Equal(2))
+		})
+
+		It("does not count tasks which have finished", func() {
+			created, err := createTaskContainer("some-plan").Created()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(created.MarkTaskFinished()).To(Succeed())
+
+			Expect(activeTasks()).To(Equal(0))
+		})
+
+		It("does not count containers which failed to be created", func() {
+			_, err := createTaskContainer("some-plan").Failed()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(activeTasks()).To(Equal(0))
+		})
+
+		It("does not count the tasks of builds which are no longer running", func() {
+			createTaskContainer("some-plan")
+
+			Expect(build.Finish(BuildStatusAborted)).To(Succeed())
+
+			Expect(activeTasks()).To(Equal(0))
+		})
+	})
 })
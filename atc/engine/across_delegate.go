@@ -0,0 +1,58 @@
+package engine
+
+import (
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/event"
+	"github.com/concourse/concourse/atc/exec"
+)
+
+type acrossDelegate struct {
+	build       db.Build
+	eventOrigin event.Origin
+	clock       clock.Clock
+}
+
+func NewAcrossDelegate(build db.Build, planID atc.PlanID, clock clock.Clock) exec.AcrossDelegate {
+	return &acrossDelegate{
+		build: build,
+		eventOrigin: event.Origin{
+			ID: event.OriginID(planID),
+		},
+		clock: clock,
+	}
+}
+
+func (d *acrossDelegate) IterationStarted(logger lager.Logger, index int, varName string, value interface{}) {
+	err := d.build.SaveEvent(event.StartAcrossIteration{
+		Origin: d.eventOrigin,
+		Time:   d.clock.Now().Unix(),
+		Index:  index,
+		Var:    varName,
+		Value:  value,
+	})
+	if err != nil {
+		logger.Error("failed-to-save-start-across-iteration-event", err)
+		return
+	}
+
+	logger.Debug("iteration-started", lager.Data{"index": index})
+}
+
+func (d *acrossDelegate) IterationFinished(logger lager.Logger, index int, succeeded bool) {
+	err := d.build.SaveEvent(event.FinishAcrossIteration{
+		Origin:    d.eventOrigin,
+		Time:      d.clock.Now().Unix(),
+		Index:     index,
+		Succeeded: succeeded,
+	})
+	if err != nil {
+		logger.Error("failed-to-save-finish-across-iteration-event", err)
+		return
+	}
+
+	logger.Debug("iteration-finished", lager.Data{"index": index, "succeeded": succeeded})
+}
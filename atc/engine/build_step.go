@@ -100,6 +100,7 @@ func (build *execBuild) buildTaskStep(logger lager.Logger, plan atc.Plan) exec.S
 		build.dbBuild,
 		containerMetadata,
 		build.delegate.TaskDelegate(plan.ID),
+		build.runState(),
 	)
 }
 
@@ -121,6 +122,7 @@ func (build *execBuild) buildGetStep(logger lager.Logger, plan atc.Plan) exec.St
 		build.stepMetadata,
 		containerMetadata,
 		build.delegate.GetDelegate(plan.ID),
+		build.runState(),
 	)
 }
 
@@ -142,9 +144,52 @@ func (build *execBuild) buildPutStep(logger lager.Logger, plan atc.Plan) exec.St
 		build.stepMetadata,
 		containerMetadata,
 		build.delegate.PutDelegate(plan.ID),
+		build.runState(),
 	)
 }
 
+func (build *execBuild) buildRunStep(logger lager.Logger, plan atc.Plan) exec.Step {
+	logger = logger.Session("run", lager.Data{
+		"message": plan.Run.Message,
+	})
+
+	containerMetadata := build.containerMetadata(
+		db.ContainerTypeRun,
+		plan.Run.Message,
+		plan.Attempts,
+	)
+
+	return build.factory.Run(
+		logger,
+		plan,
+		build.dbBuild,
+		build.stepMetadata,
+		containerMetadata,
+		build.delegate.RunDelegate(plan.ID),
+		build.runState(),
+	)
+}
+
+func (build *execBuild) buildSetPipelineStep(logger lager.Logger, plan atc.Plan) exec.Step {
+	logger = logger.Session("set-pipeline", lager.Data{
+		"name": plan.SetPipeline.Name,
+	})
+
+	return build.factory.SetPipeline(
+		logger,
+		plan,
+		build.dbBuild,
+		build.delegate.BuildStepDelegate(plan.ID),
+		build.runState(),
+	)
+}
+
+func (build *execBuild) buildLoadVarStep(logger lager.Logger, plan atc.Plan) exec.Step {
+	delegate := build.delegate.BuildStepDelegate(plan.ID)
+
+	return exec.LogError(exec.NewLoadVarStep(plan.ID, *plan.LoadVar, delegate), delegate)
+}
+
 func (build *execBuild) buildRetryStep(logger lager.Logger, plan atc.Plan) exec.Step {
 	logger = logger.Session("retry")
 
@@ -160,6 +205,29 @@ func (build *execBuild) buildRetryStep(logger lager.Logger, plan atc.Plan) exec.
 	return exec.Retry(steps...)
 }
 
+func (build *execBuild) buildAcrossStep(logger lager.Logger, plan atc.Plan) exec.Step {
+	logger = logger.Session("across")
+
+	iterations := []exec.AcrossIterationStep{}
+
+	for _, iteration := range plan.Across.Iterations {
+		innerPlan := iteration.Step
+		innerPlan.Attempts = plan.Attempts
+
+		iterations = append(iterations, exec.AcrossIterationStep{
+			Value: iteration.Value,
+			Step:  build.buildStep(logger, innerPlan),
+		})
+	}
+
+	return exec.Across(
+		plan.Across.Var,
+		iterations,
+		plan.Across.MaxInFlight,
+		build.delegate.AcrossDelegate(plan.ID),
+	)
+}
+
 func (build *execBuild) buildUserArtifactStep(logger lager.Logger, plan atc.Plan) exec.Step {
 	return exec.UserArtifact(plan.ID, worker.ArtifactName(plan.UserArtifact.Name), build.delegate.BuildStepDelegate(plan.ID))
 }
@@ -1,6 +1,7 @@
 package engine
 
 import (
+	"bytes"
 	"io"
 	"sync"
 	"unicode/utf8"
@@ -9,26 +10,57 @@ import (
 	"code.cloudfoundry.org/lager"
 
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/event"
 	"github.com/concourse/concourse/atc/exec"
 )
 
+// maxPendingLogBytes bounds how much of an unfinished line is held back
+// before it is saved anyway, so that output which never ends a line still
+// shows up.
+const maxPendingLogBytes = 64 * 1024
+
 type BuildStepDelegate struct {
-	build  db.Build
-	planID atc.PlanID
-	clock  clock.Clock
+	build   db.Build
+	planID  atc.PlanID
+	secrets *creds.SecretTracker
+	clock   clock.Clock
+
+	stdout *dbEventWriter
+	stderr *dbEventWriter
 }
 
 func NewBuildStepDelegate(
 	build db.Build,
 	planID atc.PlanID,
+	secrets *creds.SecretTracker,
 	clock clock.Clock,
 ) *BuildStepDelegate {
 	return &BuildStepDelegate{
-		build:  build,
-		planID: planID,
-		clock:  clock,
+		build:   build,
+		planID:  planID,
+		secrets: secrets,
+		clock:   clock,
+
+		stdout: newDBEventWriter(
+			build,
+			event.Origin{
+				Source: event.OriginSourceStdout,
+				ID:     event.OriginID(planID),
+			},
+			secrets,
+			clock,
+		),
+		stderr: newDBEventWriter(
+			build,
+			event.Origin{
+				Source: event.OriginSourceStderr,
+				ID:     event.OriginID(planID),
+			},
+			secrets,
+			clock,
+		),
 	}
 }
 
@@ -37,30 +69,31 @@ func (delegate *BuildStepDelegate) ImageVersionDetermined(resourceCache db.UsedR
 }
 
 func (delegate *BuildStepDelegate) Stdout() io.Writer {
-	return newDBEventWriter(
-		delegate.build,
-		event.Origin{
-			Source: event.OriginSourceStdout,
-			ID:     event.OriginID(delegate.planID),
-		},
-		delegate.clock,
-	)
+	return delegate.stdout
 }
 
 func (delegate *BuildStepDelegate) Stderr() io.Writer {
-	return newDBEventWriter(
-		delegate.build,
-		event.Origin{
-			Source: event.OriginSourceStderr,
-			ID:     event.OriginID(delegate.planID),
-		},
-		delegate.clock,
-	)
+	return delegate.stderr
+}
+
+// Flush saves any output which is being held back until the end of its line.
+func (delegate *BuildStepDelegate) Flush(logger lager.Logger) {
+	err := delegate.stdout.flush()
+	if err != nil {
+		logger.Error("failed-to-flush-stdout", err)
+	}
+
+	err = delegate.stderr.flush()
+	if err != nil {
+		logger.Error("failed-to-flush-stderr", err)
+	}
 }
 
 func (delegate *BuildStepDelegate) Errored(logger lager.Logger, message string) {
+	delegate.Flush(logger)
+
 	err := delegate.build.SaveEvent(event.Error{
-		Message: message,
+		Message: delegate.secrets.Redact(message),
 		Origin: event.Origin{
 			ID: event.OriginID(delegate.planID),
 		},
@@ -70,40 +103,50 @@ func (delegate *BuildStepDelegate) Errored(logger lager.Logger, message string)
 	}
 }
 
-func newDBEventWriter(build db.Build, origin event.Origin, clock clock.Clock) io.Writer {
+func newDBEventWriter(build db.Build, origin event.Origin, secrets *creds.SecretTracker, clock clock.Clock) *dbEventWriter {
 	return &dbEventWriter{
-		build:  build,
-		origin: origin,
-		clock:  clock,
+		build:   build,
+		origin:  origin,
+		secrets: secrets,
+		clock:   clock,
 	}
 }
 
+// dbEventWriter saves output as log events, redacting any credentials. Output
+// is saved a line at a time, so that a credential written across more than
+// one write is still redacted.
 type dbEventWriter struct {
 	build db.Build
 
 	origin event.Origin
 
-	dangling []byte
+	secrets *creds.SecretTracker
 
 	clock clock.Clock
+
+	pendingL sync.Mutex
+	pending  []byte
 }
 
 func (writer *dbEventWriter) Write(data []byte) (int, error) {
-	text := append(writer.dangling, data...)
+	writer.pendingL.Lock()
+	defer writer.pendingL.Unlock()
+
+	writer.pending = append(writer.pending, data...)
 
-	checkEncoding, _ := utf8.DecodeLastRune(text)
-	if checkEncoding == utf8.RuneError {
-		writer.dangling = text
+	end := bytes.LastIndexAny(writer.pending, "\r\n") + 1
+	if end == 0 && len(writer.pending) >= maxPendingLogBytes {
+		end = completeRunes(writer.pending)
+	}
+
+	if end == 0 {
 		return len(data), nil
 	}
 
-	writer.dangling = nil
+	err := writer.save(writer.pending[:end])
+
+	writer.pending = append([]byte(nil), writer.pending[end:]...)
 
-	err := writer.build.SaveEvent(event.Log{
-		Time:    writer.clock.Now().Unix(),
-		Payload: string(text),
-		Origin:  writer.origin,
-	})
 	if err != nil {
 		return 0, err
 	}
@@ -111,6 +154,45 @@ func (writer *dbEventWriter) Write(data []byte) (int, error) {
 	return len(data), nil
 }
 
+func (writer *dbEventWriter) flush() error {
+	writer.pendingL.Lock()
+	defer writer.pendingL.Unlock()
+
+	if len(writer.pending) == 0 {
+		return nil
+	}
+
+	err := writer.save(writer.pending)
+
+	writer.pending = nil
+
+	return err
+}
+
+func (writer *dbEventWriter) save(text []byte) error {
+	return writer.build.SaveEvent(event.Log{
+		Time:    writer.clock.Now().Unix(),
+		Payload: writer.secrets.Redact(string(text)),
+		Origin:  writer.origin,
+	})
+}
+
+// completeRunes returns the length of the text without any multi-byte
+// character which is cut off at the end.
+func completeRunes(text []byte) int {
+	for i := len(text) - 1; i >= 0 && i >= len(text)-utf8.UTFMax; i-- {
+		if utf8.RuneStart(text[i]) {
+			if !utf8.FullRune(text[i:]) {
+				return i
+			}
+
+			break
+		}
+	}
+
+	return len(text)
+}
+
 type implicitOutput struct {
 	resourceType string
 	info         exec.VersionInfo
@@ -3,10 +3,13 @@ package engine_test
 import (
 	"errors"
 	"io"
+	"strings"
 	"time"
 
 	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/lager/lagertest"
 
+	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db/dbfakes"
 	"github.com/concourse/concourse/atc/engine"
 	"github.com/concourse/concourse/atc/event"
@@ -17,6 +20,7 @@ import (
 var _ = Describe("BuildStepDelegate", func() {
 	var (
 		fakeBuild *dbfakes.FakeBuild
+		secrets   *creds.SecretTracker
 		fakeClock *fakeclock.FakeClock
 
 		delegate *engine.BuildStepDelegate
@@ -24,8 +28,9 @@ var _ = Describe("BuildStepDelegate", func() {
 
 	BeforeEach(func() {
 		fakeBuild = new(dbfakes.FakeBuild)
+		secrets = creds.NewSecretTracker()
 		fakeClock = fakeclock.NewFakeClock(time.Unix(123456789, 0))
-		delegate = engine.NewBuildStepDelegate(fakeBuild, "some-plan-id", fakeClock)
+		delegate = engine.NewBuildStepDelegate(fakeBuild, "some-plan-id", secrets, fakeClock)
 	})
 
 	Describe("ImageVersionDetermined", func() {
@@ -58,7 +63,7 @@ var _ = Describe("BuildStepDelegate", func() {
 			var writeErr error
 
 			JustBeforeEach(func() {
-				writtenBytes, writeErr = writer.Write([]byte("hello"))
+				writtenBytes, writeErr = writer.Write([]byte("hello\n"))
 			})
 
 			Context("when saving the event succeeds", func() {
@@ -67,7 +72,7 @@ var _ = Describe("BuildStepDelegate", func() {
 				})
 
 				It("returns the length of the string, and no error", func() {
-					Expect(writtenBytes).To(Equal(len("hello")))
+					Expect(writtenBytes).To(Equal(len("hello\n")))
 					Expect(writeErr).ToNot(HaveOccurred())
 				})
 
@@ -75,7 +80,7 @@ var _ = Describe("BuildStepDelegate", func() {
 					Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
 					Expect(fakeBuild.SaveEventArgsForCall(0)).To(Equal(event.Log{
 						Time:    123456789,
-						Payload: "hello",
+						Payload: "hello\n",
 						Origin: event.Origin{
 							Source: event.OriginSourceStdout,
 							ID:     "some-plan-id",
@@ -111,7 +116,7 @@ var _ = Describe("BuildStepDelegate", func() {
 			var writeErr error
 
 			JustBeforeEach(func() {
-				writtenBytes, writeErr = writer.Write([]byte("hello"))
+				writtenBytes, writeErr = writer.Write([]byte("hello\n"))
 			})
 
 			Context("when saving the event succeeds", func() {
@@ -120,7 +125,7 @@ var _ = Describe("BuildStepDelegate", func() {
 				})
 
 				It("returns the length of the string, and no error", func() {
-					Expect(writtenBytes).To(Equal(len("hello")))
+					Expect(writtenBytes).To(Equal(len("hello\n")))
 					Expect(writeErr).ToNot(HaveOccurred())
 				})
 
@@ -128,7 +133,7 @@ var _ = Describe("BuildStepDelegate", func() {
 					Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
 					Expect(fakeBuild.SaveEventArgsForCall(0)).To(Equal(event.Log{
 						Time:    123456789,
-						Payload: "hello",
+						Payload: "hello\n",
 						Origin: event.Origin{
 							Source: event.OriginSourceStderr,
 							ID:     "some-plan-id",
@@ -151,4 +156,111 @@ var _ = Describe("BuildStepDelegate", func() {
 			})
 		})
 	})
+
+	Describe("writing output", func() {
+		var writer io.Writer
+
+		stdoutLog := func(payload string) event.Log {
+			return event.Log{
+				Time:    123456789,
+				Payload: payload,
+				Origin: event.Origin{
+					Source: event.OriginSourceStdout,
+					ID:     "some-plan-id",
+				},
+			}
+		}
+
+		BeforeEach(func() {
+			secrets.Track("some-secret")
+			writer = delegate.Stdout()
+		})
+
+		It("redacts credentials", func() {
+			_, err := writer.Write([]byte("password: some-secret\n"))
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+			Expect(fakeBuild.SaveEventArgsForCall(0)).To(Equal(stdoutLog("password: ((redacted))\n")))
+		})
+
+		It("holds back output until the end of its line", func() {
+			_, err := writer.Write([]byte("password: some-"))
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeBuild.SaveEventCallCount()).To(BeZero())
+
+			_, err = writer.Write([]byte("secret\nnext: "))
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+			Expect(fakeBuild.SaveEventArgsForCall(0)).To(Equal(stdoutLog("password: ((redacted))\n")))
+		})
+
+		It("treats a carriage return as the end of a line", func() {
+			_, err := writer.Write([]byte("50%\r"))
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+			Expect(fakeBuild.SaveEventArgsForCall(0)).To(Equal(stdoutLog("50%\r")))
+		})
+
+		It("saves a long line without waiting for its end, keeping multi-byte characters whole", func() {
+			line := strings.Repeat("a", 64*1024-1) + "\xe2\x98"
+
+			_, err := writer.Write([]byte(line))
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+			Expect(fakeBuild.SaveEventArgsForCall(0)).To(Equal(stdoutLog(strings.Repeat("a", 64*1024-1))))
+
+			_, err = writer.Write([]byte("\x83\n"))
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeBuild.SaveEventCallCount()).To(Equal(2))
+			Expect(fakeBuild.SaveEventArgsForCall(1)).To(Equal(stdoutLog("☃\n")))
+		})
+
+		It("saves the rest of the output when flushed", func() {
+			_, err := writer.Write([]byte("password: some-secret"))
+			Expect(err).ToNot(HaveOccurred())
+
+			delegate.Flush(lagertest.NewTestLogger("test"))
+
+			Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+			Expect(fakeBuild.SaveEventArgsForCall(0)).To(Equal(stdoutLog("password: ((redacted))")))
+
+			delegate.Flush(lagertest.NewTestLogger("test"))
+			Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+		})
+	})
+
+	Describe("Errored", func() {
+		BeforeEach(func() {
+			secrets.Track("some-secret")
+		})
+
+		It("saves the rest of the output, then the error with credentials redacted", func() {
+			_, err := delegate.Stderr().Write([]byte("partial"))
+			Expect(err).ToNot(HaveOccurred())
+
+			delegate.Errored(lagertest.NewTestLogger("test"), "failed to log in with some-secret")
+
+			Expect(fakeBuild.SaveEventCallCount()).To(Equal(2))
+			Expect(fakeBuild.SaveEventArgsForCall(0)).To(Equal(event.Log{
+				Time:    123456789,
+				Payload: "partial",
+				Origin: event.Origin{
+					Source: event.OriginSourceStderr,
+					ID:     "some-plan-id",
+				},
+			}))
+			Expect(fakeBuild.SaveEventArgsForCall(1)).To(Equal(event.Error{
+				Message: "failed to log in with ((redacted))",
+				Origin: event.Origin{
+					ID: "some-plan-id",
+				},
+			}))
+		})
+	})
 })
@@ -11,6 +11,17 @@ import (
 )
 
 type FakeBuildDelegate struct {
+	AcrossDelegateStub        func(atc.PlanID) exec.AcrossDelegate
+	acrossDelegateMutex       sync.RWMutex
+	acrossDelegateArgsForCall []struct {
+		arg1 atc.PlanID
+	}
+	acrossDelegateReturns struct {
+		result1 exec.AcrossDelegate
+	}
+	acrossDelegateReturnsOnCall map[int]struct {
+		result1 exec.AcrossDelegate
+	}
 	BuildStepDelegateStub        func(atc.PlanID) exec.BuildStepDelegate
 	buildStepDelegateMutex       sync.RWMutex
 	buildStepDelegateArgsForCall []struct {
@@ -51,6 +62,17 @@ type FakeBuildDelegate struct {
 	putDelegateReturnsOnCall map[int]struct {
 		result1 exec.PutDelegate
 	}
+	RunDelegateStub        func(atc.PlanID) exec.RunDelegate
+	runDelegateMutex       sync.RWMutex
+	runDelegateArgsForCall []struct {
+		arg1 atc.PlanID
+	}
+	runDelegateReturns struct {
+		result1 exec.RunDelegate
+	}
+	runDelegateReturnsOnCall map[int]struct {
+		result1 exec.RunDelegate
+	}
 	TaskDelegateStub        func(atc.PlanID) exec.TaskDelegate
 	taskDelegateMutex       sync.RWMutex
 	taskDelegateArgsForCall []struct {
@@ -66,6 +88,56 @@ type FakeBuildDelegate struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeBuildDelegate) AcrossDelegate(arg1 atc.PlanID) exec.AcrossDelegate {
+	fake.acrossDelegateMutex.Lock()
+	ret, specificReturn := fake.acrossDelegateReturnsOnCall[len(fake.acrossDelegateArgsForCall)]
+	fake.acrossDelegateArgsForCall = append(fake.acrossDelegateArgsForCall, struct {
+		arg1 atc.PlanID
+	}{arg1})
+	fake.recordInvocation("AcrossDelegate", []interface{}{arg1})
+	fake.acrossDelegateMutex.Unlock()
+	if fake.AcrossDelegateStub != nil {
+		return fake.AcrossDelegateStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.acrossDelegateReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuildDelegate) AcrossDelegateCallCount() int {
+	fake.acrossDelegateMutex.RLock()
+	defer fake.acrossDelegateMutex.RUnlock()
+	return len(fake.acrossDelegateArgsForCall)
+}
+
+func (fake *FakeBuildDelegate) AcrossDelegateArgsForCall(i int) atc.PlanID {
+	fake.acrossDelegateMutex.RLock()
+	defer fake.acrossDelegateMutex.RUnlock()
+	argsForCall := fake.acrossDelegateArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuildDelegate) AcrossDelegateReturns(result1 exec.AcrossDelegate) {
+	fake.AcrossDelegateStub = nil
+	fake.acrossDelegateReturns = struct {
+		result1 exec.AcrossDelegate
+	}{result1}
+}
+
+func (fake *FakeBuildDelegate) AcrossDelegateReturnsOnCall(i int, result1 exec.AcrossDelegate) {
+	fake.AcrossDelegateStub = nil
+	if fake.acrossDelegateReturnsOnCall == nil {
+		fake.acrossDelegateReturnsOnCall = make(map[int]struct {
+			result1 exec.AcrossDelegate
+		})
+	}
+	fake.acrossDelegateReturnsOnCall[i] = struct {
+		result1 exec.AcrossDelegate
+	}{result1}
+}
+
 func (fake *FakeBuildDelegate) BuildStepDelegate(arg1 atc.PlanID) exec.BuildStepDelegate {
 	fake.buildStepDelegateMutex.Lock()
 	ret, specificReturn := fake.buildStepDelegateReturnsOnCall[len(fake.buildStepDelegateArgsForCall)]
@@ -243,6 +315,56 @@ func (fake *FakeBuildDelegate) PutDelegateReturnsOnCall(i int, result1 exec.PutD
 	}{result1}
 }
 
+func (fake *FakeBuildDelegate) RunDelegate(arg1 atc.PlanID) exec.RunDelegate {
+	fake.runDelegateMutex.Lock()
+	ret, specificReturn := fake.runDelegateReturnsOnCall[len(fake.runDelegateArgsForCall)]
+	fake.runDelegateArgsForCall = append(fake.runDelegateArgsForCall, struct {
+		arg1 atc.PlanID
+	}{arg1})
+	fake.recordInvocation("RunDelegate", []interface{}{arg1})
+	fake.runDelegateMutex.Unlock()
+	if fake.RunDelegateStub != nil {
+		return fake.RunDelegateStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.runDelegateReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuildDelegate) RunDelegateCallCount() int {
+	fake.runDelegateMutex.RLock()
+	defer fake.runDelegateMutex.RUnlock()
+	return len(fake.runDelegateArgsForCall)
+}
+
+func (fake *FakeBuildDelegate) RunDelegateArgsForCall(i int) atc.PlanID {
+	fake.runDelegateMutex.RLock()
+	defer fake.runDelegateMutex.RUnlock()
+	argsForCall := fake.runDelegateArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuildDelegate) RunDelegateReturns(result1 exec.RunDelegate) {
+	fake.RunDelegateStub = nil
+	fake.runDelegateReturns = struct {
+		result1 exec.RunDelegate
+	}{result1}
+}
+
+func (fake *FakeBuildDelegate) RunDelegateReturnsOnCall(i int, result1 exec.RunDelegate) {
+	fake.RunDelegateStub = nil
+	if fake.runDelegateReturnsOnCall == nil {
+		fake.runDelegateReturnsOnCall = make(map[int]struct {
+			result1 exec.RunDelegate
+		})
+	}
+	fake.runDelegateReturnsOnCall[i] = struct {
+		result1 exec.RunDelegate
+	}{result1}
+}
+
 func (fake *FakeBuildDelegate) TaskDelegate(arg1 atc.PlanID) exec.TaskDelegate {
 	fake.taskDelegateMutex.Lock()
 	ret, specificReturn := fake.taskDelegateReturnsOnCall[len(fake.taskDelegateArgsForCall)]
@@ -296,6 +418,8 @@ func (fake *FakeBuildDelegate) TaskDelegateReturnsOnCall(i int, result1 exec.Tas
 func (fake *FakeBuildDelegate) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.acrossDelegateMutex.RLock()
+	defer fake.acrossDelegateMutex.RUnlock()
 	fake.buildStepDelegateMutex.RLock()
 	defer fake.buildStepDelegateMutex.RUnlock()
 	fake.finishMutex.RLock()
@@ -304,6 +428,8 @@ func (fake *FakeBuildDelegate) Invocations() map[string][][]interface{} {
 	defer fake.getDelegateMutex.RUnlock()
 	fake.putDelegateMutex.RLock()
 	defer fake.putDelegateMutex.RUnlock()
+	fake.runDelegateMutex.RLock()
+	defer fake.runDelegateMutex.RUnlock()
 	fake.taskDelegateMutex.RLock()
 	defer fake.taskDelegateMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -13,6 +13,7 @@ import (
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/tracing"
 )
 
 type execMetadata struct {
@@ -57,8 +58,8 @@ func (engine *execEngine) CreateBuild(logger lager.Logger, build db.Build, plan
 
 		stepMetadata: buildMetadata(build, engine.externalURL),
 
-		factory:  engine.factory,
-		delegate: engine.delegateFactory.Delegate(build),
+		factory:         engine.factory,
+		delegateFactory: engine.delegateFactory,
 		metadata: execMetadata{
 			Plan: plan,
 		},
@@ -86,9 +87,9 @@ func (engine *execEngine) LookupBuild(logger lager.Logger, build db.Build) (Buil
 
 		stepMetadata: buildMetadata(build, engine.externalURL),
 
-		factory:  engine.factory,
-		delegate: engine.delegateFactory.Delegate(build),
-		metadata: metadata,
+		factory:         engine.factory,
+		delegateFactory: engine.delegateFactory,
+		metadata:        metadata,
 
 		ctx:    ctx,
 		cancel: cancel,
@@ -118,7 +119,11 @@ type execBuild struct {
 	dbBuild      db.Build
 	stepMetadata StepMetadata
 
-	factory  exec.Factory
+	factory         exec.Factory
+	delegateFactory BuildDelegateFactory
+
+	// delegate is constructed once the build resumes, as it redacts the
+	// credentials tracked by the build's run state
 	delegate BuildDelegate
 
 	ctx    context.Context
@@ -145,12 +150,22 @@ func (build *execBuild) Abort(lager.Logger) error {
 }
 
 func (build *execBuild) Resume(logger lager.Logger) {
+	state := build.runState()
+	defer build.clearRunState()
+
+	build.delegate = build.delegateFactory.Delegate(build.dbBuild, state.Secrets())
+
 	step := build.buildStep(logger, build.metadata.Plan)
 
-	runCtx := lagerctx.NewContext(build.ctx, logger)
+	runCtx, span := tracing.StartSpan(build.ctx, "build", tracing.Attrs{
+		"team":     build.stepMetadata.TeamName,
+		"pipeline": build.stepMetadata.PipelineName,
+		"job":      build.stepMetadata.JobName,
+		"build":    build.stepMetadata.BuildName,
+		"build_id": strconv.Itoa(build.stepMetadata.BuildID),
+	})
 
-	state := build.runState()
-	defer build.clearRunState()
+	runCtx = lagerctx.NewContext(runCtx, logger)
 
 	done := make(chan error, 1)
 	go func() {
@@ -161,9 +176,12 @@ func (build *execBuild) Resume(logger lager.Logger) {
 		select {
 		case <-build.releaseCh:
 			logger.Info("releasing")
+			span.SetAttribute("released", "true")
+			span.End()
 			return
 		case err := <-done:
 			build.delegate.Finish(logger.Session("finish"), err, step.Succeeded())
+			tracing.End(span, err)
 			return
 		}
 	}
@@ -235,6 +253,22 @@ func (build *execBuild) buildStep(logger lager.Logger, plan atc.Plan) exec.Step
 		return build.buildRetryStep(logger, plan)
 	}
 
+	if plan.Across != nil {
+		return build.buildAcrossStep(logger, plan)
+	}
+
+	if plan.SetPipeline != nil {
+		return build.buildSetPipelineStep(logger, plan)
+	}
+
+	if plan.LoadVar != nil {
+		return build.buildLoadVarStep(logger, plan)
+	}
+
+	if plan.Run != nil {
+		return build.buildRunStep(logger, plan)
+	}
+
 	if plan.UserArtifact != nil {
 		return build.buildUserArtifactStep(logger, plan)
 	}
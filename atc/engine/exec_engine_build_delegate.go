@@ -2,10 +2,12 @@ package engine
 
 import (
 	"context"
+	"sync"
 
 	"code.cloudfoundry.org/clock"
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/exec"
 )
@@ -16,6 +18,8 @@ type BuildDelegate interface {
 	GetDelegate(atc.PlanID) exec.GetDelegate
 	PutDelegate(atc.PlanID) exec.PutDelegate
 	TaskDelegate(atc.PlanID) exec.TaskDelegate
+	RunDelegate(atc.PlanID) exec.RunDelegate
+	AcrossDelegate(atc.PlanID) exec.AcrossDelegate
 
 	BuildStepDelegate(atc.PlanID) exec.BuildStepDelegate
 
@@ -25,7 +29,7 @@ type BuildDelegate interface {
 //go:generate counterfeiter . BuildDelegateFactory
 
 type BuildDelegateFactory interface {
-	Delegate(db.Build) BuildDelegate
+	Delegate(db.Build, *creds.SecretTracker) BuildDelegate
 }
 
 type buildDelegateFactory struct{}
@@ -34,37 +38,68 @@ func NewBuildDelegateFactory() BuildDelegateFactory {
 	return buildDelegateFactory{}
 }
 
-func (factory buildDelegateFactory) Delegate(build db.Build) BuildDelegate {
-	return newBuildDelegate(build)
+func (factory buildDelegateFactory) Delegate(build db.Build, secrets *creds.SecretTracker) BuildDelegate {
+	return newBuildDelegate(build, secrets)
 }
 
 type delegate struct {
-	build db.Build
+	build   db.Build
+	secrets *creds.SecretTracker
+
+	stepDelegatesL sync.Mutex
+	stepDelegates  []*BuildStepDelegate
 }
 
-func newBuildDelegate(build db.Build) BuildDelegate {
+func newBuildDelegate(build db.Build, secrets *creds.SecretTracker) BuildDelegate {
 	return &delegate{
-		build: build,
+		build:   build,
+		secrets: secrets,
 	}
 }
 
 func (delegate *delegate) GetDelegate(planID atc.PlanID) exec.GetDelegate {
-	return NewGetDelegate(delegate.build, planID, clock.NewClock())
+	return NewGetDelegate(delegate.build, planID, delegate.stepDelegate(planID))
 }
 
 func (delegate *delegate) PutDelegate(planID atc.PlanID) exec.PutDelegate {
-	return NewPutDelegate(delegate.build, planID, clock.NewClock())
+	return NewPutDelegate(delegate.build, planID, delegate.stepDelegate(planID))
 }
 
 func (delegate *delegate) TaskDelegate(planID atc.PlanID) exec.TaskDelegate {
-	return NewTaskDelegate(delegate.build, planID, clock.NewClock())
+	return NewTaskDelegate(delegate.build, planID, delegate.stepDelegate(planID))
+}
+
+func (delegate *delegate) RunDelegate(planID atc.PlanID) exec.RunDelegate {
+	return NewRunDelegate(delegate.build, planID, delegate.stepDelegate(planID))
+}
+
+func (delegate *delegate) AcrossDelegate(planID atc.PlanID) exec.AcrossDelegate {
+	return NewAcrossDelegate(delegate.build, planID, clock.NewClock())
 }
 
 func (delegate *delegate) BuildStepDelegate(planID atc.PlanID) exec.BuildStepDelegate {
-	return NewBuildStepDelegate(delegate.build, planID, clock.NewClock())
+	return delegate.stepDelegate(planID)
+}
+
+// stepDelegate creates a step's delegate, keeping track of it so that any
+// output it is still holding back can be saved when the build finishes.
+func (delegate *delegate) stepDelegate(planID atc.PlanID) *BuildStepDelegate {
+	stepDelegate := NewBuildStepDelegate(delegate.build, planID, delegate.secrets, clock.NewClock())
+
+	delegate.stepDelegatesL.Lock()
+	delegate.stepDelegates = append(delegate.stepDelegates, stepDelegate)
+	delegate.stepDelegatesL.Unlock()
+
+	return stepDelegate
 }
 
 func (delegate *delegate) Finish(logger lager.Logger, err error, succeeded bool) {
+	delegate.stepDelegatesL.Lock()
+	for _, stepDelegate := range delegate.stepDelegates {
+		stepDelegate.Flush(logger)
+	}
+	delegate.stepDelegatesL.Unlock()
+
 	if err == context.Canceled {
 		delegate.saveStatus(logger, atc.StatusAborted)
 		logger.Info("aborted")
@@ -5,6 +5,7 @@ import (
 	"errors"
 
 	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
 	. "github.com/concourse/concourse/atc/engine"
@@ -28,7 +29,7 @@ var _ = Describe("BuildDelegate", func() {
 		factory = NewBuildDelegateFactory()
 
 		fakeBuild = new(dbfakes.FakeBuild)
-		delegate = factory.Delegate(fakeBuild)
+		delegate = factory.Delegate(fakeBuild, creds.NewSecretTracker())
 
 		logger = lagertest.NewTestLogger("test")
 	})
@@ -95,6 +95,70 @@ var _ = Describe("ExecEngine", func() {
 			fakeFactory.PutReturns(outputStep)
 		})
 
+		Describe("with an across step", func() {
+			var (
+				acrossPlan atc.Plan
+				taskPlanA  atc.Plan
+				taskPlanB  atc.Plan
+
+				fakeAcrossDelegate *execfakes.FakeAcrossDelegate
+			)
+
+			BeforeEach(func() {
+				taskPlanA = planFactory.NewPlan(atc.TaskPlan{
+					Name:       "some-task-a",
+					ConfigPath: "some-config-path",
+				})
+
+				taskPlanB = planFactory.NewPlan(atc.TaskPlan{
+					Name:       "some-task-b",
+					ConfigPath: "some-config-path",
+				})
+
+				acrossPlan = planFactory.NewPlan(atc.AcrossPlan{
+					Var:         "some-var",
+					MaxInFlight: 1,
+					Iterations: []atc.AcrossIteration{
+						{Value: "a", Step: taskPlanA},
+						{Value: "b", Step: taskPlanB},
+					},
+				})
+
+				fakeAcrossDelegate = new(execfakes.FakeAcrossDelegate)
+				fakeDelegate.AcrossDelegateReturns(fakeAcrossDelegate)
+
+				build, err := execEngine.CreateBuild(logger, dbBuild, acrossPlan)
+				Expect(err).NotTo(HaveOccurred())
+
+				build.Resume(logger)
+			})
+
+			It("constructs a step for each iteration", func() {
+				Expect(fakeFactory.TaskCallCount()).To(Equal(2))
+
+				_, plan, _, _, _, _ := fakeFactory.TaskArgsForCall(0)
+				Expect(plan).To(Equal(taskPlanA))
+
+				_, plan, _, _, _, _ = fakeFactory.TaskArgsForCall(1)
+				Expect(plan).To(Equal(taskPlanB))
+			})
+
+			It("uses the across delegate for the plan", func() {
+				Expect(fakeDelegate.AcrossDelegateCallCount()).To(Equal(1))
+				Expect(fakeDelegate.AcrossDelegateArgsForCall(0)).To(Equal(acrossPlan.ID))
+
+				Expect(fakeAcrossDelegate.IterationStartedCallCount()).To(Equal(2))
+				Expect(fakeAcrossDelegate.IterationFinishedCallCount()).To(Equal(2))
+			})
+
+			It("finishes the build successfully", func() {
+				Expect(fakeDelegate.FinishCallCount()).To(Equal(1))
+				_, err, succeeded := fakeDelegate.FinishArgsForCall(0)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(succeeded).To(BeTrue())
+			})
+		})
+
 		Describe("with a putget in an aggregate", func() {
 			var (
 				putPlan               atc.Plan
@@ -141,7 +205,7 @@ var _ = Describe("ExecEngine", func() {
 					build.Resume(logger)
 					Expect(fakeFactory.PutCallCount()).To(Equal(2))
 
-					logger, plan, build, stepMetadata, containerMetadata, _ := fakeFactory.PutArgsForCall(0)
+					logger, plan, build, stepMetadata, containerMetadata, _, _ := fakeFactory.PutArgsForCall(0)
 					Expect(logger).NotTo(BeNil())
 					Expect(build).To(Equal(dbBuild))
 					Expect(plan).To(Equal(putPlan))
@@ -157,7 +221,7 @@ var _ = Describe("ExecEngine", func() {
 						BuildName:    "42",
 					}))
 
-					logger, plan, build, stepMetadata, containerMetadata, _ = fakeFactory.PutArgsForCall(1)
+					logger, plan, build, stepMetadata, containerMetadata, _, _ = fakeFactory.PutArgsForCall(1)
 					Expect(logger).NotTo(BeNil())
 					Expect(build).To(Equal(dbBuild))
 					Expect(plan).To(Equal(otherPutPlan))
@@ -234,7 +298,7 @@ var _ = Describe("ExecEngine", func() {
 			})
 
 			It("constructs the first get correctly", func() {
-				logger, plan, build, stepMetadata, containerMetadata, _ := fakeFactory.GetArgsForCall(0)
+				logger, plan, build, stepMetadata, containerMetadata, _, _ := fakeFactory.GetArgsForCall(0)
 				Expect(logger).NotTo(BeNil())
 				Expect(build).To(Equal(dbBuild))
 				expectedPlan := getPlan
@@ -254,8 +318,17 @@ var _ = Describe("ExecEngine", func() {
 				}))
 			})
 
+			It("redacts the credentials resolved by the steps from the build's logs", func() {
+				Expect(fakeDelegateFactory.DelegateCallCount()).To(Equal(1))
+				delegateBuild, secrets := fakeDelegateFactory.DelegateArgsForCall(0)
+				Expect(delegateBuild).To(Equal(dbBuild))
+
+				_, _, _, _, _, _, state := fakeFactory.GetArgsForCall(0)
+				Expect(secrets).To(BeIdenticalTo(state.Secrets()))
+			})
+
 			It("constructs the second get correctly", func() {
-				logger, plan, build, stepMetadata, containerMetadata, _ := fakeFactory.GetArgsForCall(1)
+				logger, plan, build, stepMetadata, containerMetadata, _, _ := fakeFactory.GetArgsForCall(1)
 				Expect(logger).NotTo(BeNil())
 				Expect(build).To(Equal(dbBuild))
 				expectedPlan := getPlan
@@ -280,7 +353,7 @@ var _ = Describe("ExecEngine", func() {
 			})
 
 			It("constructs nested steps correctly", func() {
-				logger, plan, build, containerMetadata, _ := fakeFactory.TaskArgsForCall(0)
+				logger, plan, build, containerMetadata, _, _ := fakeFactory.TaskArgsForCall(0)
 				Expect(logger).NotTo(BeNil())
 				Expect(build).To(Equal(dbBuild))
 				expectedPlan := taskPlan
@@ -298,7 +371,7 @@ var _ = Describe("ExecEngine", func() {
 					Attempt:      "2.1",
 				}))
 
-				logger, plan, build, containerMetadata, _ = fakeFactory.TaskArgsForCall(1)
+				logger, plan, build, containerMetadata, _, _ = fakeFactory.TaskArgsForCall(1)
 				Expect(logger).NotTo(BeNil())
 				Expect(build).To(Equal(dbBuild))
 				expectedPlan = taskPlan
@@ -367,15 +440,15 @@ var _ = Describe("ExecEngine", func() {
 			})
 
 			It("constructs nested steps correctly", func() {
-				_, _, _, containerMetadata, _ := fakeFactory.TaskArgsForCall(0)
+				_, _, _, containerMetadata, _, _ := fakeFactory.TaskArgsForCall(0)
 				Expect(containerMetadata.Attempt).To(Equal("1"))
-				_, _, _, containerMetadata, _ = fakeFactory.TaskArgsForCall(1)
+				_, _, _, containerMetadata, _, _ = fakeFactory.TaskArgsForCall(1)
 				Expect(containerMetadata.Attempt).To(Equal("1"))
-				_, _, _, containerMetadata, _ = fakeFactory.TaskArgsForCall(2)
+				_, _, _, containerMetadata, _, _ = fakeFactory.TaskArgsForCall(2)
 				Expect(containerMetadata.Attempt).To(Equal("1"))
-				_, _, _, containerMetadata, _ = fakeFactory.TaskArgsForCall(3)
+				_, _, _, containerMetadata, _, _ = fakeFactory.TaskArgsForCall(3)
 				Expect(containerMetadata.Attempt).To(Equal("1"))
-				_, _, _, containerMetadata, _ = fakeFactory.TaskArgsForCall(4)
+				_, _, _, containerMetadata, _, _ = fakeFactory.TaskArgsForCall(4)
 				Expect(containerMetadata.Attempt).To(Equal("1"))
 			})
 		})
@@ -404,7 +477,7 @@ var _ = Describe("ExecEngine", func() {
 					build.Resume(logger)
 					Expect(fakeFactory.GetCallCount()).To(Equal(1))
 
-					logger, plan, dBuild, stepMetadata, containerMetadata, _ := fakeFactory.GetArgsForCall(0)
+					logger, plan, dBuild, stepMetadata, containerMetadata, _, _ := fakeFactory.GetArgsForCall(0)
 					Expect(logger).NotTo(BeNil())
 					Expect(dBuild).To(Equal(dbBuild))
 					Expect(plan).To(Equal(expectedPlan))
@@ -453,7 +526,7 @@ var _ = Describe("ExecEngine", func() {
 					build.Resume(logger)
 					Expect(fakeFactory.TaskCallCount()).To(Equal(1))
 
-					logger, plan, build, containerMetadata, _ := fakeFactory.TaskArgsForCall(0)
+					logger, plan, build, containerMetadata, _, _ := fakeFactory.TaskArgsForCall(0)
 					Expect(logger).NotTo(BeNil())
 					Expect(build).To(Equal(dbBuild))
 					Expect(plan).To(Equal(expectedPlan))
@@ -510,7 +583,7 @@ var _ = Describe("ExecEngine", func() {
 					build.Resume(logger)
 					Expect(fakeFactory.PutCallCount()).To(Equal(1))
 
-					logger, plan, build, stepMetadata, containerMetadata, _ := fakeFactory.PutArgsForCall(0)
+					logger, plan, build, stepMetadata, containerMetadata, _, _ := fakeFactory.PutArgsForCall(0)
 					Expect(logger).NotTo(BeNil())
 					Expect(build).To(Equal(dbBuild))
 					Expect(plan).To(Equal(putPlan))
@@ -535,7 +608,7 @@ var _ = Describe("ExecEngine", func() {
 					build.Resume(logger)
 					Expect(fakeFactory.GetCallCount()).To(Equal(1))
 
-					logger, plan, build, stepMetadata, containerMetadata, _ := fakeFactory.GetArgsForCall(0)
+					logger, plan, build, stepMetadata, containerMetadata, _, _ := fakeFactory.GetArgsForCall(0)
 					Expect(logger).NotTo(BeNil())
 					Expect(build).To(Equal(dbBuild))
 					Expect(plan).To(Equal(dependentGetPlan))
@@ -552,6 +625,53 @@ var _ = Describe("ExecEngine", func() {
 					}))
 				})
 			})
+
+			Context("that contains a run", func() {
+				var (
+					runPlan atc.Plan
+					runStep *execfakes.FakeStep
+				)
+
+				BeforeEach(func() {
+					runPlan = planFactory.NewPlan(atc.RunPlan{
+						Message: "delete-branch",
+						Type:    "git",
+						Source:  atc.Source{"some": "source"},
+						Params:  atc.Params{"some": "params"},
+					})
+
+					runStep = new(execfakes.FakeStep)
+					runStep.SucceededReturns(true)
+					fakeFactory.RunReturns(runStep)
+				})
+
+				It("constructs the run correctly", func() {
+					var err error
+					build, err = execEngine.CreateBuild(logger, dbBuild, runPlan)
+					Expect(err).NotTo(HaveOccurred())
+
+					build.Resume(logger)
+					Expect(fakeFactory.RunCallCount()).To(Equal(1))
+
+					logger, plan, build, stepMetadata, containerMetadata, _, _ := fakeFactory.RunArgsForCall(0)
+					Expect(logger).NotTo(BeNil())
+					Expect(build).To(Equal(dbBuild))
+					Expect(plan).To(Equal(runPlan))
+					Expect(stepMetadata).To(Equal(expectedMetadata))
+					Expect(containerMetadata).To(Equal(db.ContainerMetadata{
+						Type:         db.ContainerTypeRun,
+						StepName:     "delete-branch",
+						PipelineID:   expectedPipelineID,
+						PipelineName: "some-pipeline",
+						JobID:        expectedJobID,
+						JobName:      "some-job",
+						BuildID:      expectedBuildID,
+						BuildName:    "42",
+					}))
+
+					Expect(runStep.RunCallCount()).To(Equal(1))
+				})
+			})
 		})
 	})
 
@@ -602,7 +722,7 @@ var _ = Describe("ExecEngine", func() {
 
 				foundBuild.Resume(logger)
 				Expect(fakeFactory.GetCallCount()).To(Equal(1))
-				logger, plan, build, stepMetadata, containerMetadata, _ := fakeFactory.GetArgsForCall(0)
+				logger, plan, build, stepMetadata, containerMetadata, _, _ := fakeFactory.GetArgsForCall(0)
 				Expect(logger).NotTo(BeNil())
 				Expect(build).To(Equal(dbBuild))
 				Expect(plan.ID).To(Equal(atc.PlanID("47")))
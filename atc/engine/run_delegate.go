@@ -0,0 +1,48 @@
+package engine
+
+import (
+	"encoding/json"
+
+	"code.cloudfoundry.org/lager"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/event"
+	"github.com/concourse/concourse/atc/exec"
+)
+
+type runDelegate struct {
+	*BuildStepDelegate
+
+	build       db.Build
+	eventOrigin event.Origin
+}
+
+func NewRunDelegate(build db.Build, planID atc.PlanID, stepDelegate *BuildStepDelegate) exec.RunDelegate {
+	return &runDelegate{
+		BuildStepDelegate: stepDelegate,
+
+		build: build,
+		eventOrigin: event.Origin{
+			ID: event.OriginID(planID),
+		},
+	}
+}
+
+func (d *runDelegate) Finished(logger lager.Logger, exitStatus exec.ExitStatus, response json.RawMessage) {
+	d.Flush(logger)
+
+	err := d.build.SaveEvent(event.FinishRun{
+		Origin:     d.eventOrigin,
+		ExitStatus: int(exitStatus),
+		Response:   response,
+	})
+	if err != nil {
+		logger.Error("failed-to-save-finish-run-event", err)
+		return
+	}
+
+	logger.Info("finished", lager.Data{
+		"exit-status": exitStatus,
+	})
+}
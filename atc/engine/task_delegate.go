@@ -3,7 +3,6 @@ package engine
 import (
 	"time"
 
-	"code.cloudfoundry.org/clock"
 	"code.cloudfoundry.org/lager"
 
 	"github.com/concourse/concourse/atc"
@@ -13,15 +12,15 @@ import (
 )
 
 type taskDelegate struct {
-	exec.BuildStepDelegate
+	*BuildStepDelegate
 
 	build       db.Build
 	eventOrigin event.Origin
 }
 
-func NewTaskDelegate(build db.Build, planID atc.PlanID, clock clock.Clock) exec.TaskDelegate {
+func NewTaskDelegate(build db.Build, planID atc.PlanID, stepDelegate *BuildStepDelegate) exec.TaskDelegate {
 	return &taskDelegate{
-		BuildStepDelegate: NewBuildStepDelegate(build, planID, clock),
+		BuildStepDelegate: stepDelegate,
 
 		build: build,
 		eventOrigin: event.Origin{
@@ -58,7 +57,26 @@ func (d *taskDelegate) Starting(logger lager.Logger, taskConfig atc.TaskConfig)
 	logger.Debug("starting")
 }
 
+// Annotated records the annotations on the build, redacting credentials from
+// their values as they are shown to anyone who can see the build.
+func (d *taskDelegate) Annotated(logger lager.Logger, annotations map[string]string) {
+	redacted := make(map[string]string, len(annotations))
+	for key, value := range annotations {
+		redacted[key] = d.secrets.Redact(value)
+	}
+
+	err := d.build.SaveAnnotations(redacted)
+	if err != nil {
+		logger.Error("failed-to-save-annotations", err)
+		return
+	}
+
+	logger.Debug("annotated", lager.Data{"annotations": redacted})
+}
+
 func (d *taskDelegate) Finished(logger lager.Logger, exitStatus exec.ExitStatus) {
+	d.Flush(logger)
+
 	err := d.build.SaveEvent(event.FinishTask{
 		ExitStatus: int(exitStatus),
 		Time:       time.Now().Unix(),
@@ -1,6 +1,10 @@
 package event
 
-import "github.com/concourse/concourse/atc"
+import (
+	"encoding/json"
+
+	"github.com/concourse/concourse/atc"
+)
 
 type Error struct {
 	Message string `json:"message"`
@@ -129,3 +133,33 @@ type FinishPut struct {
 
 func (FinishPut) EventType() atc.EventType  { return EventTypeFinishPut }
 func (FinishPut) Version() atc.EventVersion { return "5.0" }
+
+type FinishRun struct {
+	Origin     Origin          `json:"origin"`
+	ExitStatus int             `json:"exit_status"`
+	Response   json.RawMessage `json:"response,omitempty"`
+}
+
+func (FinishRun) EventType() atc.EventType  { return EventTypeFinishRun }
+func (FinishRun) Version() atc.EventVersion { return "1.0" }
+
+type StartAcrossIteration struct {
+	Time   int64       `json:"time"`
+	Origin Origin      `json:"origin"`
+	Index  int         `json:"index"`
+	Var    string      `json:"var"`
+	Value  interface{} `json:"value"`
+}
+
+func (StartAcrossIteration) EventType() atc.EventType  { return EventTypeStartAcrossIteration }
+func (StartAcrossIteration) Version() atc.EventVersion { return "1.0" }
+
+type FinishAcrossIteration struct {
+	Time      int64  `json:"time"`
+	Origin    Origin `json:"origin"`
+	Index     int    `json:"index"`
+	Succeeded bool   `json:"succeeded"`
+}
+
+func (FinishAcrossIteration) EventType() atc.EventType  { return EventTypeFinishAcrossIteration }
+func (FinishAcrossIteration) Version() atc.EventVersion { return "1.0" }
@@ -38,9 +38,12 @@ func init() {
 	registerEvent(FinishTask{})
 	registerEvent(FinishGet{})
 	registerEvent(FinishPut{})
+	registerEvent(FinishRun{})
 	registerEvent(Status{})
 	registerEvent(Log{})
 	registerEvent(Error{})
+	registerEvent(StartAcrossIteration{})
+	registerEvent(FinishAcrossIteration{})
 
 	// deprecated:
 	registerEvent(InitializeV10{})
@@ -27,6 +27,15 @@ const (
 	// finished putting something
 	EventTypeFinishPut atc.EventType = "finish-put"
 
+	// finished running a resource type's message
+	EventTypeFinishRun atc.EventType = "finish-run"
+
 	// error occurred
 	EventTypeError atc.EventType = "error"
+
+	// iteration of an 'across' step started
+	EventTypeStartAcrossIteration atc.EventType = "start-across-iteration"
+
+	// iteration of an 'across' step finished
+	EventTypeFinishAcrossIteration atc.EventType = "finish-across-iteration"
 )
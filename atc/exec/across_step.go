@@ -0,0 +1,138 @@
+package exec
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/worker"
+)
+
+//go:generate counterfeiter . AcrossDelegate
+
+type AcrossDelegate interface {
+	IterationStarted(logger lager.Logger, index int, varName string, value interface{})
+	IterationFinished(logger lager.Logger, index int, succeeded bool)
+}
+
+// AcrossIterationStep is the step to run for one value of an across var.
+type AcrossIterationStep struct {
+	Value interface{}
+	Step  Step
+}
+
+// AcrossStep is a step that runs a step once for each value of a var.
+type AcrossStep struct {
+	varName     string
+	iterations  []AcrossIterationStep
+	maxInFlight int
+	delegate    AcrossDelegate
+}
+
+func Across(
+	varName string,
+	iterations []AcrossIterationStep,
+	maxInFlight int,
+	delegate AcrossDelegate,
+) Step {
+	if maxInFlight < 1 {
+		maxInFlight = 1
+	}
+
+	return &AcrossStep{
+		varName:     varName,
+		iterations:  iterations,
+		maxInFlight: maxInFlight,
+		delegate:    delegate,
+	}
+}
+
+// Run executes the iterations, running at most maxInFlight of them at a time.
+// Each iteration has its own scope of artifacts, so that iterations producing
+// artifacts of the same name do not overwrite each other's.
+//
+// Like AggregateStep, it will wait for all iterations to exit even if one of
+// them fails or errors, and aggregate their errors into a single error. No
+// further iterations are started once the context is canceled.
+func (step *AcrossStep) Run(ctx context.Context, state RunState) error {
+	logger := lagerctx.FromContext(ctx)
+
+	errs := make([]error, len(step.iterations))
+	slots := make(chan struct{}, step.maxInFlight)
+
+	wg := new(sync.WaitGroup)
+
+	for i, iteration := range step.iterations {
+		select {
+		case slots <- struct{}{}:
+		case <-ctx.Done():
+		}
+
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+
+		go func(i int, iteration AcrossIterationStep) {
+			defer wg.Done()
+			defer func() { <-slots }()
+
+			step.delegate.IterationStarted(logger, i, step.varName, iteration.Value)
+
+			errs[i] = iteration.Step.Run(ctx, iterationState{
+				RunState:  state,
+				artifacts: state.Artifacts().NewScope(),
+			})
+
+			step.delegate.IterationFinished(logger, i, errs[i] == nil && iteration.Step.Succeeded())
+		}(i, iteration)
+	}
+
+	wg.Wait()
+
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	var errorMessages []string
+	for _, err := range errs {
+		if err != nil {
+			errorMessages = append(errorMessages, err.Error())
+		}
+	}
+
+	if len(errorMessages) > 0 {
+		return fmt.Errorf("one or more across iterations errored:\n%s", strings.Join(errorMessages, "\n"))
+	}
+
+	return nil
+}
+
+// Succeeded is true if all of the iterations' Succeeded is true.
+func (step *AcrossStep) Succeeded() bool {
+	succeeded := true
+
+	for _, iteration := range step.iterations {
+		if !iteration.Step.Succeeded() {
+			succeeded = false
+		}
+	}
+
+	return succeeded
+}
+
+// iterationState is the RunState of a single iteration, which registers its
+// artifacts to its own scope.
+type iterationState struct {
+	RunState
+
+	artifacts *worker.ArtifactRepository
+}
+
+func (state iterationState) Artifacts() *worker.ArtifactRepository {
+	return state.artifacts
+}
@@ -0,0 +1,261 @@
+package exec_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	. "github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/exec/execfakes"
+	"github.com/concourse/concourse/atc/worker/workerfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AcrossStep", func() {
+	var (
+		ctx    context.Context
+		cancel func()
+
+		fakeStepA    *execfakes.FakeStep
+		fakeStepB    *execfakes.FakeStep
+		fakeDelegate *execfakes.FakeAcrossDelegate
+
+		state RunState
+
+		maxInFlight int
+
+		step    Step
+		stepErr error
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+
+		fakeStepA = new(execfakes.FakeStep)
+		fakeStepB = new(execfakes.FakeStep)
+		fakeDelegate = new(execfakes.FakeAcrossDelegate)
+
+		state = NewRunState()
+
+		maxInFlight = 1
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	JustBeforeEach(func() {
+		step = Across(
+			"some-var",
+			[]AcrossIterationStep{
+				{Value: "a", Step: fakeStepA},
+				{Value: "b", Step: fakeStepB},
+			},
+			maxInFlight,
+			fakeDelegate,
+		)
+
+		stepErr = step.Run(ctx, state)
+	})
+
+	It("runs each iteration with the run state", func() {
+		Expect(stepErr).ToNot(HaveOccurred())
+
+		Expect(fakeStepA.RunCallCount()).To(Equal(1))
+		_, runState := fakeStepA.RunArgsForCall(0)
+		Expect(runState.LocalVariables()).To(Equal(state.LocalVariables()))
+
+		Expect(fakeStepB.RunCallCount()).To(Equal(1))
+		_, runState = fakeStepB.RunArgsForCall(0)
+		Expect(runState.LocalVariables()).To(Equal(state.LocalVariables()))
+	})
+
+	Describe("artifacts", func() {
+		var (
+			inputSource   *workerfakes.FakeArtifactSource
+			outputSourceA *workerfakes.FakeArtifactSource
+			outputSourceB *workerfakes.FakeArtifactSource
+		)
+
+		BeforeEach(func() {
+			inputSource = new(workerfakes.FakeArtifactSource)
+			outputSourceA = new(workerfakes.FakeArtifactSource)
+			outputSourceB = new(workerfakes.FakeArtifactSource)
+
+			state.Artifacts().RegisterSource("some-input", inputSource)
+
+			fakeStepA.RunStub = func(_ context.Context, state RunState) error {
+				state.Artifacts().RegisterSource("some-output", outputSourceA)
+				return nil
+			}
+
+			fakeStepB.RunStub = func(_ context.Context, state RunState) error {
+				state.Artifacts().RegisterSource("some-output", outputSourceB)
+				return nil
+			}
+		})
+
+		It("gives each iteration the run state's artifacts", func() {
+			_, runState := fakeStepA.RunArgsForCall(0)
+			source, found := runState.Artifacts().SourceFor("some-input")
+			Expect(found).To(BeTrue())
+			Expect(source).To(Equal(inputSource))
+
+			_, runState = fakeStepB.RunArgsForCall(0)
+			source, found = runState.Artifacts().SourceFor("some-input")
+			Expect(found).To(BeTrue())
+			Expect(source).To(Equal(inputSource))
+		})
+
+		It("keeps the artifacts of each iteration apart", func() {
+			_, runState := fakeStepA.RunArgsForCall(0)
+			source, found := runState.Artifacts().SourceFor("some-output")
+			Expect(found).To(BeTrue())
+			Expect(source).To(Equal(outputSourceA))
+
+			_, runState = fakeStepB.RunArgsForCall(0)
+			source, found = runState.Artifacts().SourceFor("some-output")
+			Expect(found).To(BeTrue())
+			Expect(source).To(Equal(outputSourceB))
+
+			_, found = state.Artifacts().SourceFor("some-output")
+			Expect(found).To(BeFalse())
+		})
+	})
+
+	It("notifies the delegate as each iteration starts and finishes", func() {
+		Expect(fakeDelegate.IterationStartedCallCount()).To(Equal(2))
+		_, index, varName, value := fakeDelegate.IterationStartedArgsForCall(0)
+		Expect(index).To(Equal(0))
+		Expect(varName).To(Equal("some-var"))
+		Expect(value).To(Equal("a"))
+
+		_, index, varName, value = fakeDelegate.IterationStartedArgsForCall(1)
+		Expect(index).To(Equal(1))
+		Expect(varName).To(Equal("some-var"))
+		Expect(value).To(Equal("b"))
+
+		Expect(fakeDelegate.IterationFinishedCallCount()).To(Equal(2))
+	})
+
+	Context("when max in flight is 1", func() {
+		BeforeEach(func() {
+			running := make(chan struct{}, 2)
+
+			run := func(context.Context, RunState) error {
+				running <- struct{}{}
+				defer func() { <-running }()
+
+				Expect(len(running)).To(Equal(1))
+				time.Sleep(10 * time.Millisecond)
+				return nil
+			}
+
+			fakeStepA.RunStub = run
+			fakeStepB.RunStub = run
+		})
+
+		It("runs the iterations one at a time", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+			Expect(fakeStepA.RunCallCount()).To(Equal(1))
+			Expect(fakeStepB.RunCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when max in flight allows all iterations", func() {
+		BeforeEach(func() {
+			maxInFlight = 2
+
+			wg := new(sync.WaitGroup)
+			wg.Add(2)
+
+			run := func(context.Context, RunState) error {
+				wg.Done()
+				wg.Wait()
+				return nil
+			}
+
+			fakeStepA.RunStub = run
+			fakeStepB.RunStub = run
+		})
+
+		It("runs the iterations in parallel", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+		})
+	})
+
+	Describe("Succeeded", func() {
+		Context("when all iterations succeed", func() {
+			BeforeEach(func() {
+				fakeStepA.SucceededReturns(true)
+				fakeStepB.SucceededReturns(true)
+			})
+
+			It("returns true", func() {
+				Expect(step.Succeeded()).To(BeTrue())
+			})
+
+			It("reports each iteration as succeeded", func() {
+				_, _, succeeded := fakeDelegate.IterationFinishedArgsForCall(0)
+				Expect(succeeded).To(BeTrue())
+				_, _, succeeded = fakeDelegate.IterationFinishedArgsForCall(1)
+				Expect(succeeded).To(BeTrue())
+			})
+		})
+
+		Context("when an iteration fails", func() {
+			BeforeEach(func() {
+				fakeStepA.SucceededReturns(true)
+				fakeStepB.SucceededReturns(false)
+			})
+
+			It("still runs every iteration", func() {
+				Expect(fakeStepA.RunCallCount()).To(Equal(1))
+				Expect(fakeStepB.RunCallCount()).To(Equal(1))
+			})
+
+			It("returns false", func() {
+				Expect(step.Succeeded()).To(BeFalse())
+			})
+
+			It("reports the iteration as failed", func() {
+				_, index, succeeded := fakeDelegate.IterationFinishedArgsForCall(1)
+				Expect(index).To(Equal(1))
+				Expect(succeeded).To(BeFalse())
+			})
+		})
+	})
+
+	Context("when iterations error", func() {
+		BeforeEach(func() {
+			fakeStepA.RunReturns(errors.New("nope A"))
+			fakeStepB.RunReturns(errors.New("nope B"))
+		})
+
+		It("aggregates the errors", func() {
+			Expect(stepErr).To(HaveOccurred())
+			Expect(stepErr.Error()).To(ContainSubstring("nope A"))
+			Expect(stepErr.Error()).To(ContainSubstring("nope B"))
+		})
+	})
+
+	Context("when the context is canceled during an iteration", func() {
+		BeforeEach(func() {
+			fakeStepA.RunStub = func(context.Context, RunState) error {
+				cancel()
+				return context.Canceled
+			}
+		})
+
+		It("does not start further iterations", func() {
+			Expect(fakeStepB.RunCallCount()).To(BeZero())
+		})
+
+		It("returns the context error", func() {
+			Expect(stepErr).To(Equal(context.Canceled))
+		})
+	})
+})
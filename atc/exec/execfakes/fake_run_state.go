@@ -6,6 +6,7 @@ import (
 	sync "sync"
 
 	atc "github.com/concourse/concourse/atc"
+	creds "github.com/concourse/concourse/atc/creds"
 	exec "github.com/concourse/concourse/atc/exec"
 	worker "github.com/concourse/concourse/atc/worker"
 )
@@ -21,6 +22,16 @@ type FakeRunState struct {
 	artifactsReturnsOnCall map[int]struct {
 		result1 *worker.ArtifactRepository
 	}
+	LocalVariablesStub        func() *creds.LocalVariables
+	localVariablesMutex       sync.RWMutex
+	localVariablesArgsForCall []struct {
+	}
+	localVariablesReturns struct {
+		result1 *creds.LocalVariables
+	}
+	localVariablesReturnsOnCall map[int]struct {
+		result1 *creds.LocalVariables
+	}
 	ReadPlanOutputStub        func(atc.PlanID, io.Writer)
 	readPlanOutputMutex       sync.RWMutex
 	readPlanOutputArgsForCall []struct {
@@ -51,6 +62,16 @@ type FakeRunState struct {
 	resultReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	SecretsStub        func() *creds.SecretTracker
+	secretsMutex       sync.RWMutex
+	secretsArgsForCall []struct {
+	}
+	secretsReturns struct {
+		result1 *creds.SecretTracker
+	}
+	secretsReturnsOnCall map[int]struct {
+		result1 *creds.SecretTracker
+	}
 	SendPlanOutputStub        func(atc.PlanID, exec.OutputHandler) error
 	sendPlanOutputMutex       sync.RWMutex
 	sendPlanOutputArgsForCall []struct {
@@ -121,6 +142,48 @@ func (fake *FakeRunState) ArtifactsReturnsOnCall(i int, result1 *worker.Artifact
 	}{result1}
 }
 
+func (fake *FakeRunState) LocalVariables() *creds.LocalVariables {
+	fake.localVariablesMutex.Lock()
+	ret, specificReturn := fake.localVariablesReturnsOnCall[len(fake.localVariablesArgsForCall)]
+	fake.localVariablesArgsForCall = append(fake.localVariablesArgsForCall, struct {
+	}{})
+	fake.recordInvocation("LocalVariables", []interface{}{})
+	fake.localVariablesMutex.Unlock()
+	if fake.LocalVariablesStub != nil {
+		return fake.LocalVariablesStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.localVariablesReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeRunState) LocalVariablesCallCount() int {
+	fake.localVariablesMutex.RLock()
+	defer fake.localVariablesMutex.RUnlock()
+	return len(fake.localVariablesArgsForCall)
+}
+
+func (fake *FakeRunState) LocalVariablesReturns(result1 *creds.LocalVariables) {
+	fake.LocalVariablesStub = nil
+	fake.localVariablesReturns = struct {
+		result1 *creds.LocalVariables
+	}{result1}
+}
+
+func (fake *FakeRunState) LocalVariablesReturnsOnCall(i int, result1 *creds.LocalVariables) {
+	fake.LocalVariablesStub = nil
+	if fake.localVariablesReturnsOnCall == nil {
+		fake.localVariablesReturnsOnCall = make(map[int]struct {
+			result1 *creds.LocalVariables
+		})
+	}
+	fake.localVariablesReturnsOnCall[i] = struct {
+		result1 *creds.LocalVariables
+	}{result1}
+}
+
 func (fake *FakeRunState) ReadPlanOutput(arg1 atc.PlanID, arg2 io.Writer) {
 	fake.readPlanOutputMutex.Lock()
 	fake.readPlanOutputArgsForCall = append(fake.readPlanOutputArgsForCall, struct {
@@ -249,6 +312,48 @@ func (fake *FakeRunState) ResultReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *FakeRunState) Secrets() *creds.SecretTracker {
+	fake.secretsMutex.Lock()
+	ret, specificReturn := fake.secretsReturnsOnCall[len(fake.secretsArgsForCall)]
+	fake.secretsArgsForCall = append(fake.secretsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Secrets", []interface{}{})
+	fake.secretsMutex.Unlock()
+	if fake.SecretsStub != nil {
+		return fake.SecretsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.secretsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeRunState) SecretsCallCount() int {
+	fake.secretsMutex.RLock()
+	defer fake.secretsMutex.RUnlock()
+	return len(fake.secretsArgsForCall)
+}
+
+func (fake *FakeRunState) SecretsReturns(result1 *creds.SecretTracker) {
+	fake.SecretsStub = nil
+	fake.secretsReturns = struct {
+		result1 *creds.SecretTracker
+	}{result1}
+}
+
+func (fake *FakeRunState) SecretsReturnsOnCall(i int, result1 *creds.SecretTracker) {
+	fake.SecretsStub = nil
+	if fake.secretsReturnsOnCall == nil {
+		fake.secretsReturnsOnCall = make(map[int]struct {
+			result1 *creds.SecretTracker
+		})
+	}
+	fake.secretsReturnsOnCall[i] = struct {
+		result1 *creds.SecretTracker
+	}{result1}
+}
+
 func (fake *FakeRunState) SendPlanOutput(arg1 atc.PlanID, arg2 exec.OutputHandler) error {
 	fake.sendPlanOutputMutex.Lock()
 	ret, specificReturn := fake.sendPlanOutputReturnsOnCall[len(fake.sendPlanOutputArgsForCall)]
@@ -357,12 +462,16 @@ func (fake *FakeRunState) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.artifactsMutex.RLock()
 	defer fake.artifactsMutex.RUnlock()
+	fake.localVariablesMutex.RLock()
+	defer fake.localVariablesMutex.RUnlock()
 	fake.readPlanOutputMutex.RLock()
 	defer fake.readPlanOutputMutex.RUnlock()
 	fake.readUserInputMutex.RLock()
 	defer fake.readUserInputMutex.RUnlock()
 	fake.resultMutex.RLock()
 	defer fake.resultMutex.RUnlock()
+	fake.secretsMutex.RLock()
+	defer fake.secretsMutex.RUnlock()
 	fake.sendPlanOutputMutex.RLock()
 	defer fake.sendPlanOutputMutex.RUnlock()
 	fake.sendUserInputMutex.RLock()
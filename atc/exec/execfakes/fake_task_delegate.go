@@ -12,6 +12,12 @@ import (
 )
 
 type FakeTaskDelegate struct {
+	AnnotatedStub        func(lager.Logger, map[string]string)
+	annotatedMutex       sync.RWMutex
+	annotatedArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 map[string]string
+	}
 	ErroredStub        func(lager.Logger, string)
 	erroredMutex       sync.RWMutex
 	erroredArgsForCall []struct {
@@ -71,6 +77,32 @@ type FakeTaskDelegate struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeTaskDelegate) Annotated(arg1 lager.Logger, arg2 map[string]string) {
+	fake.annotatedMutex.Lock()
+	fake.annotatedArgsForCall = append(fake.annotatedArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 map[string]string
+	}{arg1, arg2})
+	fake.recordInvocation("Annotated", []interface{}{arg1, arg2})
+	fake.annotatedMutex.Unlock()
+	if fake.AnnotatedStub != nil {
+		fake.AnnotatedStub(arg1, arg2)
+	}
+}
+
+func (fake *FakeTaskDelegate) AnnotatedCallCount() int {
+	fake.annotatedMutex.RLock()
+	defer fake.annotatedMutex.RUnlock()
+	return len(fake.annotatedArgsForCall)
+}
+
+func (fake *FakeTaskDelegate) AnnotatedArgsForCall(i int) (lager.Logger, map[string]string) {
+	fake.annotatedMutex.RLock()
+	defer fake.annotatedMutex.RUnlock()
+	argsForCall := fake.annotatedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
 func (fake *FakeTaskDelegate) Errored(arg1 lager.Logger, arg2 string) {
 	fake.erroredMutex.Lock()
 	fake.erroredArgsForCall = append(fake.erroredArgsForCall, struct {
@@ -312,6 +344,8 @@ func (fake *FakeTaskDelegate) StdoutReturnsOnCall(i int, result1 io.Writer) {
 func (fake *FakeTaskDelegate) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.annotatedMutex.RLock()
+	defer fake.annotatedMutex.RUnlock()
 	fake.erroredMutex.RLock()
 	defer fake.erroredMutex.RUnlock()
 	fake.finishedMutex.RLock()
@@ -20,6 +20,7 @@ type Factory interface {
 		StepMetadata,
 		db.ContainerMetadata,
 		GetDelegate,
+		RunState,
 	) Step
 
 	// Put constructs a Put step.
@@ -30,6 +31,7 @@ type Factory interface {
 		StepMetadata,
 		db.ContainerMetadata,
 		PutDelegate,
+		RunState,
 	) Step
 
 	// Task constructs a Task step.
@@ -39,6 +41,27 @@ type Factory interface {
 		db.Build,
 		db.ContainerMetadata,
 		TaskDelegate,
+		RunState,
+	) Step
+
+	// Run constructs a Run step.
+	Run(
+		lager.Logger,
+		atc.Plan,
+		db.Build,
+		StepMetadata,
+		db.ContainerMetadata,
+		RunDelegate,
+		RunState,
+	) Step
+
+	// SetPipeline constructs a SetPipeline step.
+	SetPipeline(
+		lager.Logger,
+		atc.Plan,
+		db.Build,
+		BuildStepDelegate,
+		RunState,
 	) Step
 }
 
@@ -15,12 +15,14 @@ import (
 )
 
 type gardenFactory struct {
-	workerClient           worker.Client
-	resourceFetcher        resource.Fetcher
-	resourceFactory        resource.ResourceFactory
-	dbResourceCacheFactory db.ResourceCacheFactory
-	variablesFactory       creds.VariablesFactory
-	defaultLimits          atc.ContainerLimits
+	workerClient             worker.Client
+	resourceFetcher          resource.Fetcher
+	resourceFactory          resource.ResourceFactory
+	dbResourceCacheFactory   db.ResourceCacheFactory
+	dbTaskOutputCacheFactory db.TaskOutputCacheFactory
+	variablesFactory         creds.VariablesFactory
+	teamFactory              db.TeamFactory
+	defaultLimits            atc.ContainerLimits
 }
 
 func NewGardenFactory(
@@ -28,16 +30,20 @@ func NewGardenFactory(
 	resourceFetcher resource.Fetcher,
 	resourceFactory resource.ResourceFactory,
 	dbResourceCacheFactory db.ResourceCacheFactory,
+	dbTaskOutputCacheFactory db.TaskOutputCacheFactory,
 	variablesFactory creds.VariablesFactory,
+	teamFactory db.TeamFactory,
 	defaultLimits atc.ContainerLimits,
 ) Factory {
 	return &gardenFactory{
-		workerClient:           workerClient,
-		resourceFetcher:        resourceFetcher,
-		resourceFactory:        resourceFactory,
-		dbResourceCacheFactory: dbResourceCacheFactory,
-		variablesFactory:       variablesFactory,
-		defaultLimits:          defaultLimits,
+		workerClient:             workerClient,
+		resourceFetcher:          resourceFetcher,
+		resourceFactory:          resourceFactory,
+		dbResourceCacheFactory:   dbResourceCacheFactory,
+		dbTaskOutputCacheFactory: dbTaskOutputCacheFactory,
+		variablesFactory:         variablesFactory,
+		teamFactory:              teamFactory,
+		defaultLimits:            defaultLimits,
 	}
 }
 
@@ -48,10 +54,11 @@ func (factory *gardenFactory) Get(
 	stepMetadata StepMetadata,
 	workerMetadata db.ContainerMetadata,
 	delegate GetDelegate,
+	state RunState,
 ) Step {
 	workerMetadata.WorkingDirectory = resource.ResourcesDir("get")
 
-	variables := factory.variablesFactory.NewVariables(build.TeamName(), build.PipelineName())
+	variables := factory.buildVariables(build, state)
 
 	getStep := NewGetStep(
 		build,
@@ -61,7 +68,8 @@ func (factory *gardenFactory) Get(
 		plan.Get.Resource,
 		creds.NewSource(variables, plan.Get.Source),
 		creds.NewParams(variables, plan.Get.Params),
-		NewVersionSourceFromPlan(plan.Get),
+		plan.Get.Space,
+		NewVersionSourceFromPlan(plan.Get, build),
 		plan.Get.Tags,
 
 		delegate,
@@ -86,10 +94,11 @@ func (factory *gardenFactory) Put(
 	stepMetadata StepMetadata,
 	workerMetadata db.ContainerMetadata,
 	delegate PutDelegate,
+	state RunState,
 ) Step {
 	workerMetadata.WorkingDirectory = resource.ResourcesDir("put")
 
-	variables := factory.variablesFactory.NewVariables(build.TeamName(), build.PipelineName())
+	variables := factory.buildVariables(build, state)
 
 	putStep := NewPutStep(
 		build,
@@ -99,6 +108,7 @@ func (factory *gardenFactory) Put(
 		plan.Put.Resource,
 		creds.NewSource(variables, plan.Put.Source),
 		creds.NewParams(variables, plan.Put.Params),
+		plan.Put.Space,
 		plan.Put.Tags,
 
 		delegate,
@@ -113,12 +123,44 @@ func (factory *gardenFactory) Put(
 	return LogError(putStep, delegate)
 }
 
+func (factory *gardenFactory) Run(
+	logger lager.Logger,
+	plan atc.Plan,
+	build db.Build,
+	stepMetadata StepMetadata,
+	workerMetadata db.ContainerMetadata,
+	delegate RunDelegate,
+	state RunState,
+) Step {
+	workerMetadata.WorkingDirectory = resource.ResourcesDir("run")
+
+	variables := factory.buildVariables(build, state)
+
+	runStep := NewRunStep(
+		build,
+		*plan.Run,
+		creds.NewSource(variables, plan.Run.Source),
+		creds.NewParams(variables, plan.Run.Params),
+
+		delegate,
+		factory.resourceFactory,
+		plan.ID,
+		workerMetadata,
+		stepMetadata,
+
+		creds.NewVersionedResourceTypes(variables, plan.Run.VersionedResourceTypes),
+	)
+
+	return LogError(runStep, delegate)
+}
+
 func (factory *gardenFactory) Task(
 	logger lager.Logger,
 	plan atc.Plan,
 	build db.Build,
 	containerMetadata db.ContainerMetadata,
 	delegate TaskDelegate,
+	state RunState,
 ) Step {
 	workingDirectory := factory.taskWorkingDirectory(worker.ArtifactName(plan.Task.Name))
 	containerMetadata.WorkingDirectory = workingDirectory
@@ -137,7 +179,7 @@ func (factory *gardenFactory) Task(
 
 	taskConfigSource = ValidatingConfigSource{ConfigSource: taskConfigSource}
 
-	variables := factory.variablesFactory.NewVariables(build.TeamName(), build.PipelineName())
+	variables := factory.buildVariables(build, state)
 
 	taskStep := NewTaskStep(
 		Privileged(plan.Task.Privileged),
@@ -145,6 +187,8 @@ func (factory *gardenFactory) Task(
 		plan.Task.Tags,
 		plan.Task.InputMapping,
 		plan.Task.OutputMapping,
+		plan.Task.Artifacts,
+		plan.Task.CacheOutputs,
 
 		workingDirectory,
 		plan.Task.ImageArtifactName,
@@ -152,6 +196,7 @@ func (factory *gardenFactory) Task(
 		delegate,
 
 		factory.workerClient,
+		factory.dbTaskOutputCacheFactory,
 		build.TeamID(),
 		build.ID(),
 		build.JobID(),
@@ -167,6 +212,35 @@ func (factory *gardenFactory) Task(
 	return LogError(taskStep, delegate)
 }
 
+func (factory *gardenFactory) SetPipeline(
+	logger lager.Logger,
+	plan atc.Plan,
+	build db.Build,
+	delegate BuildStepDelegate,
+	state RunState,
+) Step {
+	variables := factory.buildVariables(build, state)
+
+	setPipelineStep := NewSetPipelineStep(
+		plan.ID,
+		*plan.SetPipeline,
+		creds.NewParams(variables, plan.SetPipeline.Vars),
+		build,
+		delegate,
+		factory.teamFactory,
+	)
+
+	return LogError(setPipelineStep, delegate)
+}
+
+func (factory *gardenFactory) buildVariables(build db.Build, state RunState) creds.Variables {
+	return creds.NewBuildVariables(
+		factory.variablesFactory.NewVariables(build.TeamName(), build.PipelineName()),
+		state.LocalVariables(),
+		state.Secrets(),
+	)
+}
+
 func (factory *gardenFactory) taskWorkingDirectory(sourceName worker.ArtifactName) string {
 	sum := sha1.Sum([]byte(sourceName))
 	return filepath.Join("/tmp", "build", fmt.Sprintf("%x", sum[:4]))
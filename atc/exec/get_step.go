@@ -5,6 +5,7 @@ import (
 	"compress/gzip"
 	"context"
 	"io"
+	"strconv"
 
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagerctx"
@@ -12,6 +13,7 @@ import (
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/resource"
+	"github.com/concourse/concourse/atc/tracing"
 	"github.com/concourse/concourse/atc/worker"
 )
 
@@ -33,6 +35,7 @@ type GetStep struct {
 	resource      string
 	source        creds.Source
 	params        creds.Params
+	space         string
 	versionSource VersionSource
 	tags          atc.Tags
 
@@ -59,6 +62,7 @@ func NewGetStep(
 	resource string,
 	source creds.Source,
 	params creds.Params,
+	space string,
 	versionSource VersionSource,
 	tags atc.Tags,
 
@@ -82,6 +86,7 @@ func NewGetStep(
 		resource:      resource,
 		source:        source,
 		params:        params,
+		space:         space,
 		versionSource: versionSource,
 		tags:          tags,
 
@@ -123,6 +128,20 @@ func NewGetStep(
 // At the end, the resulting ArtifactSource (either from using the cache or
 // fetching the resource) is registered under the step's SourceName.
 func (step *GetStep) Run(ctx context.Context, state RunState) error {
+	ctx, span := tracing.StartSpan(ctx, "get", tracing.Attrs{
+		"name":          step.name,
+		"resource":      step.resource,
+		"resource_type": step.resourceType,
+	})
+
+	err := step.run(ctx, state)
+
+	tracing.End(span, err)
+
+	return err
+}
+
+func (step *GetStep) run(ctx context.Context, state RunState) error {
 	logger := lagerctx.FromContext(ctx)
 
 	version, err := step.versionSource.Version(state)
@@ -159,6 +178,7 @@ func (step *GetStep) Run(ctx context.Context, state RunState) error {
 		version,
 		source,
 		params,
+		step.space,
 		step.resourceTypes,
 		resourceCache,
 		db.NewBuildStepContainerOwner(step.buildID, step.planID),
@@ -194,7 +214,9 @@ func (step *GetStep) Run(ctx context.Context, state RunState) error {
 		versionedSource:  versionedSource,
 	})
 
-	if step.resource != "" {
+	// the pipeline only tracks the default space, so versions from other
+	// spaces are not recorded as build inputs
+	if step.resource != "" && step.space == "" {
 		err := step.build.SaveInput(db.BuildInput{
 			Name: step.name,
 			VersionedResource: db.VersionedResource{
@@ -237,6 +259,18 @@ func (s *getArtifactSource) VolumeOn(worker worker.Worker) (worker.Volume, bool,
 	return s.resourceInstance.FindOn(s.logger.Session("volume-on"), worker)
 }
 
+// Identity identifies the fetched bits by their resource cache, which is
+// unique to the resource's type, source, params and version.
+func (s *getArtifactSource) Identity() string {
+	return "resource-cache:" + strconv.Itoa(s.resourceInstance.ResourceCache().ID())
+}
+
+// ResourceCache returns the resource cache holding the fetched bits, so that
+// copies streamed to other workers can be reused.
+func (s *getArtifactSource) ResourceCache() db.UsedResourceCache {
+	return s.resourceInstance.ResourceCache()
+}
+
 // StreamTo streams the resource's data to the destination.
 func (s *getArtifactSource) StreamTo(destination worker.ArtifactDestination) error {
 	out, err := s.versionedSource.StreamOut(".")
@@ -81,6 +81,8 @@ var _ = Describe("GetStep", func() {
 		artifactRepository = worker.NewArtifactRepository()
 		state = new(execfakes.FakeRunState)
 		state.ArtifactsReturns(artifactRepository)
+		state.LocalVariablesReturns(creds.NewLocalVariables())
+		state.SecretsReturns(creds.NewSecretTracker())
 
 		fakeVersionedSource = new(resourcefakes.FakeVersionedSource)
 		fakeResourceFetcher.FetchReturns(fakeVersionedSource, nil)
@@ -112,7 +114,7 @@ var _ = Describe("GetStep", func() {
 			VersionedResourceTypes: resourceTypes,
 		}
 
-		factory = exec.NewGardenFactory(fakeWorkerClient, fakeResourceFetcher, fakeResourceFactory, fakeDBResourceCacheFactory, fakeVariablesFactory, atc.ContainerLimits{})
+		factory = exec.NewGardenFactory(fakeWorkerClient, fakeResourceFetcher, fakeResourceFactory, fakeDBResourceCacheFactory, new(dbfakes.FakeTaskOutputCacheFactory), fakeVariablesFactory, new(dbfakes.FakeTeamFactory), atc.ContainerLimits{})
 
 		fakeDelegate = new(execfakes.FakeGetDelegate)
 	})
@@ -132,6 +134,7 @@ var _ = Describe("GetStep", func() {
 			stepMetadata,
 			containerMetadata,
 			fakeDelegate,
+			state,
 		)
 
 		stepErr = getStep.Run(ctx, state)
@@ -154,16 +157,19 @@ var _ = Describe("GetStep", func() {
 		}))
 		Expect(tags).To(ConsistOf("some", "tags"))
 		Expect(actualTeamID).To(Equal(teamID))
+
+		buildVariables := creds.NewBuildVariables(variables, state.LocalVariables(), state.Secrets())
 		Expect(resourceInstance).To(Equal(resource.NewResourceInstance(
 			"some-resource-type",
 			atc.Version{"some-version": "some-value"},
 			atc.Source{"some": "super-secret-source"},
 			atc.Params{"some-param": "some-value"},
-			creds.NewVersionedResourceTypes(variables, resourceTypes),
+			"",
+			creds.NewVersionedResourceTypes(buildVariables, resourceTypes),
 			nil,
 			db.NewBuildStepContainerOwner(buildID, atc.PlanID(planID)),
 		)))
-		Expect(actualResourceTypes).To(Equal(creds.NewVersionedResourceTypes(variables, resourceTypes)))
+		Expect(actualResourceTypes).To(Equal(creds.NewVersionedResourceTypes(buildVariables, resourceTypes)))
 		Expect(delegate).To(Equal(fakeDelegate))
 		expectedLockName := fmt.Sprintf("%x",
 			sha256.Sum256([]byte(
@@ -0,0 +1,188 @@
+package exec
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
+	"gopkg.in/yaml.v2"
+)
+
+// LoadVarStep reads a file from the worker.ArtifactRepository and sets its
+// contents as a build-local var, which subsequent steps refer to as
+// ((.:name)).
+type LoadVarStep struct {
+	planID   atc.PlanID
+	plan     atc.LoadVarPlan
+	delegate BuildStepDelegate
+
+	succeeded bool
+}
+
+func NewLoadVarStep(
+	planID atc.PlanID,
+	plan atc.LoadVarPlan,
+	delegate BuildStepDelegate,
+) *LoadVarStep {
+	return &LoadVarStep{
+		planID:   planID,
+		plan:     plan,
+		delegate: delegate,
+	}
+}
+
+// Run reads and parses the var file and adds the var to the RunState's local
+// vars.
+//
+// The file is parsed according to the plan's format, or by its extension if
+// no format is given: .json files as JSON, .yml and .yaml files as YAML, and
+// anything else as a raw string with any trailing newline removed.
+func (step *LoadVarStep) Run(ctx context.Context, state RunState) error {
+	logger := lagerctx.FromContext(ctx).WithData(lager.Data{
+		"plan-id": step.planID,
+		"var":     step.plan.Name,
+	})
+
+	payload, err := readArtifactFile(state.Artifacts(), step.plan.File)
+	if err != nil {
+		return err
+	}
+
+	format := step.format()
+
+	value, err := parseVarFile(payload, format)
+	if err != nil {
+		logger.Error("failed-to-parse-var-file", err)
+		return fmt.Errorf("failed to parse %s as %s: %s", step.plan.File, format, err)
+	}
+
+	state.LocalVariables().Set(step.plan.Name, value)
+
+	if step.plan.Sensitive {
+		state.Secrets().Track(value)
+	}
+
+	shown := creds.RedactedValue
+	if !step.plan.Sensitive {
+		shown, err = formatVarValue(value)
+		if err != nil {
+			return err
+		}
+	}
+
+	fmt.Fprintf(step.delegate.Stdout(), "loaded var '%s': %s\n", step.plan.Name, shown)
+
+	step.succeeded = true
+
+	return nil
+}
+
+// Succeeded returns true if the var was loaded.
+func (step *LoadVarStep) Succeeded() bool {
+	return step.succeeded
+}
+
+func (step *LoadVarStep) format() string {
+	if step.plan.Format != "" {
+		return step.plan.Format
+	}
+
+	switch filepath.Ext(step.plan.File) {
+	case ".json":
+		return "json"
+	case ".yml", ".yaml":
+		return "yaml"
+	default:
+		return "raw"
+	}
+}
+
+func parseVarFile(payload []byte, format string) (interface{}, error) {
+	switch format {
+	case "raw":
+		return strings.TrimSuffix(string(payload), "\n"), nil
+
+	case "json":
+		var value interface{}
+		err := json.Unmarshal(payload, &value)
+		if err != nil {
+			return nil, err
+		}
+
+		return value, nil
+
+	case "yaml":
+		var value interface{}
+		err := yaml.Unmarshal(payload, &value)
+		if err != nil {
+			return nil, err
+		}
+
+		return stringifyKeys(value)
+
+	default:
+		return nil, fmt.Errorf("unknown format '%s'", format)
+	}
+}
+
+// stringifyKeys converts the maps produced by YAML parsing into the
+// string-keyed maps produced by JSON parsing, so that the value can be
+// interpolated the same way regardless of the file's format.
+func stringifyKeys(value interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case map[interface{}]interface{}:
+		stringified := map[string]interface{}{}
+
+		for key, val := range v {
+			str, ok := key.(string)
+			if !ok {
+				return nil, errors.New("non-string key")
+			}
+
+			sub, err := stringifyKeys(val)
+			if err != nil {
+				return nil, err
+			}
+
+			stringified[str] = sub
+		}
+
+		return stringified, nil
+
+	case []interface{}:
+		stringified := make([]interface{}, len(v))
+		for i, val := range v {
+			sub, err := stringifyKeys(val)
+			if err != nil {
+				return nil, err
+			}
+
+			stringified[i] = sub
+		}
+
+		return stringified, nil
+
+	default:
+		return v, nil
+	}
+}
+
+func formatVarValue(value interface{}) (string, error) {
+	if str, ok := value.(string); ok {
+		return str, nil
+	}
+
+	payload, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+
+	return string(payload), nil
+}
@@ -0,0 +1,209 @@
+package exec_test
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	"github.com/concourse/baggageclaim"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/exec/execfakes"
+	"github.com/concourse/concourse/atc/worker"
+	"github.com/concourse/concourse/atc/worker/workerfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("LoadVarStep", func() {
+	var (
+		ctx    context.Context
+		cancel func()
+
+		fakeDelegate        *execfakes.FakeBuildStepDelegate
+		fakeArtifactSource  *workerfakes.FakeArtifactSource
+		loadVarPlan         atc.LoadVarPlan
+		repo                *worker.ArtifactRepository
+		localVars           *creds.LocalVariables
+		secrets             *creds.SecretTracker
+		state               *execfakes.FakeRunState
+		streamedFileContent map[string]string
+
+		stdoutBuf *gbytes.Buffer
+
+		step    exec.Step
+		stepErr error
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+
+		fakeDelegate = new(execfakes.FakeBuildStepDelegate)
+		stdoutBuf = gbytes.NewBuffer()
+		fakeDelegate.StdoutReturns(stdoutBuf)
+
+		streamedFileContent = map[string]string{
+			"version":      "1.2.345\n",
+			"image.json":   `{"digest":"sha256:abc","tags":["latest"]}`,
+			"release.yml":  "name: some-release\nversion: 4\n",
+			"invalid.json": "{",
+		}
+
+		fakeArtifactSource = new(workerfakes.FakeArtifactSource)
+		fakeArtifactSource.StreamFileStub = func(path string) (io.ReadCloser, error) {
+			content, found := streamedFileContent[path]
+			if !found {
+				return nil, baggageclaim.ErrFileNotFound
+			}
+
+			return ioutil.NopCloser(strings.NewReader(content)), nil
+		}
+
+		repo = worker.NewArtifactRepository()
+		repo.RegisterSource("some-repo", fakeArtifactSource)
+
+		localVars = creds.NewLocalVariables()
+		secrets = creds.NewSecretTracker()
+
+		state = new(execfakes.FakeRunState)
+		state.ArtifactsReturns(repo)
+		state.LocalVariablesReturns(localVars)
+		state.SecretsReturns(secrets)
+
+		loadVarPlan = atc.LoadVarPlan{
+			Name: "some-var",
+			File: "some-repo/version",
+		}
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	JustBeforeEach(func() {
+		step = exec.NewLoadVarStep(
+			atc.PlanID("some-plan-id"),
+			loadVarPlan,
+			fakeDelegate,
+		)
+
+		stepErr = step.Run(ctx, state)
+	})
+
+	Context("when the file has no known extension", func() {
+		It("loads the contents as a raw string without the trailing newline", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+
+			value, found := localVars.Get("some-var")
+			Expect(found).To(BeTrue())
+			Expect(value).To(Equal("1.2.345"))
+		})
+
+		It("succeeds", func() {
+			Expect(step.Succeeded()).To(BeTrue())
+		})
+
+		It("logs the loaded value", func() {
+			Expect(stdoutBuf).To(gbytes.Say("loaded var 'some-var': 1.2.345"))
+		})
+	})
+
+	Context("when the file is JSON", func() {
+		BeforeEach(func() {
+			loadVarPlan.File = "some-repo/image.json"
+		})
+
+		It("loads the parsed value", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+
+			value, found := localVars.Get("some-var")
+			Expect(found).To(BeTrue())
+			Expect(value).To(Equal(map[string]interface{}{
+				"digest": "sha256:abc",
+				"tags":   []interface{}{"latest"},
+			}))
+		})
+
+		Context("when the format is given as raw", func() {
+			BeforeEach(func() {
+				loadVarPlan.Format = "raw"
+			})
+
+			It("loads the contents as a string", func() {
+				value, _ := localVars.Get("some-var")
+				Expect(value).To(Equal(`{"digest":"sha256:abc","tags":["latest"]}`))
+			})
+		})
+	})
+
+	Context("when the file is YAML", func() {
+		BeforeEach(func() {
+			loadVarPlan.File = "some-repo/release.yml"
+		})
+
+		It("loads the parsed value with string keys", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+
+			value, found := localVars.Get("some-var")
+			Expect(found).To(BeTrue())
+			Expect(value).To(Equal(map[string]interface{}{
+				"name":    "some-release",
+				"version": 4,
+			}))
+		})
+	})
+
+	Context("when the file cannot be parsed", func() {
+		BeforeEach(func() {
+			loadVarPlan.File = "some-repo/invalid.json"
+		})
+
+		It("errors without setting the var", func() {
+			Expect(stepErr).To(HaveOccurred())
+			Expect(stepErr.Error()).To(ContainSubstring("failed to parse some-repo/invalid.json as json"))
+
+			_, found := localVars.Get("some-var")
+			Expect(found).To(BeFalse())
+		})
+	})
+
+	Context("when the file does not exist", func() {
+		BeforeEach(func() {
+			loadVarPlan.File = "some-repo/missing"
+		})
+
+		It("errors", func() {
+			Expect(stepErr).To(MatchError("file 'some-repo/missing' not found"))
+			Expect(step.Succeeded()).To(BeFalse())
+		})
+	})
+
+	Context("when the var is sensitive", func() {
+		BeforeEach(func() {
+			loadVarPlan.Sensitive = true
+		})
+
+		It("still loads the value", func() {
+			value, _ := localVars.Get("some-var")
+			Expect(value).To(Equal("1.2.345"))
+		})
+
+		It("redacts the value from the log", func() {
+			Expect(stdoutBuf).To(gbytes.Say(`loaded var 'some-var': \(\(redacted\)\)`))
+			Expect(stdoutBuf.Contents()).ToNot(ContainSubstring("1.2.345"))
+		})
+
+		It("redacts the value from the logs of subsequent steps", func() {
+			Expect(secrets.Redact("version 1.2.345")).To(Equal("version ((redacted))"))
+		})
+	})
+
+	Context("when the var is not sensitive", func() {
+		It("does not redact the value from the logs of subsequent steps", func() {
+			Expect(secrets.Redact("version 1.2.345")).To(Equal("version 1.2.345"))
+		})
+	})
+})
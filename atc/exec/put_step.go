@@ -9,6 +9,7 @@ import (
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/resource"
+	"github.com/concourse/concourse/atc/tracing"
 	"github.com/concourse/concourse/atc/worker"
 )
 
@@ -29,6 +30,7 @@ type PutStep struct {
 	resourceType string
 	source       creds.Source
 	params       creds.Params
+	space        string
 	tags         atc.Tags
 
 	resource string
@@ -52,6 +54,7 @@ func NewPutStep(
 	resourceName string,
 	source creds.Source,
 	params creds.Params,
+	space string,
 	tags atc.Tags,
 	delegate PutDelegate,
 	resourceFactory resource.ResourceFactory,
@@ -68,6 +71,7 @@ func NewPutStep(
 		resource:          resourceName,
 		source:            source,
 		params:            params,
+		space:             space,
 		tags:              tags,
 		delegate:          delegate,
 		resourceFactory:   resourceFactory,
@@ -87,6 +91,20 @@ func NewPutStep(
 // The resource's put script is then invoked. If the context is canceled, the
 // script will be interrupted.
 func (step *PutStep) Run(ctx context.Context, state RunState) error {
+	ctx, span := tracing.StartSpan(ctx, "put", tracing.Attrs{
+		"name":          step.name,
+		"resource":      step.resource,
+		"resource_type": step.resourceType,
+	})
+
+	err := step.run(ctx, state)
+
+	tracing.End(span, err)
+
+	return err
+}
+
+func (step *PutStep) run(ctx context.Context, state RunState) error {
 	logger := lagerctx.FromContext(ctx)
 
 	containerSpec := worker.ContainerSpec{
@@ -139,6 +157,7 @@ func (step *PutStep) Run(ctx context.Context, state RunState) error {
 		},
 		source,
 		params,
+		step.space,
 	)
 
 	if err != nil {
@@ -157,7 +176,9 @@ func (step *PutStep) Run(ctx context.Context, state RunState) error {
 		Metadata: versionedSource.Metadata(),
 	}
 
-	if step.resource != "" {
+	// the pipeline only tracks the default space; versions put to other
+	// spaces are found by the resource's next check
+	if step.resource != "" && step.space == "" {
 		err = step.build.SaveOutput(
 			db.VersionedResource{
 				Resource: step.resource,
@@ -28,6 +28,7 @@ var _ = Describe("PutStep", func() {
 		fakeBuild *dbfakes.FakeBuild
 
 		pipelineResourceName string
+		space                string
 
 		fakeResourceFactory *resourcefakes.FakeResourceFactory
 		variables           creds.Variables
@@ -63,6 +64,7 @@ var _ = Describe("PutStep", func() {
 		planID = atc.PlanID("some-plan-id")
 
 		pipelineResourceName = "some-resource"
+		space = ""
 
 		fakeResourceFactory = new(resourcefakes.FakeResourceFactory)
 		variables = template.StaticVariables{
@@ -106,6 +108,7 @@ var _ = Describe("PutStep", func() {
 			pipelineResourceName,
 			creds.NewSource(variables, atc.Source{"some": "((source-param))"}),
 			creds.NewParams(variables, atc.Params{"some-param": "some-value"}),
+			space,
 			[]string{"some", "tags"},
 			fakeDelegate,
 			fakeResourceFactory,
@@ -181,14 +184,14 @@ var _ = Describe("PutStep", func() {
 
 			It("puts the resource with the given context", func() {
 				Expect(fakeResource.PutCallCount()).To(Equal(1))
-				putCtx, _, _, _ := fakeResource.PutArgsForCall(0)
+				putCtx, _, _, _, _ := fakeResource.PutArgsForCall(0)
 				Expect(putCtx).To(Equal(ctx))
 			})
 
 			It("puts the resource with the correct source and params", func() {
 				Expect(fakeResource.PutCallCount()).To(Equal(1))
 
-				_, _, putSource, putParams := fakeResource.PutArgsForCall(0)
+				_, _, putSource, putParams, _ := fakeResource.PutArgsForCall(0)
 				Expect(putSource).To(Equal(atc.Source{"some": "super-secret-source"}))
 				Expect(putParams).To(Equal(atc.Params{"some-param": "some-value"}))
 			})
@@ -196,7 +199,7 @@ var _ = Describe("PutStep", func() {
 			It("puts the resource with the io config forwarded", func() {
 				Expect(fakeResource.PutCallCount()).To(Equal(1))
 
-				_, ioConfig, _, _ := fakeResource.PutArgsForCall(0)
+				_, ioConfig, _, _, _ := fakeResource.PutArgsForCall(0)
 				Expect(ioConfig.Stdout).To(Equal(stdoutBuf))
 				Expect(ioConfig.Stderr).To(Equal(stderrBuf))
 			})
@@ -227,6 +230,21 @@ var _ = Describe("PutStep", func() {
 				}))
 			})
 
+			Context("when putting to a space", func() {
+				BeforeEach(func() {
+					space = "some-space"
+				})
+
+				It("puts the resource to the space", func() {
+					_, _, _, _, putSpace := fakeResource.PutArgsForCall(0)
+					Expect(putSpace).To(Equal("some-space"))
+				})
+
+				It("does not save the build output, as the pipeline only tracks the default space", func() {
+					Expect(fakeBuild.SaveOutputCallCount()).To(Equal(0))
+				})
+			})
+
 			Context("when the resource is blank", func() {
 				BeforeEach(func() {
 					pipelineResourceName = ""
@@ -6,11 +6,14 @@ import (
 	"sync"
 
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/worker"
 )
 
 type runState struct {
 	artifacts *worker.ArtifactRepository
+	localVars *creds.LocalVariables
+	secrets   *creds.SecretTracker
 	results   *sync.Map
 	inputs    *sync.Map
 	outputs   *sync.Map
@@ -19,6 +22,8 @@ type runState struct {
 func NewRunState() RunState {
 	return &runState{
 		artifacts: worker.NewArtifactRepository(),
+		localVars: creds.NewLocalVariables(),
+		secrets:   creds.NewSecretTracker(),
 		results:   &sync.Map{},
 		inputs:    &sync.Map{},
 		outputs:   &sync.Map{},
@@ -29,6 +34,14 @@ func (state *runState) Artifacts() *worker.ArtifactRepository {
 	return state.artifacts
 }
 
+func (state *runState) LocalVariables() *creds.LocalVariables {
+	return state.localVars
+}
+
+func (state *runState) Secrets() *creds.SecretTracker {
+	return state.secrets
+}
+
 func (state *runState) Result(id atc.PlanID, to interface{}) bool {
 	val, ok := state.results.Load(id)
 	if !ok {
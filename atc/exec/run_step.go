@@ -0,0 +1,231 @@
+package exec
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/resource"
+	"github.com/concourse/concourse/atc/tracing"
+	"github.com/concourse/concourse/atc/worker"
+)
+
+//go:generate counterfeiter . RunDelegate
+
+type RunDelegate interface {
+	BuildStepDelegate
+
+	Finished(lager.Logger, ExitStatus, json.RawMessage)
+}
+
+// RunStep runs one of a resource type's messages, e.g. deleting a branch or
+// promoting an image, without producing a version.
+type RunStep struct {
+	build db.Build
+
+	plan   atc.RunPlan
+	source creds.Source
+	params creds.Params
+
+	delegate          RunDelegate
+	resourceFactory   resource.ResourceFactory
+	planID            atc.PlanID
+	containerMetadata db.ContainerMetadata
+	stepMetadata      StepMetadata
+
+	resourceTypes creds.VersionedResourceTypes
+
+	succeeded bool
+}
+
+func NewRunStep(
+	build db.Build,
+	plan atc.RunPlan,
+	source creds.Source,
+	params creds.Params,
+	delegate RunDelegate,
+	resourceFactory resource.ResourceFactory,
+	planID atc.PlanID,
+	containerMetadata db.ContainerMetadata,
+	stepMetadata StepMetadata,
+	resourceTypes creds.VersionedResourceTypes,
+) *RunStep {
+	return &RunStep{
+		build: build,
+
+		plan:              plan,
+		source:            source,
+		params:            params,
+		delegate:          delegate,
+		resourceFactory:   resourceFactory,
+		planID:            planID,
+		containerMetadata: containerMetadata,
+		stepMetadata:      stepMetadata,
+		resourceTypes:     resourceTypes,
+	}
+}
+
+// Run creates a container from the resource type's image with the step's
+// inputs, and invokes the script for the message. The JSON it responds with is
+// written to the build log, and its outputs are registered as artifacts.
+func (step *RunStep) Run(ctx context.Context, state RunState) error {
+	ctx, span := tracing.StartSpan(ctx, "run", tracing.Attrs{
+		"message":       step.plan.Message,
+		"resource":      step.plan.Resource,
+		"resource_type": step.plan.Type,
+	})
+
+	err := step.run(ctx, state)
+
+	tracing.End(span, err)
+
+	return err
+}
+
+func (step *RunStep) run(ctx context.Context, state RunState) error {
+	logger := lagerctx.FromContext(ctx)
+
+	containerSpec := worker.ContainerSpec{
+		ImageSpec: worker.ImageSpec{
+			ResourceType: step.plan.Type,
+		},
+		Tags:   step.plan.Tags,
+		TeamID: step.build.TeamID(),
+
+		Dir: resource.ResourcesDir("run"),
+
+		Env: step.stepMetadata.Env(),
+
+		Outputs: worker.OutputPaths{},
+	}
+
+	var missingInputs []string
+	for _, input := range step.plan.Inputs {
+		artifactName := input
+		if sourceName, ok := step.plan.InputMapping[input]; ok {
+			artifactName = sourceName
+		}
+
+		source, found := state.Artifacts().SourceFor(worker.ArtifactName(artifactName))
+		if !found {
+			missingInputs = append(missingInputs, artifactName)
+			continue
+		}
+
+		containerSpec.Inputs = append(containerSpec.Inputs, &runInputSource{
+			name:   input,
+			source: source,
+		})
+	}
+
+	if len(missingInputs) > 0 {
+		return MissingInputsError{missingInputs}
+	}
+
+	for _, output := range step.plan.Outputs {
+		containerSpec.Outputs[output] = runArtifactPath(output)
+	}
+
+	runResource, err := step.resourceFactory.NewResource(
+		ctx,
+		logger,
+		db.NewBuildStepContainerOwner(step.build.ID(), step.planID),
+		step.containerMetadata,
+		containerSpec,
+		step.resourceTypes,
+		step.delegate,
+	)
+	if err != nil {
+		return err
+	}
+
+	source, err := step.source.Evaluate()
+	if err != nil {
+		return err
+	}
+
+	params, err := step.params.Evaluate()
+	if err != nil {
+		return err
+	}
+
+	response, err := runResource.Run(
+		ctx,
+		resource.IOConfig{
+			Stdout: step.delegate.Stdout(),
+			Stderr: step.delegate.Stderr(),
+		},
+		step.plan.Message,
+		source,
+		params,
+	)
+	if err != nil {
+		logger.Error("failed-to-run-resource", err)
+
+		if err, ok := err.(resource.ErrResourceScriptFailed); ok {
+			step.delegate.Finished(logger, ExitStatus(err.ExitStatus), nil)
+			return nil
+		}
+
+		return err
+	}
+
+	var pretty bytes.Buffer
+	err = json.Indent(&pretty, response, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(step.delegate.Stdout(), "%s\n", pretty.Bytes())
+
+	step.registerOutputs(logger, state.Artifacts(), runResource.Container())
+
+	step.succeeded = true
+
+	step.delegate.Finished(logger, 0, response)
+
+	return nil
+}
+
+// Succeeded returns true if the message's script exited successfully.
+func (step *RunStep) Succeeded() bool {
+	return step.succeeded
+}
+
+func (step *RunStep) registerOutputs(logger lager.Logger, repository *worker.ArtifactRepository, container worker.Container) {
+	volumeMounts := container.VolumeMounts()
+
+	for _, output := range step.plan.Outputs {
+		outputName := output
+		if destinationName, ok := step.plan.OutputMapping[output]; ok {
+			outputName = destinationName
+		}
+
+		for _, mount := range volumeMounts {
+			if mount.MountPath == runArtifactPath(output) {
+				repository.RegisterSource(worker.ArtifactName(outputName), newTaskArtifactSource(logger, mount.Volume))
+			}
+		}
+	}
+}
+
+func runArtifactPath(name string) string {
+	return resource.ResourcesDir("run/" + name)
+}
+
+type runInputSource struct {
+	name   string
+	source worker.ArtifactSource
+}
+
+func (s *runInputSource) Source() worker.ArtifactSource { return s.source }
+
+func (s *runInputSource) DestinationPath() string {
+	return runArtifactPath(s.name)
+}
@@ -0,0 +1,252 @@
+package exec_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/cloudfoundry/bosh-cli/director/template"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/exec/execfakes"
+	"github.com/concourse/concourse/atc/resource"
+	"github.com/concourse/concourse/atc/resource/resourcefakes"
+	"github.com/concourse/concourse/atc/worker"
+	"github.com/concourse/concourse/atc/worker/workerfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("RunStep", func() {
+	var (
+		ctx    context.Context
+		cancel func()
+
+		fakeBuild *dbfakes.FakeBuild
+
+		fakeResourceFactory *resourcefakes.FakeResourceFactory
+		fakeResource        *resourcefakes.FakeResource
+		fakeContainer       *workerfakes.FakeContainer
+		variables           creds.Variables
+
+		stepMetadata testMetadata = []string{"a=1", "b=2"}
+
+		containerMetadata = db.ContainerMetadata{
+			Type:     db.ContainerTypeRun,
+			StepName: "some-message",
+		}
+		planID       atc.PlanID
+		fakeDelegate *execfakes.FakeRunDelegate
+
+		runPlan       atc.RunPlan
+		resourceTypes creds.VersionedResourceTypes
+
+		repo  *worker.ArtifactRepository
+		state *execfakes.FakeRunState
+
+		stdoutBuf *gbytes.Buffer
+		stderrBuf *gbytes.Buffer
+
+		runStep *exec.RunStep
+		stepErr error
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+
+		fakeBuild = new(dbfakes.FakeBuild)
+		fakeBuild.IDReturns(42)
+		fakeBuild.TeamIDReturns(123)
+
+		planID = atc.PlanID("some-plan-id")
+
+		fakeResourceFactory = new(resourcefakes.FakeResourceFactory)
+		fakeResource = new(resourcefakes.FakeResource)
+		fakeContainer = new(workerfakes.FakeContainer)
+		fakeResource.ContainerReturns(fakeContainer)
+		fakeResource.RunReturns(json.RawMessage(`{"some":"response"}`), nil)
+		fakeResourceFactory.NewResourceReturns(fakeResource, nil)
+
+		variables = template.StaticVariables{
+			"source-param": "super-secret-source",
+		}
+
+		fakeDelegate = new(execfakes.FakeRunDelegate)
+		stdoutBuf = gbytes.NewBuffer()
+		stderrBuf = gbytes.NewBuffer()
+		fakeDelegate.StdoutReturns(stdoutBuf)
+		fakeDelegate.StderrReturns(stderrBuf)
+
+		repo = worker.NewArtifactRepository()
+		state = new(execfakes.FakeRunState)
+		state.ArtifactsReturns(repo)
+
+		runPlan = atc.RunPlan{
+			Message: "some-message",
+			Type:    "some-resource-type",
+			Source:  atc.Source{"some": "((source-param))"},
+			Params:  atc.Params{"some-param": "some-value"},
+			Tags:    atc.Tags{"some", "tags"},
+		}
+
+		resourceTypes = creds.NewVersionedResourceTypes(variables, atc.VersionedResourceTypes{})
+
+		stepErr = nil
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	JustBeforeEach(func() {
+		runStep = exec.NewRunStep(
+			fakeBuild,
+			runPlan,
+			creds.NewSource(variables, runPlan.Source),
+			creds.NewParams(variables, runPlan.Params),
+			fakeDelegate,
+			fakeResourceFactory,
+			planID,
+			containerMetadata,
+			stepMetadata,
+			resourceTypes,
+		)
+
+		stepErr = runStep.Run(ctx, state)
+	})
+
+	It("initializes the resource with the correct type and container spec", func() {
+		Expect(fakeResourceFactory.NewResourceCallCount()).To(Equal(1))
+
+		_, _, owner, cm, containerSpec, actualResourceTypes, delegate := fakeResourceFactory.NewResourceArgsForCall(0)
+		Expect(cm).To(Equal(containerMetadata))
+		Expect(owner).To(Equal(db.NewBuildStepContainerOwner(42, planID)))
+		Expect(containerSpec.ImageSpec).To(Equal(worker.ImageSpec{
+			ResourceType: "some-resource-type",
+		}))
+		Expect(containerSpec.Tags).To(Equal([]string{"some", "tags"}))
+		Expect(containerSpec.TeamID).To(Equal(123))
+		Expect(containerSpec.Env).To(Equal([]string{"a=1", "b=2"}))
+		Expect(containerSpec.Dir).To(Equal("/tmp/build/run"))
+		Expect(containerSpec.Inputs).To(BeEmpty())
+		Expect(actualResourceTypes).To(Equal(resourceTypes))
+		Expect(delegate).To(Equal(fakeDelegate))
+	})
+
+	It("runs the message with the evaluated source and params", func() {
+		Expect(fakeResource.RunCallCount()).To(Equal(1))
+
+		runCtx, ioConfig, message, source, params := fakeResource.RunArgsForCall(0)
+		Expect(runCtx).To(Equal(ctx))
+		Expect(ioConfig.Stdout).To(Equal(stdoutBuf))
+		Expect(ioConfig.Stderr).To(Equal(stderrBuf))
+		Expect(message).To(Equal("some-message"))
+		Expect(source).To(Equal(atc.Source{"some": "super-secret-source"}))
+		Expect(params).To(Equal(atc.Params{"some-param": "some-value"}))
+	})
+
+	It("writes the response to the build log", func() {
+		Expect(stdoutBuf).To(gbytes.Say(`"some": "response"`))
+	})
+
+	It("finishes with the response", func() {
+		Expect(fakeDelegate.FinishedCallCount()).To(Equal(1))
+
+		_, exitStatus, response := fakeDelegate.FinishedArgsForCall(0)
+		Expect(exitStatus).To(Equal(exec.ExitStatus(0)))
+		Expect(string(response)).To(Equal(`{"some":"response"}`))
+	})
+
+	It("succeeds", func() {
+		Expect(stepErr).NotTo(HaveOccurred())
+		Expect(runStep.Succeeded()).To(BeTrue())
+	})
+
+	Context("with inputs and outputs", func() {
+		var (
+			fakeSource       *workerfakes.FakeArtifactSource
+			fakeOutputVolume *workerfakes.FakeVolume
+		)
+
+		BeforeEach(func() {
+			fakeSource = new(workerfakes.FakeArtifactSource)
+			repo.RegisterSource("built-image", fakeSource)
+
+			runPlan.Inputs = []string{"image"}
+			runPlan.InputMapping = map[string]string{"image": "built-image"}
+			runPlan.Outputs = []string{"digest"}
+			runPlan.OutputMapping = map[string]string{"digest": "promoted-digest"}
+
+			fakeOutputVolume = new(workerfakes.FakeVolume)
+			fakeContainer.VolumeMountsReturns([]worker.VolumeMount{
+				{
+					Volume:    fakeOutputVolume,
+					MountPath: "/tmp/build/run/digest",
+				},
+			})
+		})
+
+		It("mounts the mapped inputs and the outputs", func() {
+			_, _, _, _, containerSpec, _, _ := fakeResourceFactory.NewResourceArgsForCall(0)
+			Expect(containerSpec.Inputs).To(HaveLen(1))
+			Expect(containerSpec.Inputs[0].Source()).To(Equal(fakeSource))
+			Expect(containerSpec.Inputs[0].DestinationPath()).To(Equal("/tmp/build/run/image"))
+			Expect(containerSpec.Outputs).To(Equal(worker.OutputPaths{
+				"digest": "/tmp/build/run/digest",
+			}))
+		})
+
+		It("registers the mapped outputs", func() {
+			_, found := repo.SourceFor("promoted-digest")
+			Expect(found).To(BeTrue())
+		})
+
+		Context("when an input is missing", func() {
+			BeforeEach(func() {
+				runPlan.InputMapping = map[string]string{"image": "bogus"}
+			})
+
+			It("returns a MissingInputsError", func() {
+				Expect(stepErr).To(Equal(exec.MissingInputsError{Inputs: []string{"bogus"}}))
+			})
+		})
+	})
+
+	Context("when the script exits nonzero", func() {
+		BeforeEach(func() {
+			fakeResource.RunReturns(nil, resource.ErrResourceScriptFailed{
+				ExitStatus: 42,
+			})
+		})
+
+		It("finishes with the exit status", func() {
+			Expect(fakeDelegate.FinishedCallCount()).To(Equal(1))
+
+			_, exitStatus, response := fakeDelegate.FinishedArgsForCall(0)
+			Expect(exitStatus).To(Equal(exec.ExitStatus(42)))
+			Expect(response).To(BeNil())
+		})
+
+		It("does not error, but does not succeed", func() {
+			Expect(stepErr).NotTo(HaveOccurred())
+			Expect(runStep.Succeeded()).To(BeFalse())
+		})
+	})
+
+	Context("when running the message fails", func() {
+		disaster := errors.New("oh no")
+
+		BeforeEach(func() {
+			fakeResource.RunReturns(nil, disaster)
+		})
+
+		It("returns the error", func() {
+			Expect(stepErr).To(Equal(disaster))
+			Expect(fakeDelegate.FinishedCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -0,0 +1,201 @@
+package exec
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/cloudfoundry/bosh-cli/director/template"
+	"github.com/concourse/baggageclaim"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/worker"
+	"gopkg.in/yaml.v2"
+)
+
+// SetPipelineStep configures a pipeline belonging to the build's team using a
+// config file fetched from the worker.ArtifactRepository.
+type SetPipelineStep struct {
+	planID   atc.PlanID
+	plan     atc.SetPipelinePlan
+	vars     creds.Params
+	build    db.Build
+	delegate BuildStepDelegate
+
+	teamFactory db.TeamFactory
+
+	succeeded bool
+}
+
+func NewSetPipelineStep(
+	planID atc.PlanID,
+	plan atc.SetPipelinePlan,
+	vars creds.Params,
+	build db.Build,
+	delegate BuildStepDelegate,
+	teamFactory db.TeamFactory,
+) *SetPipelineStep {
+	return &SetPipelineStep{
+		planID:      planID,
+		plan:        plan,
+		vars:        vars,
+		build:       build,
+		delegate:    delegate,
+		teamFactory: teamFactory,
+	}
+}
+
+// Run reads the pipeline config and any var files out of the
+// worker.ArtifactRepository, interpolates the vars into the config, and saves
+// it as the named pipeline of the build's team.
+//
+// If the resulting config is invalid, the errors are written to stderr and the
+// step fails. The pipeline is only created or updated if it is valid.
+//
+// Pipelines created by this step are not paused, and record the job and build
+// that configured them.
+func (step *SetPipelineStep) Run(ctx context.Context, state RunState) error {
+	logger := lagerctx.FromContext(ctx).WithData(lager.Data{
+		"plan-id":  step.planID,
+		"pipeline": step.plan.Name,
+	})
+
+	stdout := step.delegate.Stdout()
+	stderr := step.delegate.Stderr()
+
+	config, err := step.fetchConfig(state.Artifacts())
+	if err != nil {
+		return err
+	}
+
+	warnings, errorMessages := config.Validate()
+	for _, warning := range warnings {
+		fmt.Fprintf(stderr, "WARNING: %s\n", warning.Message)
+	}
+
+	if len(errorMessages) > 0 {
+		fmt.Fprintln(stderr, "invalid pipeline config:")
+		for _, message := range errorMessages {
+			fmt.Fprintf(stderr, "  - %s\n", message)
+		}
+
+		return nil
+	}
+
+	team := step.teamFactory.GetByID(step.build.TeamID())
+
+	fromVersion := db.ConfigVersion(0)
+	pausedState := db.PipelineUnpaused
+
+	pipelineRef := atc.PipelineRef{Name: step.plan.Name}
+
+	existing, found, err := team.Pipeline(pipelineRef)
+	if err != nil {
+		return err
+	}
+
+	if found {
+		fromVersion = existing.ConfigVersion()
+		pausedState = db.PipelineNoChange
+	}
+
+	fmt.Fprintf(stdout, "setting pipeline: %s\n", step.plan.Name)
+
+	// saving through the build records its job and itself as the pipeline's
+	// parents along with the config
+	_, _, err = step.build.SavePipeline(pipelineRef, config, fromVersion, pausedState)
+	if err != nil {
+		logger.Error("failed-to-save-pipeline", err)
+		return err
+	}
+
+	fmt.Fprintln(stdout, "done")
+
+	step.succeeded = true
+
+	return nil
+}
+
+// Succeeded returns true if the pipeline config was valid and saved.
+func (step *SetPipelineStep) Succeeded() bool {
+	return step.succeeded
+}
+
+func (step *SetPipelineStep) fetchConfig(repo *worker.ArtifactRepository) (atc.Config, error) {
+	configPayload, err := readArtifactFile(repo, step.plan.File)
+	if err != nil {
+		return atc.Config{}, err
+	}
+
+	params, err := step.vars.Evaluate()
+	if err != nil {
+		return atc.Config{}, err
+	}
+
+	staticVars := template.StaticVariables{}
+	for name, value := range params {
+		staticVars[name] = value
+	}
+
+	vars := []template.Variables{staticVars}
+	for i := len(step.plan.VarFiles) - 1; i >= 0; i-- {
+		payload, err := readArtifactFile(repo, step.plan.VarFiles[i])
+		if err != nil {
+			return atc.Config{}, err
+		}
+
+		var fileVars template.StaticVariables
+		err = yaml.Unmarshal(payload, &fileVars)
+		if err != nil {
+			return atc.Config{}, fmt.Errorf("failed to load %s: %s", step.plan.VarFiles[i], err)
+		}
+
+		vars = append(vars, fileVars)
+	}
+
+	evaluated, err := template.NewTemplate(configPayload).Evaluate(template.NewMultiVars(vars), nil, template.EvaluateOpts{})
+	if err != nil {
+		return atc.Config{}, fmt.Errorf("failed to interpolate %s: %s", step.plan.File, err)
+	}
+
+	var config atc.Config
+	err = yaml.Unmarshal(evaluated, &config)
+	if err != nil {
+		return atc.Config{}, fmt.Errorf("failed to load %s: %s", step.plan.File, err)
+	}
+
+	return config, nil
+}
+
+// readArtifactFile reads a file from the worker.ArtifactRepository, given a
+// path in the format SOURCE_NAME/FILE/PATH.
+func readArtifactFile(repo *worker.ArtifactRepository, path string) ([]byte, error) {
+	segs := strings.SplitN(path, "/", 2)
+	if len(segs) != 2 {
+		return nil, UnspecifiedArtifactSourceError{path}
+	}
+
+	sourceName := worker.ArtifactName(segs[0])
+	filePath := segs[1]
+
+	source, found := repo.SourceFor(sourceName)
+	if !found {
+		return nil, UnknownArtifactSourceError{sourceName, path}
+	}
+
+	stream, err := source.StreamFile(filePath)
+	if err != nil {
+		if err == baggageclaim.ErrFileNotFound {
+			return nil, fmt.Errorf("file '%s/%s' not found", sourceName, filePath)
+		}
+		return nil, err
+	}
+
+	defer stream.Close()
+
+	return ioutil.ReadAll(stream)
+}
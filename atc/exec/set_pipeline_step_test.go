@@ -0,0 +1,272 @@
+package exec_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	"github.com/cloudfoundry/bosh-cli/director/template"
+	"github.com/concourse/baggageclaim"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/exec/execfakes"
+	"github.com/concourse/concourse/atc/worker"
+	"github.com/concourse/concourse/atc/worker/workerfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("SetPipelineStep", func() {
+	const pipelineConfig = `
+resources:
+- name: some-repo
+  type: git
+  source:
+    uri: ((uri))
+    branch: ((branch))
+
+jobs:
+- name: some-job
+  plan:
+  - get: some-repo
+`
+
+	var (
+		ctx    context.Context
+		cancel func()
+
+		fakeBuild           *dbfakes.FakeBuild
+		fakeTeamFactory     *dbfakes.FakeTeamFactory
+		fakeTeam            *dbfakes.FakeTeam
+		fakePipeline        *dbfakes.FakePipeline
+		fakeDelegate        *execfakes.FakeBuildStepDelegate
+		fakeArtifactSource  *workerfakes.FakeArtifactSource
+		setPipelinePlan     atc.SetPipelinePlan
+		variables           creds.Variables
+		repo                *worker.ArtifactRepository
+		state               *execfakes.FakeRunState
+		streamedFileContent map[string]string
+
+		stdoutBuf *gbytes.Buffer
+		stderrBuf *gbytes.Buffer
+
+		step    exec.Step
+		stepErr error
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+
+		fakeBuild = new(dbfakes.FakeBuild)
+		fakeBuild.TeamIDReturns(123)
+
+		fakePipeline = new(dbfakes.FakePipeline)
+
+		fakeBuild.SavePipelineReturns(fakePipeline, true, nil)
+
+		fakeTeam = new(dbfakes.FakeTeam)
+
+		fakeTeamFactory = new(dbfakes.FakeTeamFactory)
+		fakeTeamFactory.GetByIDReturns(fakeTeam)
+
+		fakeDelegate = new(execfakes.FakeBuildStepDelegate)
+		stdoutBuf = gbytes.NewBuffer()
+		stderrBuf = gbytes.NewBuffer()
+		fakeDelegate.StdoutReturns(stdoutBuf)
+		fakeDelegate.StderrReturns(stderrBuf)
+
+		streamedFileContent = map[string]string{
+			"pipeline.yml": pipelineConfig,
+			"vars.yml":     "uri: git://some-uri\nbranch: develop\n",
+		}
+
+		fakeArtifactSource = new(workerfakes.FakeArtifactSource)
+		fakeArtifactSource.StreamFileStub = func(path string) (io.ReadCloser, error) {
+			content, found := streamedFileContent[path]
+			if !found {
+				return nil, baggageclaim.ErrFileNotFound
+			}
+
+			return ioutil.NopCloser(strings.NewReader(content)), nil
+		}
+
+		repo = worker.NewArtifactRepository()
+		repo.RegisterSource("some-repo", fakeArtifactSource)
+
+		state = new(execfakes.FakeRunState)
+		state.ArtifactsReturns(repo)
+
+		variables = template.StaticVariables{
+			"branch-secret": "master",
+		}
+
+		setPipelinePlan = atc.SetPipelinePlan{
+			Name:     "some-pipeline",
+			File:     "some-repo/pipeline.yml",
+			VarFiles: []string{"some-repo/vars.yml"},
+		}
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	JustBeforeEach(func() {
+		step = exec.NewSetPipelineStep(
+			atc.PlanID("some-plan-id"),
+			setPipelinePlan,
+			creds.NewParams(variables, setPipelinePlan.Vars),
+			fakeBuild,
+			fakeDelegate,
+			fakeTeamFactory,
+		)
+
+		stepErr = step.Run(ctx, state)
+	})
+
+	Context("when the pipeline does not exist yet", func() {
+		It("saves the interpolated config as an unpaused pipeline through the build", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+
+			Expect(fakeTeamFactory.GetByIDArgsForCall(0)).To(Equal(123))
+
+			Expect(fakeBuild.SavePipelineCallCount()).To(Equal(1))
+			pipelineRef, config, from, pausedState := fakeBuild.SavePipelineArgsForCall(0)
+			Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "some-pipeline"}))
+			Expect(from).To(Equal(db.ConfigVersion(0)))
+			Expect(pausedState).To(Equal(db.PipelineUnpaused))
+			Expect(config.Resources[0].Source).To(Equal(atc.Source{
+				"uri":    "git://some-uri",
+				"branch": "develop",
+			}))
+		})
+
+		It("succeeds", func() {
+			Expect(step.Succeeded()).To(BeTrue())
+		})
+
+		It("logs that the pipeline was set", func() {
+			Expect(stdoutBuf).To(gbytes.Say("setting pipeline: some-pipeline"))
+			Expect(stdoutBuf).To(gbytes.Say("done"))
+		})
+	})
+
+	Context("when the pipeline already exists", func() {
+		BeforeEach(func() {
+			existingPipeline := new(dbfakes.FakePipeline)
+			existingPipeline.ConfigVersionReturns(db.ConfigVersion(5))
+			fakeTeam.PipelineReturns(existingPipeline, true, nil)
+		})
+
+		It("updates it without changing whether it is paused", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+
+			_, _, from, pausedState := fakeBuild.SavePipelineArgsForCall(0)
+			Expect(from).To(Equal(db.ConfigVersion(5)))
+			Expect(pausedState).To(Equal(db.PipelineNoChange))
+		})
+	})
+
+	Context("when vars are given on the step", func() {
+		BeforeEach(func() {
+			setPipelinePlan.Vars = atc.Params{"branch": "((branch-secret))"}
+		})
+
+		It("prefers them over the var files, resolving any credentials", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+
+			_, config, _, _ := fakeBuild.SavePipelineArgsForCall(0)
+			Expect(config.Resources[0].Source).To(Equal(atc.Source{
+				"uri":    "git://some-uri",
+				"branch": "master",
+			}))
+		})
+	})
+
+	Context("when the config is invalid", func() {
+		BeforeEach(func() {
+			streamedFileContent["pipeline.yml"] = `
+jobs:
+- name: some-job
+  plan:
+  - get: some-missing-resource
+`
+		})
+
+		It("does not save the pipeline", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+			Expect(fakeBuild.SavePipelineCallCount()).To(BeZero())
+		})
+
+		It("logs the errors to stderr", func() {
+			Expect(stderrBuf).To(gbytes.Say("invalid pipeline config:"))
+			Expect(stderrBuf).To(gbytes.Say("some-missing-resource"))
+		})
+
+		It("fails", func() {
+			Expect(step.Succeeded()).To(BeFalse())
+		})
+	})
+
+	Context("when the config file cannot be found", func() {
+		BeforeEach(func() {
+			setPipelinePlan.File = "some-repo/missing.yml"
+		})
+
+		It("returns an error", func() {
+			Expect(stepErr).To(MatchError("file 'some-repo/missing.yml' not found"))
+			Expect(fakeBuild.SavePipelineCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when the config file's artifact source is unknown", func() {
+		BeforeEach(func() {
+			setPipelinePlan.File = "some-other-repo/pipeline.yml"
+		})
+
+		It("returns an error", func() {
+			Expect(stepErr).To(Equal(exec.UnknownArtifactSourceError{
+				SourceName: "some-other-repo",
+				ConfigPath: "some-other-repo/pipeline.yml",
+			}))
+		})
+	})
+
+	Context("when a var is not provided", func() {
+		BeforeEach(func() {
+			setPipelinePlan.VarFiles = nil
+		})
+
+		It("leaves it to be resolved by the credential manager", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+
+			_, config, _, _ := fakeBuild.SavePipelineArgsForCall(0)
+			Expect(config.Resources[0].Source).To(Equal(atc.Source{
+				"uri":    "((uri))",
+				"branch": "((branch))",
+			}))
+		})
+	})
+
+	Context("when saving the pipeline fails", func() {
+		disaster := errors.New("nope")
+
+		BeforeEach(func() {
+			fakeBuild.SavePipelineReturns(nil, false, disaster)
+		})
+
+		It("returns the error", func() {
+			Expect(stepErr).To(Equal(disaster))
+		})
+
+		It("fails", func() {
+			Expect(step.Succeeded()).To(BeFalse())
+		})
+	})
+})
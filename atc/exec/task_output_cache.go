@@ -0,0 +1,302 @@
+package exec
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/worker"
+)
+
+// identifiableSource is implemented by artifact sources whose content can be
+// identified without streaming it, so that the outputs of tasks consuming
+// them can be cached.
+type identifiableSource interface {
+	Identity() string
+}
+
+// taskOutputCacheKey is everything which determines the outputs of a task.
+// Images configured by an image_resource without a version are identified by
+// the versions determined for them when fetched.
+type taskOutputCacheKey struct {
+	Config        atc.TaskConfig    `json:"config"`
+	Params        map[string]string `json:"params"`
+	Privileged    bool              `json:"privileged"`
+	Image         string            `json:"image,omitempty"`
+	ImageSource   atc.Source        `json:"image_source,omitempty"`
+	ImageVersions []string          `json:"image_versions,omitempty"`
+	Inputs        map[string]string `json:"inputs"`
+}
+
+// imageVersionUnknown returns true if the version of the task's image is only
+// known once it has been fetched.
+func imageVersionUnknown(spec worker.ContainerSpec) bool {
+	return spec.ImageSpec.ImageArtifactSource == nil &&
+		spec.ImageSpec.ImageResource != nil &&
+		spec.ImageSpec.ImageResource.Version == nil
+}
+
+// imageVersionRecorder records the versions determined while fetching the
+// images of the task's container, i.e. its image_resource and the images of
+// any custom resource types it uses.
+type imageVersionRecorder struct {
+	worker.ImageFetchingDelegate
+
+	lock     sync.Mutex
+	versions map[string]bool
+}
+
+func newImageVersionRecorder(delegate worker.ImageFetchingDelegate) *imageVersionRecorder {
+	return &imageVersionRecorder{
+		ImageFetchingDelegate: delegate,
+		versions:              map[string]bool{},
+	}
+}
+
+func (recorder *imageVersionRecorder) ImageVersionDetermined(resourceCache db.UsedResourceCache) error {
+	payload, err := json.Marshal(resourceCache.Version())
+	if err != nil {
+		return err
+	}
+
+	recorder.lock.Lock()
+	recorder.versions[string(payload)] = true
+	recorder.lock.Unlock()
+
+	return recorder.ImageFetchingDelegate.ImageVersionDetermined(resourceCache)
+}
+
+// Versions returns the recorded versions in a stable order.
+func (recorder *imageVersionRecorder) Versions() []string {
+	recorder.lock.Lock()
+	defer recorder.lock.Unlock()
+
+	versions := []string{}
+	for version := range recorder.versions {
+		versions = append(versions, version)
+	}
+
+	sort.Strings(versions)
+
+	return versions
+}
+
+// lookUpCachedOutputs computes the task's cache key and reuses the outputs
+// cached under it, if any. An empty key is returned if the outputs cannot be
+// cached.
+func (action *TaskStep) lookUpCachedOutputs(logger lager.Logger, repository *worker.ArtifactRepository, config atc.TaskConfig, spec worker.ContainerSpec, imageVersions []string) (string, bool, error) {
+	cacheKey, err := action.outputCacheKey(logger, config, spec, imageVersions)
+	if err != nil {
+		return "", false, err
+	}
+
+	if cacheKey == "" {
+		return "", false, nil
+	}
+
+	reused, err := action.reuseCachedOutputs(logger, repository, config, cacheKey)
+	if err != nil {
+		return "", false, err
+	}
+
+	return cacheKey, reused, nil
+}
+
+// outputCacheKey computes the key under which the outputs of the task are
+// cached. An empty key is returned if the image or any of the inputs cannot
+// be identified, in which case the outputs are not cached.
+func (action *TaskStep) outputCacheKey(logger lager.Logger, config atc.TaskConfig, spec worker.ContainerSpec, imageVersions []string) (string, error) {
+	params, err := creds.NewTaskParams(action.variables, config.Params).Evaluate()
+	if err != nil {
+		return "", err
+	}
+
+	key := taskOutputCacheKey{
+		Config:     config,
+		Params:     params,
+		Privileged: bool(action.privileged),
+		Inputs:     map[string]string{},
+	}
+
+	if spec.ImageSpec.ImageArtifactSource != nil {
+		source, ok := spec.ImageSpec.ImageArtifactSource.(identifiableSource)
+		if !ok {
+			action.outputsNotCached(logger, "image '%s' cannot be identified", spec.ImageSpec.ImageArtifactName)
+			return "", nil
+		}
+
+		key.Image = source.Identity()
+	} else if spec.ImageSpec.ImageResource != nil {
+		key.ImageSource, err = spec.ImageSpec.ImageResource.Source.Evaluate()
+		if err != nil {
+			return "", err
+		}
+
+		if imageVersionUnknown(spec) {
+			if len(imageVersions) == 0 {
+				action.outputsNotCached(logger, "version of image could not be determined")
+				return "", nil
+			}
+
+			key.ImageVersions = imageVersions
+		}
+	}
+
+	for _, input := range spec.Inputs {
+		// task caches change from run to run by design, so they are left out
+		taskInput, ok := input.(*taskInputSource)
+		if !ok {
+			continue
+		}
+
+		source, ok := taskInput.source.(identifiableSource)
+		if !ok {
+			action.outputsNotCached(logger, "input '%s' cannot be identified", taskInput.config.Name)
+			return "", nil
+		}
+
+		key.Inputs[taskInput.config.Name] = source.Identity()
+	}
+
+	payload, err := json.Marshal(key)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%x", sha256.Sum256(payload)), nil
+}
+
+func (action *TaskStep) outputsNotCached(logger lager.Logger, reason string, args ...interface{}) {
+	message := fmt.Sprintf(reason, args...)
+
+	logger.Info("not-caching-outputs", lager.Data{"reason": message})
+	fmt.Fprintf(action.delegate.Stderr(), "not caching outputs: %s\n", message)
+}
+
+// reuseCachedOutputs registers the outputs of a previous successful run with
+// the same key, if all of them are still available, and records the
+// annotations of that run on the build.
+func (action *TaskStep) reuseCachedOutputs(logger lager.Logger, repository *worker.ArtifactRepository, config atc.TaskConfig, cacheKey string) (bool, error) {
+	logger = logger.Session("reuse-cached-outputs", lager.Data{"key": cacheKey})
+
+	cache, found, err := action.taskOutputCacheFactory.Find(action.teamID, cacheKey)
+	if err != nil {
+		return false, err
+	}
+
+	if !found {
+		logger.Debug("cache-miss")
+		return false, nil
+	}
+
+	workers, err := action.workerPool.RunningWorkers(logger)
+	if err != nil {
+		return false, err
+	}
+
+	volumes := map[string]worker.Volume{}
+	for _, output := range config.Outputs {
+		dbVolume, found := cache.Volumes[output.Name]
+		if !found {
+			logger.Info("cached-output-not-found", lager.Data{"output": output.Name})
+			return false, nil
+		}
+
+		volume, found, err := lookupCachedVolume(logger, workers, dbVolume)
+		if err != nil {
+			return false, err
+		}
+
+		if !found {
+			logger.Info("cached-output-volume-not-found", lager.Data{
+				"output": output.Name,
+				"worker": dbVolume.WorkerName(),
+				"volume": dbVolume.Handle(),
+			})
+			return false, nil
+		}
+
+		volumes[output.Name] = volume
+	}
+
+	// keep the volumes around while this build uses them
+	used, err := action.taskOutputCacheFactory.Use(action.buildID, cache)
+	if err != nil {
+		return false, err
+	}
+
+	if !used {
+		logger.Info("cached-output-volume-released")
+		return false, nil
+	}
+
+	for _, output := range config.Outputs {
+		outputName := output.Name
+		if destinationName, ok := action.outputMapping[output.Name]; ok {
+			outputName = destinationName
+		}
+
+		err := action.registerOutput(logger, repository, outputName, volumes[output.Name])
+		if err != nil {
+			return false, err
+		}
+	}
+
+	logger.Info("cache-hit")
+	fmt.Fprintf(action.delegate.Stdout(), "reusing outputs of a previous successful run with the same inputs (cache key %s)\n", cacheKey)
+
+	if len(cache.Annotations) > 0 {
+		action.delegate.Annotated(logger, cache.Annotations)
+	}
+
+	action.delegate.Finished(logger, ExitStatus(0))
+
+	action.succeeded = true
+
+	return true, nil
+}
+
+func lookupCachedVolume(logger lager.Logger, workers []worker.Worker, dbVolume db.CreatedVolume) (worker.Volume, bool, error) {
+	for _, w := range workers {
+		if w.Name() == dbVolume.WorkerName() {
+			return w.LookupVolume(logger, dbVolume.Handle())
+		}
+	}
+
+	return nil, false, nil
+}
+
+// storeCachedOutputs records the successful run under the key so that its
+// outputs and annotations can be reused. Failing to do so does not fail the
+// step.
+func (action *TaskStep) storeCachedOutputs(logger lager.Logger, config atc.TaskConfig, container worker.Container, cacheKey string, annotations map[string]string) {
+	logger = logger.Session("store-cached-outputs", lager.Data{"key": cacheKey})
+
+	cache, err := action.taskOutputCacheFactory.Create(action.teamID, cacheKey, annotations)
+	if err != nil {
+		logger.Error("failed-to-create-task-output-cache", err)
+		return
+	}
+
+	volumeMounts := container.VolumeMounts()
+
+	for _, output := range config.Outputs {
+		outputPath := artifactsPath(output, action.artifactsRoot)
+
+		for _, mount := range volumeMounts {
+			if mount.MountPath == outputPath {
+				err := mount.Volume.InitializeTaskOutputCache(cache.ID, output.Name)
+				if err != nil {
+					logger.Error("failed-to-initialize-task-output-cache", err, lager.Data{"output": output.Name})
+					return
+				}
+			}
+		}
+	}
+}
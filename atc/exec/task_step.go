@@ -4,11 +4,13 @@ import (
 	"archive/tar"
 	"compress/gzip"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"path"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -18,6 +20,7 @@ import (
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/tracing"
 	"github.com/concourse/concourse/atc/worker"
 )
 
@@ -25,6 +28,23 @@ const taskProcessID = "task"
 const taskProcessPropertyName = "concourse:task-process"
 const taskExitStatusPropertyName = "concourse:exit-status"
 
+// TaskAnnotationsFile is the file, relative to the task's build directory,
+// that a task may write a JSON object of strings to in order to annotate its
+// build, e.g. with a commit URL or a deployed version.
+const TaskAnnotationsFile = ".annotations.json"
+
+const (
+	// MaxTaskAnnotations is the number of annotations a task may record on its
+	// build; any more are dropped.
+	MaxTaskAnnotations = 20
+
+	// MaxTaskAnnotationLength is the length of the longest annotation value
+	// which is recorded; longer values are dropped.
+	MaxTaskAnnotationLength = 1024
+
+	maxTaskAnnotationsFileSize = 64 * 1024
+)
+
 // MissingInputsError is returned when any of the task's required inputs are
 // missing.
 type MissingInputsError struct {
@@ -36,6 +56,18 @@ func (err MissingInputsError) Error() string {
 	return fmt.Sprintf("missing inputs: %s", strings.Join(err.Inputs, ", "))
 }
 
+// UndeclaredArtifactsError is returned when the step retains artifacts which
+// are not outputs of the task.
+type UndeclaredArtifactsError struct {
+	Artifacts []string
+}
+
+// Error prints a human-friendly message listing the artifacts that are not
+// outputs of the task.
+func (err UndeclaredArtifactsError) Error() string {
+	return fmt.Sprintf("artifacts are not outputs of the task: %s", strings.Join(err.Artifacts, ", "))
+}
+
 type MissingTaskImageSourceError struct {
 	SourceName string
 }
@@ -61,6 +93,7 @@ type TaskDelegate interface {
 
 	Initializing(lager.Logger, atc.TaskConfig)
 	Starting(lager.Logger, atc.TaskConfig)
+	Annotated(lager.Logger, map[string]string)
 	Finished(lager.Logger, ExitStatus)
 }
 
@@ -72,19 +105,22 @@ type TaskStep struct {
 	tags          atc.Tags
 	inputMapping  map[string]string
 	outputMapping map[string]string
+	artifacts     []string
+	cacheOutputs  bool
 
 	artifactsRoot     string
 	imageArtifactName string
 
 	delegate TaskDelegate
 
-	workerPool        worker.Client
-	teamID            int
-	buildID           int
-	jobID             int
-	stepName          string
-	planID            atc.PlanID
-	containerMetadata db.ContainerMetadata
+	workerPool             worker.Client
+	taskOutputCacheFactory db.TaskOutputCacheFactory
+	teamID                 int
+	buildID                int
+	jobID                  int
+	stepName               string
+	planID                 atc.PlanID
+	containerMetadata      db.ContainerMetadata
 
 	resourceTypes creds.VersionedResourceTypes
 
@@ -100,10 +136,13 @@ func NewTaskStep(
 	tags atc.Tags,
 	inputMapping map[string]string,
 	outputMapping map[string]string,
+	artifacts []string,
+	cacheOutputs bool,
 	artifactsRoot string,
 	imageArtifactName string,
 	delegate TaskDelegate,
 	workerPool worker.Client,
+	taskOutputCacheFactory db.TaskOutputCacheFactory,
 	teamID int,
 	buildID int,
 	jobID int,
@@ -115,24 +154,27 @@ func NewTaskStep(
 	defaultLimits atc.ContainerLimits,
 ) Step {
 	return &TaskStep{
-		privileged:        privileged,
-		configSource:      configSource,
-		tags:              tags,
-		inputMapping:      inputMapping,
-		outputMapping:     outputMapping,
-		artifactsRoot:     artifactsRoot,
-		imageArtifactName: imageArtifactName,
-		delegate:          delegate,
-		workerPool:        workerPool,
-		teamID:            teamID,
-		buildID:           buildID,
-		jobID:             jobID,
-		stepName:          stepName,
-		planID:            planID,
-		containerMetadata: containerMetadata,
-		resourceTypes:     resourceTypes,
-		variables:         variables,
-		defaultLimits:     defaultLimits,
+		privileged:             privileged,
+		configSource:           configSource,
+		tags:                   tags,
+		inputMapping:           inputMapping,
+		outputMapping:          outputMapping,
+		artifacts:              artifacts,
+		cacheOutputs:           cacheOutputs,
+		artifactsRoot:          artifactsRoot,
+		imageArtifactName:      imageArtifactName,
+		delegate:               delegate,
+		workerPool:             workerPool,
+		taskOutputCacheFactory: taskOutputCacheFactory,
+		teamID:                 teamID,
+		buildID:                buildID,
+		jobID:                  jobID,
+		stepName:               stepName,
+		planID:                 planID,
+		containerMetadata:      containerMetadata,
+		resourceTypes:          resourceTypes,
+		variables:              variables,
+		defaultLimits:          defaultLimits,
 	}
 }
 
@@ -151,7 +193,23 @@ func NewTaskStep(
 // are registered with the worker.ArtifactRepository. If no outputs are specified, the
 // task's entire working directory is registered as an ArtifactSource under the
 // name of the task.
+//
+// If the step caches its outputs and a previous run with the same config,
+// params, image and inputs succeeded for the team, the outputs of that run
+// are registered instead and the script is not executed.
 func (action *TaskStep) Run(ctx context.Context, state RunState) error {
+	ctx, span := tracing.StartSpan(ctx, "task", tracing.Attrs{
+		"name": action.stepName,
+	})
+
+	err := action.run(ctx, state)
+
+	tracing.End(span, err)
+
+	return err
+}
+
+func (action *TaskStep) run(ctx context.Context, state RunState) error {
 	logger := lagerctx.FromContext(ctx)
 
 	repository := state.Artifacts()
@@ -165,6 +223,12 @@ func (action *TaskStep) Run(ctx context.Context, state RunState) error {
 	if err != nil {
 		return err
 	}
+
+	err = action.checkArtifacts(config)
+	if err != nil {
+		return err
+	}
+
 	if config.Limits.CPU == nil {
 		config.Limits.CPU = action.defaultLimits.CPU
 	}
@@ -179,10 +243,35 @@ func (action *TaskStep) Run(ctx context.Context, state RunState) error {
 		return err
 	}
 
+	var cacheKey string
+	var imageVersions *imageVersionRecorder
+	var imageFetchingDelegate worker.ImageFetchingDelegate = action.delegate
+	if action.cacheOutputs {
+		if imageVersionUnknown(containerSpec) {
+			// the image's version is only determined when it is fetched for the
+			// container, so the outputs are looked up once it has been created
+			imageVersions = newImageVersionRecorder(action.delegate)
+			imageFetchingDelegate = imageVersions
+		} else {
+			var reused bool
+			cacheKey, reused, err = action.lookUpCachedOutputs(logger, repository, config, containerSpec, nil)
+			if err != nil {
+				return err
+			}
+
+			if reused {
+				return nil
+			}
+		}
+	}
+
+	// let processes in the container continue the build's trace
+	containerSpec.Env = append(containerSpec.Env, tracing.Env(ctx)...)
+
 	container, err := action.workerPool.FindOrCreateContainer(
 		ctx,
 		logger,
-		action.delegate,
+		imageFetchingDelegate,
 		db.NewBuildStepContainerOwner(action.buildID, action.planID),
 		action.containerMetadata,
 		containerSpec,
@@ -192,6 +281,21 @@ func (action *TaskStep) Run(ctx context.Context, state RunState) error {
 		return err
 	}
 
+	if imageVersions != nil {
+		var reused bool
+		cacheKey, reused, err = action.lookUpCachedOutputs(logger, repository, config, containerSpec, imageVersions.Versions())
+		if err != nil {
+			return err
+		}
+
+		if reused {
+			// the container was only needed to fetch the image; the task will
+			// not run in it
+			action.taskFinished(logger, container)
+			return nil
+		}
+	}
+
 	exitStatusProp, err := container.Property(taskExitStatusPropertyName)
 	if err == nil {
 		logger.Info("already-exited", lager.Data{"status": exitStatusProp})
@@ -203,11 +307,15 @@ func (action *TaskStep) Run(ctx context.Context, state RunState) error {
 
 		action.succeeded = status == 0
 
+		action.taskFinished(logger, container)
+
 		err = action.registerOutputs(logger, repository, config, container)
 		if err != nil {
 			return err
 		}
 
+		action.saveAnnotations(logger, container)
+
 		return nil
 	}
 
@@ -266,6 +374,8 @@ func (action *TaskStep) Run(ctx context.Context, state RunState) error {
 
 		<-exited
 
+		action.taskFinished(logger, container)
+
 		return ctx.Err()
 
 	case <-exited:
@@ -273,11 +383,15 @@ func (action *TaskStep) Run(ctx context.Context, state RunState) error {
 			return processErr
 		}
 
+		action.taskFinished(logger, container)
+
 		err = action.registerOutputs(logger, repository, config, container)
 		if err != nil {
 			return err
 		}
 
+		annotations := action.saveAnnotations(logger, container)
+
 		action.delegate.Finished(logger, ExitStatus(processStatus))
 
 		err = container.SetProperty(taskExitStatusPropertyName, fmt.Sprintf("%d", processStatus))
@@ -287,10 +401,91 @@ func (action *TaskStep) Run(ctx context.Context, state RunState) error {
 
 		action.succeeded = processStatus == 0
 
+		if action.succeeded && cacheKey != "" {
+			action.storeCachedOutputs(logger, config, container, cacheKey, annotations)
+		}
+
 		return nil
 	}
 }
 
+// saveAnnotations reads the annotations the task wrote to
+// TaskAnnotationsFile, if any, and records them on the build. Malformed
+// annotations, and any beyond the limits on their number and length, are
+// reported to the build log but do not fail the task.
+func (action *TaskStep) saveAnnotations(logger lager.Logger, container worker.Container) map[string]string {
+	out, err := container.StreamOut(garden.StreamOutSpec{
+		Path: path.Join(action.artifactsRoot, TaskAnnotationsFile),
+	})
+	if err != nil {
+		return nil
+	}
+
+	defer out.Close()
+
+	tarReader := tar.NewReader(out)
+
+	header, err := tarReader.Next()
+	if err != nil {
+		return nil
+	}
+
+	if header.Size > maxTaskAnnotationsFileSize {
+		logger.Info("annotations-too-large", lager.Data{"size": header.Size})
+		fmt.Fprintf(action.delegate.Stderr(), "[WARNING] ignoring %s larger than %d bytes\n", TaskAnnotationsFile, maxTaskAnnotationsFileSize)
+		return nil
+	}
+
+	var annotations map[string]string
+	err = json.NewDecoder(tarReader).Decode(&annotations)
+	if err != nil {
+		logger.Error("failed-to-parse-annotations", err)
+		fmt.Fprintf(action.delegate.Stderr(), "[WARNING] ignoring malformed %s: %s\n", TaskAnnotationsFile, err)
+		return nil
+	}
+
+	keys := make([]string, 0, len(annotations))
+	for key := range annotations {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	var dropped []string
+	var kept int
+	for _, key := range keys {
+		if kept == MaxTaskAnnotations || len(annotations[key]) > MaxTaskAnnotationLength {
+			delete(annotations, key)
+			dropped = append(dropped, key)
+			continue
+		}
+
+		kept++
+	}
+
+	if len(dropped) > 0 {
+		logger.Info("dropped-annotations", lager.Data{"keys": dropped})
+		fmt.Fprintf(action.delegate.Stderr(), "[WARNING] ignoring annotations beyond the first %d, or longer than %d characters: %s\n", MaxTaskAnnotations, MaxTaskAnnotationLength, strings.Join(dropped, ", "))
+	}
+
+	if len(annotations) == 0 {
+		return nil
+	}
+
+	action.delegate.Annotated(logger, annotations)
+
+	return annotations
+}
+
+// taskFinished records that the task is no longer running, so that it no
+// longer counts towards the worker's active tasks.
+func (action *TaskStep) taskFinished(logger lager.Logger, container worker.Container) {
+	err := container.MarkTaskFinished()
+	if err != nil {
+		logger.Error("failed-to-mark-task-finished", err)
+	}
+}
+
 func (action *TaskStep) Succeeded() bool {
 	return action.succeeded
 }
@@ -397,8 +592,10 @@ func (action *TaskStep) registerOutputs(logger lager.Logger, repository *worker.
 
 		for _, mount := range volumeMounts {
 			if mount.MountPath == outputPath {
-				source := newTaskArtifactSource(logger, mount.Volume)
-				repository.RegisterSource(worker.ArtifactName(outputName), source)
+				err := action.registerOutput(logger, repository, outputName, mount.Volume)
+				if err != nil {
+					return err
+				}
 			}
 		}
 	}
@@ -426,6 +623,57 @@ func (action *TaskStep) registerOutputs(logger lager.Logger, repository *worker.
 	return nil
 }
 
+func (action *TaskStep) registerOutput(logger lager.Logger, repository *worker.ArtifactRepository, outputName string, volume worker.Volume) error {
+	source := newTaskArtifactSource(logger, volume)
+	repository.RegisterSource(worker.ArtifactName(outputName), source)
+
+	if action.retainsArtifact(outputName) {
+		logger.Debug("retaining-artifact", lager.Data{"artifact": outputName})
+
+		err := volume.InitializeArtifact(action.buildID, outputName)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (action *TaskStep) checkArtifacts(config atc.TaskConfig) error {
+	outputs := map[string]bool{}
+	for _, output := range config.Outputs {
+		outputName := output.Name
+		if destinationName, ok := action.outputMapping[output.Name]; ok {
+			outputName = destinationName
+		}
+
+		outputs[outputName] = true
+	}
+
+	var undeclared []string
+	for _, artifact := range action.artifacts {
+		if !outputs[artifact] {
+			undeclared = append(undeclared, artifact)
+		}
+	}
+
+	if len(undeclared) > 0 {
+		return UndeclaredArtifactsError{undeclared}
+	}
+
+	return nil
+}
+
+func (action *TaskStep) retainsArtifact(name string) bool {
+	for _, artifact := range action.artifacts {
+		if artifact == name {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (TaskStep) envForParams(params map[string]string) []string {
 	env := make([]string, 0, len(params))
 
@@ -490,6 +738,12 @@ func (src *taskArtifactSource) VolumeOn(w worker.Worker) (worker.Volume, bool, e
 	return w.LookupVolume(src.logger, src.volume.Handle())
 }
 
+// Identity identifies the output by its volume, which is only reused when the
+// task producing it hit its output cache.
+func (src *taskArtifactSource) Identity() string {
+	return "volume:" + src.volume.Handle()
+}
+
 type taskInputSource struct {
 	config        atc.TaskInputConfig
 	source        worker.ArtifactSource
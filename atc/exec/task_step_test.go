@@ -5,6 +5,7 @@ import (
 	"compress/gzip"
 	"context"
 	"errors"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"strings"
@@ -16,8 +17,11 @@ import (
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
 	"github.com/concourse/concourse/atc/exec"
 	"github.com/concourse/concourse/atc/exec/execfakes"
+	"github.com/concourse/concourse/atc/tracing"
+	"github.com/concourse/concourse/atc/tracing/tracingfakes"
 	"github.com/concourse/concourse/atc/worker"
 	"github.com/concourse/concourse/atc/worker/workerfakes"
 	. "github.com/onsi/ginkgo"
@@ -30,7 +34,8 @@ var _ = Describe("TaskStep", func() {
 		ctx    context.Context
 		cancel func()
 
-		fakeWorkerClient *workerfakes.FakeClient
+		fakeWorkerClient           *workerfakes.FakeClient
+		fakeTaskOutputCacheFactory *dbfakes.FakeTaskOutputCacheFactory
 
 		stdoutBuf *gbytes.Buffer
 		stderrBuf *gbytes.Buffer
@@ -50,6 +55,8 @@ var _ = Describe("TaskStep", func() {
 		resourceTypes creds.VersionedResourceTypes
 		inputMapping  map[string]string
 		outputMapping map[string]string
+		artifacts     []string
+		cacheOutputs  bool
 		variables     creds.Variables
 
 		repo  *worker.ArtifactRepository
@@ -64,6 +71,7 @@ var _ = Describe("TaskStep", func() {
 		ctx, cancel = context.WithCancel(context.Background())
 
 		fakeWorkerClient = new(workerfakes.FakeClient)
+		fakeTaskOutputCacheFactory = new(dbfakes.FakeTaskOutputCacheFactory)
 
 		stdoutBuf = gbytes.NewBuffer()
 		stderrBuf = gbytes.NewBuffer()
@@ -98,6 +106,8 @@ var _ = Describe("TaskStep", func() {
 
 		inputMapping = nil
 		outputMapping = nil
+		artifacts = nil
+		cacheOutputs = false
 		imageArtifactName = ""
 
 		variables = template.StaticVariables{
@@ -120,10 +130,13 @@ var _ = Describe("TaskStep", func() {
 			tags,
 			inputMapping,
 			outputMapping,
+			artifacts,
+			cacheOutputs,
 			"some-artifact-root",
 			imageArtifactName,
 			fakeDelegate,
 			fakeWorkerClient,
+			fakeTaskOutputCacheFactory,
 			teamID,
 			buildID,
 			jobID,
@@ -176,6 +189,7 @@ var _ = Describe("TaskStep", func() {
 			BeforeEach(func() {
 				fakeContainer = new(workerfakes.FakeContainer)
 				fakeContainer.HandleReturns("some-handle")
+				fakeContainer.StreamOutReturns(nil, errors.New("no annotations"))
 				fakeWorkerClient.FindOrCreateContainerReturns(fakeContainer, nil)
 			})
 
@@ -231,6 +245,25 @@ var _ = Describe("TaskStep", func() {
 				Expect(actualResourceTypes).To(Equal(resourceTypes))
 			})
 
+			Context("when tracing is configured", func() {
+				BeforeEach(func() {
+					tracing.Configure(new(tracingfakes.FakeExporter), 1)
+				})
+
+				AfterEach(func() {
+					tracing.Configure(nil, 0)
+				})
+
+				It("propagates the trace into the container", func() {
+					Expect(fakeWorkerClient.FindOrCreateContainerCallCount()).To(Equal(1))
+					spanCtx, _, _, _, _, spec, _ := fakeWorkerClient.FindOrCreateContainerArgsForCall(0)
+					Expect(spec.Env).To(ConsistOf(
+						"SECURE=super-secret-param",
+						"TRACEPARENT="+traceParent(spanCtx),
+					))
+				})
+			})
+
 			Context("when rootfs uri is set instead of image resource", func() {
 				BeforeEach(func() {
 					fetchedConfig = atc.TaskConfig{
@@ -302,6 +335,24 @@ var _ = Describe("TaskStep", func() {
 					Expect(taskStep.Succeeded()).To(BeFalse())
 				})
 
+				It("marks the task as finished", func() {
+					Expect(fakeContainer.MarkTaskFinishedCallCount()).To(Equal(1))
+				})
+
+				Context("when the task wrote annotations", func() {
+					BeforeEach(func() {
+						fakeContainer.StreamOutStub = func(garden.StreamOutSpec) (io.ReadCloser, error) {
+							return annotationsStream(`{"version":"1.2.3"}`), nil
+						}
+					})
+
+					It("annotates the build via the delegate", func() {
+						Expect(fakeDelegate.AnnotatedCallCount()).To(Equal(1))
+						_, actual := fakeDelegate.AnnotatedArgsForCall(0)
+						Expect(actual).To(Equal(map[string]string{"version": "1.2.3"}))
+					})
+				})
+
 				Context("when outputs are configured and present on the container", func() {
 					var (
 						fakeMountPath1 string = "some-artifact-root/some-output-configured-path/"
@@ -1126,6 +1177,430 @@ var _ = Describe("TaskStep", func() {
 					})
 				})
 
+				Context("when outputs are retained as artifacts", func() {
+					var (
+						reportVolume *workerfakes.FakeVolume
+						otherVolume  *workerfakes.FakeVolume
+					)
+
+					BeforeEach(func() {
+						artifacts = []string{"specific-report"}
+						outputMapping = map[string]string{"report": "specific-report"}
+
+						configSource.FetchConfigReturns(atc.TaskConfig{
+							Run: atc.TaskRunConfig{
+								Path: "ls",
+							},
+							Outputs: []atc.TaskOutputConfig{
+								{Name: "report"},
+								{Name: "other-output"},
+							},
+						}, nil)
+
+						reportVolume = new(workerfakes.FakeVolume)
+						otherVolume = new(workerfakes.FakeVolume)
+
+						fakeContainer.VolumeMountsReturns([]worker.VolumeMount{
+							{
+								Volume:    reportVolume,
+								MountPath: "some-artifact-root/report/",
+							},
+							{
+								Volume:    otherVolume,
+								MountPath: "some-artifact-root/other-output/",
+							},
+						})
+					})
+
+					Context("when the task succeeds", func() {
+						BeforeEach(func() {
+							fakeProcess.WaitReturns(0, nil)
+						})
+
+						It("retains the output's volume as an artifact of the build", func() {
+							Expect(stepErr).ToNot(HaveOccurred())
+
+							Expect(reportVolume.InitializeArtifactCallCount()).To(Equal(1))
+							artifactBuildID, name := reportVolume.InitializeArtifactArgsForCall(0)
+							Expect(artifactBuildID).To(Equal(buildID))
+							Expect(name).To(Equal("specific-report"))
+
+							Expect(otherVolume.InitializeArtifactCallCount()).To(Equal(0))
+						})
+					})
+
+					Context("when the task fails", func() {
+						BeforeEach(func() {
+							fakeProcess.WaitReturns(1, nil)
+						})
+
+						It("still retains the output's volume", func() {
+							Expect(reportVolume.InitializeArtifactCallCount()).To(Equal(1))
+						})
+					})
+
+					Context("when an artifact is not an output of the task", func() {
+						BeforeEach(func() {
+							artifacts = []string{"specific-report", "typo-report"}
+						})
+
+						It("returns an UndeclaredArtifactsError", func() {
+							Expect(stepErr).To(Equal(exec.UndeclaredArtifactsError{Artifacts: []string{"typo-report"}}))
+						})
+
+						It("does not run the task", func() {
+							Expect(fakeWorkerClient.FindOrCreateContainerCallCount()).To(BeZero())
+						})
+					})
+
+					Context("when retaining the volume fails", func() {
+						disaster := errors.New("nope")
+
+						BeforeEach(func() {
+							fakeProcess.WaitReturns(0, nil)
+							reportVolume.InitializeArtifactReturns(disaster)
+						})
+
+						It("returns the error", func() {
+							Expect(stepErr).To(Equal(disaster))
+						})
+					})
+				})
+
+				Context("when outputs are cached", func() {
+					var (
+						inputSource  *identifiedArtifactSource
+						outputVolume *workerfakes.FakeVolume
+					)
+
+					BeforeEach(func() {
+						cacheOutputs = true
+
+						inputSource = &identifiedArtifactSource{
+							FakeArtifactSource: new(workerfakes.FakeArtifactSource),
+							identity:           "resource-cache:1",
+						}
+						repo.RegisterSource("some-input", inputSource)
+
+						configSource.FetchConfigReturns(atc.TaskConfig{
+							Run: atc.TaskRunConfig{
+								Path: "ls",
+							},
+							Inputs: []atc.TaskInputConfig{
+								{Name: "some-input"},
+							},
+							Outputs: []atc.TaskOutputConfig{
+								{Name: "some-output"},
+							},
+						}, nil)
+
+						outputVolume = new(workerfakes.FakeVolume)
+						outputVolume.HandleReturns("some-output-handle")
+
+						fakeContainer.VolumeMountsReturns([]worker.VolumeMount{
+							{
+								Volume:    outputVolume,
+								MountPath: "some-artifact-root/some-output/",
+							},
+						})
+
+						fakeTaskOutputCacheFactory.CreateReturns(&db.UsedTaskOutputCache{ID: 99}, nil)
+					})
+
+					Context("when no previous run has been cached", func() {
+						It("looks up the cache for the team", func() {
+							Expect(fakeTaskOutputCacheFactory.FindCallCount()).To(Equal(1))
+							cacheTeamID, key := fakeTaskOutputCacheFactory.FindArgsForCall(0)
+							Expect(cacheTeamID).To(Equal(teamID))
+							Expect(key).ToNot(BeEmpty())
+						})
+
+						It("runs the task", func() {
+							Expect(fakeContainer.RunCallCount()).To(Equal(1))
+						})
+
+						Context("when the task succeeds", func() {
+							BeforeEach(func() {
+								fakeProcess.WaitReturns(0, nil)
+							})
+
+							It("caches the outputs under the same key", func() {
+								Expect(fakeTaskOutputCacheFactory.CreateCallCount()).To(Equal(1))
+								cacheTeamID, key, _ := fakeTaskOutputCacheFactory.CreateArgsForCall(0)
+								Expect(cacheTeamID).To(Equal(teamID))
+
+								_, foundKey := fakeTaskOutputCacheFactory.FindArgsForCall(0)
+								Expect(key).To(Equal(foundKey))
+
+								Expect(outputVolume.InitializeTaskOutputCacheCallCount()).To(Equal(1))
+								cacheID, name := outputVolume.InitializeTaskOutputCacheArgsForCall(0)
+								Expect(cacheID).To(Equal(99))
+								Expect(name).To(Equal("some-output"))
+							})
+
+							Context("when the task wrote annotations", func() {
+								BeforeEach(func() {
+									fakeContainer.StreamOutStub = func(garden.StreamOutSpec) (io.ReadCloser, error) {
+										return annotationsStream(`{"version":"1.2.3"}`), nil
+									}
+								})
+
+								It("caches the annotations along with the outputs", func() {
+									Expect(fakeTaskOutputCacheFactory.CreateCallCount()).To(Equal(1))
+									_, _, cachedAnnotations := fakeTaskOutputCacheFactory.CreateArgsForCall(0)
+									Expect(cachedAnnotations).To(Equal(map[string]string{"version": "1.2.3"}))
+								})
+							})
+
+							Context("when caching the outputs fails", func() {
+								BeforeEach(func() {
+									outputVolume.InitializeTaskOutputCacheReturns(errors.New("nope"))
+								})
+
+								It("still succeeds", func() {
+									Expect(stepErr).ToNot(HaveOccurred())
+									Expect(taskStep.Succeeded()).To(BeTrue())
+								})
+							})
+						})
+
+						Context("when the task fails", func() {
+							BeforeEach(func() {
+								fakeProcess.WaitReturns(1, nil)
+							})
+
+							It("does not cache the outputs", func() {
+								Expect(fakeTaskOutputCacheFactory.CreateCallCount()).To(BeZero())
+							})
+						})
+					})
+
+					Context("when a previous run has been cached", func() {
+						var (
+							fakeWorker   *workerfakes.FakeWorker
+							cachedVolume *workerfakes.FakeVolume
+						)
+
+						BeforeEach(func() {
+							dbVolume := new(dbfakes.FakeCreatedVolume)
+							dbVolume.WorkerNameReturns("some-worker")
+							dbVolume.HandleReturns("some-cached-handle")
+
+							fakeTaskOutputCacheFactory.FindReturns(&db.UsedTaskOutputCache{
+								ID: 99,
+								Volumes: map[string]db.CreatedVolume{
+									"some-output": dbVolume,
+								},
+								Annotations: map[string]string{"version": "1.2.3"},
+							}, true, nil)
+
+							cachedVolume = new(workerfakes.FakeVolume)
+							cachedVolume.HandleReturns("some-cached-handle")
+
+							fakeWorker = new(workerfakes.FakeWorker)
+							fakeWorker.NameReturns("some-worker")
+							fakeWorker.LookupVolumeReturns(cachedVolume, true, nil)
+							fakeWorkerClient.RunningWorkersReturns([]worker.Worker{fakeWorker}, nil)
+
+							fakeTaskOutputCacheFactory.UseReturns(true, nil)
+						})
+
+						It("does not run the task", func() {
+							Expect(stepErr).ToNot(HaveOccurred())
+							Expect(fakeWorkerClient.FindOrCreateContainerCallCount()).To(BeZero())
+						})
+
+						It("keeps the cached volumes for the build", func() {
+							Expect(fakeTaskOutputCacheFactory.UseCallCount()).To(Equal(1))
+							usingBuildID, cache := fakeTaskOutputCacheFactory.UseArgsForCall(0)
+							Expect(usingBuildID).To(Equal(buildID))
+							Expect(cache.ID).To(Equal(99))
+						})
+
+						Context("when the cached volumes have been released", func() {
+							BeforeEach(func() {
+								fakeTaskOutputCacheFactory.UseReturns(false, nil)
+							})
+
+							It("runs the task", func() {
+								Expect(fakeContainer.RunCallCount()).To(Equal(1))
+							})
+						})
+
+						It("registers the cached volumes as the outputs", func() {
+							_, handle := fakeWorker.LookupVolumeArgsForCall(0)
+							Expect(handle).To(Equal("some-cached-handle"))
+
+							source, found := repo.SourceFor("some-output")
+							Expect(found).To(BeTrue())
+
+							volume, found, err := source.VolumeOn(fakeWorker)
+							Expect(err).ToNot(HaveOccurred())
+							Expect(found).To(BeTrue())
+							Expect(volume).To(Equal(cachedVolume))
+						})
+
+						It("notes the cache hit in the build log and succeeds", func() {
+							Expect(stdoutBuf).To(gbytes.Say("reusing outputs of a previous successful run"))
+
+							Expect(fakeDelegate.FinishedCallCount()).To(Equal(1))
+							_, status := fakeDelegate.FinishedArgsForCall(0)
+							Expect(status).To(Equal(exec.ExitStatus(0)))
+
+							Expect(taskStep.Succeeded()).To(BeTrue())
+						})
+
+						It("annotates the build with the annotations of the cached run", func() {
+							Expect(fakeDelegate.AnnotatedCallCount()).To(Equal(1))
+							_, actual := fakeDelegate.AnnotatedArgsForCall(0)
+							Expect(actual).To(Equal(map[string]string{"version": "1.2.3"}))
+						})
+
+						Context("when the cached volume is no longer on any worker", func() {
+							BeforeEach(func() {
+								fakeWorker.LookupVolumeReturns(nil, false, nil)
+							})
+
+							It("runs the task", func() {
+								Expect(fakeContainer.RunCallCount()).To(Equal(1))
+							})
+						})
+					})
+
+					Context("when an input changes", func() {
+						var firstKey string
+
+						BeforeEach(func() {
+							fakeProcess.WaitReturns(0, nil)
+						})
+
+						JustBeforeEach(func() {
+							_, firstKey = fakeTaskOutputCacheFactory.FindArgsForCall(0)
+
+							inputSource.identity = "resource-cache:2"
+
+							Expect(taskStep.Run(ctx, state)).To(Succeed())
+						})
+
+						It("uses a different key", func() {
+							_, secondKey := fakeTaskOutputCacheFactory.FindArgsForCall(1)
+							Expect(secondKey).ToNot(Equal(firstKey))
+						})
+					})
+
+					Context("when an input cannot be identified", func() {
+						BeforeEach(func() {
+							repo.RegisterSource("some-input", new(workerfakes.FakeArtifactSource))
+						})
+
+						It("runs the task without caching", func() {
+							Expect(fakeTaskOutputCacheFactory.FindCallCount()).To(BeZero())
+							Expect(fakeContainer.RunCallCount()).To(Equal(1))
+						})
+
+						It("says why in the build log", func() {
+							Expect(stderrBuf).To(gbytes.Say("not caching outputs: input 'some-input' cannot be identified"))
+						})
+					})
+
+					Context("when the image is an image_resource without a version", func() {
+						var imageVersion atc.Version
+
+						BeforeEach(func() {
+							imageVersion = atc.Version{"digest": "sha256:1"}
+
+							configSource.FetchConfigReturns(atc.TaskConfig{
+								ImageResource: &atc.ImageResource{
+									Type:   "docker",
+									Source: atc.Source{"repository": "some-image"},
+								},
+								Run: atc.TaskRunConfig{
+									Path: "ls",
+								},
+								Inputs: []atc.TaskInputConfig{
+									{Name: "some-input"},
+								},
+								Outputs: []atc.TaskOutputConfig{
+									{Name: "some-output"},
+								},
+							}, nil)
+
+							fakeWorkerClient.FindOrCreateContainerStub = func(_ context.Context, _ lager.Logger, delegate worker.ImageFetchingDelegate, _ db.ContainerOwner, _ db.ContainerMetadata, _ worker.ContainerSpec, _ creds.VersionedResourceTypes) (worker.Container, error) {
+								resourceCache := new(dbfakes.FakeUsedResourceCache)
+								resourceCache.VersionReturns(imageVersion)
+
+								err := delegate.ImageVersionDetermined(resourceCache)
+								if err != nil {
+									return nil, err
+								}
+
+								return fakeContainer, nil
+							}
+						})
+
+						It("looks up the cache once the image has been fetched", func() {
+							Expect(fakeWorkerClient.FindOrCreateContainerCallCount()).To(Equal(1))
+							Expect(fakeTaskOutputCacheFactory.FindCallCount()).To(Equal(1))
+						})
+
+						It("still reports the image version to the build", func() {
+							Expect(fakeDelegate.ImageVersionDeterminedCallCount()).To(Equal(1))
+						})
+
+						Context("when a new version of the image is fetched", func() {
+							var firstKey string
+
+							BeforeEach(func() {
+								fakeProcess.WaitReturns(0, nil)
+							})
+
+							JustBeforeEach(func() {
+								_, firstKey = fakeTaskOutputCacheFactory.FindArgsForCall(0)
+
+								imageVersion = atc.Version{"digest": "sha256:2"}
+
+								Expect(taskStep.Run(ctx, state)).To(Succeed())
+							})
+
+							It("uses a different key", func() {
+								_, secondKey := fakeTaskOutputCacheFactory.FindArgsForCall(1)
+								Expect(secondKey).ToNot(Equal(firstKey))
+							})
+						})
+
+						Context("when a previous run with the same image version has been cached", func() {
+							BeforeEach(func() {
+								dbVolume := new(dbfakes.FakeCreatedVolume)
+								dbVolume.WorkerNameReturns("some-worker")
+								dbVolume.HandleReturns("some-cached-handle")
+
+								fakeTaskOutputCacheFactory.FindReturns(&db.UsedTaskOutputCache{
+									ID: 99,
+									Volumes: map[string]db.CreatedVolume{
+										"some-output": dbVolume,
+									},
+								}, true, nil)
+								fakeTaskOutputCacheFactory.UseReturns(true, nil)
+
+								fakeWorker := new(workerfakes.FakeWorker)
+								fakeWorker.NameReturns("some-worker")
+								fakeWorker.LookupVolumeReturns(new(workerfakes.FakeVolume), true, nil)
+								fakeWorkerClient.RunningWorkersReturns([]worker.Worker{fakeWorker}, nil)
+							})
+
+							It("reuses the outputs without running the task", func() {
+								Expect(stepErr).ToNot(HaveOccurred())
+								Expect(fakeContainer.RunCallCount()).To(BeZero())
+								Expect(taskStep.Succeeded()).To(BeTrue())
+							})
+
+							It("marks the task as finished in the container used to fetch the image", func() {
+								Expect(fakeContainer.MarkTaskFinishedCallCount()).To(Equal(1))
+							})
+						})
+					})
+				})
+
 				Context("when an image artifact name is specified", func() {
 					BeforeEach(func() {
 						imageArtifactName = "some-image-artifact"
@@ -1305,6 +1780,10 @@ var _ = Describe("TaskStep", func() {
 						Expect(taskStep.Succeeded()).To(BeTrue())
 					})
 
+					It("marks the task as finished", func() {
+						Expect(fakeContainer.MarkTaskFinishedCallCount()).To(Equal(1))
+					})
+
 					It("doesn't register a source", func() {
 						Expect(stepErr).ToNot(HaveOccurred())
 
@@ -1312,6 +1791,88 @@ var _ = Describe("TaskStep", func() {
 						Expect(sourceMap).To(BeEmpty())
 					})
 
+					It("looks for annotations in the build directory", func() {
+						Expect(fakeContainer.StreamOutCallCount()).To(Equal(1))
+						Expect(fakeContainer.StreamOutArgsForCall(0)).To(Equal(garden.StreamOutSpec{
+							Path: "some-artifact-root/.annotations.json",
+						}))
+					})
+
+					It("does not annotate the build when the task wrote no annotations", func() {
+						Expect(fakeDelegate.AnnotatedCallCount()).To(BeZero())
+					})
+
+					Context("when the task wrote annotations", func() {
+						var annotations string
+
+						BeforeEach(func() {
+							annotations = `{"version":"1.2.3","commit":"https://example.com/abcdef"}`
+
+							fakeContainer.StreamOutStub = func(garden.StreamOutSpec) (io.ReadCloser, error) {
+								return annotationsStream(annotations), nil
+							}
+						})
+
+						It("annotates the build via the delegate", func() {
+							Expect(fakeDelegate.AnnotatedCallCount()).To(Equal(1))
+							_, actual := fakeDelegate.AnnotatedArgsForCall(0)
+							Expect(actual).To(Equal(map[string]string{
+								"version": "1.2.3",
+								"commit":  "https://example.com/abcdef",
+							}))
+						})
+
+						Context("when there are too many annotations, or they are too long", func() {
+							BeforeEach(func() {
+								entries := []string{fmt.Sprintf(`"long":"%s"`, strings.Repeat("x", exec.MaxTaskAnnotationLength+1))}
+								for i := 0; i < exec.MaxTaskAnnotations+1; i++ {
+									entries = append(entries, fmt.Sprintf(`"key-%02d":"value"`, i))
+								}
+
+								annotations = "{" + strings.Join(entries, ",") + "}"
+							})
+
+							It("annotates the build with those within the limits", func() {
+								Expect(fakeDelegate.AnnotatedCallCount()).To(Equal(1))
+								_, actual := fakeDelegate.AnnotatedArgsForCall(0)
+								Expect(actual).To(HaveLen(exec.MaxTaskAnnotations))
+								Expect(actual).ToNot(HaveKey("long"))
+								Expect(actual).ToNot(HaveKey(fmt.Sprintf("key-%02d", exec.MaxTaskAnnotations)))
+							})
+
+							It("warns about the dropped annotations", func() {
+								Expect(stderrBuf).To(gbytes.Say(fmt.Sprintf(`\[WARNING\] ignoring annotations beyond the first %d, or longer than %d characters: key-%02d, long`, exec.MaxTaskAnnotations, exec.MaxTaskAnnotationLength, exec.MaxTaskAnnotations)))
+							})
+						})
+
+						Context("when the annotations file is too large", func() {
+							BeforeEach(func() {
+								annotations = fmt.Sprintf(`{"huge":"%s"}`, strings.Repeat("x", 64*1024))
+							})
+
+							It("warns and does not annotate the build", func() {
+								Expect(stderrBuf).To(gbytes.Say(`\[WARNING\] ignoring .annotations.json larger than`))
+								Expect(fakeDelegate.AnnotatedCallCount()).To(BeZero())
+							})
+						})
+
+						Context("when the annotations are malformed", func() {
+							BeforeEach(func() {
+								annotations = `{"tests":42}`
+							})
+
+							It("warns and does not annotate the build", func() {
+								Expect(stderrBuf).To(gbytes.Say(`\[WARNING\] ignoring malformed .annotations.json`))
+								Expect(fakeDelegate.AnnotatedCallCount()).To(BeZero())
+							})
+
+							It("is still successful", func() {
+								Expect(stepErr).ToNot(HaveOccurred())
+								Expect(taskStep.Succeeded()).To(BeTrue())
+							})
+						})
+					})
+
 					Context("when saving the exit status succeeds", func() {
 						BeforeEach(func() {
 							fakeContainer.SetPropertyReturns(nil)
@@ -1423,6 +1984,10 @@ var _ = Describe("TaskStep", func() {
 					It("is not successful", func() {
 						Expect(taskStep.Succeeded()).To(BeFalse())
 					})
+
+					It("does not mark the task as finished, as it may still be running", func() {
+						Expect(fakeContainer.MarkTaskFinishedCallCount()).To(BeZero())
+					})
 				})
 
 				Context("when the process is interrupted", func() {
@@ -1451,6 +2016,10 @@ var _ = Describe("TaskStep", func() {
 						Expect(stepErr).To(Equal(context.Canceled))
 					})
 
+					It("marks the task as finished", func() {
+						Expect(fakeContainer.MarkTaskFinishedCallCount()).To(Equal(1))
+					})
+
 					It("is not successful", func() {
 						Expect(taskStep.Succeeded()).To(BeFalse())
 					})
@@ -1533,3 +2102,41 @@ var _ = Describe("TaskStep", func() {
 		})
 	})
 })
+
+func traceParent(ctx context.Context) string {
+	sc, ok := tracing.SpanContextFromContext(ctx)
+	Expect(ok).To(BeTrue())
+	return sc.TraceParent()
+}
+
+type identifiedArtifactSource struct {
+	*workerfakes.FakeArtifactSource
+
+	identity string
+}
+
+func (source *identifiedArtifactSource) Identity() string {
+	return source.identity
+}
+
+// annotationsStream tars up the contents of a task's annotations file, as
+// streamed out of its container.
+func annotationsStream(annotations string) io.ReadCloser {
+	buf := gbytes.NewBuffer()
+
+	tarWriter := tar.NewWriter(buf)
+
+	err := tarWriter.WriteHeader(&tar.Header{
+		Name: ".annotations.json",
+		Mode: 0644,
+		Size: int64(len(annotations)),
+	})
+	Expect(err).NotTo(HaveOccurred())
+
+	_, err = tarWriter.Write([]byte(annotations))
+	Expect(err).NotTo(HaveOccurred())
+
+	Expect(tarWriter.Close()).To(Succeed())
+
+	return buf
+}
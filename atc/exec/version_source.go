@@ -2,11 +2,13 @@ package exec
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
 )
 
-func NewVersionSourceFromPlan(getPlan *atc.GetPlan) VersionSource {
+func NewVersionSourceFromPlan(getPlan *atc.GetPlan, build db.Build) VersionSource {
 	if getPlan.Version != nil {
 		return &StaticVersionSource{
 			version: *getPlan.Version,
@@ -15,6 +17,12 @@ func NewVersionSourceFromPlan(getPlan *atc.GetPlan) VersionSource {
 		return &PutStepVersionSource{
 			planID: *getPlan.VersionFrom,
 		}
+	} else if getPlan.Space != "" {
+		return &SpaceVersionSource{
+			build:    build,
+			resource: getPlan.Resource,
+			space:    getPlan.Space,
+		}
 	} else {
 		return &EmptyVersionSource{}
 	}
@@ -47,6 +55,45 @@ func (p *PutStepVersionSource) Version(state RunState) (atc.Version, error) {
 	return info.Version, nil
 }
 
+// SpaceVersionSource finds the latest version checked in a space of the
+// pipeline resource, as only the default space is scheduled.
+type SpaceVersionSource struct {
+	build    db.Build
+	resource string
+	space    string
+}
+
+func (p *SpaceVersionSource) Version(RunState) (atc.Version, error) {
+	pipeline, found, err := p.build.Pipeline()
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return nil, fmt.Errorf("pipeline of build %d not found", p.build.ID())
+	}
+
+	resource, found, err := pipeline.Resource(p.resource)
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return nil, fmt.Errorf("resource '%s' not found", p.resource)
+	}
+
+	version, found, err := resource.LatestSpaceVersion(p.space)
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return nil, fmt.Errorf("no version of resource '%s' has been found in space '%s'", p.resource, p.space)
+	}
+
+	return version, nil
+}
+
 type EmptyVersionSource struct{}
 
 func (p *EmptyVersionSource) Version(RunState) (atc.Version, error) {
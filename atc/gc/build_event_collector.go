@@ -0,0 +1,46 @@
+package gc
+
+import (
+	"context"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type buildEventCollector struct {
+	buildEventMigrator db.BuildEventMigrator
+	batchSize          int
+}
+
+// NewBuildEventCollector returns a Collector which moves the events of
+// completed builds out of the database and into the configured event store,
+// batchSize builds at a time.
+func NewBuildEventCollector(
+	buildEventMigrator db.BuildEventMigrator,
+	batchSize int,
+) Collector {
+	return &buildEventCollector{
+		buildEventMigrator: buildEventMigrator,
+		batchSize:          batchSize,
+	}
+}
+
+func (bec *buildEventCollector) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("build-event-collector")
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+
+	migrated, err := bec.buildEventMigrator.MigrateCompletedBuilds(logger, bec.batchSize)
+	if err != nil {
+		logger.Error("failed-to-migrate-build-events", err)
+		return err
+	}
+
+	if migrated > 0 {
+		logger.Debug("migrated", lager.Data{"builds": migrated})
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package gc_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/gc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("BuildEventCollector", func() {
+	var (
+		fakeBuildEventMigrator *dbfakes.FakeBuildEventMigrator
+		collector              gc.Collector
+
+		runErr error
+	)
+
+	BeforeEach(func() {
+		fakeBuildEventMigrator = new(dbfakes.FakeBuildEventMigrator)
+		collector = gc.NewBuildEventCollector(fakeBuildEventMigrator, 42)
+	})
+
+	JustBeforeEach(func() {
+		runErr = collector.Run(context.TODO())
+	})
+
+	It("migrates a batch of completed builds", func() {
+		Expect(runErr).ToNot(HaveOccurred())
+		Expect(fakeBuildEventMigrator.MigrateCompletedBuildsCallCount()).To(Equal(1))
+
+		_, limit := fakeBuildEventMigrator.MigrateCompletedBuildsArgsForCall(0)
+		Expect(limit).To(Equal(42))
+	})
+
+	Context("when migrating fails", func() {
+		disaster := errors.New("disaster")
+
+		BeforeEach(func() {
+			fakeBuildEventMigrator.MigrateCompletedBuildsReturns(0, disaster)
+		})
+
+		It("returns the error", func() {
+			Expect(runErr).To(Equal(disaster))
+		})
+	})
+})
@@ -66,6 +66,7 @@ func (br *buildLogCollector) Run(ctx context.Context) error {
 
 				buildsToConsiderDeleting, _, err = job.Builds(
 					db.Page{Since: 2, Limit: 1},
+					nil,
 				)
 				if err != nil {
 					logger.Error("failed-to-get-job-build-1-to-delete", err)
@@ -78,6 +79,7 @@ func (br *buildLogCollector) Run(ctx context.Context) error {
 			if limit > 0 {
 				moreBuildsToConsiderDeleting, _, err := job.Builds(
 					db.Page{Until: until, Limit: limit},
+					nil,
 				)
 				if err != nil {
 					logger.Error("failed-to-get-job-builds-to-delete", err)
@@ -97,6 +99,7 @@ func (br *buildLogCollector) Run(ctx context.Context) error {
 
 			buildsToRetain, _, err := job.Builds(
 				db.Page{Limit: buildLogsToRetain},
+					nil,
 			)
 			if err != nil {
 				logger.Error("failed-to-get-job-builds-to-retain", err)
@@ -85,7 +85,7 @@ var _ = Describe("BuildLogCollector", func() {
 					)
 				})
 				BeforeEach(func() {
-					fakeJob.BuildsStub = func(page db.Page) ([]db.Build, db.Pagination, error) {
+					fakeJob.BuildsStub = func(page db.Page, _ []string) ([]db.Build, db.Pagination, error) {
 						if page == (db.Page{Until: 5, Limit: 5}) {
 							return []db.Build{sbDrained(1, true), sbDrained(2, false), sbDrained(3, false), sbDrained(4, true), sbDrained(5, false)}, db.Pagination{}, nil
 						} else if page == (db.Page{Limit: 10}) {
@@ -121,7 +121,7 @@ var _ = Describe("BuildLogCollector", func() {
 
 			Context("when drain has not been configured", func() {
 				BeforeEach(func() {
-					fakeJob.BuildsStub = func(page db.Page) ([]db.Build, db.Pagination, error) {
+					fakeJob.BuildsStub = func(page db.Page, _ []string) ([]db.Build, db.Pagination, error) {
 						if page == (db.Page{Until: 5, Limit: 5}) {
 							return []db.Build{sbDrained(1, true), sbDrained(2, false), sbDrained(3, false), sbDrained(4, true), sbDrained(5, false)}, db.Pagination{}, nil
 						} else if page == (db.Page{Limit: 10}) {
@@ -143,7 +143,7 @@ var _ = Describe("BuildLogCollector", func() {
 			})
 			Context("when there are more build logs than we can reap in this run", func() {
 				BeforeEach(func() {
-					fakeJob.BuildsStub = func(page db.Page) ([]db.Build, db.Pagination, error) {
+					fakeJob.BuildsStub = func(page db.Page, _ []string) ([]db.Build, db.Pagination, error) {
 						if page == (db.Page{Limit: 10}) {
 							return []db.Build{sb(25), sb(24), sb(23), sb(22), sb(21), sb(20), sb(19), sb(18), sb(17), sb(16)}, db.Pagination{}, nil
 						} else if page == (db.Page{Until: 5, Limit: 5}) {
@@ -219,7 +219,7 @@ var _ = Describe("BuildLogCollector", func() {
 
 			Context("when there are fewer build logs than we can reap in this run", func() {
 				BeforeEach(func() {
-					fakeJob.BuildsStub = func(page db.Page) ([]db.Build, db.Pagination, error) {
+					fakeJob.BuildsStub = func(page db.Page, _ []string) ([]db.Build, db.Pagination, error) {
 						if page == (db.Page{Limit: 10}) {
 							return []db.Build{sb(18), sb(17), sb(16), sb(15), sb(14), sb(13), sb(12), sb(11), sb(10), sb(9)}, db.Pagination{}, nil
 						} else if page == (db.Page{Until: 5, Limit: 5}) {
@@ -256,7 +256,7 @@ var _ = Describe("BuildLogCollector", func() {
 
 			Context("when the builds we want to reap are still running", func() {
 				BeforeEach(func() {
-					fakeJob.BuildsStub = func(page db.Page) ([]db.Build, db.Pagination, error) {
+					fakeJob.BuildsStub = func(page db.Page, _ []string) ([]db.Build, db.Pagination, error) {
 						if page == (db.Page{Limit: 10}) {
 							return []db.Build{sb(25), sb(24), sb(23), sb(22), sb(21), sb(20), sb(19), sb(18), sb(17), sb(16)}, db.Pagination{}, nil
 						} else if page == (db.Page{Until: 5, Limit: 5}) {
@@ -299,7 +299,7 @@ var _ = Describe("BuildLogCollector", func() {
 
 			Context("when no builds need to be reaped", func() {
 				BeforeEach(func() {
-					fakeJob.BuildsStub = func(page db.Page) ([]db.Build, db.Pagination, error) {
+					fakeJob.BuildsStub = func(page db.Page, _ []string) ([]db.Build, db.Pagination, error) {
 						if page == (db.Page{Limit: 10}) {
 							return []db.Build{sb(12), sb(11), sb(10), sb(9), sb(8), sb(7), sb(6), sb(5), sb(4), sb(3)}, db.Pagination{}, nil
 						} else if page == (db.Page{Until: 5, Limit: 5}) {
@@ -382,7 +382,7 @@ var _ = Describe("BuildLogCollector", func() {
 				BeforeEach(func() {
 					buildLogRetainCalc = NewBuildLogRetentionCalculator(3, 3)
 
-					fakeJob.BuildsStub = func(page db.Page) ([]db.Build, db.Pagination, error) {
+					fakeJob.BuildsStub = func(page db.Page, _ []string) ([]db.Build, db.Pagination, error) {
 						if page == (db.Page{Since: 2, Limit: 1}) {
 							return []db.Build{sb(1)}, db.Pagination{}, nil
 						} else if page == (db.Page{Until: 1, Limit: 4}) {
@@ -408,7 +408,7 @@ var _ = Describe("BuildLogCollector", func() {
 
 			Context("when a build of this job has build id 1", func() {
 				BeforeEach(func() {
-					fakeJob.BuildsStub = func(page db.Page) ([]db.Build, db.Pagination, error) {
+					fakeJob.BuildsStub = func(page db.Page, _ []string) ([]db.Build, db.Pagination, error) {
 						if page == (db.Page{Limit: 10}) {
 							return []db.Build{sb(25), sb(24), sb(23), sb(22), sb(21), sb(20), sb(19), sb(18), sb(17), sb(16)}, db.Pagination{}, nil
 						} else if page == (db.Page{Until: 1, Limit: 4}) {
@@ -470,7 +470,7 @@ var _ = Describe("BuildLogCollector", func() {
 
 			Context("when no build of this job has build id 1", func() {
 				BeforeEach(func() {
-					fakeJob.BuildsStub = func(page db.Page) ([]db.Build, db.Pagination, error) {
+					fakeJob.BuildsStub = func(page db.Page, _ []string) ([]db.Build, db.Pagination, error) {
 						if page == (db.Page{Limit: 10}) {
 							return []db.Build{sb(25), sb(24), sb(23), sb(22), sb(21), sb(20), sb(19), sb(18), sb(17), sb(16)}, db.Pagination{}, nil
 						} else if page == (db.Page{Until: 1, Limit: 5}) {
@@ -528,7 +528,7 @@ var _ = Describe("BuildLogCollector", func() {
 
 			Context("when a build of this job has build id 1", func() {
 				BeforeEach(func() {
-					fakeJob.BuildsStub = func(page db.Page) ([]db.Build, db.Pagination, error) {
+					fakeJob.BuildsStub = func(page db.Page, _ []string) ([]db.Build, db.Pagination, error) {
 						if page == (db.Page{Limit: 10}) {
 							return []db.Build{sb(25), sb(24), sb(23), sb(22), sb(21), sb(20), sb(19), sb(18), sb(17), sb(16)}, db.Pagination{}, nil
 						} else if page == (db.Page{Until: 1, Limit: 4}) {
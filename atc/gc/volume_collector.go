@@ -14,15 +14,21 @@ import (
 type volumeCollector struct {
 	volumeRepository         db.VolumeRepository
 	missingVolumeGracePeriod time.Duration
+	artifactRetention        time.Duration
+	taskOutputCacheRetention time.Duration
 }
 
 func NewVolumeCollector(
 	volumeRepository db.VolumeRepository,
 	missingVolumeGracePeriod time.Duration,
+	artifactRetention time.Duration,
+	taskOutputCacheRetention time.Duration,
 ) Collector {
 	return &volumeCollector{
 		volumeRepository:         volumeRepository,
 		missingVolumeGracePeriod: missingVolumeGracePeriod,
+		artifactRetention:        artifactRetention,
+		taskOutputCacheRetention: taskOutputCacheRetention,
 	}
 }
 
@@ -40,6 +46,25 @@ func (vc *volumeCollector) Run(ctx context.Context) error {
 		logger.Error("failed-to-clean-up-failed-volumes", err)
 	}
 
+	// expired artifacts release their volumes, which are then orphaned below
+	err = vc.removeExpiredArtifacts(logger.Session("expired-artifacts"))
+	if err != nil {
+		errs = multierror.Append(errs, err)
+		logger.Error("failed-to-remove-expired-artifacts", err)
+	}
+
+	err = vc.removeExpiredTaskOutputCaches(logger.Session("expired-task-output-caches"))
+	if err != nil {
+		errs = multierror.Append(errs, err)
+		logger.Error("failed-to-remove-expired-task-output-caches", err)
+	}
+
+	err = vc.removeTaskOutputCacheUses(logger.Session("task-output-cache-uses"))
+	if err != nil {
+		errs = multierror.Append(errs, err)
+		logger.Error("failed-to-remove-task-output-cache-uses", err)
+	}
+
 	err = vc.markOrphanedVolumesAsDestroying(logger.Session("mark-volumes"))
 	if err != nil {
 		errs = multierror.Append(errs, err)
@@ -75,6 +100,21 @@ func (vc *volumeCollector) cleanupFailedVolumes(logger lager.Logger) error {
 	return nil
 }
 
+func (vc *volumeCollector) removeExpiredArtifacts(logger lager.Logger) error {
+	removed, err := vc.volumeRepository.RemoveExpiredArtifacts(vc.artifactRetention)
+	if err != nil {
+		return err
+	}
+
+	if removed > 0 {
+		logger.Debug("removed-expired-artifacts", lager.Data{
+			"removed": removed,
+		})
+	}
+
+	return nil
+}
+
 func (vc *volumeCollector) markOrphanedVolumesAsDestroying(logger lager.Logger) error {
 	orphanedVolumesHandles, err := vc.volumeRepository.GetOrphanedVolumes()
 	if err != nil {
@@ -109,3 +149,33 @@ func (vc *volumeCollector) markOrphanedVolumesAsDestroying(logger lager.Logger)
 
 	return nil
 }
+
+func (vc *volumeCollector) removeExpiredTaskOutputCaches(logger lager.Logger) error {
+	removed, err := vc.volumeRepository.RemoveExpiredTaskOutputCaches(vc.taskOutputCacheRetention)
+	if err != nil {
+		return err
+	}
+
+	if removed > 0 {
+		logger.Debug("removed-expired-task-output-caches", lager.Data{
+			"removed": removed,
+		})
+	}
+
+	return nil
+}
+
+func (vc *volumeCollector) removeTaskOutputCacheUses(logger lager.Logger) error {
+	removed, err := vc.volumeRepository.RemoveTaskOutputCacheUsesOfFinishedBuilds()
+	if err != nil {
+		return err
+	}
+
+	if removed > 0 {
+		logger.Debug("removed-task-output-cache-uses", lager.Data{
+			"removed": removed,
+		})
+	}
+
+	return nil
+}
@@ -17,6 +17,8 @@ var _ = Describe("VolumeCollector", func() {
 	var (
 		volumeCollector          gc.Collector
 		missingVolumeGracePeriod time.Duration
+		artifactRetention        time.Duration
+		taskOutputCacheRetention time.Duration
 
 		volumeRepository   db.VolumeRepository
 		workerFactory      db.WorkerFactory
@@ -34,10 +36,14 @@ var _ = Describe("VolumeCollector", func() {
 		workerFactory = db.NewWorkerFactory(dbConn)
 
 		missingVolumeGracePeriod = 1 * time.Minute
+		artifactRetention = 1 * time.Hour
+		taskOutputCacheRetention = 2 * time.Hour
 
 		volumeCollector = gc.NewVolumeCollector(
 			volumeRepository,
 			missingVolumeGracePeriod,
+			artifactRetention,
+			taskOutputCacheRetention,
 		)
 	})
 
@@ -73,6 +79,8 @@ var _ = Describe("VolumeCollector", func() {
 				volumeCollector = gc.NewVolumeCollector(
 					fakeVolumeRepository,
 					missingVolumeGracePeriod,
+					artifactRetention,
+					taskOutputCacheRetention,
 				)
 
 				err = volumeCollector.Run(context.TODO())
@@ -83,6 +91,20 @@ var _ = Describe("VolumeCollector", func() {
 				Expect(fakeVolumeRepository.RemoveMissingVolumesCallCount()).To(Equal(1))
 				Expect(fakeVolumeRepository.RemoveMissingVolumesArgsForCall(0)).To(Equal(missingVolumeGracePeriod))
 			})
+
+			It("removes expired artifacts", func() {
+				Expect(fakeVolumeRepository.RemoveExpiredArtifactsCallCount()).To(Equal(1))
+				Expect(fakeVolumeRepository.RemoveExpiredArtifactsArgsForCall(0)).To(Equal(artifactRetention))
+			})
+
+			It("removes expired task output caches", func() {
+				Expect(fakeVolumeRepository.RemoveExpiredTaskOutputCachesCallCount()).To(Equal(1))
+				Expect(fakeVolumeRepository.RemoveExpiredTaskOutputCachesArgsForCall(0)).To(Equal(taskOutputCacheRetention))
+			})
+
+			It("releases task output caches reused by finished builds", func() {
+				Expect(fakeVolumeRepository.RemoveTaskOutputCacheUsesOfFinishedBuildsCallCount()).To(Equal(1))
+			})
 		})
 
 		Context("when there are failed volumes", func() {
@@ -84,7 +84,7 @@ jobs:
 				It("should NOT be able to set pipelines", func() {
 					ccClient := login(atcURL, "v-user", "v-user")
 
-					_, _, _, err := ccClient.Team(team.Name).CreateOrUpdatePipelineConfig("pipeline-new", "0", pipelineData, false)
+					_, _, _, err := ccClient.Team(team.Name).CreateOrUpdatePipelineConfig(atc.PipelineRef{Name: "pipeline-new"}, "0", pipelineData, false)
 					Expect(err).To(HaveOccurred())
 					Expect(err.Error()).To(Equal("forbidden"))
 				})
@@ -115,7 +115,7 @@ jobs:
 				It("should be able to set pipelines", func() {
 					ccClient := login(atcURL, "m-user", "m-user")
 
-					_, _, _, err := ccClient.Team(team.Name).CreateOrUpdatePipelineConfig("pipeline-new", "0", pipelineData, false)
+					_, _, _, err := ccClient.Team(team.Name).CreateOrUpdatePipelineConfig(atc.PipelineRef{Name: "pipeline-new"}, "0", pipelineData, false)
 					Expect(err).ToNot(HaveOccurred())
 				})
 			})
@@ -141,7 +141,7 @@ jobs:
 				It("should be able to set pipelines", func() {
 					ccClient := login(atcURL, "o-user", "o-user")
 
-					_, _, _, err := ccClient.Team(team.Name).CreateOrUpdatePipelineConfig("pipeline-new", "0", pipelineData, false)
+					_, _, _, err := ccClient.Team(team.Name).CreateOrUpdatePipelineConfig(atc.PipelineRef{Name: "pipeline-new"}, "0", pipelineData, false)
 					Expect(err).ToNot(HaveOccurred())
 				})
 
@@ -203,6 +203,6 @@ func setupTeam(atcURL string, team atc.Team) {
 
 func setupPipeline(atcURL, teamName string, config []byte) {
 	ccClient := login(atcURL, "test", "test")
-	_, _, _, err := ccClient.Team(teamName).CreateOrUpdatePipelineConfig("pipeline-name", "0", config, false)
+	_, _, _, err := ccClient.Team(teamName).CreateOrUpdatePipelineConfig(atc.PipelineRef{Name: "pipeline-name"}, "0", config, false)
 	Expect(err).ToNot(HaveOccurred())
 }
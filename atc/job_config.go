@@ -1,5 +1,11 @@
 package atc
 
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc/cron"
+)
+
 type JobConfig struct {
 	Name   string `yaml:"name" json:"name" mapstructure:"name"`
 	Public bool   `yaml:"public,omitempty" json:"public,omitempty" mapstructure:"public"`
@@ -11,6 +17,8 @@ type JobConfig struct {
 	RawMaxInFlight       int      `yaml:"max_in_flight,omitempty" json:"max_in_flight,omitempty" mapstructure:"max_in_flight"`
 	BuildLogsToRetain    int      `yaml:"build_logs_to_retain,omitempty" json:"build_logs_to_retain,omitempty" mapstructure:"build_logs_to_retain"`
 
+	Schedule *ScheduleConfig `yaml:"schedule,omitempty" json:"schedule,omitempty" mapstructure:"schedule"`
+
 	Plan PlanSequence `yaml:"plan,omitempty" json:"plan,omitempty" mapstructure:"plan"`
 
 	Abort   *PlanConfig `yaml:"on_abort,omitempty" json:"on_abort,omitempty" mapstructure:"on_abort"`
@@ -19,6 +27,62 @@ type JobConfig struct {
 	Success *PlanConfig `yaml:"on_success,omitempty" json:"on_success,omitempty" mapstructure:"on_success"`
 }
 
+// ScheduleConfig triggers a job on a cron schedule, e.g. "0 9 * * mon-fri",
+// evaluated in the given time zone (UTC by default).
+type ScheduleConfig struct {
+	Cron     string `yaml:"cron" json:"cron" mapstructure:"cron"`
+	Location string `yaml:"location,omitempty" json:"location,omitempty" mapstructure:"location"`
+}
+
+// Next returns the first time after the given time at which the schedule
+// fires. The zero time is returned if it never fires.
+func (config ScheduleConfig) Next(after time.Time) (time.Time, error) {
+	schedule, location, err := config.parse()
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return schedule.Next(after.In(location)), nil
+}
+
+// Latest returns the last time after the given time, and no later than until,
+// at which the schedule fires. The zero time is returned if it does not fire
+// in between.
+func (config ScheduleConfig) Latest(after time.Time, until time.Time) (time.Time, error) {
+	schedule, location, err := config.parse()
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	var latest time.Time
+	for {
+		next := schedule.Next(after.In(location))
+		if next.IsZero() || next.After(until) {
+			return latest, nil
+		}
+
+		latest = next
+		after = next
+	}
+}
+
+func (config ScheduleConfig) parse() (cron.Schedule, *time.Location, error) {
+	schedule, err := cron.Parse(config.Cron)
+	if err != nil {
+		return cron.Schedule{}, nil, err
+	}
+
+	location := time.UTC
+	if config.Location != "" {
+		location, err = time.LoadLocation(config.Location)
+		if err != nil {
+			return cron.Schedule{}, nil, err
+		}
+	}
+
+	return schedule, location, nil
+}
+
 func (config JobConfig) Hooks() Hooks {
 	return Hooks{Abort: config.Abort, Failure: config.Failure, Ensure: config.Ensure, Success: config.Success}
 }
@@ -125,7 +189,9 @@ func (config JobConfig) Inputs() []JobInput {
 	var inputs []JobInput
 
 	for _, plan := range config.Plans() {
-		if plan.Get != "" {
+		// versions from a space other than the resource's default are not
+		// tracked by the pipeline, so they can't be scheduled
+		if plan.Get != "" && plan.Space == "" {
 			get := plan.Get
 
 			resource := get
@@ -97,6 +97,11 @@ func Initialize(logger lager.Logger, host string, attributes map[string]string)
 	return nil
 }
 
+// IsConfigured returns true if Initialize configured an emitter.
+func IsConfigured() bool {
+	return emitter != nil
+}
+
 func emit(logger lager.Logger, event Event) {
 	if emitter == nil {
 		return
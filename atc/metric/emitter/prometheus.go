@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"strings"
 	"sync"
 	"time"
 
@@ -25,8 +26,13 @@ type PrometheusEmitter struct {
 	buildsFinishedVec *prometheus.CounterVec
 	buildDurationsVec *prometheus.HistogramVec
 
+	jobBuildDurationsVec *prometheus.HistogramVec
+	jobBuildsRunning     *prometheus.GaugeVec
+	jobBuildsPending     *prometheus.GaugeVec
+
 	workerContainers *prometheus.GaugeVec
 	workerVolumes    *prometheus.GaugeVec
+	workersInState   *prometheus.GaugeVec
 
 	httpRequestsDuration *prometheus.HistogramVec
 
@@ -37,7 +43,14 @@ type PrometheusEmitter struct {
 	dbQueriesTotal prometheus.Counter
 	dbConnections  *prometheus.GaugeVec
 
-	resourceChecksVec *prometheus.CounterVec
+	resourceChecksVec           *prometheus.CounterVec
+	resourceCheckErrorsVec      *prometheus.CounterVec
+	resourceLastSuccessfulCheck *prometheus.GaugeVec
+
+	gcContainersToBeCollected *prometheus.GaugeVec
+	gcVolumesToBeCollected    *prometheus.GaugeVec
+
+	series *seriesLimiter
 
 	workerLastSeen map[string]time.Time
 	mu             sync.Mutex
@@ -46,6 +59,9 @@ type PrometheusEmitter struct {
 type PrometheusConfig struct {
 	BindIP   string `long:"prometheus-bind-ip" description:"IP to listen on to expose Prometheus metrics."`
 	BindPort string `long:"prometheus-bind-port" description:"Port to listen on to expose Prometheus metrics."`
+
+	MaxSeriesPerMetric int           `long:"prometheus-max-series-per-metric" default:"10000" description:"Maximum number of label combinations to expose for each per-job and per-resource metric. Further combinations are dropped. 0 means no limit."`
+	SeriesExpiry       time.Duration `long:"prometheus-series-expiry"         default:"1h"    description:"Length of time after which a label combination of a per-job or per-resource metric is removed if it has not been updated, e.g. because its job or resource was deleted."`
 }
 
 func init() {
@@ -132,6 +148,41 @@ func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
 	)
 	prometheus.MustRegister(buildDurationsVec)
 
+	// job metrics
+	jobBuildDurationsVec := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "concourse",
+			Subsystem: "builds",
+			Name:      "job_duration_seconds",
+			Help:      "Build time in seconds per job",
+			Buckets:   []float64{1, 60, 180, 300, 600, 900, 1200, 1800, 2700, 3600, 7200, 18000, 36000},
+		},
+		[]string{"team", "pipeline", "job", "status"},
+	)
+	prometheus.MustRegister(jobBuildDurationsVec)
+
+	jobBuildsRunning := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "concourse",
+			Subsystem: "jobs",
+			Name:      "running_builds",
+			Help:      "Number of builds currently running per job",
+		},
+		[]string{"team", "pipeline", "job"},
+	)
+	prometheus.MustRegister(jobBuildsRunning)
+
+	jobBuildsPending := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "concourse",
+			Subsystem: "jobs",
+			Name:      "pending_builds",
+			Help:      "Number of builds waiting to start per job",
+		},
+		[]string{"team", "pipeline", "job"},
+	)
+	prometheus.MustRegister(jobBuildsPending)
+
 	// worker metrics
 	workerContainers := prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
@@ -155,6 +206,17 @@ func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
 	)
 	prometheus.MustRegister(workerVolumes)
 
+	workersInState := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "concourse",
+			Subsystem: "workers",
+			Name:      "registered",
+			Help:      "Number of registered workers per state and platform",
+		},
+		[]string{"state", "platform"},
+	)
+	prometheus.MustRegister(workersInState)
+
 	// http metrics
 	httpRequestsDuration := prometheus.NewHistogramVec(
 		prometheus.HistogramOpts{
@@ -231,6 +293,62 @@ func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
 	)
 	prometheus.MustRegister(resourceChecksVec)
 
+	resourceCheckErrorsVec := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "concourse",
+			Subsystem: "resource",
+			Name:      "check_errors_total",
+			Help:      "Counts the number of resource checks that failed per resource",
+		},
+		[]string{"team", "pipeline", "resource"},
+	)
+	prometheus.MustRegister(resourceCheckErrorsVec)
+
+	resourceLastSuccessfulCheck := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "concourse",
+			Subsystem: "resource",
+			Name:      "last_successful_check_age_seconds",
+			Help:      "Time in seconds since the resource was last checked successfully",
+		},
+		[]string{"team", "pipeline", "resource"},
+	)
+	prometheus.MustRegister(resourceLastSuccessfulCheck)
+
+	// gc metrics
+	gcContainersToBeCollected := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "concourse",
+			Subsystem: "gc",
+			Name:      "containers_to_be_collected",
+			Help:      "Number of containers found for garbage collection per state",
+		},
+		[]string{"state"},
+	)
+	prometheus.MustRegister(gcContainersToBeCollected)
+
+	gcVolumesToBeCollected := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "concourse",
+			Subsystem: "gc",
+			Name:      "volumes_to_be_collected",
+			Help:      "Number of volumes found for garbage collection per state",
+		},
+		[]string{"state"},
+	)
+	prometheus.MustRegister(gcVolumesToBeCollected)
+
+	seriesDropped := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "concourse",
+			Subsystem: "metrics",
+			Name:      "series_dropped_total",
+			Help:      "Number of observations dropped because a metric reached its maximum number of series",
+		},
+		[]string{"metric"},
+	)
+	prometheus.MustRegister(seriesDropped)
+
 	listener, err := net.Listen("tcp", config.bind())
 	if err != nil {
 		return nil, err
@@ -238,6 +356,13 @@ func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
 
 	go http.Serve(listener, promhttp.Handler())
 
+	series := newSeriesLimiter(config.MaxSeriesPerMetric, config.SeriesExpiry, seriesDropped)
+	series.limit("builds_job_duration_seconds", jobBuildDurationsVec)
+	series.limit("jobs_running_builds", jobBuildsRunning)
+	series.limit("jobs_pending_builds", jobBuildsPending)
+	series.limit("resource_check_errors_total", resourceCheckErrorsVec)
+	series.limit("resource_last_successful_check_age_seconds", resourceLastSuccessfulCheck)
+
 	emitter := &PrometheusEmitter{
 		buildsStarted:     buildsStarted,
 		buildsFinished:    buildsFinished,
@@ -248,8 +373,13 @@ func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
 		buildsFailed:      buildsFailed,
 		buildsAborted:     buildsAborted,
 
+		jobBuildDurationsVec: jobBuildDurationsVec,
+		jobBuildsRunning:     jobBuildsRunning,
+		jobBuildsPending:     jobBuildsPending,
+
 		workerContainers: workerContainers,
 		workerVolumes:    workerVolumes,
+		workersInState:   workersInState,
 
 		httpRequestsDuration: httpRequestsDuration,
 
@@ -260,7 +390,14 @@ func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
 		dbQueriesTotal: dbQueriesTotal,
 		dbConnections:  dbConnections,
 
-		resourceChecksVec: resourceChecksVec,
+		resourceChecksVec:           resourceChecksVec,
+		resourceCheckErrorsVec:      resourceCheckErrorsVec,
+		resourceLastSuccessfulCheck: resourceLastSuccessfulCheck,
+
+		gcContainersToBeCollected: gcContainersToBeCollected,
+		gcVolumesToBeCollected:    gcVolumesToBeCollected,
+
+		series: series,
 
 		workerLastSeen: map[string]time.Time{},
 	}
@@ -301,6 +438,22 @@ func (emitter *PrometheusEmitter) Emit(logger lager.Logger, event metric.Event)
 		emitter.databaseMetrics(logger, event)
 	case "resource checked":
 		emitter.resourceMetric(logger, event)
+	case "job builds running", "job builds pending":
+		emitter.jobBuildsMetric(logger, event)
+	case "workers in state":
+		emitter.workersInStateMetric(logger, event)
+	case "resource last successful check":
+		emitter.resourceLastSuccessfulCheckMetric(logger, event)
+	case "creating containers to be garbage collected",
+		"created containers to be garbage collected",
+		"destroying containers to be garbage collected",
+		"failed containers to be garbage collected":
+		emitter.gcMetric(logger, event, emitter.gcContainersToBeCollected)
+	case "orphaned volumes to be garbage collected",
+		"created volumes to be garbage collected",
+		"destroying volumes to be garbage collected",
+		"failed volumes to be garbage collected":
+		emitter.gcMetric(logger, event, emitter.gcVolumesToBeCollected)
 	default:
 		// unless we have a specific metric, we do nothing
 	}
@@ -355,6 +508,56 @@ func (emitter *PrometheusEmitter) buildFinishedMetrics(logger lager.Logger, even
 	// seconds are the standard prometheus base unit for time
 	duration = duration / 1000
 	emitter.buildDurationsVec.WithLabelValues(team, pipeline).Observe(duration)
+
+	// concourse_builds_job_duration_seconds
+	job, exists := event.Attributes["job"]
+	if !exists || job == "" {
+		// one-off builds do not belong to a job
+		return
+	}
+
+	if emitter.series.allow("builds_job_duration_seconds", team, pipeline, job, buildStatus) {
+		emitter.jobBuildDurationsVec.WithLabelValues(team, pipeline, job, buildStatus).Observe(duration)
+	}
+}
+
+func (emitter *PrometheusEmitter) jobBuildsMetric(logger lager.Logger, event metric.Event) {
+	team, exists := event.Attributes["team_name"]
+	if !exists {
+		logger.Error("failed-to-find-team-name-in-event", fmt.Errorf("expected team_name to exist in event.Attributes"))
+		return
+	}
+
+	pipeline, exists := event.Attributes["pipeline"]
+	if !exists {
+		logger.Error("failed-to-find-pipeline-in-event", fmt.Errorf("expected pipeline to exist in event.Attributes"))
+		return
+	}
+
+	job, exists := event.Attributes["job"]
+	if !exists {
+		logger.Error("failed-to-find-job-in-event", fmt.Errorf("expected job to exist in event.Attributes"))
+		return
+	}
+
+	builds, ok := event.Value.(int)
+	if !ok {
+		logger.Error("job-builds-event-value-type-mismatch", fmt.Errorf("expected event.Value to be an int"))
+		return
+	}
+
+	switch event.Name {
+	case "job builds running":
+		// concourse_jobs_running_builds
+		if emitter.series.allow("jobs_running_builds", team, pipeline, job) {
+			emitter.jobBuildsRunning.WithLabelValues(team, pipeline, job).Set(float64(builds))
+		}
+	case "job builds pending":
+		// concourse_jobs_pending_builds
+		if emitter.series.allow("jobs_pending_builds", team, pipeline, job) {
+			emitter.jobBuildsPending.WithLabelValues(team, pipeline, job).Set(float64(builds))
+		}
+	}
 }
 
 func (emitter *PrometheusEmitter) workerContainersMetric(logger lager.Logger, event metric.Event) {
@@ -399,6 +602,41 @@ func (emitter *PrometheusEmitter) workerVolumesMetric(logger lager.Logger, event
 	emitter.workerVolumes.WithLabelValues(worker, platform).Set(float64(volumes))
 }
 
+func (emitter *PrometheusEmitter) workersInStateMetric(logger lager.Logger, event metric.Event) {
+	state, exists := event.Attributes["state"]
+	if !exists {
+		logger.Error("failed-to-find-state-in-event", fmt.Errorf("expected state to exist in event.Attributes"))
+		return
+	}
+
+	platform, exists := event.Attributes["platform"]
+	if !exists {
+		logger.Error("failed-to-find-platform-in-event", fmt.Errorf("expected platform to exist in event.Attributes"))
+		return
+	}
+
+	workers, ok := event.Value.(int)
+	if !ok {
+		logger.Error("workers-in-state-event-value-type-mismatch", fmt.Errorf("expected event.Value to be an int"))
+		return
+	}
+
+	emitter.workersInState.WithLabelValues(state, platform).Set(float64(workers))
+}
+
+func (emitter *PrometheusEmitter) gcMetric(logger lager.Logger, event metric.Event, gauge *prometheus.GaugeVec) {
+	count, ok := event.Value.(int)
+	if !ok {
+		logger.Error("gc-event-value-type-mismatch", fmt.Errorf("expected event.Value to be an int"))
+		return
+	}
+
+	// the state is the first word of the event name, e.g. "created" or "orphaned"
+	state := strings.SplitN(event.Name, " ", 2)[0]
+
+	gauge.WithLabelValues(state).Set(float64(count))
+}
+
 func (emitter *PrometheusEmitter) httpResponseTimeMetrics(logger lager.Logger, event metric.Event) {
 	route, exists := event.Attributes["route"]
 	if !exists {
@@ -475,12 +713,56 @@ func (emitter *PrometheusEmitter) resourceMetric(logger lager.Logger, event metr
 		return
 	}
 	team, exists := event.Attributes["team"]
+	if !exists {
+		logger.Error("failed-to-find-team-in-event", fmt.Errorf("expected team to exist in event.Attributes"))
+		return
+	}
+
+	emitter.resourceChecksVec.WithLabelValues(team, pipeline).Inc()
+
+	resource, exists := event.Attributes["resource"]
+	if !exists {
+		logger.Error("failed-to-find-resource-in-event", fmt.Errorf("expected resource to exist in event.Attributes"))
+		return
+	}
+
+	if event.State != metric.EventStateOK {
+		// concourse_resource_check_errors_total
+		if emitter.series.allow("resource_check_errors_total", team, pipeline, resource) {
+			emitter.resourceCheckErrorsVec.WithLabelValues(team, pipeline, resource).Inc()
+		}
+	}
+}
+
+func (emitter *PrometheusEmitter) resourceLastSuccessfulCheckMetric(logger lager.Logger, event metric.Event) {
+	team, exists := event.Attributes["team_name"]
+	if !exists {
+		logger.Error("failed-to-find-team-name-in-event", fmt.Errorf("expected team_name to exist in event.Attributes"))
+		return
+	}
+
+	pipeline, exists := event.Attributes["pipeline"]
 	if !exists {
 		logger.Error("failed-to-find-pipeline-in-event", fmt.Errorf("expected pipeline to exist in event.Attributes"))
 		return
 	}
 
-	emitter.resourceChecksVec.WithLabelValues(pipeline, team).Inc()
+	resource, exists := event.Attributes["resource"]
+	if !exists {
+		logger.Error("failed-to-find-resource-in-event", fmt.Errorf("expected resource to exist in event.Attributes"))
+		return
+	}
+
+	age, ok := event.Value.(float64)
+	if !ok {
+		logger.Error("resource-last-successful-check-event-value-type-mismatch", fmt.Errorf("expected event.Value to be a float64"))
+		return
+	}
+
+	// concourse_resource_last_successful_check_age_seconds
+	if emitter.series.allow("resource_last_successful_check_age_seconds", team, pipeline, resource) {
+		emitter.resourceLastSuccessfulCheck.WithLabelValues(team, pipeline, resource).Set(age)
+	}
 }
 
 // updateLastSeen tracks for each worker when it last received a metric event.
@@ -510,6 +792,85 @@ func (emitter *PrometheusEmitter) periodicMetricGC() {
 			}
 		}
 		emitter.mu.Unlock()
+
+		emitter.series.expire(now)
+
 		time.Sleep(60 * time.Second)
 	}
 }
+
+// labelDeleter is implemented by the metric vectors whose series are limited.
+type labelDeleter interface {
+	DeleteLabelValues(...string) bool
+}
+
+// seriesLimiter caps the number of label combinations exposed for metrics
+// whose labels come from user-defined names, so that a large installation
+// cannot overwhelm Prometheus. Label combinations which have not been updated
+// for the expiry are removed, making room for new ones.
+type seriesLimiter struct {
+	max     int
+	expiry  time.Duration
+	dropped *prometheus.CounterVec
+
+	vecs     map[string]labelDeleter
+	lastSeen map[string]map[string]time.Time
+	mu       sync.Mutex
+}
+
+func newSeriesLimiter(max int, expiry time.Duration, dropped *prometheus.CounterVec) *seriesLimiter {
+	return &seriesLimiter{
+		max:      max,
+		expiry:   expiry,
+		dropped:  dropped,
+		vecs:     map[string]labelDeleter{},
+		lastSeen: map[string]map[string]time.Time{},
+	}
+}
+
+// limit registers the vector holding the series of the metric, so that they
+// can be removed once expired.
+func (limiter *seriesLimiter) limit(metricName string, vec labelDeleter) {
+	limiter.vecs[metricName] = vec
+	limiter.lastSeen[metricName] = map[string]time.Time{}
+}
+
+// allow reports whether the given label values may be recorded for the
+// metric, remembering when they were last recorded.
+func (limiter *seriesLimiter) allow(metricName string, labelValues ...string) bool {
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+
+	known := limiter.lastSeen[metricName]
+
+	key := strings.Join(labelValues, "\x00")
+	if _, found := known[key]; !found && limiter.max > 0 && len(known) >= limiter.max {
+		limiter.dropped.WithLabelValues(metricName).Inc()
+		return false
+	}
+
+	known[key] = time.Now()
+
+	return true
+}
+
+// expire removes the series which have not been recorded for the expiry.
+func (limiter *seriesLimiter) expire(now time.Time) {
+	if limiter.expiry <= 0 {
+		return
+	}
+
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+
+	for metricName, known := range limiter.lastSeen {
+		for key, lastSeen := range known {
+			if now.Sub(lastSeen) <= limiter.expiry {
+				continue
+			}
+
+			limiter.vecs[metricName].DeleteLabelValues(strings.Split(key, "\x00")...)
+			delete(known, key)
+		}
+	}
+}
@@ -1,6 +1,9 @@
 package metric_test
 
 import (
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/metric"
+	"github.com/concourse/concourse/atc/metric/metricfakes"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
@@ -11,3 +14,25 @@ func TestMetric(t *testing.T) {
 	RegisterFailHandler(Fail)
 	RunSpecs(t, "Metric Suite")
 }
+
+// emitter receives the events emitted during the current spec. The metric
+// package can only be initialized once, so the registered emitter forwards
+// to whichever fake is current.
+var emitter *metricfakes.FakeEmitter
+
+var _ = BeforeSuite(func() {
+	emitterFactory := &metricfakes.FakeEmitterFactory{}
+	emitterFactory.IsConfiguredReturns(true)
+	emitterFactory.NewEmitterReturns(&metricfakes.FakeEmitter{
+		EmitStub: func(logger lager.Logger, event metric.Event) {
+			emitter.Emit(logger, event)
+		},
+	}, nil)
+
+	metric.RegisterEmitter(emitterFactory)
+	Expect(metric.Initialize(nil, "test", map[string]string{})).To(Succeed())
+})
+
+var _ = BeforeEach(func() {
+	emitter = &metricfakes.FakeEmitter{}
+})
@@ -415,3 +415,83 @@ func (event ResourceCheck) Emit(logger lager.Logger) {
 		},
 	)
 }
+
+type JobBuildsInFlight struct {
+	TeamName     string
+	PipelineName string
+	JobName      string
+	Running      int
+	Pending      int
+}
+
+func (event JobBuildsInFlight) Emit(logger lager.Logger) {
+	attributes := map[string]string{
+		"team_name": event.TeamName,
+		"pipeline":  event.PipelineName,
+		"job":       event.JobName,
+	}
+
+	emit(
+		logger.Session("job-builds-running"),
+		Event{
+			Name:       "job builds running",
+			Value:      event.Running,
+			State:      EventStateOK,
+			Attributes: attributes,
+		},
+	)
+
+	emit(
+		logger.Session("job-builds-pending"),
+		Event{
+			Name:       "job builds pending",
+			Value:      event.Pending,
+			State:      EventStateOK,
+			Attributes: attributes,
+		},
+	)
+}
+
+type WorkersInState struct {
+	State    string
+	Platform string
+	Workers  int
+}
+
+func (event WorkersInState) Emit(logger lager.Logger) {
+	emit(
+		logger.Session("workers-in-state"),
+		Event{
+			Name:  "workers in state",
+			Value: event.Workers,
+			State: EventStateOK,
+			Attributes: map[string]string{
+				"state":    event.State,
+				"platform": event.Platform,
+			},
+		},
+	)
+}
+
+type ResourceLastSuccessfulCheck struct {
+	TeamName     string
+	PipelineName string
+	ResourceName string
+	Age          time.Duration
+}
+
+func (event ResourceLastSuccessfulCheck) Emit(logger lager.Logger) {
+	emit(
+		logger.Session("resource-last-successful-check"),
+		Event{
+			Name:  "resource last successful check",
+			Value: event.Age.Seconds(),
+			State: EventStateOK,
+			Attributes: map[string]string{
+				"team_name": event.TeamName,
+				"pipeline":  event.PipelineName,
+				"resource":  event.ResourceName,
+			},
+		},
+	)
+}
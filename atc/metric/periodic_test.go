@@ -8,7 +8,6 @@ import (
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
 	"github.com/concourse/concourse/atc/metric"
-	"github.com/concourse/concourse/atc/metric/metricfakes"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
@@ -16,25 +15,14 @@ import (
 )
 
 var _ = Describe("Periodic emission of metrics", func() {
-	var (
-		emitter *metricfakes.FakeEmitter
-
-		process ifrit.Process
-	)
+	var process ifrit.Process
 
 	BeforeEach(func() {
-		emitterFactory := &metricfakes.FakeEmitterFactory{}
-		emitter = &metricfakes.FakeEmitter{}
-
-		metric.RegisterEmitter(emitterFactory)
-		emitterFactory.IsConfiguredReturns(true)
-		emitterFactory.NewEmitterReturns(emitter, nil)
 		a := &dbfakes.FakeConn{}
 		a.NameReturns("A")
 		b := &dbfakes.FakeConn{}
 		b.NameReturns("B")
 		metric.Databases = []db.Conn{a, b}
-		metric.Initialize(nil, "test", map[string]string{})
 
 		process = ifrit.Invoke(metric.PeriodicallyEmit(lager.NewLogger("dont care"), 250*time.Millisecond))
 	})
@@ -0,0 +1,150 @@
+package metric
+
+import (
+	"context"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// NewStateEmitter returns a task which emits metrics describing what the
+// cluster is doing, such as the builds waiting on each job, the state of the
+// workers and how long ago each resource was last checked successfully. Once a
+// job or worker state has been reported, it keeps being reported (as zero) so
+// that gauges go back down rather than going stale.
+//
+// The metrics are computed from the database, so the task should only be run
+// by one ATC at a time; otherwise every ATC reports the same values.
+func NewStateEmitter(
+	jobFactory db.JobFactory,
+	workerFactory db.WorkerFactory,
+	resourceFactory db.ResourceFactory,
+) *StateEmitter {
+	return &StateEmitter{
+		jobFactory:      jobFactory,
+		workerFactory:   workerFactory,
+		resourceFactory: resourceFactory,
+
+		seenJobs:      map[jobKey]bool{},
+		seenPlatforms: map[string]bool{},
+	}
+}
+
+type jobKey struct {
+	teamName     string
+	pipelineName string
+	jobName      string
+}
+
+var workerStates = []db.WorkerState{
+	db.WorkerStateRunning,
+	db.WorkerStateStalled,
+	db.WorkerStateLanding,
+	db.WorkerStateLanded,
+	db.WorkerStateRetiring,
+}
+
+type StateEmitter struct {
+	jobFactory      db.JobFactory
+	workerFactory   db.WorkerFactory
+	resourceFactory db.ResourceFactory
+
+	seenJobs      map[jobKey]bool
+	seenPlatforms map[string]bool
+}
+
+func (emitter *StateEmitter) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("state-emitter")
+
+	emitter.emitJobBuilds(logger)
+	emitter.emitWorkerStates(logger)
+	emitter.emitResourceChecks(logger)
+
+	return nil
+}
+
+func (emitter *StateEmitter) emitJobBuilds(logger lager.Logger) {
+	counts, err := emitter.jobFactory.InFlightBuildCounts()
+	if err != nil {
+		logger.Error("failed-to-get-in-flight-build-counts", err)
+		return
+	}
+
+	reported := map[jobKey]bool{}
+	for _, count := range counts {
+		key := jobKey{count.TeamName, count.PipelineName, count.JobName}
+		reported[key] = true
+		emitter.seenJobs[key] = true
+
+		JobBuildsInFlight{
+			TeamName:     count.TeamName,
+			PipelineName: count.PipelineName,
+			JobName:      count.JobName,
+			Running:      count.Running,
+			Pending:      count.Pending,
+		}.Emit(logger)
+	}
+
+	for key := range emitter.seenJobs {
+		if reported[key] {
+			continue
+		}
+
+		JobBuildsInFlight{
+			TeamName:     key.teamName,
+			PipelineName: key.pipelineName,
+			JobName:      key.jobName,
+		}.Emit(logger)
+
+		delete(emitter.seenJobs, key)
+	}
+}
+
+func (emitter *StateEmitter) emitWorkerStates(logger lager.Logger) {
+	workers, err := emitter.workerFactory.Workers()
+	if err != nil {
+		logger.Error("failed-to-get-workers", err)
+		return
+	}
+
+	counts := map[string]map[db.WorkerState]int{}
+	for _, worker := range workers {
+		emitter.seenPlatforms[worker.Platform()] = true
+
+		if counts[worker.Platform()] == nil {
+			counts[worker.Platform()] = map[db.WorkerState]int{}
+		}
+
+		counts[worker.Platform()][worker.State()]++
+	}
+
+	for platform := range emitter.seenPlatforms {
+		for _, state := range workerStates {
+			WorkersInState{
+				State:    string(state),
+				Platform: platform,
+				Workers:  counts[platform][state],
+			}.Emit(logger)
+		}
+	}
+}
+
+func (emitter *StateEmitter) emitResourceChecks(logger lager.Logger) {
+	checks, err := emitter.resourceFactory.LastSuccessfulChecks()
+	if err != nil {
+		logger.Error("failed-to-get-last-successful-checks", err)
+		return
+	}
+
+	now := time.Now()
+	for _, check := range checks {
+		ResourceLastSuccessfulCheck{
+			TeamName:     check.TeamName,
+			PipelineName: check.PipelineName,
+			ResourceName: check.ResourceName,
+			Age:          now.Sub(check.LastSuccessfulCheck),
+		}.Emit(logger)
+	}
+}
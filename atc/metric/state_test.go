@@ -0,0 +1,139 @@
+package metric_test
+
+import (
+	"context"
+	"time"
+
+	"code.cloudfoundry.org/lager/lagerctx"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/metric"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+var _ = Describe("StateEmitter", func() {
+	var (
+		jobFactory      *dbfakes.FakeJobFactory
+		workerFactory   *dbfakes.FakeWorkerFactory
+		resourceFactory *dbfakes.FakeResourceFactory
+
+		stateEmitter *metric.StateEmitter
+		ctx          context.Context
+	)
+
+	emitted := func() []interface{} {
+		var events []interface{}
+		for _, args := range emitter.Invocations()["Emit"] {
+			events = append(events, args[1])
+		}
+		return events
+	}
+
+	BeforeEach(func() {
+		jobFactory = new(dbfakes.FakeJobFactory)
+		jobFactory.InFlightBuildCountsReturnsOnCall(0, []db.JobBuildCounts{
+			{
+				TeamName:     "some-team",
+				PipelineName: "some-pipeline",
+				JobName:      "some-job",
+				Running:      1,
+				Pending:      2,
+			},
+		}, nil)
+
+		runningWorker := new(dbfakes.FakeWorker)
+		runningWorker.StateReturns(db.WorkerStateRunning)
+		runningWorker.PlatformReturns("linux")
+
+		stalledWorker := new(dbfakes.FakeWorker)
+		stalledWorker.StateReturns(db.WorkerStateStalled)
+		stalledWorker.PlatformReturns("linux")
+
+		workerFactory = new(dbfakes.FakeWorkerFactory)
+		workerFactory.WorkersReturns([]db.Worker{runningWorker, stalledWorker}, nil)
+
+		resourceFactory = new(dbfakes.FakeResourceFactory)
+		resourceFactory.LastSuccessfulChecksReturns([]db.ResourceLastSuccessfulCheck{
+			{
+				TeamName:            "some-team",
+				PipelineName:        "some-pipeline",
+				ResourceName:        "some-resource",
+				LastSuccessfulCheck: time.Now().Add(-time.Hour),
+			},
+		}, nil)
+	})
+
+	JustBeforeEach(func() {
+		stateEmitter = metric.NewStateEmitter(
+			jobFactory,
+			workerFactory,
+			resourceFactory,
+		)
+
+		ctx = lagerctx.NewContext(context.Background(), lagertest.NewTestLogger("test"))
+
+		Expect(stateEmitter.Run(ctx)).To(Succeed())
+	})
+
+	It("emits the running and pending builds of each job", func() {
+		Eventually(emitted).Should(ContainElement(MatchFields(IgnoreExtras, Fields{
+			"Name":  Equal("job builds running"),
+			"Value": Equal(1),
+			"Attributes": SatisfyAll(
+				HaveKeyWithValue("team_name", "some-team"),
+				HaveKeyWithValue("pipeline", "some-pipeline"),
+				HaveKeyWithValue("job", "some-job"),
+			),
+		})))
+
+		Expect(emitted()).To(ContainElement(MatchFields(IgnoreExtras, Fields{
+			"Name":  Equal("job builds pending"),
+			"Value": Equal(2),
+		})))
+	})
+
+	It("emits zero for jobs which no longer have builds in flight", func() {
+		Expect(stateEmitter.Run(ctx)).To(Succeed())
+
+		Eventually(emitted).Should(ContainElement(MatchFields(IgnoreExtras, Fields{
+			"Name":       Equal("job builds pending"),
+			"Value":      Equal(0),
+			"Attributes": HaveKeyWithValue("job", "some-job"),
+		})))
+	})
+
+	It("emits the number of workers in each state", func() {
+		Eventually(emitted).Should(ContainElement(MatchFields(IgnoreExtras, Fields{
+			"Name":  Equal("workers in state"),
+			"Value": Equal(1),
+			"Attributes": SatisfyAll(
+				HaveKeyWithValue("state", "stalled"),
+				HaveKeyWithValue("platform", "linux"),
+			),
+		})))
+
+		Expect(emitted()).To(ContainElement(MatchFields(IgnoreExtras, Fields{
+			"Name":  Equal("workers in state"),
+			"Value": Equal(0),
+			"Attributes": SatisfyAll(
+				HaveKeyWithValue("state", "landing"),
+				HaveKeyWithValue("platform", "linux"),
+			),
+		})))
+	})
+
+	It("emits how long ago each resource was last checked successfully", func() {
+		Eventually(emitted).Should(ContainElement(MatchFields(IgnoreExtras, Fields{
+			"Name":  Equal("resource last successful check"),
+			"Value": BeNumerically("~", time.Hour.Seconds(), 60),
+			"Attributes": SatisfyAll(
+				HaveKeyWithValue("team_name", "some-team"),
+				HaveKeyWithValue("pipeline", "some-pipeline"),
+				HaveKeyWithValue("resource", "some-resource"),
+			),
+		})))
+	})
+})
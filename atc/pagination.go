@@ -1,5 +1,7 @@
 package atc
 
+import "net/url"
+
 const (
 	LinkRelNext     = "next"
 	LinkRelPrevious = "previous"
@@ -11,4 +13,19 @@ const (
 	PaginationQueryLimit      = "limit"
 	PaginationWebLimit        = 100
 	PaginationAPIDefaultLimit = 100
+
+	// BuildsQueryAnnotation only lists builds with the given annotation. It may
+	// be given more than once, in which case builds must have all of them.
+	BuildsQueryAnnotation = "annotation"
 )
+
+// BuildsAnnotationQuery returns the query to append to a link to another page
+// of builds, so that the page is filtered by the same annotations.
+func BuildsAnnotationQuery(keys []string) string {
+	query := ""
+	for _, key := range keys {
+		query += "&" + BuildsQueryAnnotation + "=" + url.QueryEscape(key)
+	}
+
+	return query
+}
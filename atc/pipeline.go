@@ -1,14 +1,25 @@
 package atc
 
 type Pipeline struct {
-	ID       int          `json:"id"`
-	Name     string       `json:"name"`
-	Paused   bool         `json:"paused"`
-	Public   bool         `json:"public"`
-	Groups   GroupConfigs `json:"groups,omitempty"`
-	TeamName string       `json:"team_name"`
+	ID            int          `json:"id"`
+	Name          string       `json:"name"`
+	Paused        bool         `json:"paused"`
+	Public        bool         `json:"public"`
+	Archived      bool         `json:"archived,omitempty"`
+	InstanceVars  InstanceVars `json:"instance_vars,omitempty"`
+	Groups        GroupConfigs `json:"groups,omitempty"`
+	TeamName      string       `json:"team_name"`
+	ParentBuildID int          `json:"parent_build_id,omitempty"`
+	ParentJobID   int          `json:"parent_job_id,omitempty"`
 }
 
 type RenameRequest struct {
 	NewName string `json:"name"`
 }
+
+func (pipeline Pipeline) Ref() PipelineRef {
+	return PipelineRef{
+		Name:         pipeline.Name,
+		InstanceVars: pipeline.InstanceVars,
+	}
+}
@@ -0,0 +1,92 @@
+package atc
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// InstanceVarsQueryParam is the query parameter used to select a pipeline
+// instance in API requests. Its value is the JSON-encoded instance vars.
+const InstanceVarsQueryParam = "vars"
+
+// InstanceVars distinguish pipelines of the same name within a team, e.g.
+// one pipeline per branch of a repository.
+type InstanceVars map[string]interface{}
+
+// PipelineRef identifies a pipeline within a team by its name and, for
+// instanced pipelines, its instance vars.
+type PipelineRef struct {
+	Name         string       `json:"name"`
+	InstanceVars InstanceVars `json:"instance_vars,omitempty"`
+}
+
+// String renders the ref as "name" or, for instanced pipelines,
+// "name/key1:value1,key2:value2" with the keys in sorted order.
+func (ref PipelineRef) String() string {
+	if len(ref.InstanceVars) == 0 {
+		return ref.Name
+	}
+
+	keys := []string{}
+	for key := range ref.InstanceVars {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	pairs := []string{}
+	for _, key := range keys {
+		pairs = append(pairs, key+":"+formatInstanceVar(ref.InstanceVars[key]))
+	}
+
+	return ref.Name + "/" + strings.Join(pairs, ",")
+}
+
+// QueryParams returns the query parameters selecting the instance in API
+// requests, or nil if the pipeline is not instanced.
+func (ref PipelineRef) QueryParams() url.Values {
+	if len(ref.InstanceVars) == 0 {
+		return nil
+	}
+
+	payload, _ := json.Marshal(ref.InstanceVars)
+
+	return url.Values{InstanceVarsQueryParam: []string{string(payload)}}
+}
+
+// InstanceVarsFromQueryParams parses the instance vars given by the "vars"
+// query parameter. It returns nil if the parameter is absent.
+func InstanceVarsFromQueryParams(params url.Values) (InstanceVars, error) {
+	payload := params.Get(InstanceVarsQueryParam)
+	if payload == "" {
+		return nil, nil
+	}
+
+	var vars InstanceVars
+	err := json.Unmarshal([]byte(payload), &vars)
+	if err != nil {
+		return nil, fmt.Errorf("malformed instance vars: %s", err)
+	}
+
+	if len(vars) == 0 {
+		return nil, nil
+	}
+
+	return vars, nil
+}
+
+func formatInstanceVar(value interface{}) string {
+	if str, ok := value.(string); ok {
+		return str
+	}
+
+	payload, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+
+	return string(payload)
+}
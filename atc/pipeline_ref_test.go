@@ -0,0 +1,61 @@
+package atc_test
+
+import (
+	"net/url"
+
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PipelineRef", func() {
+	Describe("String", func() {
+		It("renders just the name for a plain pipeline", func() {
+			Expect(atc.PipelineRef{Name: "some-pipeline"}.String()).To(Equal("some-pipeline"))
+		})
+
+		It("renders the instance vars in sorted order", func() {
+			ref := atc.PipelineRef{
+				Name: "some-pipeline",
+				InstanceVars: atc.InstanceVars{
+					"branch":  "feature/foo",
+					"version": 2,
+					"debug":   true,
+				},
+			}
+
+			Expect(ref.String()).To(Equal("some-pipeline/branch:feature/foo,debug:true,version:2"))
+		})
+	})
+
+	Describe("QueryParams", func() {
+		It("is empty for a plain pipeline", func() {
+			Expect(atc.PipelineRef{Name: "some-pipeline"}.QueryParams()).To(BeEmpty())
+		})
+
+		It("round-trips through InstanceVarsFromQueryParams", func() {
+			ref := atc.PipelineRef{
+				Name:         "some-pipeline",
+				InstanceVars: atc.InstanceVars{"branch": "master"},
+			}
+
+			vars, err := atc.InstanceVarsFromQueryParams(ref.QueryParams())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(vars).To(Equal(ref.InstanceVars))
+		})
+	})
+
+	Describe("InstanceVarsFromQueryParams", func() {
+		It("returns nil when the param is absent", func() {
+			vars, err := atc.InstanceVarsFromQueryParams(url.Values{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(vars).To(BeNil())
+		})
+
+		It("errors when the param is malformed", func() {
+			_, err := atc.InstanceVarsFromQueryParams(url.Values{"vars": {"{"}})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -29,6 +29,7 @@ type radarSchedulerFactory struct {
 	resourceConfigCheckSessionFactory db.ResourceConfigCheckSessionFactory
 	resourceTypeCheckingInterval      time.Duration
 	resourceCheckingInterval          time.Duration
+	enableGlobalResources             bool
 	engine                            engine.Engine
 }
 
@@ -37,6 +38,7 @@ func NewRadarSchedulerFactory(
 	resourceConfigCheckSessionFactory db.ResourceConfigCheckSessionFactory,
 	resourceTypeCheckingInterval time.Duration,
 	resourceCheckingInterval time.Duration,
+	enableGlobalResources bool,
 	engine engine.Engine,
 ) RadarSchedulerFactory {
 	return &radarSchedulerFactory{
@@ -44,12 +46,13 @@ func NewRadarSchedulerFactory(
 		resourceConfigCheckSessionFactory: resourceConfigCheckSessionFactory,
 		resourceTypeCheckingInterval:      resourceTypeCheckingInterval,
 		resourceCheckingInterval:          resourceCheckingInterval,
+		enableGlobalResources:             enableGlobalResources,
 		engine:                            engine,
 	}
 }
 
 func (rsf *radarSchedulerFactory) BuildScanRunnerFactory(dbPipeline db.Pipeline, externalURL string, variables creds.Variables) radar.ScanRunnerFactory {
-	return radar.NewScanRunnerFactory(rsf.resourceFactory, rsf.resourceConfigCheckSessionFactory, rsf.resourceTypeCheckingInterval, rsf.resourceCheckingInterval, dbPipeline, clock.NewClock(), externalURL, variables)
+	return radar.NewScanRunnerFactory(rsf.resourceFactory, rsf.resourceConfigCheckSessionFactory, rsf.resourceTypeCheckingInterval, rsf.resourceCheckingInterval, rsf.enableGlobalResources, dbPipeline, clock.NewClock(), externalURL, variables)
 }
 
 func (rsf *radarSchedulerFactory) BuildScheduler(pipeline db.Pipeline, externalURL string, variables creds.Variables) scheduler.BuildScheduler {
@@ -69,6 +72,7 @@ func (rsf *radarSchedulerFactory) BuildScheduler(pipeline db.Pipeline, externalU
 		rsf.resourceFactory,
 		rsf.resourceConfigCheckSessionFactory,
 		rsf.resourceCheckingInterval,
+		rsf.enableGlobalResources,
 		pipeline,
 		externalURL,
 		variables,
@@ -94,5 +98,6 @@ func (rsf *radarSchedulerFactory) BuildScheduler(pipeline db.Pipeline, externalU
 			rsf.engine,
 		),
 		Scanner: scanner,
+		Clock:   clock.NewClock(),
 	}
 }
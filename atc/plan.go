@@ -17,6 +17,11 @@ type Plan struct {
 	Timeout   *TimeoutPlan   `json:"timeout,omitempty"`
 	Retry     *RetryPlan     `json:"retry,omitempty"`
 
+	SetPipeline *SetPipelinePlan `json:"set_pipeline,omitempty"`
+	LoadVar     *LoadVarPlan     `json:"load_var,omitempty"`
+	Across      *AcrossPlan      `json:"across,omitempty"`
+	Run         *RunPlan         `json:"run,omitempty"`
+
 	// used for 'fly execute'
 	UserArtifact   *UserArtifactPlan   `json:"user_artifact,omitempty"`
 	ArtifactOutput *ArtifactOutputPlan `json:"artifact_output,omitempty"`
@@ -74,6 +79,7 @@ type GetPlan struct {
 	Resource    string   `json:"resource"`
 	Source      Source   `json:"source"`
 	Params      Params   `json:"params,omitempty"`
+	Space       string   `json:"space,omitempty"`
 	Version     *Version `json:"version,omitempty"`
 	VersionFrom *PlanID  `json:"version_from,omitempty"`
 	Tags        Tags     `json:"tags,omitempty"`
@@ -87,11 +93,28 @@ type PutPlan struct {
 	Resource string `json:"resource"`
 	Source   Source `json:"source"`
 	Params   Params `json:"params,omitempty"`
+	Space    string `json:"space,omitempty"`
 	Tags     Tags   `json:"tags,omitempty"`
 
 	VersionedResourceTypes VersionedResourceTypes `json:"resource_types,omitempty"`
 }
 
+type RunPlan struct {
+	Message  string `json:"message"`
+	Type     string `json:"type"`
+	Resource string `json:"resource,omitempty"`
+	Source   Source `json:"source,omitempty"`
+	Params   Params `json:"params,omitempty"`
+	Tags     Tags   `json:"tags,omitempty"`
+
+	Inputs        []string          `json:"inputs,omitempty"`
+	Outputs       []string          `json:"outputs,omitempty"`
+	InputMapping  map[string]string `json:"input_mapping,omitempty"`
+	OutputMapping map[string]string `json:"output_mapping,omitempty"`
+
+	VersionedResourceTypes VersionedResourceTypes `json:"resource_types,omitempty"`
+}
+
 type TaskPlan struct {
 	Name string `json:"name,omitempty"`
 
@@ -104,11 +127,38 @@ type TaskPlan struct {
 	Params            Params            `json:"params,omitempty"`
 	InputMapping      map[string]string `json:"input_mapping,omitempty"`
 	OutputMapping     map[string]string `json:"output_mapping,omitempty"`
+	Artifacts         []string          `json:"artifacts,omitempty"`
+	CacheOutputs      bool              `json:"cache_outputs,omitempty"`
 	ImageArtifactName string            `json:"image,omitempty"`
 
 	VersionedResourceTypes VersionedResourceTypes `json:"resource_types,omitempty"`
 }
 
+type SetPipelinePlan struct {
+	Name     string   `json:"name"`
+	File     string   `json:"file"`
+	VarFiles []string `json:"var_files,omitempty"`
+	Vars     Params   `json:"vars,omitempty"`
+}
+
+type LoadVarPlan struct {
+	Name      string `json:"name"`
+	File      string `json:"file"`
+	Format    string `json:"format,omitempty"`
+	Sensitive bool   `json:"sensitive,omitempty"`
+}
+
+type AcrossPlan struct {
+	Var         string            `json:"var"`
+	Iterations  []AcrossIteration `json:"iterations"`
+	MaxInFlight int               `json:"max_in_flight"`
+}
+
+type AcrossIteration struct {
+	Value interface{} `json:"value"`
+	Step  Plan        `json:"step"`
+}
+
 type RetryPlan []Plan
 
 type DependentGetPlan struct {
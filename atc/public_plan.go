@@ -19,6 +19,10 @@ func (plan Plan) Public() *json.RawMessage {
 		DependentGet   *json.RawMessage `json:"dependent_get,omitempty"`
 		Timeout        *json.RawMessage `json:"timeout,omitempty"`
 		Retry          *json.RawMessage `json:"retry,omitempty"`
+		SetPipeline    *json.RawMessage `json:"set_pipeline,omitempty"`
+		LoadVar        *json.RawMessage `json:"load_var,omitempty"`
+		Across         *json.RawMessage `json:"across,omitempty"`
+		Run            *json.RawMessage `json:"run,omitempty"`
 		UserArtifact   *json.RawMessage `json:"user_artifact,omitempty"`
 		ArtifactOutput *json.RawMessage `json:"artifact_output,omitempty"`
 	}
@@ -73,6 +77,22 @@ func (plan Plan) Public() *json.RawMessage {
 		public.Retry = plan.Retry.Public()
 	}
 
+	if plan.SetPipeline != nil {
+		public.SetPipeline = plan.SetPipeline.Public()
+	}
+
+	if plan.LoadVar != nil {
+		public.LoadVar = plan.LoadVar.Public()
+	}
+
+	if plan.Across != nil {
+		public.Across = plan.Across.Public()
+	}
+
+	if plan.Run != nil {
+		public.Run = plan.Run.Public()
+	}
+
 	if plan.UserArtifact != nil {
 		public.UserArtifact = plan.UserArtifact.Public()
 	}
@@ -214,6 +234,59 @@ func (plan TryPlan) Public() *json.RawMessage {
 	})
 }
 
+func (plan SetPipelinePlan) Public() *json.RawMessage {
+	return enc(struct {
+		Name string `json:"name"`
+	}{
+		Name: plan.Name,
+	})
+}
+
+func (plan LoadVarPlan) Public() *json.RawMessage {
+	return enc(struct {
+		Name string `json:"name"`
+	}{
+		Name: plan.Name,
+	})
+}
+
+func (plan AcrossPlan) Public() *json.RawMessage {
+	type publicIteration struct {
+		Value interface{}      `json:"value"`
+		Step  *json.RawMessage `json:"step"`
+	}
+
+	iterations := make([]publicIteration, len(plan.Iterations))
+	for i, iteration := range plan.Iterations {
+		iterations[i] = publicIteration{
+			Value: iteration.Value,
+			Step:  iteration.Step.Public(),
+		}
+	}
+
+	return enc(struct {
+		Var         string            `json:"var"`
+		Iterations  []publicIteration `json:"iterations"`
+		MaxInFlight int               `json:"max_in_flight"`
+	}{
+		Var:         plan.Var,
+		Iterations:  iterations,
+		MaxInFlight: plan.MaxInFlight,
+	})
+}
+
+func (plan RunPlan) Public() *json.RawMessage {
+	return enc(struct {
+		Message  string `json:"message"`
+		Type     string `json:"type"`
+		Resource string `json:"resource,omitempty"`
+	}{
+		Message:  plan.Message,
+		Type:     plan.Type,
+		Resource: plan.Resource,
+	})
+}
+
 func (plan RetryPlan) Public() *json.RawMessage {
 	public := make([]*json.RawMessage, len(plan))
 
@@ -295,6 +295,61 @@ var _ = Describe("Plan", func() {
 							Name: "some-name",
 						},
 					},
+
+					atc.Plan{
+						ID: "33",
+						SetPipeline: &atc.SetPipelinePlan{
+							Name:     "some-pipeline",
+							File:     "some-repo/pipeline.yml",
+							VarFiles: []string{"some-repo/vars.yml"},
+							Vars:     atc.Params{"some": "secret"},
+						},
+					},
+
+					atc.Plan{
+						ID: "36",
+						LoadVar: &atc.LoadVarPlan{
+							Name:      "some-var",
+							File:      "some-repo/version",
+							Format:    "raw",
+							Sensitive: true,
+						},
+					},
+
+					atc.Plan{
+						ID: "37",
+						Run: &atc.RunPlan{
+							Message:  "delete-branch",
+							Type:     "git",
+							Resource: "some-resource",
+							Source:   atc.Source{"some": "secret"},
+							Params:   atc.Params{"some": "secret"},
+							Inputs:   []string{"some-input"},
+						},
+					},
+
+					atc.Plan{
+						ID: "34",
+						Across: &atc.AcrossPlan{
+							Var:         "some-var",
+							MaxInFlight: 2,
+							Iterations: []atc.AcrossIteration{
+								{
+									Value: "some-value",
+									Step: atc.Plan{
+										ID: "35",
+										Task: &atc.TaskPlan{
+											Name:       "name",
+											ConfigPath: "some/config/path.yml",
+											Config: &atc.TaskConfig{
+												Params: map[string]string{"some": "secret"},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
 				},
 			}
 
@@ -510,6 +565,45 @@ var _ = Describe("Plan", func() {
 			"artifact_output": {
 				"name": "some-name"
 			}
+		},
+		{
+			"id": "33",
+			"set_pipeline": {
+				"name": "some-pipeline"
+			}
+		},
+		{
+			"id": "36",
+			"load_var": {
+				"name": "some-var"
+			}
+		},
+		{
+			"id": "37",
+			"run": {
+				"message": "delete-branch",
+				"type": "git",
+				"resource": "some-resource"
+			}
+		},
+		{
+			"id": "34",
+			"across": {
+				"var": "some-var",
+				"iterations": [
+					{
+						"value": "some-value",
+						"step": {
+							"id": "35",
+							"task": {
+								"name": "name",
+								"privileged": false
+							}
+						}
+					}
+				],
+				"max_in_flight": 2
+			}
 		}
   ]
 }
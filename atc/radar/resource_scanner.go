@@ -12,8 +12,10 @@ import (
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/lock"
 	"github.com/concourse/concourse/atc/metric"
 	"github.com/concourse/concourse/atc/resource"
+	"github.com/concourse/concourse/atc/tracing"
 	"github.com/concourse/concourse/atc/worker"
 )
 
@@ -24,6 +26,7 @@ type resourceScanner struct {
 	resourceFactory                   resource.ResourceFactory
 	resourceConfigCheckSessionFactory db.ResourceConfigCheckSessionFactory
 	defaultInterval                   time.Duration
+	enableGlobalResources             bool
 	dbPipeline                        db.Pipeline
 	externalURL                       string
 	variables                         creds.Variables
@@ -35,6 +38,7 @@ func NewResourceScanner(
 	resourceFactory resource.ResourceFactory,
 	resourceConfigCheckSessionFactory db.ResourceConfigCheckSessionFactory,
 	defaultInterval time.Duration,
+	enableGlobalResources bool,
 	dbPipeline db.Pipeline,
 	externalURL string,
 	variables creds.Variables,
@@ -45,6 +49,7 @@ func NewResourceScanner(
 		resourceFactory:                   resourceFactory,
 		resourceConfigCheckSessionFactory: resourceConfigCheckSessionFactory,
 		defaultInterval:                   defaultInterval,
+		enableGlobalResources:             enableGlobalResources,
 		dbPipeline:                        dbPipeline,
 		externalURL:                       externalURL,
 		variables:                         variables,
@@ -98,6 +103,11 @@ func (scanner *resourceScanner) scan(logger lager.Logger, resourceName string, f
 		return 0, err
 	}
 
+	if !mustComplete && savedResource.CheckEvery() == atc.CheckEveryNever {
+		logger.Debug("periodic-checking-disabled")
+		return interval, nil
+	}
+
 	resourceTypes, err := scanner.dbPipeline.ResourceTypes()
 	if err != nil {
 		logger.Error("failed-to-get-resource-types", err)
@@ -151,18 +161,28 @@ func (scanner *resourceScanner) scan(logger lager.Logger, resourceName string, f
 		return 0, err
 	}
 
-	err = savedResource.SetResourceConfig(resourceConfigCheckSession.ResourceConfig().ID())
-	if err != nil {
-		logger.Error("failed-to-set-resource-config-id-on-resource", err)
-		scanner.setResourceCheckError(logger, savedResource, err)
-		return 0, err
+	resourceConfig := resourceConfigCheckSession.ResourceConfig()
+
+	if savedResource.ResourceConfigID() != resourceConfig.ID() {
+		err = savedResource.SetResourceConfig(resourceConfig.ID())
+		if err != nil {
+			logger.Error("failed-to-set-resource-config-id-on-resource", err)
+			scanner.setResourceCheckError(logger, savedResource, err)
+			return 0, err
+		}
+
+		if scanner.enableGlobalResources {
+			// the config may already have been checked on behalf of other
+			// pipelines
+			scanner.syncVersions(logger, savedResource, resourceConfig)
+		}
 	}
 
 	for breaker := true; breaker == true; breaker = mustComplete {
-		lock, acquired, err := scanner.dbPipeline.AcquireResourceCheckingLockWithIntervalCheck(
+		lock, acquired, err := scanner.acquireCheckingLock(
 			logger,
-			savedResource.Name(),
-			resourceConfigCheckSession.ResourceConfig(),
+			savedResource,
+			resourceConfig,
 			interval,
 			mustComplete,
 		)
@@ -189,15 +209,15 @@ func (scanner *resourceScanner) scan(logger lager.Logger, resourceName string, f
 	}
 
 	if fromVersion == nil {
-		vr, _, err := scanner.dbPipeline.GetLatestVersionedResource(resourceName)
+		var err error
+		fromVersion, err = scanner.latestVersion(savedResource, resourceConfig)
 		if err != nil {
 			logger.Error("failed-to-get-current-version", err)
 			return interval, err
 		}
-		fromVersion = atc.Version(vr.Version)
 	}
 
-	return interval, scanner.check(
+	err = scanner.check(
 		logger,
 		savedResource,
 		resourceConfigCheckSession,
@@ -206,6 +226,79 @@ func (scanner *resourceScanner) scan(logger lager.Logger, resourceName string, f
 		source,
 		saveGiven,
 	)
+
+	return interval, err
+}
+
+func (scanner *resourceScanner) acquireCheckingLock(
+	logger lager.Logger,
+	savedResource db.Resource,
+	resourceConfig db.ResourceConfig,
+	interval time.Duration,
+	immediate bool,
+) (lock.Lock, bool, error) {
+	if scanner.enableGlobalResources {
+		return resourceConfig.AcquireResourceConfigCheckingLockWithIntervalCheck(
+			logger,
+			interval,
+			immediate,
+		)
+	}
+
+	return scanner.dbPipeline.AcquireResourceCheckingLockWithIntervalCheck(
+		logger,
+		savedResource.Name(),
+		resourceConfig,
+		interval,
+		immediate,
+	)
+}
+
+func (scanner *resourceScanner) latestVersion(savedResource db.Resource, resourceConfig db.ResourceConfig) (atc.Version, error) {
+	if scanner.enableGlobalResources {
+		version, _, err := resourceConfig.LatestVersion()
+		return version, err
+	}
+
+	vr, _, err := scanner.dbPipeline.GetLatestVersionedResource(savedResource.Name())
+	if err != nil {
+		return nil, err
+	}
+
+	return atc.Version(vr.Version), nil
+}
+
+// syncVersions copies the versions saved to the resource's config since the
+// resource's latest version into the resource's own version history, for a
+// resource which has just started using the config. Afterwards, the versions
+// found by checking the config are added to every resource using it as they
+// are saved.
+func (scanner *resourceScanner) syncVersions(logger lager.Logger, savedResource db.Resource, resourceConfig db.ResourceConfig) {
+	vr, _, err := scanner.dbPipeline.GetLatestVersionedResource(savedResource.Name())
+	if err != nil {
+		logger.Error("failed-to-get-current-version", err)
+		return
+	}
+
+	versions, err := resourceConfig.VersionsAfter(atc.Version(vr.Version))
+	if err != nil {
+		logger.Error("failed-to-get-resource-config-versions", err)
+		return
+	}
+
+	if len(versions) == 0 {
+		return
+	}
+
+	err = scanner.dbPipeline.SaveResourceVersions(atc.ResourceConfig{
+		Name: savedResource.Name(),
+		Type: savedResource.Type(),
+	}, versions)
+	if err != nil {
+		logger.Error("failed-to-save-versions", err, lager.Data{
+			"versions": versions,
+		})
+	}
 }
 
 func (scanner *resourceScanner) check(
@@ -233,6 +326,30 @@ func (scanner *resourceScanner) check(
 		return nil
 	}
 
+	ctx, span := tracing.StartSpan(context.Background(), "check", tracing.Attrs{
+		"team":          scanner.dbPipeline.TeamName(),
+		"pipeline":      scanner.dbPipeline.Name(),
+		"resource":      savedResource.Name(),
+		"resource_type": savedResource.Type(),
+	})
+
+	err = scanner.runCheck(ctx, logger, savedResource, resourceConfigCheckSession, fromVersion, resourceTypes, source, saveGiven)
+
+	tracing.End(span, err)
+
+	return err
+}
+
+func (scanner *resourceScanner) runCheck(
+	ctx context.Context,
+	logger lager.Logger,
+	savedResource db.Resource,
+	resourceConfigCheckSession db.ResourceConfigCheckSession,
+	fromVersion atc.Version,
+	resourceTypes creds.VersionedResourceTypes,
+	source atc.Source,
+	saveGiven bool,
+) error {
 	found, err := scanner.dbPipeline.Reload()
 	if err != nil {
 		logger.Error("failed-to-reload-scannerdb", err)
@@ -259,7 +376,7 @@ func (scanner *resourceScanner) check(
 	}
 
 	res, err := scanner.resourceFactory.NewResource(
-		context.Background(),
+		ctx,
 		logger,
 		db.NewResourceConfigCheckSessionContainerOwner(resourceConfigCheckSession, scanner.dbPipeline.TeamID()),
 		db.ContainerMetadata{
@@ -286,10 +403,21 @@ func (scanner *resourceScanner) check(
 		logger.Error("failed-to-read-check-timeout", err)
 		return err
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	resourceConfig := resourceConfigCheckSession.ResourceConfig()
+
+	var fromSpaces map[string]atc.Version
+	if res.SupportsSpaces() {
+		fromSpaces, err = resourceConfig.LatestVersions()
+		if err != nil {
+			logger.Error("failed-to-get-latest-space-versions", err)
+			return err
+		}
+	}
+
+	checkCtx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	newVersions, err := res.Check(ctx, source, fromVersion)
+	result, err := res.CheckSpaces(checkCtx, source, fromVersion, fromSpaces)
 	if err == context.DeadlineExceeded {
 		err = fmt.Errorf("Timed out after %v while checking for new versions - perhaps increase your resource check timeout?", timeout)
 	}
@@ -312,6 +440,14 @@ func (scanner *resourceScanner) check(
 		return err
 	}
 
+	err = scanner.saveSpaceVersions(resourceConfig, result)
+	if err != nil {
+		logger.Error("failed-to-save-space-versions", err)
+		return err
+	}
+
+	newVersions := result.Versions[result.DefaultSpace]
+
 	if len(newVersions) == 0 || (!saveGiven && reflect.DeepEqual(newVersions, []atc.Version{fromVersion})) {
 		logger.Debug("no-new-versions")
 		return nil
@@ -322,10 +458,14 @@ func (scanner *resourceScanner) check(
 		"total":    len(newVersions),
 	})
 
-	err = scanner.dbPipeline.SaveResourceVersions(atc.ResourceConfig{
-		Name: savedResource.Name(),
-		Type: savedResource.Type(),
-	}, newVersions)
+	if scanner.enableGlobalResources {
+		err = resourceConfig.SaveResourceVersions(result.DefaultSpace, newVersions)
+	} else {
+		err = scanner.dbPipeline.SaveResourceVersions(atc.ResourceConfig{
+			Name: savedResource.Name(),
+			Type: savedResource.Type(),
+		}, newVersions)
+	}
 	if err != nil {
 		logger.Error("failed-to-save-versions", err, lager.Data{
 			"versions": newVersions,
@@ -335,6 +475,34 @@ func (scanner *resourceScanner) check(
 	return nil
 }
 
+// saveSpaceVersions saves the versions found in each space of a resource with
+// spaces to its config. The pipeline only tracks the default space, so this
+// is where gets and puts with a space find their versions.
+func (scanner *resourceScanner) saveSpaceVersions(resourceConfig db.ResourceConfig, result resource.CheckResult) error {
+	if result.DefaultSpace == "" {
+		return nil
+	}
+
+	err := resourceConfig.SetDefaultSpace(result.DefaultSpace)
+	if err != nil {
+		return err
+	}
+
+	for space, versions := range result.Versions {
+		if space == result.DefaultSpace && scanner.enableGlobalResources {
+			// saved along with the versions of resources without spaces
+			continue
+		}
+
+		err := resourceConfig.SaveVersions(space, versions)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func swallowErrResourceScriptFailed(err error) error {
 	if _, ok := err.(resource.ErrResourceScriptFailed); ok {
 		return nil
@@ -358,7 +526,7 @@ func (scanner *resourceScanner) parseResourceCheckTimeoutOrDefault(checkTimeout
 
 func (scanner *resourceScanner) checkInterval(checkEvery string) (time.Duration, error) {
 	interval := scanner.defaultInterval
-	if checkEvery != "" {
+	if checkEvery != "" && checkEvery != atc.CheckEveryNever {
 		configuredInterval, err := time.ParseDuration(checkEvery)
 		if err != nil {
 			return 0, err
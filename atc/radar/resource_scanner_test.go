@@ -21,6 +21,8 @@ import (
 	. "github.com/concourse/concourse/atc/radar"
 	"github.com/concourse/concourse/atc/resource"
 	rfakes "github.com/concourse/concourse/atc/resource/resourcefakes"
+	"github.com/concourse/concourse/atc/tracing"
+	"github.com/concourse/concourse/atc/tracing/tracingfakes"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -35,6 +37,7 @@ var _ = Describe("ResourceScanner", func() {
 		fakeDBPipeline                        *dbfakes.FakePipeline
 		fakeClock                             *fakeclock.FakeClock
 		interval                              time.Duration
+		enableGlobalResources                 bool
 		variables                             creds.Variables
 
 		fakeResourceType      *dbfakes.FakeResourceType
@@ -55,6 +58,7 @@ var _ = Describe("ResourceScanner", func() {
 		epoch = time.Unix(123, 456).UTC()
 		fakeLock = &lockfakes.FakeLock{}
 		interval = 1 * time.Minute
+		enableGlobalResources = false
 		GlobalResourceCheckTimeout = 1 * time.Hour
 		variables = template.StaticVariables{
 			"source-params": "some-secret-sauce",
@@ -115,12 +119,15 @@ var _ = Describe("ResourceScanner", func() {
 		fakeDBPipeline.ResourceReturns(fakeDBResource, true, nil)
 
 		fakeResourceTypeScanner = new(radarfakes.FakeScanner)
+	})
 
+	JustBeforeEach(func() {
 		scanner = NewResourceScanner(
 			fakeClock,
 			fakeResourceFactory,
 			fakeResourceConfigCheckSessionFactory,
 			interval,
+			enableGlobalResources,
 			fakeDBPipeline,
 			"https://www.example.com",
 			variables,
@@ -150,7 +157,7 @@ var _ = Describe("ResourceScanner", func() {
 			})
 
 			It("does not check", func() {
-				Expect(fakeResource.CheckCallCount()).To(Equal(0))
+				Expect(fakeResource.CheckSpacesCallCount()).To(Equal(0))
 			})
 
 			It("returns the configured interval", func() {
@@ -165,7 +172,7 @@ var _ = Describe("ResourceScanner", func() {
 			})
 
 			It("checks immediately", func() {
-				Expect(fakeResource.CheckCallCount()).To(Equal(1))
+				Expect(fakeResource.CheckSpacesCallCount()).To(Equal(1))
 			})
 
 			It("constructs the resource of the correct type", func() {
@@ -203,6 +210,16 @@ var _ = Describe("ResourceScanner", func() {
 				})))
 			})
 
+			Context("when the resource already uses the resource config", func() {
+				BeforeEach(func() {
+					fakeDBResource.ResourceConfigIDReturns(123)
+				})
+
+				It("does not set it again", func() {
+					Expect(fakeDBResource.SetResourceConfigCallCount()).To(Equal(0))
+				})
+			})
+
 			Context("when the resource config has a specified check interval", func() {
 				BeforeEach(func() {
 					fakeDBResource.CheckEveryReturns("10ms")
@@ -245,6 +262,23 @@ var _ = Describe("ResourceScanner", func() {
 				})
 			})
 
+			Context("when periodic checking is disabled", func() {
+				BeforeEach(func() {
+					fakeDBResource.CheckEveryReturns("never")
+					fakeDBPipeline.ResourceReturns(fakeDBResource, true, nil)
+				})
+
+				It("does not check", func() {
+					Expect(fakeDBPipeline.AcquireResourceCheckingLockWithIntervalCheckCallCount()).To(Equal(0))
+					Expect(fakeResource.CheckSpacesCallCount()).To(Equal(0))
+				})
+
+				It("returns the default interval", func() {
+					Expect(runErr).NotTo(HaveOccurred())
+					Expect(actualInterval).To(Equal(interval))
+				})
+			})
+
 			It("grabs a periodic resource checking lock before checking, breaks lock after done", func() {
 				Expect(fakeDBPipeline.AcquireResourceCheckingLockWithIntervalCheckCallCount()).To(Equal(1))
 
@@ -301,7 +335,7 @@ var _ = Describe("ResourceScanner", func() {
 
 			Context("when there is no current version", func() {
 				It("checks from nil", func() {
-					_, _, version := fakeResource.CheckArgsForCall(0)
+					_, _, version, _ := fakeResource.CheckSpacesArgsForCall(0)
 					Expect(version).To(BeNil())
 				})
 			})
@@ -320,11 +354,77 @@ var _ = Describe("ResourceScanner", func() {
 				})
 
 				It("checks from it", func() {
-					_, _, version := fakeResource.CheckArgsForCall(0)
+					_, _, version, _ := fakeResource.CheckSpacesArgsForCall(0)
 					Expect(version).To(Equal(atc.Version{"version": "1"}))
 				})
 			})
 
+			Context("when the resource config has versions in spaces", func() {
+				BeforeEach(func() {
+					fakeResourceConfig.LatestVersionsReturns(map[string]atc.Version{
+						"other": {"version": "o1"},
+					}, nil)
+				})
+
+				Context("when the resource supports spaces", func() {
+					BeforeEach(func() {
+						fakeResource.SupportsSpacesReturns(true)
+					})
+
+					It("checks each space from its latest version", func() {
+						_, _, _, fromSpaces := fakeResource.CheckSpacesArgsForCall(0)
+						Expect(fromSpaces).To(Equal(map[string]atc.Version{
+							"other": {"version": "o1"},
+						}))
+					})
+				})
+
+				Context("when the resource does not support spaces", func() {
+					It("does not look up the latest versions of the spaces", func() {
+						Expect(fakeResourceConfig.LatestVersionsCallCount()).To(BeZero())
+
+						_, _, _, fromSpaces := fakeResource.CheckSpacesArgsForCall(0)
+						Expect(fromSpaces).To(BeNil())
+					})
+				})
+			})
+
+			Context("when the check returns versions in several spaces", func() {
+				BeforeEach(func() {
+					fakeResource.CheckSpacesReturns(resource.CheckResult{
+						DefaultSpace: "main",
+						Versions: map[string][]atc.Version{
+							"main":  {{"version": "m1"}},
+							"other": {{"version": "o1"}},
+						},
+					}, nil)
+				})
+
+				It("records the default space on the resource config", func() {
+					Expect(fakeResourceConfig.SetDefaultSpaceCallCount()).To(Equal(1))
+					Expect(fakeResourceConfig.SetDefaultSpaceArgsForCall(0)).To(Equal("main"))
+				})
+
+				It("saves the versions of every space to the resource config", func() {
+					saved := map[string][]atc.Version{}
+					for i := 0; i < fakeResourceConfig.SaveVersionsCallCount(); i++ {
+						space, versions := fakeResourceConfig.SaveVersionsArgsForCall(i)
+						saved[space] = versions
+					}
+
+					Expect(saved).To(Equal(map[string][]atc.Version{
+						"main":  {{"version": "m1"}},
+						"other": {{"version": "o1"}},
+					}))
+				})
+
+				It("saves only the default space's versions to the pipeline", func() {
+					Expect(fakeDBPipeline.SaveResourceVersionsCallCount()).To(Equal(1))
+					_, versions := fakeDBPipeline.SaveResourceVersionsArgsForCall(0)
+					Expect(versions).To(Equal([]atc.Version{{"version": "m1"}}))
+				})
+			})
+
 			Context("when the check returns versions", func() {
 				var checkedFrom chan atc.Version
 
@@ -344,7 +444,7 @@ var _ = Describe("ResourceScanner", func() {
 					}
 
 					check := 0
-					fakeResource.CheckStub = func(ctx context.Context, source atc.Source, from atc.Version) ([]atc.Version, error) {
+					fakeResource.CheckSpacesStub = func(ctx context.Context, source atc.Source, from atc.Version, fromSpaces map[string]atc.Version) (resource.CheckResult, error) {
 						defer GinkgoRecover()
 
 						Expect(source).To(Equal(resourceConfig.Source))
@@ -353,7 +453,7 @@ var _ = Describe("ResourceScanner", func() {
 						result := checkResults[check]
 						check++
 
-						return result, nil
+						return defaultSpaceResult(result...), nil
 					}
 				})
 
@@ -388,7 +488,7 @@ var _ = Describe("ResourceScanner", func() {
 				disaster := errors.New("nope")
 
 				BeforeEach(func() {
-					fakeResource.CheckReturns(nil, disaster)
+					fakeResource.CheckSpacesReturns(resource.CheckResult{}, disaster)
 				})
 
 				It("exits with the failure", func() {
@@ -401,7 +501,7 @@ var _ = Describe("ResourceScanner", func() {
 				scriptFail := resource.ErrResourceScriptFailed{}
 
 				BeforeEach(func() {
-					fakeResource.CheckReturns(nil, scriptFail)
+					fakeResource.CheckSpacesReturns(resource.CheckResult{}, scriptFail)
 				})
 
 				It("returns no error", func() {
@@ -410,12 +510,26 @@ var _ = Describe("ResourceScanner", func() {
 			})
 
 			Context("when the pipeline is paused", func() {
+				var fakeExporter *tracingfakes.FakeExporter
+
 				BeforeEach(func() {
 					fakeDBPipeline.CheckPausedReturns(true, nil)
+
+					fakeExporter = new(tracingfakes.FakeExporter)
+					tracing.Configure(fakeExporter, 1)
+				})
+
+				AfterEach(func() {
+					tracing.Configure(nil, 0)
 				})
 
 				It("does not check", func() {
-					Expect(fakeResource.CheckCallCount()).To(BeZero())
+					Expect(fakeResource.CheckSpacesCallCount()).To(BeZero())
+				})
+
+				It("does not trace the check", func() {
+					tracing.Flush()
+					Expect(fakeExporter.ExportSpansCallCount()).To(BeZero())
 				})
 
 				It("returns the default interval", func() {
@@ -437,7 +551,7 @@ var _ = Describe("ResourceScanner", func() {
 				})
 
 				It("does not check", func() {
-					Expect(fakeResource.CheckCallCount()).To(BeZero())
+					Expect(fakeResource.CheckSpacesCallCount()).To(BeZero())
 				})
 
 				It("returns the default interval", func() {
@@ -486,6 +600,112 @@ var _ = Describe("ResourceScanner", func() {
 				})
 			})
 		})
+
+		Context("when global resources are enabled", func() {
+			BeforeEach(func() {
+				enableGlobalResources = true
+
+				fakeDBPipeline.GetLatestVersionedResourceReturns(
+					db.SavedVersionedResource{
+						ID: 1,
+						VersionedResource: db.VersionedResource{
+							Version: db.ResourceVersion{
+								"version": "1",
+							},
+						},
+					}, true, nil)
+
+				fakeResourceConfig.VersionsAfterReturns([]atc.Version{
+					{"version": "2"},
+					{"version": "3"},
+				}, nil)
+			})
+
+			Context("when the resource has just started using the config", func() {
+				BeforeEach(func() {
+					fakeResourceConfig.AcquireResourceConfigCheckingLockWithIntervalCheckReturns(nil, false, nil)
+				})
+
+				It("saves the versions already found by other pipelines to the resource", func() {
+					Expect(fakeResourceConfig.VersionsAfterCallCount()).To(Equal(1))
+					Expect(fakeResourceConfig.VersionsAfterArgsForCall(0)).To(Equal(atc.Version{"version": "1"}))
+
+					Expect(fakeDBPipeline.SaveResourceVersionsCallCount()).To(Equal(1))
+					resourceConfig, versions := fakeDBPipeline.SaveResourceVersionsArgsForCall(0)
+					Expect(resourceConfig).To(Equal(atc.ResourceConfig{
+						Name: "some-resource",
+						Type: "git",
+					}))
+					Expect(versions).To(Equal([]atc.Version{
+						{"version": "2"},
+						{"version": "3"},
+					}))
+				})
+			})
+
+			Context("when the resource config's lock cannot be acquired", func() {
+				BeforeEach(func() {
+					fakeDBResource.ResourceConfigIDReturns(123)
+					fakeResourceConfig.AcquireResourceConfigCheckingLockWithIntervalCheckReturns(nil, false, nil)
+				})
+
+				It("does not check", func() {
+					Expect(fakeResource.CheckSpacesCallCount()).To(Equal(0))
+					Expect(runErr).To(Equal(ErrFailedToAcquireLock))
+				})
+
+				It("does not look for versions found by other pipelines", func() {
+					Expect(fakeResourceConfig.VersionsAfterCallCount()).To(Equal(0))
+					Expect(fakeDBPipeline.SaveResourceVersionsCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the resource config's lock can be acquired", func() {
+				BeforeEach(func() {
+					fakeDBResource.ResourceConfigIDReturns(123)
+					fakeResourceConfig.AcquireResourceConfigCheckingLockWithIntervalCheckReturns(fakeLock, true, nil)
+					fakeResourceConfig.LatestVersionReturns(atc.Version{"version": "3"}, true, nil)
+
+					fakeResource.CheckSpacesReturns(defaultSpaceResult(
+						atc.Version{"version": "3"},
+						atc.Version{"version": "4"},
+					), nil)
+				})
+
+				It("grabs the resource config's lock rather than the resource's", func() {
+					Expect(fakeDBPipeline.AcquireResourceCheckingLockWithIntervalCheckCallCount()).To(Equal(0))
+					Expect(fakeResourceConfig.AcquireResourceConfigCheckingLockWithIntervalCheckCallCount()).To(Equal(1))
+
+					_, leaseInterval, immediate := fakeResourceConfig.AcquireResourceConfigCheckingLockWithIntervalCheckArgsForCall(0)
+					Expect(leaseInterval).To(Equal(interval))
+					Expect(immediate).To(BeFalse())
+
+					Eventually(fakeLock.ReleaseCallCount).Should(Equal(1))
+				})
+
+				It("checks from the resource config's latest version", func() {
+					_, _, version, _ := fakeResource.CheckSpacesArgsForCall(0)
+					Expect(version).To(Equal(atc.Version{"version": "3"}))
+				})
+
+				It("saves the new versions to the resource config and every resource using it", func() {
+					Expect(fakeResourceConfig.SaveResourceVersionsCallCount()).To(Equal(1))
+					space, versions := fakeResourceConfig.SaveResourceVersionsArgsForCall(0)
+					Expect(space).To(Equal(""))
+					Expect(versions).To(Equal([]atc.Version{
+						{"version": "3"},
+						{"version": "4"},
+					}))
+
+					Expect(fakeResourceConfig.SaveVersionsCallCount()).To(Equal(0))
+					Expect(fakeDBPipeline.SaveResourceVersionsCallCount()).To(Equal(0))
+				})
+
+				It("does not look for versions found by other pipelines", func() {
+					Expect(fakeResourceConfig.VersionsAfterCallCount()).To(Equal(0))
+				})
+			})
+		})
 	})
 
 	Describe("Scan", func() {
@@ -675,6 +895,21 @@ var _ = Describe("ResourceScanner", func() {
 				})
 			})
 
+			Context("when periodic checking is disabled", func() {
+				BeforeEach(func() {
+					fakeDBResource.CheckEveryReturns("never")
+					fakeDBPipeline.ResourceReturns(fakeDBResource, true, nil)
+				})
+
+				It("still checks, leasing for the default interval", func() {
+					Expect(fakeResource.CheckSpacesCallCount()).To(Equal(1))
+
+					_, _, _, leaseInterval, immediate := fakeDBPipeline.AcquireResourceCheckingLockWithIntervalCheckArgsForCall(0)
+					Expect(leaseInterval).To(Equal(interval))
+					Expect(immediate).To(BeTrue())
+				})
+			})
+
 			Context("when the resource config has a specified timeout", func() {
 				BeforeEach(func() {
 					fakeDBResource.CheckTimeoutReturns("10s")
@@ -683,7 +918,7 @@ var _ = Describe("ResourceScanner", func() {
 
 				It("times out after the specified timeout", func() {
 					now := time.Now()
-					ctx, _, _ := fakeResource.CheckArgsForCall(0)
+					ctx, _, _, _ := fakeResource.CheckSpacesArgsForCall(0)
 					deadline, _ := ctx.Deadline()
 					Expect(deadline).Should(BeTemporally("~", now.Add(10*time.Second), time.Second))
 				})
@@ -764,7 +999,7 @@ var _ = Describe("ResourceScanner", func() {
 				})
 
 				It("checks from nil", func() {
-					_, _, version := fakeResource.CheckArgsForCall(0)
+					_, _, version, _ := fakeResource.CheckSpacesArgsForCall(0)
 					Expect(version).To(BeNil())
 				})
 			})
@@ -781,7 +1016,7 @@ var _ = Describe("ResourceScanner", func() {
 				})
 
 				It("does not check", func() {
-					Expect(fakeResource.CheckCallCount()).To(Equal(0))
+					Expect(fakeResource.CheckSpacesCallCount()).To(Equal(0))
 				})
 			})
 
@@ -799,13 +1034,13 @@ var _ = Describe("ResourceScanner", func() {
 				})
 
 				It("checks from it", func() {
-					_, _, version := fakeResource.CheckArgsForCall(0)
+					_, _, version, _ := fakeResource.CheckSpacesArgsForCall(0)
 					Expect(version).To(Equal(atc.Version{"version": "1"}))
 				})
 
 				Context("when the check returns only the latest version", func() {
 					BeforeEach(func() {
-						fakeResource.CheckReturns([]atc.Version{atc.Version(latestVersion)}, nil)
+						fakeResource.CheckSpacesReturns(defaultSpaceResult(atc.Version(latestVersion)), nil)
 					})
 
 					It("does not save it", func() {
@@ -833,7 +1068,7 @@ var _ = Describe("ResourceScanner", func() {
 					}
 
 					check := 0
-					fakeResource.CheckStub = func(ctx context.Context, source atc.Source, from atc.Version) ([]atc.Version, error) {
+					fakeResource.CheckSpacesStub = func(ctx context.Context, source atc.Source, from atc.Version, fromSpaces map[string]atc.Version) (resource.CheckResult, error) {
 						defer GinkgoRecover()
 
 						Expect(source).To(Equal(resourceConfig.Source))
@@ -842,7 +1077,7 @@ var _ = Describe("ResourceScanner", func() {
 						result := checkResults[check]
 						check++
 
-						return result, nil
+						return defaultSpaceResult(result...), nil
 					}
 				})
 
@@ -868,7 +1103,7 @@ var _ = Describe("ResourceScanner", func() {
 				disaster := errors.New("nope")
 
 				BeforeEach(func() {
-					fakeResource.CheckReturns(nil, disaster)
+					fakeResource.CheckSpacesReturns(resource.CheckResult{}, disaster)
 				})
 
 				It("returns the error", func() {
@@ -888,7 +1123,7 @@ var _ = Describe("ResourceScanner", func() {
 				scriptFail := resource.ErrResourceScriptFailed{}
 
 				BeforeEach(func() {
-					fakeResource.CheckReturns(nil, scriptFail)
+					fakeResource.CheckSpacesReturns(resource.CheckResult{}, scriptFail)
 				})
 
 				It("returns no error", func() {
@@ -931,7 +1166,7 @@ var _ = Describe("ResourceScanner", func() {
 
 			Context("when fromVersion is nil", func() {
 				It("checks from nil", func() {
-					_, _, version := fakeResource.CheckArgsForCall(0)
+					_, _, version, _ := fakeResource.CheckSpacesArgsForCall(0)
 					Expect(version).To(BeNil())
 				})
 			})
@@ -944,13 +1179,13 @@ var _ = Describe("ResourceScanner", func() {
 				})
 
 				It("checks from it", func() {
-					_, _, version := fakeResource.CheckArgsForCall(0)
+					_, _, version, _ := fakeResource.CheckSpacesArgsForCall(0)
 					Expect(version).To(Equal(atc.Version{"version": "1"}))
 				})
 
 				Context("when the check returns only the latest version", func() {
 					BeforeEach(func() {
-						fakeResource.CheckReturns([]atc.Version{fromVersion}, nil)
+						fakeResource.CheckSpacesReturns(defaultSpaceResult(fromVersion), nil)
 					})
 
 					It("saves it", func() {
@@ -969,7 +1204,7 @@ var _ = Describe("ResourceScanner", func() {
 				scriptFail := resource.ErrResourceScriptFailed{}
 
 				BeforeEach(func() {
-					fakeResource.CheckReturns(nil, scriptFail)
+					fakeResource.CheckSpacesReturns(resource.CheckResult{}, scriptFail)
 				})
 
 				It("returns the error", func() {
@@ -990,3 +1225,9 @@ var _ = Describe("ResourceScanner", func() {
 		})
 	})
 })
+
+func defaultSpaceResult(versions ...atc.Version) resource.CheckResult {
+	return resource.CheckResult{
+		Versions: map[string][]atc.Version{"": versions},
+	}
+}
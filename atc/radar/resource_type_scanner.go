@@ -11,6 +11,7 @@ import (
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/resource"
+	"github.com/concourse/concourse/atc/tracing"
 	"github.com/concourse/concourse/atc/worker"
 )
 
@@ -201,6 +202,29 @@ func (scanner *resourceTypeScanner) check(
 		return nil
 	}
 
+	ctx, span := tracing.StartSpan(context.Background(), "check", tracing.Attrs{
+		"team":          scanner.dbPipeline.TeamName(),
+		"pipeline":      scanner.dbPipeline.Name(),
+		"resource_type": savedResourceType.Name(),
+	})
+
+	err = scanner.runCheck(ctx, logger, savedResourceType, resourceConfigCheckSession, fromVersion, versionedResourceTypes, source, saveGiven)
+
+	tracing.End(span, err)
+
+	return err
+}
+
+func (scanner *resourceTypeScanner) runCheck(
+	ctx context.Context,
+	logger lager.Logger,
+	savedResourceType db.ResourceType,
+	resourceConfigCheckSession db.ResourceConfigCheckSession,
+	fromVersion atc.Version,
+	versionedResourceTypes creds.VersionedResourceTypes,
+	source atc.Source,
+	saveGiven bool,
+) error {
 	resourceSpec := worker.ContainerSpec{
 		ImageSpec: worker.ImageSpec{
 			ResourceType: savedResourceType.Type(),
@@ -210,7 +234,7 @@ func (scanner *resourceTypeScanner) check(
 	}
 
 	res, err := scanner.resourceFactory.NewResource(
-		context.Background(),
+		ctx,
 		logger,
 		db.NewResourceConfigCheckSessionContainerOwner(resourceConfigCheckSession, scanner.dbPipeline.TeamID()),
 		db.ContainerMetadata{
@@ -225,7 +249,7 @@ func (scanner *resourceTypeScanner) check(
 		return err
 	}
 
-	newVersions, err := res.Check(context.TODO(), source, fromVersion)
+	newVersions, err := res.Check(ctx, source, fromVersion)
 	if err != nil {
 		if rErr, ok := err.(resource.ErrResourceScriptFailed); ok {
 			logger.Info("check-failed", lager.Data{"exit-status": rErr.ExitStatus})
@@ -38,6 +38,7 @@ func NewScanRunnerFactory(
 	resourceConfigCheckSessionFactory db.ResourceConfigCheckSessionFactory,
 	resourceTypeCheckingInterval time.Duration,
 	resourceCheckingInterval time.Duration,
+	enableGlobalResources bool,
 	dbPipeline db.Pipeline,
 	clock clock.Clock,
 	externalURL string,
@@ -58,6 +59,7 @@ func NewScanRunnerFactory(
 		resourceFactory,
 		resourceConfigCheckSessionFactory,
 		resourceCheckingInterval,
+		enableGlobalResources,
 		dbPipeline,
 		externalURL,
 		variables,
@@ -23,6 +23,7 @@ type scannerFactory struct {
 	resourceConfigCheckSessionFactory db.ResourceConfigCheckSessionFactory
 	resourceTypeCheckingInterval      time.Duration
 	resourceCheckingInterval          time.Duration
+	enableGlobalResources             bool
 	externalURL                       string
 	variablesFactory                  creds.VariablesFactory
 }
@@ -38,6 +39,7 @@ func NewScannerFactory(
 	resourceConfigCheckSessionFactory db.ResourceConfigCheckSessionFactory,
 	resourceTypeCheckingInterval time.Duration,
 	resourceCheckingInterval time.Duration,
+	enableGlobalResources bool,
 	externalURL string,
 	variablesFactory creds.VariablesFactory,
 ) ScannerFactory {
@@ -46,6 +48,7 @@ func NewScannerFactory(
 		resourceConfigCheckSessionFactory: resourceConfigCheckSessionFactory,
 		resourceCheckingInterval:          resourceCheckingInterval,
 		resourceTypeCheckingInterval:      resourceTypeCheckingInterval,
+		enableGlobalResources:             enableGlobalResources,
 		externalURL:                       externalURL,
 		variablesFactory:                  variablesFactory,
 	}
@@ -69,6 +72,7 @@ func (f *scannerFactory) NewResourceScanner(dbPipeline db.Pipeline) Scanner {
 		f.resourceFactory,
 		f.resourceConfigCheckSessionFactory,
 		f.resourceCheckingInterval,
+		f.enableGlobalResources,
 		dbPipeline,
 		f.externalURL,
 		variables,
@@ -11,4 +11,9 @@ type Resource struct {
 
 	FailingToCheck bool   `json:"failing_to_check,omitempty"`
 	CheckError     string `json:"check_error,omitempty"`
+
+	PinnedVersion  Version `json:"pinned_version,omitempty"`
+	PinnedInConfig bool    `json:"pinned_in_config,omitempty"`
+	PinComment     string  `json:"pin_comment,omitempty"`
+	PinnedBy       string  `json:"pinned_by,omitempty"`
 }
@@ -2,8 +2,10 @@ package resource
 
 import (
 	"context"
+	"encoding/json"
 	"io"
 	"path/filepath"
+	"sync"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
@@ -13,9 +15,12 @@ import (
 //go:generate counterfeiter . Resource
 
 type Resource interface {
-	Get(context.Context, worker.Volume, IOConfig, atc.Source, atc.Params, atc.Version) (VersionedSource, error)
-	Put(context.Context, IOConfig, atc.Source, atc.Params) (VersionedSource, error)
+	Get(context.Context, worker.Volume, IOConfig, atc.Source, atc.Params, string, atc.Version) (VersionedSource, error)
+	Put(context.Context, IOConfig, atc.Source, atc.Params, string) (VersionedSource, error)
 	Check(context.Context, atc.Source, atc.Version) ([]atc.Version, error)
+	CheckSpaces(context.Context, atc.Source, atc.Version, map[string]atc.Version) (CheckResult, error)
+	SupportsSpaces() bool
+	Run(context.Context, IOConfig, string, atc.Source, atc.Params) (json.RawMessage, error)
 	Container() worker.Container
 }
 
@@ -42,6 +47,9 @@ func ResourcesDir(suffix string) string {
 type resource struct {
 	container worker.Container
 
+	protocolOnce sync.Once
+	protocol     string
+
 	ScriptFailure bool
 }
 
@@ -6,12 +6,51 @@ import (
 	"github.com/concourse/concourse/atc"
 )
 
+// CheckResult is the outcome of checking every space of a resource.
+// Resources which don't support spaces only have the default space, named "".
+type CheckResult struct {
+	DefaultSpace string
+	Versions     map[string][]atc.Version
+}
+
 type checkRequest struct {
 	Source  atc.Source  `json:"source"`
 	Version atc.Version `json:"version"`
 }
 
+// Check returns the versions of the default space found after fromVersion.
 func (resource *resource) Check(ctx context.Context, source atc.Source, fromVersion atc.Version) ([]atc.Version, error) {
+	if resource.isV2() {
+		result, err := resource.checkV2(ctx, source, fromVersion, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		return result.Versions[result.DefaultSpace], nil
+	}
+
+	return resource.checkV1(ctx, source, fromVersion)
+}
+
+// CheckSpaces returns the versions found in every space, starting from
+// fromVersion in the default space and from fromSpaces in the others.
+func (resource *resource) CheckSpaces(ctx context.Context, source atc.Source, fromVersion atc.Version, fromSpaces map[string]atc.Version) (CheckResult, error) {
+	if resource.isV2() {
+		return resource.checkV2(ctx, source, fromVersion, fromSpaces)
+	}
+
+	versions, err := resource.checkV1(ctx, source, fromVersion)
+	if err != nil {
+		return CheckResult{}, err
+	}
+
+	return CheckResult{
+		Versions: map[string][]atc.Version{"": versions},
+	}, nil
+}
+
+func (resource *resource) checkV1(ctx context.Context, source atc.Source, fromVersion atc.Version) ([]atc.Version, error) {
+
 	var versions []atc.Version
 
 	err := resource.runScript(
@@ -19,8 +19,17 @@ func (resource *resource) Get(
 	ioConfig IOConfig,
 	source atc.Source,
 	params atc.Params,
+	space string,
 	version atc.Version,
 ) (VersionedSource, error) {
+	if resource.isV2() {
+		return resource.getV2(ctx, volume, ioConfig, source, params, space, version)
+	}
+
+	if space != "" {
+		return nil, ErrSpacesNotSupported
+	}
+
 	var vr versionResult
 
 	err := resource.runScript(
@@ -147,7 +147,7 @@ var _ = Describe("Resource Get", func() {
 				return inScriptProcess, nil
 			}
 
-			versionedSource, getErr = resourceForContainer.Get(ctx, fakeVolume, ioConfig, source, params, version)
+			versionedSource, getErr = resourceForContainer.Get(ctx, fakeVolume, ioConfig, source, params, "", version)
 		})
 
 		Context("when a result is already present on the container", func() {
@@ -439,7 +439,7 @@ var _ = Describe("Resource Get", func() {
 			}
 
 			go func() {
-				versionedSource, getErr = resourceForContainer.Get(ctx, fakeVolume, ioConfig, source, params, version)
+				versionedSource, getErr = resourceForContainer.Get(ctx, fakeVolume, ioConfig, source, params, "", version)
 				close(done)
 			}()
 		})
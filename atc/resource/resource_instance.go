@@ -18,6 +18,7 @@ type ResourceInstance interface {
 	// XXX: do we need these?
 	Source() atc.Source
 	Params() atc.Params
+	Space() string
 	Version() atc.Version
 	ResourceType() ResourceType
 
@@ -34,6 +35,7 @@ type resourceInstance struct {
 	version          atc.Version
 	source           atc.Source
 	params           atc.Params
+	space            string
 	resourceTypes    creds.VersionedResourceTypes
 
 	resourceCache  db.UsedResourceCache
@@ -45,6 +47,7 @@ func NewResourceInstance(
 	version atc.Version,
 	source atc.Source,
 	params atc.Params,
+	space string,
 	resourceTypes creds.VersionedResourceTypes,
 
 	resourceCache db.UsedResourceCache,
@@ -55,6 +58,7 @@ func NewResourceInstance(
 		version:          version,
 		source:           source,
 		params:           params,
+		space:            space,
 		resourceTypes:    resourceTypes,
 
 		resourceCache:  resourceCache,
@@ -78,6 +82,10 @@ func (instance resourceInstance) Params() atc.Params {
 	return instance.params
 }
 
+func (instance resourceInstance) Space() string {
+	return instance.space
+}
+
 func (instance resourceInstance) Version() atc.Version {
 	return instance.version
 }
@@ -93,6 +101,7 @@ func (instance resourceInstance) LockName(workerName string) (string, error) {
 		Version:    instance.version,
 		Source:     instance.source,
 		Params:     instance.params,
+		Space:      instance.space,
 		WorkerName: workerName,
 	}
 
@@ -115,5 +124,6 @@ type resourceInstanceLockID struct {
 	Version    atc.Version  `json:"version,omitempty"`
 	Source     atc.Source   `json:"source,omitempty"`
 	Params     atc.Params   `json:"params,omitempty"`
+	Space      string       `json:"space,omitempty"`
 	WorkerName string       `json:"worker_name,omitempty"`
 }
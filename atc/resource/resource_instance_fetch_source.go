@@ -142,6 +142,7 @@ func (s *resourceInstanceFetchSource) Create(ctx context.Context) (VersionedSour
 		},
 		s.resourceInstance.Source(),
 		s.resourceInstance.Params(),
+		s.resourceInstance.Space(),
 		s.resourceInstance.Version(),
 	)
 	if err != nil {
@@ -36,6 +36,7 @@ var _ = Describe("ResourceInstance", func() {
 			atc.Version{"some": "version"},
 			atc.Source{"some": "source"},
 			atc.Params{"some": "params"},
+			"",
 			creds.VersionedResourceTypes{},
 			fakeResourceCache,
 			db.NewBuildStepContainerOwner(42, atc.PlanID("some-plan-id")),
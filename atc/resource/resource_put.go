@@ -16,7 +16,16 @@ func (resource *resource) Put(
 	ioConfig IOConfig,
 	source atc.Source,
 	params atc.Params,
+	space string,
 ) (VersionedSource, error) {
+	if resource.isV2() {
+		return resource.putV2(ctx, ioConfig, source, params, space)
+	}
+
+	if space != "" {
+		return nil, ErrSpacesNotSupported
+	}
+
 	resourceDir := ResourcesDir("put")
 
 	vs := &putVersionedSource{
@@ -100,7 +100,7 @@ var _ = Describe("Resource Put", func() {
 				return outScriptProcess, nil
 			}
 
-			versionedSource, putErr = resourceForContainer.Put(ctx, ioConfig, source, params)
+			versionedSource, putErr = resourceForContainer.Put(ctx, ioConfig, source, params, "")
 		})
 
 		itCanStreamOut := func() {
@@ -457,7 +457,7 @@ var _ = Describe("Resource Put", func() {
 			}
 
 			go func() {
-				versionedSource, putErr = resourceForContainer.Put(ctx, ioConfig, source, params)
+				versionedSource, putErr = resourceForContainer.Put(ctx, ioConfig, source, params, "")
 				close(done)
 			}()
 		})
@@ -0,0 +1,54 @@
+package resource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"regexp"
+
+	"github.com/concourse/concourse/atc"
+)
+
+var runMessageRegex = regexp.MustCompile(atc.RunMessageRegex)
+
+type runRequest struct {
+	Source atc.Source `json:"source,omitempty"`
+	Params atc.Params `json:"params,omitempty"`
+}
+
+// Run runs the resource type's script for the message, e.g.
+// /opt/resource/delete-branch, and returns the JSON it responded with.
+func (resource *resource) Run(
+	ctx context.Context,
+	ioConfig IOConfig,
+	message string,
+	source atc.Source,
+	params atc.Params,
+) (json.RawMessage, error) {
+	// the message names a script in /opt/resource; anything else could run
+	// arbitrary binaries of the resource type's image
+	if !runMessageRegex.MatchString(message) {
+		return nil, fmt.Errorf("invalid message name '%s'", message)
+	}
+
+	var response json.RawMessage
+
+	err := resource.runScript(
+		ctx,
+		filepath.Join("/opt/resource", message),
+		[]string{ResourcesDir("run")},
+		runRequest{
+			Source: source,
+			Params: params,
+		},
+		&response,
+		ioConfig.Stderr,
+		true,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
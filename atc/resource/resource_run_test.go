@@ -0,0 +1,157 @@
+package resource_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+
+	"code.cloudfoundry.org/garden"
+	gfakes "code.cloudfoundry.org/garden/gardenfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/concourse/concourse/atc/resource"
+)
+
+var _ = Describe("Resource Run", func() {
+	var (
+		source  atc.Source
+		params  atc.Params
+		message string
+
+		scriptStdout     string
+		scriptStderr     string
+		scriptExitStatus int
+		runScriptError   error
+
+		scriptProcess *gfakes.FakeProcess
+
+		ioConfig  IOConfig
+		stderrBuf *gbytes.Buffer
+
+		response json.RawMessage
+		runErr   error
+	)
+
+	BeforeEach(func() {
+		source = atc.Source{"some": "source"}
+		params = atc.Params{"some": "params"}
+		message = "delete-branch"
+
+		scriptStdout = `{"deleted":"some-branch"}`
+		scriptStderr = ""
+		scriptExitStatus = 0
+		runScriptError = nil
+
+		scriptProcess = new(gfakes.FakeProcess)
+		scriptProcess.WaitStub = func() (int, error) {
+			return scriptExitStatus, nil
+		}
+
+		stderrBuf = gbytes.NewBuffer()
+		ioConfig = IOConfig{Stderr: stderrBuf}
+
+		fakeContainer.PropertyReturns("", errors.New("no property"))
+		fakeContainer.AttachReturns(nil, errors.New("no process"))
+	})
+
+	JustBeforeEach(func() {
+		fakeContainer.RunStub = func(spec garden.ProcessSpec, io garden.ProcessIO) (garden.Process, error) {
+			if runScriptError != nil {
+				return nil, runScriptError
+			}
+
+			_, err := io.Stdout.Write([]byte(scriptStdout))
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = io.Stderr.Write([]byte(scriptStderr))
+			Expect(err).NotTo(HaveOccurred())
+
+			return scriptProcess, nil
+		}
+
+		response, runErr = resourceForContainer.Run(context.TODO(), ioConfig, message, source, params)
+	})
+
+	It("runs the message's script with the request on stdin", func() {
+		Expect(runErr).NotTo(HaveOccurred())
+
+		spec, io := fakeContainer.RunArgsForCall(0)
+		Expect(spec.ID).To(Equal(TaskProcessID))
+		Expect(spec.Path).To(Equal("/opt/resource/delete-branch"))
+		Expect(spec.Args).To(Equal([]string{ResourcesDir("run")}))
+
+		request, err := ioutil.ReadAll(io.Stdin)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(request)).To(Equal(`{"source":{"some":"source"},"params":{"some":"params"}}`))
+	})
+
+	It("returns the response", func() {
+		Expect(runErr).NotTo(HaveOccurred())
+		Expect(string(response)).To(Equal(`{"deleted":"some-branch"}`))
+	})
+
+	It("saves the response so that it can be recovered", func() {
+		Expect(fakeContainer.SetPropertyCallCount()).To(Equal(1))
+
+		name, value := fakeContainer.SetPropertyArgsForCall(0)
+		Expect(name).To(Equal("concourse:resource-result"))
+		Expect(value).To(Equal(`{"deleted":"some-branch"}`))
+	})
+
+	Context("when the script exits nonzero", func() {
+		BeforeEach(func() {
+			scriptStderr = "some-stderr"
+			scriptExitStatus = 9
+		})
+
+		It("returns an ErrResourceScriptFailed", func() {
+			Expect(runErr).To(Equal(ErrResourceScriptFailed{
+				Path:       "/opt/resource/delete-branch",
+				Args:       []string{ResourcesDir("run")},
+				ExitStatus: 9,
+			}))
+		})
+
+		It("writes stderr to the log", func() {
+			Expect(stderrBuf).To(gbytes.Say("some-stderr"))
+		})
+	})
+
+	Context("when the script responds with invalid JSON", func() {
+		BeforeEach(func() {
+			scriptStdout = "ok"
+		})
+
+		It("returns an error", func() {
+			Expect(runErr).To(HaveOccurred())
+		})
+	})
+
+	Context("when running the script fails", func() {
+		disaster := errors.New("oh no!")
+
+		BeforeEach(func() {
+			runScriptError = disaster
+		})
+
+		It("returns the error", func() {
+			Expect(runErr).To(Equal(disaster))
+		})
+	})
+
+	Context("when the message is not a plain name", func() {
+		BeforeEach(func() {
+			message = "../../bin/sh"
+		})
+
+		It("refuses to run it", func() {
+			Expect(runErr).To(MatchError("invalid message name '../../bin/sh'"))
+			Expect(fakeContainer.RunCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -0,0 +1,389 @@
+package resource
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/worker"
+)
+
+// Resource types opt in to protocol v2 through their image metadata. Rather
+// than a fixed set of scripts, a v2 resource type provides an info script
+// which tells us where to find its check, get and put scripts. The scripts
+// write a stream of JSON events to stdout, one per line, which lets them
+// report progress as they go, versions per space and structured errors.
+const infoScriptPath = "/opt/resource/info"
+
+const (
+	EventTypeProgress = "progress"
+	EventTypeVersion  = "version"
+	EventTypeError    = "error"
+)
+
+type infoRequest struct {
+	Source atc.Source `json:"source"`
+}
+
+type infoResponse struct {
+	DefaultSpace string `json:"default_space"`
+	Check        string `json:"check"`
+	Get          string `json:"get"`
+	Put          string `json:"put"`
+}
+
+type checkRequestV2 struct {
+	Source atc.Source             `json:"source"`
+	From   map[string]atc.Version `json:"from,omitempty"`
+}
+
+type getRequestV2 struct {
+	Source  atc.Source  `json:"source"`
+	Params  atc.Params  `json:"params,omitempty"`
+	Space   string      `json:"space,omitempty"`
+	Version atc.Version `json:"version,omitempty"`
+}
+
+type putRequestV2 struct {
+	Source atc.Source `json:"source"`
+	Params atc.Params `json:"params,omitempty"`
+	Space  string     `json:"space,omitempty"`
+}
+
+// Event is a single line of output from a v2 script.
+type Event struct {
+	Type     string                 `json:"type"`
+	Space    string                 `json:"space,omitempty"`
+	Version  atc.Version            `json:"version,omitempty"`
+	Metadata []atc.MetadataField    `json:"metadata,omitempty"`
+	Message  string                 `json:"message,omitempty"`
+	Details  map[string]interface{} `json:"details,omitempty"`
+}
+
+// ErrSpacesNotSupported is returned when a space is given to a resource type
+// which doesn't implement protocol v2.
+var ErrSpacesNotSupported = errors.New("resource type does not support spaces")
+
+// isV2 determines the protocol from the container's properties the first
+// time it is needed.
+func (resource *resource) isV2() bool {
+	resource.protocolOnce.Do(func() {
+		protocol, err := resource.container.Property(worker.ResourceProtocolPropertyName)
+		if err == nil {
+			resource.protocol = protocol
+		}
+	})
+
+	return resource.protocol == worker.ResourceProtocolV2
+}
+
+// SupportsSpaces reports whether the resource's type implements protocol v2,
+// and so has versions in more than one space.
+func (resource *resource) SupportsSpaces() bool {
+	return resource.isV2()
+}
+
+func (resource *resource) info(ctx context.Context, source atc.Source) (infoResponse, error) {
+	var info infoResponse
+
+	err := resource.runScript(
+		ctx,
+		infoScriptPath,
+		nil,
+		infoRequest{source},
+		&info,
+		nil,
+		false,
+	)
+	if err != nil {
+		return infoResponse{}, err
+	}
+
+	if info.Check == "" {
+		info.Check = "/opt/resource/check"
+	}
+
+	if info.Get == "" {
+		info.Get = "/opt/resource/in"
+	}
+
+	if info.Put == "" {
+		info.Put = "/opt/resource/out"
+	}
+
+	return info, nil
+}
+
+func (resource *resource) checkV2(
+	ctx context.Context,
+	source atc.Source,
+	fromVersion atc.Version,
+	fromSpaces map[string]atc.Version,
+) (CheckResult, error) {
+	info, err := resource.info(ctx, source)
+	if err != nil {
+		return CheckResult{}, err
+	}
+
+	request := checkRequestV2{Source: source}
+	if len(fromSpaces) > 0 || fromVersion != nil {
+		request.From = map[string]atc.Version{}
+		for space, version := range fromSpaces {
+			request.From[space] = version
+		}
+
+		if fromVersion != nil {
+			request.From[info.DefaultSpace] = fromVersion
+		}
+	}
+
+	events, err := resource.runEvents(ctx, info.Check, nil, request, nil, false)
+	if err != nil {
+		return CheckResult{}, err
+	}
+
+	result := CheckResult{
+		DefaultSpace: info.DefaultSpace,
+		Versions:     map[string][]atc.Version{},
+	}
+
+	for _, event := range events {
+		if event.Type == EventTypeVersion {
+			space := eventSpace(event, info)
+			result.Versions[space] = append(result.Versions[space], event.Version)
+		}
+	}
+
+	return result, nil
+}
+
+func (resource *resource) getV2(
+	ctx context.Context,
+	volume worker.Volume,
+	ioConfig IOConfig,
+	source atc.Source,
+	params atc.Params,
+	space string,
+	version atc.Version,
+) (VersionedSource, error) {
+	info, err := resource.info(ctx, source)
+	if err != nil {
+		return nil, err
+	}
+
+	if space == "" {
+		space = info.DefaultSpace
+	}
+
+	events, err := resource.runEvents(
+		ctx,
+		info.Get,
+		[]string{ResourcesDir("get")},
+		getRequestV2{source, params, space, version},
+		ioConfig.Stderr,
+		true,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	vr, found := versionFromEvents(events, info, space)
+	if !found {
+		return nil, fmt.Errorf("resource script '%s' did not emit a version", info.Get)
+	}
+
+	return NewGetVersionedSource(volume, vr.Version, vr.Metadata), nil
+}
+
+func (resource *resource) putV2(
+	ctx context.Context,
+	ioConfig IOConfig,
+	source atc.Source,
+	params atc.Params,
+	space string,
+) (VersionedSource, error) {
+	info, err := resource.info(ctx, source)
+	if err != nil {
+		return nil, err
+	}
+
+	if space == "" {
+		space = info.DefaultSpace
+	}
+
+	resourceDir := ResourcesDir("put")
+
+	events, err := resource.runEvents(
+		ctx,
+		info.Put,
+		[]string{resourceDir},
+		putRequestV2{source, params, space},
+		ioConfig.Stderr,
+		true,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	vr, found := versionFromEvents(events, info, space)
+	if !found {
+		return nil, fmt.Errorf("resource script '%s' did not emit a version", info.Put)
+	}
+
+	return &putVersionedSource{
+		container:     resource.container,
+		resourceDir:   resourceDir,
+		versionResult: vr,
+	}, nil
+}
+
+// runEvents runs a v2 script, writing its progress events to logDest as they
+// are emitted, and returns all of the events once it has exited. If the script
+// fails, the last error event it emitted is included in the returned error.
+func (resource *resource) runEvents(
+	ctx context.Context,
+	path string,
+	args []string,
+	input interface{},
+	logDest io.Writer,
+	recoverable bool,
+) ([]Event, error) {
+	writer := &eventWriter{logDest: logDest}
+
+	stdout, err := resource.runProcess(ctx, path, args, input, writer, logDest, recoverable)
+	if err != nil {
+		if scriptErr, ok := err.(ErrResourceScriptFailed); ok {
+			if event, found := writer.lastError(); found {
+				scriptErr.Message = event.Message
+				scriptErr.Details = event.Details
+			}
+
+			return nil, scriptErr
+		}
+
+		return nil, err
+	}
+
+	return parseEvents(stdout)
+}
+
+// versionFromEvents returns the last version emitted for the space.
+func versionFromEvents(events []Event, info infoResponse, space string) (versionResult, bool) {
+	var result versionResult
+	var found bool
+
+	for _, event := range events {
+		if event.Type != EventTypeVersion || eventSpace(event, info) != space {
+			continue
+		}
+
+		result = versionResult{
+			Version:  event.Version,
+			Metadata: event.Metadata,
+		}
+		found = true
+	}
+
+	return result, found
+}
+
+// eventSpace returns the space an event belongs to. Events which don't name
+// one belong to the default space.
+func eventSpace(event Event, info infoResponse) string {
+	if event.Space == "" {
+		return info.DefaultSpace
+	}
+
+	return event.Space
+}
+
+func parseEvents(output []byte) ([]Event, error) {
+	events := []Event{}
+
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	scanner.Buffer(nil, 1024*1024)
+
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+
+		var event Event
+		err := json.Unmarshal(line, &event)
+		if err != nil {
+			return nil, fmt.Errorf("invalid event '%s': %s", line, err)
+		}
+
+		events = append(events, event)
+	}
+
+	return events, scanner.Err()
+}
+
+// eventWriter parses events from a script's stdout as it is written, so that
+// progress can be shown while the script is still running.
+type eventWriter struct {
+	logDest io.Writer
+
+	lock      sync.Mutex
+	buf       []byte
+	lastErr   Event
+	sawErrors bool
+}
+
+func (writer *eventWriter) Write(p []byte) (int, error) {
+	writer.lock.Lock()
+	defer writer.lock.Unlock()
+
+	writer.buf = append(writer.buf, p...)
+
+	for {
+		i := bytes.IndexByte(writer.buf, '\n')
+		if i == -1 {
+			break
+		}
+
+		writer.handle(writer.buf[:i])
+		writer.buf = writer.buf[i+1:]
+	}
+
+	return len(p), nil
+}
+
+func (writer *eventWriter) handle(line []byte) {
+	var event Event
+	err := json.Unmarshal(line, &event)
+	if err != nil {
+		return
+	}
+
+	switch event.Type {
+	case EventTypeProgress:
+		if writer.logDest != nil && event.Message != "" {
+			fmt.Fprintln(writer.logDest, event.Message)
+		}
+
+	case EventTypeError:
+		writer.lastErr = event
+		writer.sawErrors = true
+	}
+}
+
+func (writer *eventWriter) lastError() (Event, bool) {
+	writer.lock.Lock()
+	defer writer.lock.Unlock()
+
+	if len(writer.buf) > 0 {
+		writer.handle(writer.buf)
+		writer.buf = nil
+	}
+
+	return writer.lastErr, writer.sawErrors
+}
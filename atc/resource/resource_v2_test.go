@@ -0,0 +1,401 @@
+package resource_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+
+	"code.cloudfoundry.org/garden"
+	"code.cloudfoundry.org/garden/gardenfakes"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/resource"
+	"github.com/concourse/concourse/atc/worker"
+	"github.com/concourse/concourse/atc/worker/workerfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Resource Protocol V2", func() {
+	var (
+		source atc.Source
+		params atc.Params
+
+		infoStdout string
+
+		scriptStdout     string
+		scriptStderr     string
+		scriptExitStatus int
+
+		stderrBuf *bytes.Buffer
+		ioConfig  resource.IOConfig
+	)
+
+	BeforeEach(func() {
+		source = atc.Source{"some": "source"}
+		params = atc.Params{"some": "params"}
+
+		infoStdout = `{"default_space":"main","check":"/opt/resource/v2/check","get":"/opt/resource/v2/get","put":"/opt/resource/v2/put"}`
+
+		scriptStdout = ""
+		scriptStderr = ""
+		scriptExitStatus = 0
+
+		stderrBuf = new(bytes.Buffer)
+		ioConfig = resource.IOConfig{Stderr: stderrBuf}
+
+		fakeContainer.PropertyStub = func(name string) (string, error) {
+			if name == worker.ResourceProtocolPropertyName {
+				return worker.ResourceProtocolV2, nil
+			}
+
+			return "", errors.New("no property")
+		}
+
+		fakeContainer.AttachReturns(nil, errors.New("no process"))
+
+		fakeContainer.RunStub = func(spec garden.ProcessSpec, io garden.ProcessIO) (garden.Process, error) {
+			process := new(gardenfakes.FakeProcess)
+
+			if spec.Path == "/opt/resource/info" {
+				_, err := io.Stdout.Write([]byte(infoStdout))
+				Expect(err).NotTo(HaveOccurred())
+
+				return process, nil
+			}
+
+			_, err := io.Stdout.Write([]byte(scriptStdout))
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = io.Stderr.Write([]byte(scriptStderr))
+			Expect(err).NotTo(HaveOccurred())
+
+			process.WaitReturns(scriptExitStatus, nil)
+
+			return process, nil
+		}
+	})
+
+	Describe("Check", func() {
+		var (
+			version     atc.Version
+			checkResult []atc.Version
+			checkErr    error
+		)
+
+		BeforeEach(func() {
+			version = atc.Version{"ver": "abc"}
+			scriptStdout = `{"type":"version","space":"main","version":{"ver":"def"}}
+{"type":"version","space":"other","version":{"ver":"xyz"}}
+{"type":"version","space":"main","version":{"ver":"ghi"}}
+`
+		})
+
+		JustBeforeEach(func() {
+			checkResult, checkErr = resourceForContainer.Check(context.TODO(), source, version)
+		})
+
+		It("runs the info script with the source", func() {
+			Expect(checkErr).NotTo(HaveOccurred())
+
+			spec, io := fakeContainer.RunArgsForCall(0)
+			Expect(spec.Path).To(Equal("/opt/resource/info"))
+
+			request, err := ioutil.ReadAll(io.Stdin)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(request)).To(Equal(`{"source":{"some":"source"}}`))
+		})
+
+		It("runs the check script from the info with the version in the default space", func() {
+			Expect(checkErr).NotTo(HaveOccurred())
+
+			spec, io := fakeContainer.RunArgsForCall(1)
+			Expect(spec.Path).To(Equal("/opt/resource/v2/check"))
+
+			request, err := ioutil.ReadAll(io.Stdin)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(request)).To(Equal(`{"source":{"some":"source"},"from":{"main":{"ver":"abc"}}}`))
+		})
+
+		It("returns the versions emitted for the default space", func() {
+			Expect(checkErr).NotTo(HaveOccurred())
+			Expect(checkResult).To(Equal([]atc.Version{
+				{"ver": "def"},
+				{"ver": "ghi"},
+			}))
+		})
+
+		It("determines the protocol only once", func() {
+			_, err := resourceForContainer.Check(context.TODO(), source, version)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeContainer.PropertyCallCount()).To(Equal(1))
+		})
+
+		Context("when the script does not name the space of its versions", func() {
+			BeforeEach(func() {
+				scriptStdout = `{"type":"version","version":{"ver":"def"}}
+{"type":"version","space":"other","version":{"ver":"xyz"}}
+`
+			})
+
+			It("returns them as versions of the default space", func() {
+				Expect(checkErr).NotTo(HaveOccurred())
+				Expect(checkResult).To(Equal([]atc.Version{
+					{"ver": "def"},
+				}))
+			})
+		})
+
+		Context("when the info does not specify scripts", func() {
+			BeforeEach(func() {
+				infoStdout = `{"default_space":"main"}`
+			})
+
+			It("runs /opt/resource/check", func() {
+				spec, _ := fakeContainer.RunArgsForCall(1)
+				Expect(spec.Path).To(Equal("/opt/resource/check"))
+			})
+		})
+
+		Context("when the script emits an error event and exits nonzero", func() {
+			BeforeEach(func() {
+				scriptStdout = `{"type":"error","message":"repository not found","details":{"uri":"some-uri"}}` + "\n"
+				scriptStderr = "some-stderr"
+				scriptExitStatus = 1
+			})
+
+			It("returns an error with the message and details", func() {
+				Expect(checkErr).To(HaveOccurred())
+
+				scriptErr, ok := checkErr.(resource.ErrResourceScriptFailed)
+				Expect(ok).To(BeTrue())
+				Expect(scriptErr.ExitStatus).To(Equal(1))
+				Expect(scriptErr.Message).To(Equal("repository not found"))
+				Expect(scriptErr.Details).To(Equal(map[string]interface{}{"uri": "some-uri"}))
+				Expect(scriptErr.Error()).To(ContainSubstring("repository not found"))
+				Expect(scriptErr.Error()).To(ContainSubstring("some-stderr"))
+			})
+		})
+
+		Context("when the script emits invalid events", func() {
+			BeforeEach(func() {
+				scriptStdout = "not-json\n"
+			})
+
+			It("returns an error", func() {
+				Expect(checkErr).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("CheckSpaces", func() {
+		var (
+			fromVersion atc.Version
+			fromSpaces  map[string]atc.Version
+			checkResult resource.CheckResult
+			checkErr    error
+		)
+
+		BeforeEach(func() {
+			fromVersion = atc.Version{"ver": "abc"}
+			fromSpaces = map[string]atc.Version{"other": {"ver": "uvw"}}
+
+			scriptStdout = `{"type":"version","space":"main","version":{"ver":"def"}}
+{"type":"version","space":"other","version":{"ver":"xyz"}}
+{"type":"version","space":"main","version":{"ver":"ghi"}}
+`
+		})
+
+		JustBeforeEach(func() {
+			checkResult, checkErr = resourceForContainer.CheckSpaces(context.TODO(), source, fromVersion, fromSpaces)
+		})
+
+		It("checks the default space from the version and the others from their latest versions", func() {
+			Expect(checkErr).NotTo(HaveOccurred())
+
+			_, io := fakeContainer.RunArgsForCall(1)
+			request, err := ioutil.ReadAll(io.Stdin)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(request)).To(Equal(`{"source":{"some":"source"},"from":{"main":{"ver":"abc"},"other":{"ver":"uvw"}}}`))
+		})
+
+		It("returns the versions of every space along with the default space", func() {
+			Expect(checkErr).NotTo(HaveOccurred())
+			Expect(checkResult).To(Equal(resource.CheckResult{
+				DefaultSpace: "main",
+				Versions: map[string][]atc.Version{
+					"main":  {{"ver": "def"}, {"ver": "ghi"}},
+					"other": {{"ver": "xyz"}},
+				},
+			}))
+		})
+	})
+
+	Describe("Get", func() {
+		var (
+			fakeVolume      *workerfakes.FakeVolume
+			space           string
+			version         atc.Version
+			versionedSource resource.VersionedSource
+			getErr          error
+		)
+
+		BeforeEach(func() {
+			fakeVolume = new(workerfakes.FakeVolume)
+			space = ""
+			version = atc.Version{"ver": "abc"}
+
+			scriptStdout = `{"type":"progress","message":"cloning"}
+{"type":"version","space":"main","version":{"ver":"abc"},"metadata":[{"name":"a","value":"b"}]}
+`
+		})
+
+		JustBeforeEach(func() {
+			versionedSource, getErr = resourceForContainer.Get(context.TODO(), fakeVolume, ioConfig, source, params, space, version)
+		})
+
+		It("runs the get script with the request on stdin", func() {
+			Expect(getErr).NotTo(HaveOccurred())
+
+			spec, io := fakeContainer.RunArgsForCall(1)
+			Expect(spec.Path).To(Equal("/opt/resource/v2/get"))
+			Expect(spec.Args).To(Equal([]string{resource.ResourcesDir("get")}))
+			Expect(spec.ID).To(Equal(resource.TaskProcessID))
+
+			request, err := ioutil.ReadAll(io.Stdin)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(request)).To(Equal(`{"source":{"some":"source"},"params":{"some":"params"},"space":"main","version":{"ver":"abc"}}`))
+		})
+
+		It("returns the emitted version and metadata", func() {
+			Expect(getErr).NotTo(HaveOccurred())
+			Expect(versionedSource.Version()).To(Equal(atc.Version{"ver": "abc"}))
+			Expect(versionedSource.Metadata()).To(Equal([]atc.MetadataField{{Name: "a", Value: "b"}}))
+		})
+
+		It("writes progress messages to stderr", func() {
+			Expect(stderrBuf.String()).To(Equal("cloning\n"))
+		})
+
+		Context("when the script does not emit a version", func() {
+			BeforeEach(func() {
+				scriptStdout = `{"type":"progress","message":"cloning"}` + "\n"
+			})
+
+			It("returns an error", func() {
+				Expect(getErr).To(HaveOccurred())
+			})
+		})
+
+		Context("when the script does not name the space of its version", func() {
+			BeforeEach(func() {
+				scriptStdout = `{"type":"version","version":{"ver":"abc"}}` + "\n"
+			})
+
+			It("returns it as the version of the default space", func() {
+				Expect(getErr).NotTo(HaveOccurred())
+				Expect(versionedSource.Version()).To(Equal(atc.Version{"ver": "abc"}))
+			})
+		})
+
+		Context("when the script only emits a version for another space", func() {
+			BeforeEach(func() {
+				scriptStdout = `{"type":"version","space":"other","version":{"ver":"abc"}}` + "\n"
+			})
+
+			It("returns an error", func() {
+				Expect(getErr).To(HaveOccurred())
+			})
+		})
+
+		Context("when a space is given", func() {
+			BeforeEach(func() {
+				space = "other"
+				scriptStdout = `{"type":"version","space":"other","version":{"ver":"abc"}}` + "\n"
+			})
+
+			It("gets the version from the space", func() {
+				Expect(getErr).NotTo(HaveOccurred())
+
+				_, io := fakeContainer.RunArgsForCall(1)
+				request, err := ioutil.ReadAll(io.Stdin)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(request)).To(Equal(`{"source":{"some":"source"},"params":{"some":"params"},"space":"other","version":{"ver":"abc"}}`))
+			})
+		})
+	})
+
+	Describe("Put", func() {
+		var (
+			space           string
+			versionedSource resource.VersionedSource
+			putErr          error
+		)
+
+		BeforeEach(func() {
+			space = ""
+
+			scriptStdout = `{"type":"version","space":"other","version":{"ver":"xyz"}}
+{"type":"version","space":"main","version":{"ver":"abc"}}
+{"type":"version","space":"other","version":{"ver":"uvw"}}
+`
+		})
+
+		JustBeforeEach(func() {
+			versionedSource, putErr = resourceForContainer.Put(context.TODO(), ioConfig, source, params, space)
+		})
+
+		It("runs the put script with the request on stdin", func() {
+			Expect(putErr).NotTo(HaveOccurred())
+
+			spec, io := fakeContainer.RunArgsForCall(1)
+			Expect(spec.Path).To(Equal("/opt/resource/v2/put"))
+			Expect(spec.Args).To(Equal([]string{resource.ResourcesDir("put")}))
+
+			request, err := ioutil.ReadAll(io.Stdin)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(request)).To(Equal(`{"source":{"some":"source"},"params":{"some":"params"},"space":"main"}`))
+		})
+
+		It("returns the version emitted for the default space", func() {
+			Expect(putErr).NotTo(HaveOccurred())
+			Expect(versionedSource.Version()).To(Equal(atc.Version{"ver": "abc"}))
+		})
+
+		Context("when a space is given", func() {
+			BeforeEach(func() {
+				space = "other"
+			})
+
+			It("puts to the space and returns the last version emitted for it", func() {
+				Expect(putErr).NotTo(HaveOccurred())
+
+				_, io := fakeContainer.RunArgsForCall(1)
+				request, err := ioutil.ReadAll(io.Stdin)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(request)).To(Equal(`{"source":{"some":"source"},"params":{"some":"params"},"space":"other"}`))
+
+				Expect(versionedSource.Version()).To(Equal(atc.Version{"ver": "uvw"}))
+			})
+		})
+	})
+
+	Context("when the resource type does not implement protocol v2", func() {
+		BeforeEach(func() {
+			fakeContainer.PropertyReturns("", errors.New("no property"))
+			fakeContainer.PropertyStub = nil
+		})
+
+		It("fails to get from a space", func() {
+			_, err := resourceForContainer.Get(context.TODO(), new(workerfakes.FakeVolume), ioConfig, source, params, "other", atc.Version{"ver": "abc"})
+			Expect(err).To(Equal(resource.ErrSpacesNotSupported))
+		})
+
+		It("fails to put to a space", func() {
+			_, err := resourceForContainer.Put(context.TODO(), ioConfig, source, params, "other")
+			Expect(err).To(Equal(resource.ErrSpacesNotSupported))
+		})
+	})
+})
@@ -3,6 +3,7 @@ package resourcefakes
 
 import (
 	context "context"
+	json "encoding/json"
 	sync "sync"
 
 	atc "github.com/concourse/concourse/atc"
@@ -26,6 +27,22 @@ type FakeResource struct {
 		result1 []atc.Version
 		result2 error
 	}
+	CheckSpacesStub        func(context.Context, atc.Source, atc.Version, map[string]atc.Version) (resource.CheckResult, error)
+	checkSpacesMutex       sync.RWMutex
+	checkSpacesArgsForCall []struct {
+		arg1 context.Context
+		arg2 atc.Source
+		arg3 atc.Version
+		arg4 map[string]atc.Version
+	}
+	checkSpacesReturns struct {
+		result1 resource.CheckResult
+		result2 error
+	}
+	checkSpacesReturnsOnCall map[int]struct {
+		result1 resource.CheckResult
+		result2 error
+	}
 	ContainerStub        func() worker.Container
 	containerMutex       sync.RWMutex
 	containerArgsForCall []struct {
@@ -36,7 +53,7 @@ type FakeResource struct {
 	containerReturnsOnCall map[int]struct {
 		result1 worker.Container
 	}
-	GetStub        func(context.Context, worker.Volume, resource.IOConfig, atc.Source, atc.Params, atc.Version) (resource.VersionedSource, error)
+	GetStub        func(context.Context, worker.Volume, resource.IOConfig, atc.Source, atc.Params, string, atc.Version) (resource.VersionedSource, error)
 	getMutex       sync.RWMutex
 	getArgsForCall []struct {
 		arg1 context.Context
@@ -44,7 +61,8 @@ type FakeResource struct {
 		arg3 resource.IOConfig
 		arg4 atc.Source
 		arg5 atc.Params
-		arg6 atc.Version
+		arg6 string
+		arg7 atc.Version
 	}
 	getReturns struct {
 		result1 resource.VersionedSource
@@ -54,13 +72,14 @@ type FakeResource struct {
 		result1 resource.VersionedSource
 		result2 error
 	}
-	PutStub        func(context.Context, resource.IOConfig, atc.Source, atc.Params) (resource.VersionedSource, error)
+	PutStub        func(context.Context, resource.IOConfig, atc.Source, atc.Params, string) (resource.VersionedSource, error)
 	putMutex       sync.RWMutex
 	putArgsForCall []struct {
 		arg1 context.Context
 		arg2 resource.IOConfig
 		arg3 atc.Source
 		arg4 atc.Params
+		arg5 string
 	}
 	putReturns struct {
 		result1 resource.VersionedSource
@@ -70,6 +89,33 @@ type FakeResource struct {
 		result1 resource.VersionedSource
 		result2 error
 	}
+	RunStub        func(context.Context, resource.IOConfig, string, atc.Source, atc.Params) (json.RawMessage, error)
+	runMutex       sync.RWMutex
+	runArgsForCall []struct {
+		arg1 context.Context
+		arg2 resource.IOConfig
+		arg3 string
+		arg4 atc.Source
+		arg5 atc.Params
+	}
+	runReturns struct {
+		result1 json.RawMessage
+		result2 error
+	}
+	runReturnsOnCall map[int]struct {
+		result1 json.RawMessage
+		result2 error
+	}
+	SupportsSpacesStub        func() bool
+	supportsSpacesMutex       sync.RWMutex
+	supportsSpacesArgsForCall []struct {
+	}
+	supportsSpacesReturns struct {
+		result1 bool
+	}
+	supportsSpacesReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -129,6 +175,62 @@ func (fake *FakeResource) CheckReturnsOnCall(i int, result1 []atc.Version, resul
 	}{result1, result2}
 }
 
+func (fake *FakeResource) CheckSpaces(arg1 context.Context, arg2 atc.Source, arg3 atc.Version, arg4 map[string]atc.Version) (resource.CheckResult, error) {
+	fake.checkSpacesMutex.Lock()
+	ret, specificReturn := fake.checkSpacesReturnsOnCall[len(fake.checkSpacesArgsForCall)]
+	fake.checkSpacesArgsForCall = append(fake.checkSpacesArgsForCall, struct {
+		arg1 context.Context
+		arg2 atc.Source
+		arg3 atc.Version
+		arg4 map[string]atc.Version
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("CheckSpaces", []interface{}{arg1, arg2, arg3, arg4})
+	fake.checkSpacesMutex.Unlock()
+	if fake.CheckSpacesStub != nil {
+		return fake.CheckSpacesStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.checkSpacesReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeResource) CheckSpacesCallCount() int {
+	fake.checkSpacesMutex.RLock()
+	defer fake.checkSpacesMutex.RUnlock()
+	return len(fake.checkSpacesArgsForCall)
+}
+
+func (fake *FakeResource) CheckSpacesArgsForCall(i int) (context.Context, atc.Source, atc.Version, map[string]atc.Version) {
+	fake.checkSpacesMutex.RLock()
+	defer fake.checkSpacesMutex.RUnlock()
+	argsForCall := fake.checkSpacesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeResource) CheckSpacesReturns(result1 resource.CheckResult, result2 error) {
+	fake.CheckSpacesStub = nil
+	fake.checkSpacesReturns = struct {
+		result1 resource.CheckResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResource) CheckSpacesReturnsOnCall(i int, result1 resource.CheckResult, result2 error) {
+	fake.CheckSpacesStub = nil
+	if fake.checkSpacesReturnsOnCall == nil {
+		fake.checkSpacesReturnsOnCall = make(map[int]struct {
+			result1 resource.CheckResult
+			result2 error
+		})
+	}
+	fake.checkSpacesReturnsOnCall[i] = struct {
+		result1 resource.CheckResult
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeResource) Container() worker.Container {
 	fake.containerMutex.Lock()
 	ret, specificReturn := fake.containerReturnsOnCall[len(fake.containerArgsForCall)]
@@ -171,7 +273,7 @@ func (fake *FakeResource) ContainerReturnsOnCall(i int, result1 worker.Container
 	}{result1}
 }
 
-func (fake *FakeResource) Get(arg1 context.Context, arg2 worker.Volume, arg3 resource.IOConfig, arg4 atc.Source, arg5 atc.Params, arg6 atc.Version) (resource.VersionedSource, error) {
+func (fake *FakeResource) Get(arg1 context.Context, arg2 worker.Volume, arg3 resource.IOConfig, arg4 atc.Source, arg5 atc.Params, arg6 string, arg7 atc.Version) (resource.VersionedSource, error) {
 	fake.getMutex.Lock()
 	ret, specificReturn := fake.getReturnsOnCall[len(fake.getArgsForCall)]
 	fake.getArgsForCall = append(fake.getArgsForCall, struct {
@@ -180,12 +282,13 @@ func (fake *FakeResource) Get(arg1 context.Context, arg2 worker.Volume, arg3 res
 		arg3 resource.IOConfig
 		arg4 atc.Source
 		arg5 atc.Params
-		arg6 atc.Version
-	}{arg1, arg2, arg3, arg4, arg5, arg6})
-	fake.recordInvocation("Get", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6})
+		arg6 string
+		arg7 atc.Version
+	}{arg1, arg2, arg3, arg4, arg5, arg6, arg7})
+	fake.recordInvocation("Get", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6, arg7})
 	fake.getMutex.Unlock()
 	if fake.GetStub != nil {
-		return fake.GetStub(arg1, arg2, arg3, arg4, arg5, arg6)
+		return fake.GetStub(arg1, arg2, arg3, arg4, arg5, arg6, arg7)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -200,11 +303,11 @@ func (fake *FakeResource) GetCallCount() int {
 	return len(fake.getArgsForCall)
 }
 
-func (fake *FakeResource) GetArgsForCall(i int) (context.Context, worker.Volume, resource.IOConfig, atc.Source, atc.Params, atc.Version) {
+func (fake *FakeResource) GetArgsForCall(i int) (context.Context, worker.Volume, resource.IOConfig, atc.Source, atc.Params, string, atc.Version) {
 	fake.getMutex.RLock()
 	defer fake.getMutex.RUnlock()
 	argsForCall := fake.getArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6, argsForCall.arg7
 }
 
 func (fake *FakeResource) GetReturns(result1 resource.VersionedSource, result2 error) {
@@ -229,7 +332,7 @@ func (fake *FakeResource) GetReturnsOnCall(i int, result1 resource.VersionedSour
 	}{result1, result2}
 }
 
-func (fake *FakeResource) Put(arg1 context.Context, arg2 resource.IOConfig, arg3 atc.Source, arg4 atc.Params) (resource.VersionedSource, error) {
+func (fake *FakeResource) Put(arg1 context.Context, arg2 resource.IOConfig, arg3 atc.Source, arg4 atc.Params, arg5 string) (resource.VersionedSource, error) {
 	fake.putMutex.Lock()
 	ret, specificReturn := fake.putReturnsOnCall[len(fake.putArgsForCall)]
 	fake.putArgsForCall = append(fake.putArgsForCall, struct {
@@ -237,11 +340,12 @@ func (fake *FakeResource) Put(arg1 context.Context, arg2 resource.IOConfig, arg3
 		arg2 resource.IOConfig
 		arg3 atc.Source
 		arg4 atc.Params
-	}{arg1, arg2, arg3, arg4})
-	fake.recordInvocation("Put", []interface{}{arg1, arg2, arg3, arg4})
+		arg5 string
+	}{arg1, arg2, arg3, arg4, arg5})
+	fake.recordInvocation("Put", []interface{}{arg1, arg2, arg3, arg4, arg5})
 	fake.putMutex.Unlock()
 	if fake.PutStub != nil {
-		return fake.PutStub(arg1, arg2, arg3, arg4)
+		return fake.PutStub(arg1, arg2, arg3, arg4, arg5)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -256,11 +360,11 @@ func (fake *FakeResource) PutCallCount() int {
 	return len(fake.putArgsForCall)
 }
 
-func (fake *FakeResource) PutArgsForCall(i int) (context.Context, resource.IOConfig, atc.Source, atc.Params) {
+func (fake *FakeResource) PutArgsForCall(i int) (context.Context, resource.IOConfig, atc.Source, atc.Params, string) {
 	fake.putMutex.RLock()
 	defer fake.putMutex.RUnlock()
 	argsForCall := fake.putArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
 }
 
 func (fake *FakeResource) PutReturns(result1 resource.VersionedSource, result2 error) {
@@ -285,17 +389,122 @@ func (fake *FakeResource) PutReturnsOnCall(i int, result1 resource.VersionedSour
 	}{result1, result2}
 }
 
+func (fake *FakeResource) Run(arg1 context.Context, arg2 resource.IOConfig, arg3 string, arg4 atc.Source, arg5 atc.Params) (json.RawMessage, error) {
+	fake.runMutex.Lock()
+	ret, specificReturn := fake.runReturnsOnCall[len(fake.runArgsForCall)]
+	fake.runArgsForCall = append(fake.runArgsForCall, struct {
+		arg1 context.Context
+		arg2 resource.IOConfig
+		arg3 string
+		arg4 atc.Source
+		arg5 atc.Params
+	}{arg1, arg2, arg3, arg4, arg5})
+	fake.recordInvocation("Run", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.runMutex.Unlock()
+	if fake.RunStub != nil {
+		return fake.RunStub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.runReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeResource) RunCallCount() int {
+	fake.runMutex.RLock()
+	defer fake.runMutex.RUnlock()
+	return len(fake.runArgsForCall)
+}
+
+func (fake *FakeResource) RunArgsForCall(i int) (context.Context, resource.IOConfig, string, atc.Source, atc.Params) {
+	fake.runMutex.RLock()
+	defer fake.runMutex.RUnlock()
+	argsForCall := fake.runArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeResource) RunReturns(result1 json.RawMessage, result2 error) {
+	fake.RunStub = nil
+	fake.runReturns = struct {
+		result1 json.RawMessage
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResource) RunReturnsOnCall(i int, result1 json.RawMessage, result2 error) {
+	fake.RunStub = nil
+	if fake.runReturnsOnCall == nil {
+		fake.runReturnsOnCall = make(map[int]struct {
+			result1 json.RawMessage
+			result2 error
+		})
+	}
+	fake.runReturnsOnCall[i] = struct {
+		result1 json.RawMessage
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResource) SupportsSpaces() bool {
+	fake.supportsSpacesMutex.Lock()
+	ret, specificReturn := fake.supportsSpacesReturnsOnCall[len(fake.supportsSpacesArgsForCall)]
+	fake.supportsSpacesArgsForCall = append(fake.supportsSpacesArgsForCall, struct {
+	}{})
+	fake.recordInvocation("SupportsSpaces", []interface{}{})
+	fake.supportsSpacesMutex.Unlock()
+	if fake.SupportsSpacesStub != nil {
+		return fake.SupportsSpacesStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.supportsSpacesReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeResource) SupportsSpacesCallCount() int {
+	fake.supportsSpacesMutex.RLock()
+	defer fake.supportsSpacesMutex.RUnlock()
+	return len(fake.supportsSpacesArgsForCall)
+}
+
+func (fake *FakeResource) SupportsSpacesReturns(result1 bool) {
+	fake.SupportsSpacesStub = nil
+	fake.supportsSpacesReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeResource) SupportsSpacesReturnsOnCall(i int, result1 bool) {
+	fake.SupportsSpacesStub = nil
+	if fake.supportsSpacesReturnsOnCall == nil {
+		fake.supportsSpacesReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.supportsSpacesReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeResource) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.checkMutex.RLock()
 	defer fake.checkMutex.RUnlock()
+	fake.checkSpacesMutex.RLock()
+	defer fake.checkSpacesMutex.RUnlock()
 	fake.containerMutex.RLock()
 	defer fake.containerMutex.RUnlock()
 	fake.getMutex.RLock()
 	defer fake.getMutex.RUnlock()
 	fake.putMutex.RLock()
 	defer fake.putMutex.RUnlock()
+	fake.runMutex.RLock()
+	defer fake.runMutex.RUnlock()
+	fake.supportsSpacesMutex.RLock()
+	defer fake.supportsSpacesMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -20,6 +20,11 @@ type ErrResourceScriptFailed struct {
 	ExitStatus int
 
 	Stderr string
+
+	// Message and Details are reported by resources speaking protocol v2
+	// which emit an error event before failing.
+	Message string
+	Details map[string]interface{}
 }
 
 func (err ErrResourceScriptFailed) Error() string {
@@ -30,6 +35,10 @@ func (err ErrResourceScriptFailed) Error() string {
 		err.ExitStatus,
 	)
 
+	if len(err.Message) > 0 {
+		msg += ": " + err.Message
+	}
+
 	if len(err.Stderr) > 0 {
 		msg += "\n\nstderr:\n" + err.Stderr
 	}
@@ -46,15 +55,36 @@ func (resource *resource) runScript(
 	logDest io.Writer,
 	recoverable bool,
 ) error {
-	request, err := json.Marshal(input)
+	stdout, err := resource.runProcess(ctx, path, args, input, nil, logDest, recoverable)
 	if err != nil {
 		return err
 	}
 
+	return json.Unmarshal(stdout, output)
+}
+
+// runProcess runs the script with the input on stdin and returns its stdout.
+// If stdoutDest is given, stdout is also copied to it as it is written. The
+// stdout of recoverable scripts is saved on the container so that it can be
+// returned without running the script again.
+func (resource *resource) runProcess(
+	ctx context.Context,
+	path string,
+	args []string,
+	input interface{},
+	stdoutDest io.Writer,
+	logDest io.Writer,
+	recoverable bool,
+) ([]byte, error) {
+	request, err := json.Marshal(input)
+	if err != nil {
+		return nil, err
+	}
+
 	if recoverable {
 		result, err := resource.container.Property(resourceResultPropertyName)
 		if err == nil {
-			return json.Unmarshal([]byte(result), &output)
+			return []byte(result), nil
 		}
 	}
 
@@ -66,6 +96,10 @@ func (resource *resource) runScript(
 		Stdout: stdout,
 	}
 
+	if stdoutDest != nil {
+		processIO.Stdout = io.MultiWriter(stdout, stdoutDest)
+	}
+
 	if logDest != nil {
 		processIO.Stderr = logDest
 	} else {
@@ -83,7 +117,7 @@ func (resource *resource) runScript(
 				Args: args,
 			}, processIO)
 			if err != nil {
-				return err
+				return nil, err
 			}
 		}
 	} else {
@@ -92,7 +126,7 @@ func (resource *resource) runScript(
 			Args: args,
 		}, processIO)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 
@@ -109,11 +143,11 @@ func (resource *resource) runScript(
 	select {
 	case <-processExited:
 		if processErr != nil {
-			return processErr
+			return nil, processErr
 		}
 
 		if processStatus != 0 {
-			return ErrResourceScriptFailed{
+			return nil, ErrResourceScriptFailed{
 				Path:       path,
 				Args:       args,
 				ExitStatus: processStatus,
@@ -125,15 +159,15 @@ func (resource *resource) runScript(
 		if recoverable {
 			err := resource.container.SetProperty(resourceResultPropertyName, stdout.String())
 			if err != nil {
-				return err
+				return nil, err
 			}
 		}
 
-		return json.Unmarshal(stdout.Bytes(), output)
+		return stdout.Bytes(), nil
 
 	case <-ctx.Done():
 		resource.container.Stop(false)
 		<-processExited
-		return ctx.Err()
+		return nil, ctx.Err()
 	}
 }
@@ -0,0 +1,6 @@
+package atc
+
+type PinRequestBody struct {
+	Version Version `json:"version"`
+	Comment string  `json:"comment,omitempty"`
+}
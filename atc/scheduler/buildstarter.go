@@ -87,53 +87,66 @@ func (s *buildStarter) tryStartNextPendingBuild(
 		"build-name": nextPendingBuild.Name(),
 	})
 
-	reachedMaxInFlight, err := s.maxInFlightUpdater.UpdateMaxInFlightReached(logger, job, nextPendingBuild.ID())
+	reachedMaxInFlight, reason, err := s.maxInFlightUpdater.UpdateMaxInFlightReached(logger, job, nextPendingBuild.ID())
 	if err != nil {
 		return false, err
 	}
 	if reachedMaxInFlight {
-		return false, nil
+		return false, s.setBlockingReasons(logger, nextPendingBuild, reason)
 	}
 
-	if nextPendingBuild.IsManuallyTriggered() {
-		jobBuildInputs := job.Config().Inputs()
-		for _, input := range jobBuildInputs {
-			scanLog := logger.Session("scan", lager.Data{
-				"input":    input.Name,
-				"resource": input.Resource,
-			})
+	var buildInputs []db.BuildInput
+	if nextPendingBuild.RerunOf() != 0 {
+		// a rerun uses the inputs of the build it reruns, so the input
+		// mapping is bypassed entirely
+		buildInputs, err = nextPendingBuild.Inputs()
+		if err != nil {
+			logger.Error("failed-to-get-rerun-build-inputs", err)
+			return false, err
+		}
+	} else {
+		switch nextPendingBuild.Cause() {
+		case db.BuildCauseManual, db.BuildCauseSchedule:
+			jobBuildInputs := job.Config().Inputs()
+			for _, input := range jobBuildInputs {
+				scanLog := logger.Session("scan", lager.Data{
+					"input":    input.Name,
+					"resource": input.Resource,
+				})
+
+				err := s.scanner.Scan(scanLog, input.Resource)
+				if err != nil {
+					return false, err
+				}
+			}
 
-			err := s.scanner.Scan(scanLog, input.Resource)
+			versions, err := s.pipeline.LoadVersionsDB()
 			if err != nil {
+				logger.Error("failed-to-load-versions-db", err)
 				return false, err
 			}
-		}
 
-		versions, err := s.pipeline.LoadVersionsDB()
-		if err != nil {
-			logger.Error("failed-to-load-versions-db", err)
-			return false, err
-		}
+			_, err = s.inputMapper.SaveNextInputMapping(logger, versions, job, resources)
+			if err != nil {
+				return false, err
+			}
 
-		_, err = s.inputMapper.SaveNextInputMapping(logger, versions, job, resources)
-		if err != nil {
-			return false, err
+			dbResourceTypes, err := s.pipeline.ResourceTypes()
+			if err != nil {
+				return false, err
+			}
+			resourceTypes = dbResourceTypes.Deserialize()
 		}
 
-		dbResourceTypes, err := s.pipeline.ResourceTypes()
+		var found bool
+		buildInputs, found, err = job.GetNextBuildInputs()
 		if err != nil {
+			logger.Error("failed-to-get-next-build-inputs", err)
 			return false, err
 		}
-		resourceTypes = dbResourceTypes.Deserialize()
-	}
-
-	buildInputs, found, err := job.GetNextBuildInputs()
-	if err != nil {
-		logger.Error("failed-to-get-next-build-inputs", err)
-		return false, err
-	}
-	if !found {
-		return false, nil
+		if !found {
+			return false, s.setBlockingReasons(logger, nextPendingBuild)
+		}
 	}
 
 	pipelinePaused, err := s.pipeline.CheckPaused()
@@ -142,11 +155,16 @@ func (s *buildStarter) tryStartNextPendingBuild(
 		return false, err
 	}
 	if pipelinePaused {
-		return false, nil
+		return false, s.setBlockingReasons(logger, nextPendingBuild)
 	}
 
 	if job.Paused() {
-		return false, nil
+		return false, s.setBlockingReasons(logger, nextPendingBuild)
+	}
+
+	err = s.setBlockingReasons(logger, nextPendingBuild)
+	if err != nil {
+		return false, err
 	}
 
 	updated, err := nextPendingBuild.Schedule()
@@ -197,3 +215,35 @@ func (s *buildStarter) tryStartNextPendingBuild(
 
 	return true, nil
 }
+
+// setBlockingReasons records why the build is not being started. The pipeline
+// and job being paused, missing inputs and missing workers are determined when
+// the build preparation is requested, so they are not recorded here. Nothing
+// is written when the reasons are unchanged since the build was loaded.
+func (s *buildStarter) setBlockingReasons(logger lager.Logger, build db.Build, reasons ...string) error {
+	if equalReasons(build.BlockingReasons(), reasons) {
+		return nil
+	}
+
+	err := build.SetBlockingReasons(reasons)
+	if err != nil {
+		logger.Error("failed-to-set-blocking-reasons", err)
+		return err
+	}
+
+	return nil
+}
+
+func equalReasons(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -65,7 +65,7 @@ var _ = Describe("BuildStarter", func() {
 
 			createdBuild = new(dbfakes.FakeBuild)
 			createdBuild.IDReturns(66)
-			createdBuild.IsManuallyTriggeredReturns(true)
+			createdBuild.CauseReturns(db.BuildCauseManual)
 
 			pendingBuilds = []db.Build{createdBuild}
 
@@ -99,7 +99,7 @@ var _ = Describe("BuildStarter", func() {
 
 			Context("when max in flight is reached", func() {
 				BeforeEach(func() {
-					fakeUpdater.UpdateMaxInFlightReachedReturns(true, nil)
+					fakeUpdater.UpdateMaxInFlightReachedReturns(true, "max_in_flight 1 reached", nil)
 				})
 
 				It("does not run resource check", func() {
@@ -109,7 +109,7 @@ var _ = Describe("BuildStarter", func() {
 
 			Context("when max in flight is not reached", func() {
 				BeforeEach(func() {
-					fakeUpdater.UpdateMaxInFlightReachedReturns(false, nil)
+					fakeUpdater.UpdateMaxInFlightReachedReturns(false, "", nil)
 				})
 
 				It("runs resource check for every job resource", func() {
@@ -284,12 +284,105 @@ var _ = Describe("BuildStarter", func() {
 			})
 		})
 
+		Context("when rerunning a build", func() {
+			var rerunInputs []db.BuildInput
+
+			BeforeEach(func() {
+				job = new(dbfakes.FakeJob)
+				job.NameReturns("some-job")
+				job.ConfigReturns(atc.JobConfig{Name: "some-job", Plan: atc.PlanSequence{{Get: "input-1"}}})
+
+				createdBuild.RerunOfReturns(42)
+
+				rerunInputs = []db.BuildInput{
+					{
+						Name: "input-1",
+						VersionedResource: db.VersionedResource{
+							Resource: "some-resource",
+							Version:  db.ResourceVersion{"ref": "v1"},
+						},
+					},
+				}
+				createdBuild.InputsReturns(rerunInputs, nil)
+				createdBuild.ScheduleReturns(true, nil)
+				fakeFactory.CreateReturns(atc.Plan{}, nil)
+				fakeEngine.CreateBuildReturns(new(enginefakes.FakeBuild), nil)
+			})
+
+			JustBeforeEach(func() {
+				tryStartErr = buildStarter.TryStartPendingBuildsForJob(
+					lagertest.NewTestLogger("test"),
+					job,
+					db.Resources{resource},
+					versionedResourceTypes,
+					pendingBuilds,
+				)
+			})
+
+			It("does not scan or determine the inputs", func() {
+				Expect(tryStartErr).NotTo(HaveOccurred())
+				Expect(fakeScanner.ScanCallCount()).To(BeZero())
+				Expect(fakeInputMapper.SaveNextInputMappingCallCount()).To(BeZero())
+				Expect(job.GetNextBuildInputsCallCount()).To(BeZero())
+			})
+
+			It("starts the build with the inputs of the original build", func() {
+				Expect(createdBuild.UseInputsCallCount()).To(Equal(1))
+				Expect(createdBuild.UseInputsArgsForCall(0)).To(Equal(rerunInputs))
+
+				Expect(fakeFactory.CreateCallCount()).To(Equal(1))
+				_, _, _, actualInputs := fakeFactory.CreateArgsForCall(0)
+				Expect(actualInputs).To(Equal(rerunInputs))
+
+				Expect(fakeEngine.CreateBuildCallCount()).To(Equal(1))
+			})
+
+			Context("when getting the inputs of the build fails", func() {
+				BeforeEach(func() {
+					createdBuild.InputsReturns(nil, disaster)
+				})
+
+				It("returns the error without scheduling the build", func() {
+					Expect(tryStartErr).To(Equal(disaster))
+					Expect(createdBuild.ScheduleCallCount()).To(BeZero())
+				})
+			})
+		})
+
+		Context("when triggered by the job's schedule", func() {
+			BeforeEach(func() {
+				job = new(dbfakes.FakeJob)
+				job.NameReturns("some-job")
+				job.ConfigReturns(atc.JobConfig{Plan: atc.PlanSequence{{Get: "input-1"}, {Get: "input-2"}}})
+				createdBuild.CauseReturns(db.BuildCauseSchedule)
+				fakeUpdater.UpdateMaxInFlightReachedReturns(false, "", nil)
+			})
+
+			JustBeforeEach(func() {
+				tryStartErr = buildStarter.TryStartPendingBuildsForJob(
+					lagertest.NewTestLogger("test"),
+					job,
+					db.Resources{resource},
+					versionedResourceTypes,
+					pendingBuilds,
+				)
+			})
+
+			It("runs resource check for every job resource", func() {
+				Expect(fakeScanner.ScanCallCount()).To(Equal(2))
+			})
+
+			It("saves the next input mapping", func() {
+				Expect(fakeInputMapper.SaveNextInputMappingCallCount()).To(Equal(1))
+			})
+		})
+
 		Context("when not manually triggered", func() {
 			BeforeEach(func() {
 				job = new(dbfakes.FakeJob)
 				job.NameReturns("some-job")
 				job.ConfigReturns(atc.JobConfig{Name: "some-job"})
-				createdBuild.IsManuallyTriggeredReturns(false)
+				createdBuild.CauseReturns("")
 			})
 
 			JustBeforeEach(func() {
@@ -352,7 +445,7 @@ var _ = Describe("BuildStarter", func() {
 			Context("when the stars align", func() {
 				BeforeEach(func() {
 					job.PausedReturns(false)
-					fakeUpdater.UpdateMaxInFlightReachedReturns(false, nil)
+					fakeUpdater.UpdateMaxInFlightReachedReturns(false, "", nil)
 					job.GetNextBuildInputsReturns([]db.BuildInput{{Name: "some-input"}}, true, nil)
 					fakePipeline.PausedReturns(false)
 				})
@@ -565,7 +658,7 @@ var _ = Describe("BuildStarter", func() {
 
 					Context("when updating max in flight reached fails", func() {
 						BeforeEach(func() {
-							fakeUpdater.UpdateMaxInFlightReachedReturns(false, disaster)
+							fakeUpdater.UpdateMaxInFlightReachedReturns(false, "", disaster)
 						})
 
 						itReturnsTheError()
@@ -574,10 +667,73 @@ var _ = Describe("BuildStarter", func() {
 
 					Context("when max in flight is reached", func() {
 						BeforeEach(func() {
-							fakeUpdater.UpdateMaxInFlightReachedReturns(true, nil)
+							fakeUpdater.UpdateMaxInFlightReachedReturns(true, "max_in_flight 1 reached", nil)
 						})
 
 						itDoesntReturnAnErrorOrMarkTheBuildAsScheduled()
+
+						It("records why the build is blocked", func() {
+							Expect(pendingBuild1.SetBlockingReasonsCallCount()).To(Equal(1))
+							Expect(pendingBuild1.SetBlockingReasonsArgsForCall(0)).To(Equal([]string{"max_in_flight 1 reached"}))
+						})
+
+						Context("when recording the reason fails", func() {
+							BeforeEach(func() {
+								pendingBuild1.SetBlockingReasonsReturns(disaster)
+							})
+
+							itReturnsTheError()
+						})
+						Context("when the same reason is already recorded", func() {
+							BeforeEach(func() {
+								pendingBuild1.BlockingReasonsReturns([]string{"max_in_flight 1 reached"})
+							})
+
+							It("does not record it again", func() {
+								Expect(pendingBuild1.SetBlockingReasonsCallCount()).To(BeZero())
+							})
+						})
+					})
+
+					Context("when a step has tags", func() {
+						BeforeEach(func() {
+							job.ConfigReturns(atc.JobConfig{
+								Name: "some-job",
+								Plan: atc.PlanSequence{
+									{Task: "some-task", Tags: atc.Tags{"z", "y"}},
+								},
+							})
+
+							fakeEngine.CreateBuildReturns(new(enginefakes.FakeBuild), nil)
+						})
+
+						It("schedules the build without checking the workers", func() {
+							Expect(pendingBuild1.ScheduleCallCount()).To(Equal(1))
+							Expect(fakeEngine.CreateBuildCallCount()).To(Equal(3))
+						})
+					})
+
+					Context("when the build has reasons recorded from an earlier attempt", func() {
+						BeforeEach(func() {
+							pendingBuild1.BlockingReasonsReturns([]string{"max_in_flight 1 reached"})
+							fakeEngine.CreateBuildReturns(new(enginefakes.FakeBuild), nil)
+						})
+
+						It("clears the blocking reasons and schedules the build", func() {
+							Expect(pendingBuild1.SetBlockingReasonsCallCount()).To(Equal(1))
+							Expect(pendingBuild1.SetBlockingReasonsArgsForCall(0)).To(BeEmpty())
+							Expect(pendingBuild1.ScheduleCallCount()).To(Equal(1))
+						})
+					})
+
+					Context("when the build has no reasons recorded", func() {
+						BeforeEach(func() {
+							fakeEngine.CreateBuildReturns(new(enginefakes.FakeBuild), nil)
+						})
+
+						It("does not write the blocking reasons", func() {
+							Expect(pendingBuild1.SetBlockingReasonsCallCount()).To(BeZero())
+						})
 					})
 
 					Context("when getting the next build inputs fails", func() {
@@ -0,0 +1,80 @@
+package factory
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+)
+
+// interpolateAcrossVar replaces every occurrence of ((varName)) in the step
+// config with the given value. A string consisting solely of the var is
+// replaced with the value as-is, preserving its type; otherwise the value is
+// spliced into the string.
+func interpolateAcrossVar(planConfig atc.PlanConfig, varName string, value interface{}) (atc.PlanConfig, error) {
+	payload, err := json.Marshal(planConfig)
+	if err != nil {
+		return atc.PlanConfig{}, err
+	}
+
+	var generic interface{}
+	err = json.Unmarshal(payload, &generic)
+	if err != nil {
+		return atc.PlanConfig{}, err
+	}
+
+	placeholder := "((" + varName + "))"
+
+	replacement, err := acrossVarString(value)
+	if err != nil {
+		return atc.PlanConfig{}, err
+	}
+
+	payload, err = json.Marshal(replaceAcrossVar(generic, placeholder, value, replacement))
+	if err != nil {
+		return atc.PlanConfig{}, err
+	}
+
+	var interpolated atc.PlanConfig
+	err = json.Unmarshal(payload, &interpolated)
+	if err != nil {
+		return atc.PlanConfig{}, err
+	}
+
+	return interpolated, nil
+}
+
+func replaceAcrossVar(node interface{}, placeholder string, value interface{}, replacement string) interface{} {
+	switch n := node.(type) {
+	case string:
+		if n == placeholder {
+			return value
+		}
+
+		return strings.Replace(n, placeholder, replacement, -1)
+	case []interface{}:
+		for i, elem := range n {
+			n[i] = replaceAcrossVar(elem, placeholder, value, replacement)
+		}
+	case map[string]interface{}:
+		for key, elem := range n {
+			n[key] = replaceAcrossVar(elem, placeholder, value, replacement)
+		}
+	}
+
+	return node
+}
+
+func acrossVarString(value interface{}) (string, error) {
+	if str, ok := value.(string); ok {
+		return str, nil
+	}
+
+	payload, err := json.Marshal(value)
+	if err != nil {
+		return "", fmt.Errorf("invalid value for across var: %s", err)
+	}
+
+	return string(payload), nil
+}
@@ -99,6 +99,10 @@ func (factory *buildFactory) constructPlanFromConfig(
 	resourceTypes atc.VersionedResourceTypes,
 	inputs []db.BuildInput,
 ) (atc.Plan, error) {
+	if planConfig.Across != nil {
+		return factory.across(planConfig, resources, resourceTypes, inputs)
+	}
+
 	var plan atc.Plan
 	var err error
 
@@ -131,6 +135,57 @@ func (factory *buildFactory) constructPlanFromConfig(
 	})
 }
 
+func (factory *buildFactory) across(
+	planConfig atc.PlanConfig,
+	resources atc.ResourceConfigs,
+	resourceTypes atc.VersionedResourceTypes,
+	inputs []db.BuildInput,
+) (atc.Plan, error) {
+	acrossConfig := *planConfig.Across
+
+	// the hooks apply to the step as a whole rather than to each iteration
+	stepConfig := planConfig
+	stepConfig.Across = nil
+	stepConfig.Abort = nil
+	stepConfig.Failure = nil
+	stepConfig.Ensure = nil
+	stepConfig.Success = nil
+
+	across := atc.AcrossPlan{
+		Var:         acrossConfig.Var,
+		MaxInFlight: acrossConfig.MaxInFlight,
+	}
+
+	if across.MaxInFlight == 0 {
+		across.MaxInFlight = 1
+	}
+
+	for _, value := range acrossConfig.Values {
+		iterationConfig, err := interpolateAcrossVar(stepConfig, acrossConfig.Var, value)
+		if err != nil {
+			return atc.Plan{}, err
+		}
+
+		step, err := factory.constructPlanFromConfig(iterationConfig, resources, resourceTypes, inputs)
+		if err != nil {
+			return atc.Plan{}, err
+		}
+
+		across.Iterations = append(across.Iterations, atc.AcrossIteration{
+			Value: value,
+			Step:  step,
+		})
+	}
+
+	return factory.applyHooks(constructionParams{
+		plan:          factory.planFactory.NewPlan(across),
+		hooks:         planConfig.Hooks(),
+		resources:     resources,
+		resourceTypes: resourceTypes,
+		inputs:        inputs,
+	})
+}
+
 func (factory *buildFactory) constructUnhookedPlan(
 	planConfig atc.PlanConfig,
 	resources atc.ResourceConfigs,
@@ -171,6 +226,7 @@ func (factory *buildFactory) constructUnhookedPlan(
 			Resource: resourceName,
 			Source:   resource.Source,
 			Params:   planConfig.Params,
+			Space:    planConfig.Space,
 			Tags:     planConfig.Tags,
 
 			VersionedResourceTypes: resourceTypes,
@@ -183,6 +239,7 @@ func (factory *buildFactory) constructUnhookedPlan(
 			VersionFrom: &putPlan.ID,
 
 			Params: planConfig.GetParams,
+			Space:  planConfig.Space,
 			Tags:   planConfig.Tags,
 			Source: resource.Source,
 
@@ -206,11 +263,21 @@ func (factory *buildFactory) constructUnhookedPlan(
 		}
 
 		name := planConfig.Get
-		var version atc.Version
-		for _, input := range inputs {
-			if input.Name == name {
-				version = atc.Version(input.Version)
-				break
+		var version *atc.Version
+		if planConfig.Space != "" {
+			// the version is looked up in the space when the step runs,
+			// unless one is pinned
+			if planConfig.Version != nil && planConfig.Version.Pinned != nil {
+				pinned := planConfig.Version.Pinned
+				version = &pinned
+			}
+		} else {
+			version = new(atc.Version)
+			for _, input := range inputs {
+				if input.Name == name {
+					*version = atc.Version(input.Version)
+					break
+				}
 			}
 		}
 
@@ -220,7 +287,8 @@ func (factory *buildFactory) constructUnhookedPlan(
 			Resource: resourceName,
 			Source:   resource.Source,
 			Params:   planConfig.Params,
-			Version:  &version,
+			Space:    planConfig.Space,
+			Version:  version,
 			Tags:     planConfig.Tags,
 
 			VersionedResourceTypes: resourceTypes,
@@ -236,10 +304,55 @@ func (factory *buildFactory) constructUnhookedPlan(
 			Params:            planConfig.Params,
 			InputMapping:      planConfig.InputMapping,
 			OutputMapping:     planConfig.OutputMapping,
+			Artifacts:         planConfig.Artifacts,
+			CacheOutputs:      planConfig.CacheOutputs,
 			ImageArtifactName: planConfig.ImageArtifactName,
 
 			VersionedResourceTypes: resourceTypes,
 		})
+	case planConfig.SetPipeline != "":
+		plan = factory.planFactory.NewPlan(atc.SetPipelinePlan{
+			Name:     planConfig.SetPipeline,
+			File:     planConfig.TaskConfigPath,
+			VarFiles: planConfig.VarFiles,
+			Vars:     planConfig.Vars,
+		})
+
+	case planConfig.LoadVar != "":
+		plan = factory.planFactory.NewPlan(atc.LoadVarPlan{
+			Name:      planConfig.LoadVar,
+			File:      planConfig.TaskConfigPath,
+			Format:    planConfig.Format,
+			Sensitive: planConfig.Sensitive,
+		})
+
+	case planConfig.Run != "":
+		runPlan := atc.RunPlan{
+			Message:       planConfig.Run,
+			Type:          planConfig.Type,
+			Params:        planConfig.Params,
+			Tags:          planConfig.Tags,
+			Inputs:        planConfig.Inputs,
+			Outputs:       planConfig.Outputs,
+			InputMapping:  planConfig.InputMapping,
+			OutputMapping: planConfig.OutputMapping,
+
+			VersionedResourceTypes: resourceTypes,
+		}
+
+		if planConfig.Resource != "" {
+			resource, found := resources.Lookup(planConfig.Resource)
+			if !found {
+				return atc.Plan{}, ErrResourceNotFound
+			}
+
+			runPlan.Type = resource.Type
+			runPlan.Resource = planConfig.Resource
+			runPlan.Source = resource.Source
+		}
+
+		plan = factory.planFactory.NewPlan(runPlan)
+
 	case planConfig.Try != nil:
 		nextStep, err := factory.constructPlanFromConfig(
 			*planConfig.Try,
@@ -0,0 +1,166 @@
+package factory_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/scheduler/factory"
+	"github.com/concourse/concourse/atc/testhelpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Factory Across Step", func() {
+	var (
+		resourceTypes atc.VersionedResourceTypes
+
+		buildFactory        factory.BuildFactory
+		actualPlanFactory   atc.PlanFactory
+		expectedPlanFactory atc.PlanFactory
+	)
+
+	BeforeEach(func() {
+		actualPlanFactory = atc.NewPlanFactory(123)
+		expectedPlanFactory = atc.NewPlanFactory(123)
+		buildFactory = factory.NewBuildFactory(42, actualPlanFactory)
+
+		resourceTypes = atc.VersionedResourceTypes{
+			{
+				ResourceType: atc.ResourceType{
+					Name:   "some-custom-resource",
+					Type:   "registry-image",
+					Source: atc.Source{"some": "custom-source"},
+				},
+				Version: atc.Version{"some": "version"},
+			},
+		}
+	})
+
+	Context("when there is a task annotated with 'across'", func() {
+		It("builds a step for each value with the var interpolated", func() {
+			actual, err := buildFactory.Create(atc.JobConfig{
+				Plan: atc.PlanSequence{
+					{
+						Task:           "unit-((go_version))",
+						TaskConfigPath: "ci/unit.yml",
+						Params: atc.Params{
+							"GO_VERSION": "((go_version))",
+							"FLAGS":      "-v ((go_version))",
+						},
+						Across: &atc.AcrossConfig{
+							Var:         "go_version",
+							Values:      []interface{}{"1.10", 1.11},
+							MaxInFlight: 2,
+						},
+					},
+				},
+			}, nil, resourceTypes, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			expected := expectedPlanFactory.NewPlan(atc.AcrossPlan{
+				Var:         "go_version",
+				MaxInFlight: 2,
+				Iterations: []atc.AcrossIteration{
+					{
+						Value: "1.10",
+						Step: expectedPlanFactory.NewPlan(atc.TaskPlan{
+							Name:       "unit-1.10",
+							ConfigPath: "ci/unit.yml",
+							Params: atc.Params{
+								"GO_VERSION": "1.10",
+								"FLAGS":      "-v 1.10",
+							},
+							VersionedResourceTypes: resourceTypes,
+						}),
+					},
+					{
+						Value: 1.11,
+						Step: expectedPlanFactory.NewPlan(atc.TaskPlan{
+							Name:       "unit-1.11",
+							ConfigPath: "ci/unit.yml",
+							Params: atc.Params{
+								"GO_VERSION": 1.11,
+								"FLAGS":      "-v 1.11",
+							},
+							VersionedResourceTypes: resourceTypes,
+						}),
+					},
+				},
+			})
+
+			Expect(actual).To(testhelpers.MatchPlan(expected))
+		})
+
+		It("runs one iteration at a time by default", func() {
+			actual, err := buildFactory.Create(atc.JobConfig{
+				Plan: atc.PlanSequence{
+					{
+						Task: "some-task",
+						Across: &atc.AcrossConfig{
+							Var:    "some-var",
+							Values: []interface{}{"a"},
+						},
+					},
+				},
+			}, nil, resourceTypes, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(actual.Across).ToNot(BeNil())
+			Expect(actual.Across.MaxInFlight).To(Equal(1))
+		})
+	})
+
+	Context("when there is a task annotated with 'across', 'attempts' and 'on_success'", func() {
+		It("retries each iteration and runs the hook once for the whole step", func() {
+			actual, err := buildFactory.Create(atc.JobConfig{
+				Plan: atc.PlanSequence{
+					{
+						Task:     "some-task",
+						Attempts: 2,
+						Across: &atc.AcrossConfig{
+							Var:    "some-var",
+							Values: []interface{}{"a", "b"},
+						},
+						Success: &atc.PlanConfig{
+							Task: "next-task",
+						},
+					},
+				},
+			}, nil, resourceTypes, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			taskPlan := atc.TaskPlan{
+				Name:                   "some-task",
+				VersionedResourceTypes: resourceTypes,
+			}
+
+			expected := expectedPlanFactory.NewPlan(atc.OnSuccessPlan{
+				Step: expectedPlanFactory.NewPlan(atc.AcrossPlan{
+					Var:         "some-var",
+					MaxInFlight: 1,
+					Iterations: []atc.AcrossIteration{
+						{
+							Value: "a",
+							Step: expectedPlanFactory.NewPlan(atc.RetryPlan{
+								expectedPlanFactory.NewPlan(taskPlan),
+								expectedPlanFactory.NewPlan(taskPlan),
+							}),
+						},
+						{
+							Value: "b",
+							Step: expectedPlanFactory.NewPlan(atc.RetryPlan{
+								expectedPlanFactory.NewPlan(taskPlan),
+								expectedPlanFactory.NewPlan(taskPlan),
+							}),
+						},
+					},
+				}),
+				Next: expectedPlanFactory.NewPlan(atc.TaskPlan{
+					Name:                   "next-task",
+					VersionedResourceTypes: resourceTypes,
+				}),
+			})
+
+			Expect(actual).To(testhelpers.MatchPlan(expected))
+		})
+	})
+})
@@ -0,0 +1,69 @@
+package factory_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/scheduler/factory"
+	"github.com/concourse/concourse/atc/testhelpers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Factory LoadVar", func() {
+	Describe("LoadVarPlan", func() {
+		var (
+			buildFactory factory.BuildFactory
+
+			resourceTypes       atc.VersionedResourceTypes
+			input               atc.JobConfig
+			actualPlanFactory   atc.PlanFactory
+			expectedPlanFactory atc.PlanFactory
+		)
+
+		BeforeEach(func() {
+			actualPlanFactory = atc.NewPlanFactory(123)
+			expectedPlanFactory = atc.NewPlanFactory(123)
+			buildFactory = factory.NewBuildFactory(42, actualPlanFactory)
+
+			resourceTypes = atc.VersionedResourceTypes{}
+		})
+
+		Context("with a load_var followed by a task", func() {
+			BeforeEach(func() {
+				input = atc.JobConfig{
+					Plan: atc.PlanSequence{
+						{
+							LoadVar:        "version",
+							TaskConfigPath: "some-resource/version",
+							Format:         "raw",
+							Sensitive:      true,
+						},
+						{
+							Task:   "some-task",
+							Params: atc.Params{"VERSION": "((.:version))"},
+						},
+					},
+				}
+			})
+
+			It("returns the correct plan", func() {
+				actual, err := buildFactory.Create(input, nil, resourceTypes, nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				expected := expectedPlanFactory.NewPlan(atc.DoPlan{
+					expectedPlanFactory.NewPlan(atc.LoadVarPlan{
+						Name:      "version",
+						File:      "some-resource/version",
+						Format:    "raw",
+						Sensitive: true,
+					}),
+					expectedPlanFactory.NewPlan(atc.TaskPlan{
+						Name:                   "some-task",
+						Params:                 atc.Params{"VERSION": "((.:version))"},
+						VersionedResourceTypes: resourceTypes,
+					}),
+				})
+				Expect(actual).To(testhelpers.MatchPlan(expected))
+			})
+		})
+	})
+})
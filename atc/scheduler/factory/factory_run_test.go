@@ -0,0 +1,127 @@
+package factory_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/scheduler/factory"
+	"github.com/concourse/concourse/atc/testhelpers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Factory Run", func() {
+	Describe("RunPlan", func() {
+		var (
+			buildFactory factory.BuildFactory
+
+			resources           atc.ResourceConfigs
+			resourceTypes       atc.VersionedResourceTypes
+			input               atc.JobConfig
+			actualPlanFactory   atc.PlanFactory
+			expectedPlanFactory atc.PlanFactory
+		)
+
+		BeforeEach(func() {
+			actualPlanFactory = atc.NewPlanFactory(123)
+			expectedPlanFactory = atc.NewPlanFactory(123)
+			buildFactory = factory.NewBuildFactory(42, actualPlanFactory)
+
+			resources = atc.ResourceConfigs{
+				{
+					Name:   "some-resource",
+					Type:   "git",
+					Source: atc.Source{"uri": "git://some-resource"},
+				},
+			}
+
+			resourceTypes = atc.VersionedResourceTypes{
+				{
+					ResourceType: atc.ResourceType{
+						Name:   "some-custom-resource",
+						Type:   "registry-image",
+						Source: atc.Source{"some": "custom-source"},
+					},
+					Version: atc.Version{"some": "version"},
+				},
+			}
+		})
+
+		Context("with a run of a resource type's message", func() {
+			BeforeEach(func() {
+				input = atc.JobConfig{
+					Plan: atc.PlanSequence{
+						{
+							Run:           "promote",
+							Type:          "some-custom-resource",
+							Params:        atc.Params{"tag": "latest"},
+							Tags:          atc.Tags{"some-tag"},
+							Inputs:        []string{"image"},
+							Outputs:       []string{"digest"},
+							InputMapping:  map[string]string{"image": "built-image"},
+							OutputMapping: map[string]string{"digest": "promoted-digest"},
+						},
+					},
+				}
+			})
+
+			It("returns the correct plan", func() {
+				actual, err := buildFactory.Create(input, resources, resourceTypes, nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				expected := expectedPlanFactory.NewPlan(atc.RunPlan{
+					Message:       "promote",
+					Type:          "some-custom-resource",
+					Params:        atc.Params{"tag": "latest"},
+					Tags:          atc.Tags{"some-tag"},
+					Inputs:        []string{"image"},
+					Outputs:       []string{"digest"},
+					InputMapping:  map[string]string{"image": "built-image"},
+					OutputMapping: map[string]string{"digest": "promoted-digest"},
+
+					VersionedResourceTypes: resourceTypes,
+				})
+				Expect(actual).To(testhelpers.MatchPlan(expected))
+			})
+		})
+
+		Context("with a run of a resource's message", func() {
+			BeforeEach(func() {
+				input = atc.JobConfig{
+					Plan: atc.PlanSequence{
+						{
+							Run:      "delete-branch",
+							Resource: "some-resource",
+							Params:   atc.Params{"branch": "pr-1"},
+						},
+					},
+				}
+			})
+
+			It("uses the type and source of the resource", func() {
+				actual, err := buildFactory.Create(input, resources, resourceTypes, nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				expected := expectedPlanFactory.NewPlan(atc.RunPlan{
+					Message:  "delete-branch",
+					Type:     "git",
+					Resource: "some-resource",
+					Source:   atc.Source{"uri": "git://some-resource"},
+					Params:   atc.Params{"branch": "pr-1"},
+
+					VersionedResourceTypes: resourceTypes,
+				})
+				Expect(actual).To(testhelpers.MatchPlan(expected))
+			})
+
+			Context("when the resource does not exist", func() {
+				BeforeEach(func() {
+					resources = atc.ResourceConfigs{}
+				})
+
+				It("returns an error", func() {
+					_, err := buildFactory.Create(input, resources, resourceTypes, nil)
+					Expect(err).To(Equal(factory.ErrResourceNotFound))
+				})
+			})
+		})
+	})
+})
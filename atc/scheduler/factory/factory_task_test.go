@@ -181,5 +181,67 @@ var _ = Describe("Factory Task", func() {
 				Expect(actual).To(testhelpers.MatchPlan(expected))
 			})
 		})
+
+		Context("when artifacts are specified", func() {
+			BeforeEach(func() {
+				input = atc.JobConfig{
+					Plan: atc.PlanSequence{
+						{
+							Task:      "some-task",
+							Artifacts: []string{"test-report"},
+							TaskConfig: &atc.TaskConfig{
+								Outputs: []atc.TaskOutputConfig{
+									{Name: "test-report"},
+								},
+							},
+						},
+					},
+				}
+			})
+
+			It("creates build plan retaining the artifacts", func() {
+				actual, err := buildFactory.Create(input, resources, resourceTypes, nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				expected := expectedPlanFactory.NewPlan(atc.TaskPlan{
+					Name:                   "some-task",
+					VersionedResourceTypes: resourceTypes,
+					Artifacts:              []string{"test-report"},
+					Config: &atc.TaskConfig{
+						Outputs: []atc.TaskOutputConfig{
+							{Name: "test-report"},
+						},
+					},
+				})
+				Expect(actual).To(testhelpers.MatchPlan(expected))
+			})
+		})
+
+		Context("when output caching is enabled", func() {
+			BeforeEach(func() {
+				input = atc.JobConfig{
+					Plan: atc.PlanSequence{
+						{
+							Task:         "some-task",
+							CacheOutputs: true,
+							TaskConfig:   &atc.TaskConfig{},
+						},
+					},
+				}
+			})
+
+			It("creates build plan caching the outputs", func() {
+				actual, err := buildFactory.Create(input, resources, resourceTypes, nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				expected := expectedPlanFactory.NewPlan(atc.TaskPlan{
+					Name:                   "some-task",
+					VersionedResourceTypes: resourceTypes,
+					CacheOutputs:           true,
+					Config:                 &atc.TaskConfig{},
+				})
+				Expect(actual).To(testhelpers.MatchPlan(expected))
+			})
+		})
 	})
 })
@@ -10,7 +10,7 @@ import (
 )
 
 type FakeUpdater struct {
-	UpdateMaxInFlightReachedStub        func(lager.Logger, db.Job, int) (bool, error)
+	UpdateMaxInFlightReachedStub        func(lager.Logger, db.Job, int) (bool, string, error)
 	updateMaxInFlightReachedMutex       sync.RWMutex
 	updateMaxInFlightReachedArgsForCall []struct {
 		arg1 lager.Logger
@@ -19,17 +19,19 @@ type FakeUpdater struct {
 	}
 	updateMaxInFlightReachedReturns struct {
 		result1 bool
-		result2 error
+		result2 string
+		result3 error
 	}
 	updateMaxInFlightReachedReturnsOnCall map[int]struct {
 		result1 bool
-		result2 error
+		result2 string
+		result3 error
 	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeUpdater) UpdateMaxInFlightReached(arg1 lager.Logger, arg2 db.Job, arg3 int) (bool, error) {
+func (fake *FakeUpdater) UpdateMaxInFlightReached(arg1 lager.Logger, arg2 db.Job, arg3 int) (bool, string, error) {
 	fake.updateMaxInFlightReachedMutex.Lock()
 	ret, specificReturn := fake.updateMaxInFlightReachedReturnsOnCall[len(fake.updateMaxInFlightReachedArgsForCall)]
 	fake.updateMaxInFlightReachedArgsForCall = append(fake.updateMaxInFlightReachedArgsForCall, struct {
@@ -43,10 +45,10 @@ func (fake *FakeUpdater) UpdateMaxInFlightReached(arg1 lager.Logger, arg2 db.Job
 		return fake.UpdateMaxInFlightReachedStub(arg1, arg2, arg3)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2
+		return ret.result1, ret.result2, ret.result3
 	}
 	fakeReturns := fake.updateMaxInFlightReachedReturns
-	return fakeReturns.result1, fakeReturns.result2
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
 func (fake *FakeUpdater) UpdateMaxInFlightReachedCallCount() int {
@@ -62,26 +64,29 @@ func (fake *FakeUpdater) UpdateMaxInFlightReachedArgsForCall(i int) (lager.Logge
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeUpdater) UpdateMaxInFlightReachedReturns(result1 bool, result2 error) {
+func (fake *FakeUpdater) UpdateMaxInFlightReachedReturns(result1 bool, result2 string, result3 error) {
 	fake.UpdateMaxInFlightReachedStub = nil
 	fake.updateMaxInFlightReachedReturns = struct {
 		result1 bool
-		result2 error
-	}{result1, result2}
+		result2 string
+		result3 error
+	}{result1, result2, result3}
 }
 
-func (fake *FakeUpdater) UpdateMaxInFlightReachedReturnsOnCall(i int, result1 bool, result2 error) {
+func (fake *FakeUpdater) UpdateMaxInFlightReachedReturnsOnCall(i int, result1 bool, result2 string, result3 error) {
 	fake.UpdateMaxInFlightReachedStub = nil
 	if fake.updateMaxInFlightReachedReturnsOnCall == nil {
 		fake.updateMaxInFlightReachedReturnsOnCall = make(map[int]struct {
 			result1 bool
-			result2 error
+			result2 string
+			result3 error
 		})
 	}
 	fake.updateMaxInFlightReachedReturnsOnCall[i] = struct {
 		result1 bool
-		result2 error
-	}{result1, result2}
+		result2 string
+		result3 error
+	}{result1, result2, result3}
 }
 
 func (fake *FakeUpdater) Invocations() map[string][][]interface{} {
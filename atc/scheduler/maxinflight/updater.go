@@ -1,14 +1,19 @@
 package maxinflight
 
 import (
+	"fmt"
+	"strings"
+
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc/db"
 )
 
 //go:generate counterfeiter . Updater
 
+// Updater records whether a job can start another build. When it can't, the
+// returned reason describes what the build is waiting on.
 type Updater interface {
-	UpdateMaxInFlightReached(logger lager.Logger, job db.Job, buildID int) (bool, error)
+	UpdateMaxInFlightReached(logger lager.Logger, job db.Job, buildID int) (bool, string, error)
 }
 
 func NewUpdater(pipeline db.Pipeline) Updater {
@@ -19,50 +24,74 @@ type updater struct {
 	pipeline db.Pipeline
 }
 
-func (u *updater) UpdateMaxInFlightReached(logger lager.Logger, job db.Job, buildID int) (bool, error) {
+func (u *updater) UpdateMaxInFlightReached(logger lager.Logger, job db.Job, buildID int) (bool, string, error) {
 	logger = logger.Session("is-max-in-flight-reached", lager.Data{"job-name": job.Name()})
 
-	reached, err := u.isMaxInFlightReached(logger, job, buildID)
+	reason, err := u.isMaxInFlightReached(logger, job, buildID)
 	if err != nil {
-		return false, err
+		return false, "", err
 	}
 
+	reached := reason != ""
+
 	err = job.SetMaxInFlightReached(reached)
 	if err != nil {
 		logger.Error("failed-to-set-max-in-flight-reached", err)
-		return false, err
+		return false, "", err
 	}
 
-	return reached, nil
+	return reached, reason, nil
 }
 
-func (u *updater) isMaxInFlightReached(logger lager.Logger, job db.Job, buildID int) (bool, error) {
+func (u *updater) isMaxInFlightReached(logger lager.Logger, job db.Job, buildID int) (string, error) {
 	maxInFlight := job.Config().MaxInFlight()
 
 	if maxInFlight == 0 {
-		return false, nil
+		return "", nil
 	}
 
-	builds, err := job.GetRunningBuildsBySerialGroup(job.Config().GetSerialGroups())
+	serialGroups := job.Config().GetSerialGroups()
+
+	builds, err := job.GetRunningBuildsBySerialGroup(serialGroups)
 	if err != nil {
 		logger.Error("failed-to-get-running-builds-by-serial-group", err)
-		return false, err
+		return "", err
 	}
 
 	if len(builds) >= maxInFlight {
-		return true, nil
+		if len(job.Config().SerialGroups) > 0 {
+			return fmt.Sprintf(db.BlockingReasonSerialGroupHeld, strings.Join(serialGroups, ", "), buildName(builds[0])), nil
+		}
+
+		return fmt.Sprintf(db.BlockingReasonMaxInFlight, maxInFlight), nil
 	}
 
-	nextMostPendingBuild, found, err := job.GetNextPendingBuildBySerialGroup(job.Config().GetSerialGroups())
+	nextMostPendingBuild, found, err := job.GetNextPendingBuildBySerialGroup(serialGroups)
 	if err != nil {
 		logger.Error("failed-to-get-next-pending-build-by-serial-group", err)
-		return false, err
+		return "", err
 	}
 
 	if !found {
 		logger.Info("pending-build-disappeared-from-serial-group")
-		return true, nil
+		return db.BlockingReasonPendingBuildGone, nil
+	}
+
+	if nextMostPendingBuild.ID() != buildID {
+		if len(job.Config().SerialGroups) > 0 {
+			return fmt.Sprintf(db.BlockingReasonSerialGroupQueue, strings.Join(serialGroups, ", "), buildName(nextMostPendingBuild)), nil
+		}
+
+		return fmt.Sprintf(db.BlockingReasonPendingBuild, buildName(nextMostPendingBuild)), nil
+	}
+
+	return "", nil
+}
+
+func buildName(build db.Build) string {
+	if build.JobName() == "" {
+		return "#" + build.Name()
 	}
 
-	return nextMostPendingBuild.ID() != buildID, nil
+	return build.JobName() + " #" + build.Name()
 }
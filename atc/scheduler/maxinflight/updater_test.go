@@ -33,6 +33,7 @@ var _ = Describe("Updater", func() {
 		var serialGroups []string
 		var updateErr error
 		var reached bool
+		var reason string
 
 		JustBeforeEach(func() {
 			fakeJob.NameReturns("some-job")
@@ -42,7 +43,7 @@ var _ = Describe("Updater", func() {
 				RawMaxInFlight: rawMaxInFlight,
 			})
 
-			reached, updateErr = updater.UpdateMaxInFlightReached(
+			reached, reason, updateErr = updater.UpdateMaxInFlightReached(
 				lagertest.NewTestLogger("test"),
 				fakeJob,
 				57,
@@ -53,6 +54,7 @@ var _ = Describe("Updater", func() {
 			It("returns false and no error", func() {
 				Expect(updateErr).NotTo(HaveOccurred())
 				Expect(reached).To(BeFalse())
+				Expect(reason).To(BeEmpty())
 				Expect(fakeJob.SetMaxInFlightReachedCallCount()).To(Equal(1))
 				actualReached := fakeJob.SetMaxInFlightReachedArgsForCall(0)
 				Expect(actualReached).To(BeFalse())
@@ -100,6 +102,8 @@ var _ = Describe("Updater", func() {
 			})
 		})
 
+		var expectedQueueReason string
+
 		itReturnsFalseIfOurBuildIsNext := func() {
 			Context("when the build we are trying to run is no longer pending", func() {
 				BeforeEach(func() {
@@ -115,10 +119,16 @@ var _ = Describe("Updater", func() {
 				BeforeEach(func() {
 					fakeBuild = new(dbfakes.FakeBuild)
 					fakeBuild.IDReturns(101)
+					fakeBuild.JobNameReturns("other-job")
+					fakeBuild.NameReturns("4")
 					fakeJob.GetNextPendingBuildBySerialGroupReturns(fakeBuild, true, nil)
 				})
 
 				itReturnsTrueAndNoError()
+
+				It("explains which build is ahead", func() {
+					Expect(reason).To(Equal(expectedQueueReason))
+				})
 			})
 
 			Context("when the build we are trying to run is first in line", func() {
@@ -161,6 +171,10 @@ var _ = Describe("Updater", func() {
 
 				itReturnsTrueAndNoError()
 
+				It("explains that max in flight has been reached", func() {
+					Expect(reason).To(Equal("max_in_flight 3 reached"))
+				})
+
 				It("doesn't look up the next pending build", func() {
 					Expect(fakeJob.GetNextPendingBuildBySerialGroupCallCount()).To(BeZero())
 				})
@@ -169,6 +183,7 @@ var _ = Describe("Updater", func() {
 			Context("when there are 2 builds of the job running", func() {
 				BeforeEach(func() {
 					fakeJob.GetRunningBuildsBySerialGroupReturns([]db.Build{nil, nil}, nil)
+					expectedQueueReason = "waiting behind build other-job #4"
 				})
 
 				Context("when looking up the next pending build returns an error", func() {
@@ -211,11 +226,18 @@ var _ = Describe("Updater", func() {
 
 			Context("when a job in the serial group is running", func() {
 				BeforeEach(func() {
-					fakeJob.GetRunningBuildsBySerialGroupReturns([]db.Build{nil}, nil)
+					runningBuild := new(dbfakes.FakeBuild)
+					runningBuild.JobNameReturns("other-job")
+					runningBuild.NameReturns("3")
+					fakeJob.GetRunningBuildsBySerialGroupReturns([]db.Build{runningBuild}, nil)
 				})
 
 				itReturnsTrueAndNoError()
 
+				It("explains which build holds the serial group", func() {
+					Expect(reason).To(Equal("waiting on serial group serial-group-1, serial-group-2 held by build other-job #3"))
+				})
+
 				It("doesn't look up the next pending build", func() {
 					Expect(fakeJob.GetNextPendingBuildBySerialGroupCallCount()).To(BeZero())
 				})
@@ -224,6 +246,7 @@ var _ = Describe("Updater", func() {
 			Context("when no job in the serial group is running", func() {
 				BeforeEach(func() {
 					fakeJob.GetRunningBuildsBySerialGroupReturns([]db.Build{}, nil)
+					expectedQueueReason = "waiting on serial group serial-group-1, serial-group-2 behind build other-job #4"
 				})
 
 				Context("when looking up the next pending build returns an error", func() {
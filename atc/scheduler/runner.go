@@ -30,6 +30,14 @@ type BuildScheduler interface {
 		resourceTypes atc.VersionedResourceTypes,
 	) (db.Build, Waiter, error)
 
+	RerunImmediately(
+		logger lager.Logger,
+		job db.Job,
+		buildToRerun db.Build,
+		resources db.Resources,
+		resourceTypes atc.VersionedResourceTypes,
+	) (db.Build, Waiter, error)
+
 	SaveNextInputMapping(logger lager.Logger, job db.Job, resource db.Resources) error
 }
 
@@ -4,6 +4,7 @@ import (
 	"sync"
 	"time"
 
+	"code.cloudfoundry.org/clock"
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
@@ -16,6 +17,7 @@ type Scheduler struct {
 	InputMapper  inputmapper.InputMapper
 	BuildStarter BuildStarter
 	Scanner      Scanner
+	Clock        clock.Clock
 }
 
 //go:generate counterfeiter . Scanner
@@ -36,6 +38,9 @@ func (s *Scheduler) Schedule(
 	for _, job := range jobs {
 		jStart := time.Now()
 		err := s.ensurePendingBuildExists(logger, versions, job, resources)
+		if err == nil {
+			err = s.ensureScheduledBuildExists(logger, job)
+		}
 		jobSchedulingTime[job.Name()] = time.Since(jStart)
 
 		if err != nil {
@@ -96,6 +101,39 @@ func (s *Scheduler) ensurePendingBuildExists(
 	return nil
 }
 
+// ensureScheduledBuildExists creates a build for a job with a schedule once
+// it fires. If it fired more than once since the job was last scheduled, e.g.
+// while the job was paused, only one build is created, for the latest time it
+// fired.
+func (s *Scheduler) ensureScheduledBuildExists(logger lager.Logger, job db.Job) error {
+	schedule := job.Config().Schedule
+	if schedule == nil || job.Paused() || job.LastScheduled().IsZero() {
+		return nil
+	}
+
+	logger = logger.Session("schedule", lager.Data{"job": job.Name()})
+
+	latest, err := schedule.Latest(job.LastScheduled(), s.Clock.Now())
+	if err != nil {
+		logger.Error("failed-to-evaluate-schedule", err)
+		return nil
+	}
+
+	if latest.IsZero() {
+		return nil
+	}
+
+	build, err := job.CreateScheduledBuild(latest)
+	if err != nil {
+		logger.Error("failed-to-create-scheduled-build", err)
+		return err
+	}
+
+	logger.Info("created-scheduled-build", lager.Data{"build": build.Name(), "scheduled-for": latest})
+
+	return nil
+}
+
 type Waiter interface {
 	Wait()
 }
@@ -108,11 +146,42 @@ func (s *Scheduler) TriggerImmediately(
 ) (db.Build, Waiter, error) {
 	logger = logger.Session("trigger-immediately", lager.Data{"job_name": job.Name()})
 
-	build, err := job.CreateBuild()
+	build, err := job.CreateBuild(db.BuildCauseManual)
 	if err != nil {
 		logger.Error("failed-to-create-job-build", err)
 		return nil, nil, err
 	}
+
+	return build, s.startPendingBuilds(logger, job, resources, resourceTypes), nil
+}
+
+func (s *Scheduler) RerunImmediately(
+	logger lager.Logger,
+	job db.Job,
+	buildToRerun db.Build,
+	resources db.Resources,
+	resourceTypes atc.VersionedResourceTypes,
+) (db.Build, Waiter, error) {
+	logger = logger.Session("rerun-immediately", lager.Data{
+		"job_name":   job.Name(),
+		"build_name": buildToRerun.Name(),
+	})
+
+	build, err := job.RerunBuild(buildToRerun)
+	if err != nil {
+		logger.Error("failed-to-create-rerun-build", err)
+		return nil, nil, err
+	}
+
+	return build, s.startPendingBuilds(logger, job, resources, resourceTypes), nil
+}
+
+func (s *Scheduler) startPendingBuilds(
+	logger lager.Logger,
+	job db.Job,
+	resources db.Resources,
+	resourceTypes atc.VersionedResourceTypes,
+) Waiter {
 	wg := new(sync.WaitGroup)
 	wg.Add(1)
 
@@ -132,7 +201,7 @@ func (s *Scheduler) TriggerImmediately(
 		}
 	}()
 
-	return build, wg, nil
+	return wg
 }
 
 func (s *Scheduler) SaveNextInputMapping(logger lager.Logger, job db.Job, resources db.Resources) error {
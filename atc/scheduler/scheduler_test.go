@@ -2,7 +2,9 @@ package scheduler_test
 
 import (
 	"errors"
+	"time"
 
+	"code.cloudfoundry.org/clock/fakeclock"
 	"code.cloudfoundry.org/lager/lagertest"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
@@ -21,6 +23,7 @@ var _ = Describe("Scheduler", func() {
 		fakeInputMapper  *inputmapperfakes.FakeInputMapper
 		fakeBuildStarter *schedulerfakes.FakeBuildStarter
 		fakeScanner      *schedulerfakes.FakeScanner
+		fakeClock        *fakeclock.FakeClock
 
 		scheduler *Scheduler
 
@@ -32,12 +35,14 @@ var _ = Describe("Scheduler", func() {
 		fakeInputMapper = new(inputmapperfakes.FakeInputMapper)
 		fakeBuildStarter = new(schedulerfakes.FakeBuildStarter)
 		fakeScanner = new(schedulerfakes.FakeScanner)
+		fakeClock = fakeclock.NewFakeClock(time.Date(2018, time.October, 17, 9, 0, 30, 0, time.UTC))
 
 		scheduler = &Scheduler{
 			Pipeline:     fakePipeline,
 			InputMapper:  fakeInputMapper,
 			BuildStarter: fakeBuildStarter,
 			Scanner:      fakeScanner,
+			Clock:        fakeClock,
 		}
 
 		disaster = errors.New("bad thing")
@@ -251,6 +256,102 @@ var _ = Describe("Scheduler", func() {
 				})
 			})
 		})
+
+		Context("when the job has a schedule", func() {
+			BeforeEach(func() {
+				fakeJob = new(dbfakes.FakeJob)
+				fakeJob.NameReturns("some-job")
+				fakeJob.ConfigReturns(atc.JobConfig{
+					Schedule: &atc.ScheduleConfig{Cron: "0 9 * * *"},
+				})
+				fakeJob.CreateScheduledBuildReturns(new(dbfakes.FakeBuild), nil)
+
+				fakeJobs = []db.Job{fakeJob}
+
+				fakeInputMapper.SaveNextInputMappingReturns(algorithm.InputMapping{}, nil)
+				fakeBuildStarter.TryStartPendingBuildsForJobReturns(nil)
+			})
+
+			Context("when the schedule has fired since the job was last scheduled", func() {
+				BeforeEach(func() {
+					fakeJob.LastScheduledReturns(time.Date(2018, time.October, 16, 9, 0, 5, 0, time.UTC))
+				})
+
+				It("creates a build for the time the schedule fired", func() {
+					Expect(fakeJob.CreateScheduledBuildCallCount()).To(Equal(1))
+					Expect(fakeJob.CreateScheduledBuildArgsForCall(0)).To(Equal(time.Date(2018, time.October, 17, 9, 0, 0, 0, time.UTC)))
+				})
+
+				It("starts all pending builds and returns no error", func() {
+					Expect(fakeBuildStarter.TryStartPendingBuildsForJobCallCount()).To(Equal(1))
+					Expect(scheduleErr).NotTo(HaveOccurred())
+				})
+
+				Context("when the job is paused", func() {
+					BeforeEach(func() {
+						fakeJob.PausedReturns(true)
+					})
+
+					It("does not create a build", func() {
+						Expect(fakeJob.CreateScheduledBuildCallCount()).To(BeZero())
+					})
+				})
+
+				Context("when creating the build fails", func() {
+					BeforeEach(func() {
+						fakeJob.CreateScheduledBuildReturns(nil, disaster)
+					})
+
+					It("returns the error", func() {
+						Expect(scheduleErr).To(Equal(disaster))
+					})
+				})
+			})
+
+			Context("when the schedule has fired several times since the job was last scheduled", func() {
+				BeforeEach(func() {
+					fakeJob.LastScheduledReturns(time.Date(2018, time.October, 13, 9, 0, 5, 0, time.UTC))
+				})
+
+				It("creates one build, for the latest time the schedule fired", func() {
+					Expect(fakeJob.CreateScheduledBuildCallCount()).To(Equal(1))
+					Expect(fakeJob.CreateScheduledBuildArgsForCall(0)).To(Equal(time.Date(2018, time.October, 17, 9, 0, 0, 0, time.UTC)))
+				})
+
+				It("does not create another build when it runs again", func() {
+					fakeJob.LastScheduledReturns(fakeJob.CreateScheduledBuildArgsForCall(0))
+
+					_, err := scheduler.Schedule(
+						lagertest.NewTestLogger("test"),
+						versionsDB,
+						fakeJobs,
+						db.Resources{fakeResource},
+						versionedResourceTypes,
+					)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakeJob.CreateScheduledBuildCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("when the schedule has not fired since the job was last scheduled", func() {
+				BeforeEach(func() {
+					fakeJob.LastScheduledReturns(time.Date(2018, time.October, 17, 9, 0, 5, 0, time.UTC))
+				})
+
+				It("does not create a build", func() {
+					Expect(fakeJob.CreateScheduledBuildCallCount()).To(BeZero())
+					Expect(scheduleErr).NotTo(HaveOccurred())
+				})
+			})
+
+			Context("when the schedule's clock has not started", func() {
+				It("does not create a build", func() {
+					Expect(fakeJob.CreateScheduledBuildCallCount()).To(BeZero())
+					Expect(scheduleErr).NotTo(HaveOccurred())
+				})
+			})
+		})
 	})
 
 	Describe("TriggerImmediately", func() {
@@ -303,12 +404,13 @@ var _ = Describe("Scheduler", func() {
 
 			BeforeEach(func() {
 				createdBuild = new(dbfakes.FakeBuild)
-				createdBuild.IsManuallyTriggeredReturns(true)
+				createdBuild.CauseReturns(db.BuildCauseManual)
 				fakeJob.CreateBuildReturns(createdBuild, nil)
 			})
 
-			It("tried to create a build for the right job", func() {
+			It("tried to create a manual build for the right job", func() {
 				Expect(fakeJob.CreateBuildCallCount()).To(Equal(1))
+				Expect(fakeJob.CreateBuildArgsForCall(0)).To(Equal(db.BuildCauseManual))
 			})
 
 			Context("when get pending builds for job fails", func() {
@@ -346,6 +448,78 @@ var _ = Describe("Scheduler", func() {
 		})
 	})
 
+	Describe("RerunImmediately", func() {
+		var (
+			fakeJob           *dbfakes.FakeJob
+			buildToRerun      *dbfakes.FakeBuild
+			rerunBuild        db.Build
+			rerunErr          error
+			nextPendingBuilds []db.Build
+		)
+
+		BeforeEach(func() {
+			fakeJob = new(dbfakes.FakeJob)
+			fakeJob.NameReturns("some-job")
+
+			buildToRerun = new(dbfakes.FakeBuild)
+			buildToRerun.NameReturns("42")
+		})
+
+		JustBeforeEach(func() {
+			var waiter Waiter
+			rerunBuild, waiter, rerunErr = scheduler.RerunImmediately(
+				lagertest.NewTestLogger("test"),
+				fakeJob,
+				buildToRerun,
+				db.Resources{},
+				atc.VersionedResourceTypes{},
+			)
+			if waiter != nil {
+				waiter.Wait()
+			}
+		})
+
+		Context("when creating the rerun build fails", func() {
+			BeforeEach(func() {
+				fakeJob.RerunBuildReturns(nil, disaster)
+			})
+
+			It("returns the error", func() {
+				Expect(rerunErr).To(Equal(disaster))
+			})
+
+			It("does not try to start pending builds for job", func() {
+				Expect(fakeBuildStarter.TryStartPendingBuildsForJobCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when creating the rerun build succeeds", func() {
+			var createdBuild *dbfakes.FakeBuild
+
+			BeforeEach(func() {
+				createdBuild = new(dbfakes.FakeBuild)
+				createdBuild.RerunOfReturns(42)
+				fakeJob.RerunBuildReturns(createdBuild, nil)
+
+				nextPendingBuilds = []db.Build{createdBuild}
+				fakeJob.GetPendingBuildsReturns(nextPendingBuilds, nil)
+			})
+
+			It("reruns the given build", func() {
+				Expect(rerunErr).NotTo(HaveOccurred())
+				Expect(rerunBuild).To(Equal(createdBuild))
+				Expect(fakeJob.RerunBuildCallCount()).To(Equal(1))
+				Expect(fakeJob.RerunBuildArgsForCall(0)).To(Equal(buildToRerun))
+			})
+
+			It("tries to start the pending builds of the job", func() {
+				Expect(fakeBuildStarter.TryStartPendingBuildsForJobCallCount()).To(Equal(1))
+				_, _, _, _, b := fakeBuildStarter.TryStartPendingBuildsForJobArgsForCall(0)
+				Expect(b).To(Equal(nextPendingBuilds))
+			})
+		})
+	})
+
 	Describe("SaveNextInputMapping", func() {
 		var saveErr error
 		var fakeJob *dbfakes.FakeJob
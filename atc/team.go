@@ -1,5 +1,7 @@
 package atc
 
+import "errors"
+
 type Team struct {
 	ID   int      `json:"id,omitempty"`
 	Name string   `json:"name,omitempty"`
@@ -7,3 +9,43 @@ type Team struct {
 }
 
 type TeamAuth map[string]map[string][]string
+
+// CredentialManagerConfig configures a team's own credential manager, used in
+// place of the one configured for the whole cluster. The config's keys are the
+// manager's flags without their namespace, e.g. "url" for --vault-url.
+type CredentialManagerConfig struct {
+	Type   string                 `json:"type"`
+	Config map[string]interface{} `json:"config,omitempty"`
+}
+
+func (config *CredentialManagerConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var raw struct {
+		Type   string      `yaml:"type"`
+		Config interface{} `yaml:"config"`
+	}
+
+	err := unmarshal(&raw)
+	if err != nil {
+		return err
+	}
+
+	config.Type = raw.Type
+
+	if raw.Config == nil {
+		return nil
+	}
+
+	sanitized, err := sanitize(raw.Config)
+	if err != nil {
+		return err
+	}
+
+	managerConfig, ok := sanitized.(map[string]interface{})
+	if !ok {
+		return errors.New("credential manager config must be a map")
+	}
+
+	config.Config = managerConfig
+
+	return nil
+}
@@ -133,6 +133,18 @@ func stripIDs(plan atc.Plan) (atc.Plan, []string) {
 		ids = append(ids, subIDs...)
 	}
 
+	if plan.Across != nil {
+		across := *plan.Across
+		across.Iterations = make([]atc.AcrossIteration, len(plan.Across.Iterations))
+		for i, iteration := range plan.Across.Iterations {
+			iteration.Step, subIDs = stripIDs(iteration.Step)
+			ids = append(ids, subIDs...)
+			across.Iterations[i] = iteration
+		}
+
+		plan.Across = &across
+	}
+
 	if plan.Get != nil {
 		if plan.Get.VersionFrom != nil {
 			planID := atc.PlanID("<stripped>")
@@ -0,0 +1,110 @@
+package tracing
+
+import (
+	"time"
+)
+
+const (
+	batchSize     = 512
+	batchInterval = 5 * time.Second
+)
+
+// batcher exports ended spans in the background, so that builds and checks
+// never wait on the tracing backend. Spans are dropped if it falls behind.
+type batcher struct {
+	exporter Exporter
+
+	spans   chan SpanData
+	flushes chan chan struct{}
+	stopped chan struct{}
+	done    chan struct{}
+}
+
+func newBatcher(exporter Exporter) *batcher {
+	b := &batcher{
+		exporter: exporter,
+
+		spans:   make(chan SpanData, 4*batchSize),
+		flushes: make(chan chan struct{}),
+		stopped: make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+
+	go b.loop()
+
+	return b
+}
+
+func (b *batcher) add(data SpanData) {
+	select {
+	case b.spans <- data:
+	default:
+	}
+}
+
+func (b *batcher) flush() {
+	done := make(chan struct{})
+
+	select {
+	case b.flushes <- done:
+		<-done
+	case <-b.stopped:
+	}
+}
+
+// stop exports the spans which have been added and waits for it to finish.
+func (b *batcher) stop() {
+	close(b.stopped)
+	<-b.done
+}
+
+func (b *batcher) loop() {
+	defer close(b.done)
+
+	ticker := time.NewTicker(batchInterval)
+	defer ticker.Stop()
+
+	batch := []SpanData{}
+
+	export := func() {
+	drain:
+		for {
+			select {
+			case data := <-b.spans:
+				batch = append(batch, data)
+			default:
+				break drain
+			}
+		}
+
+		if len(batch) == 0 {
+			return
+		}
+
+		// errors are dropped along with the batch; tracing is best-effort
+		_ = b.exporter.ExportSpans(batch)
+
+		batch = []SpanData{}
+	}
+
+	for {
+		select {
+		case data := <-b.spans:
+			batch = append(batch, data)
+			if len(batch) >= batchSize {
+				export()
+			}
+
+		case <-ticker.C:
+			export()
+
+		case done := <-b.flushes:
+			export()
+			close(done)
+
+		case <-b.stopped:
+			export()
+			return
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package tracing
+
+import (
+	"errors"
+)
+
+// Config holds the flags for exporting traces of builds and checks.
+type Config struct {
+	ServiceName   string            `long:"service-name"   default:"concourse" description:"Service name to attach to exported spans."`
+	OTLPAddress   string            `long:"otlp-address"   description:"OTLP/HTTP endpoint to export spans to, e.g. an OpenTelemetry collector or Jaeger's OTLP receiver (http://jaeger:4318)."`
+	OTLPHeaders   map[string]string `long:"otlp-header"    description:"Header to send with every export request, e.g. for authentication. Can be specified multiple times." value-name:"NAME:VALUE"`
+	SamplingRatio float64           `long:"sampling-ratio" default:"1"         description:"Ratio (0 to 1) of new traces to record."`
+}
+
+// Prepare configures tracing if an exporter has been specified.
+func (c Config) Prepare() error {
+	if c.OTLPAddress == "" {
+		return nil
+	}
+
+	if c.SamplingRatio < 0 || c.SamplingRatio > 1 {
+		return errors.New("tracing sampling ratio must be between 0 and 1")
+	}
+
+	Configure(NewOTLPExporter(c.OTLPAddress, c.OTLPHeaders, c.ServiceName), c.SamplingRatio)
+
+	return nil
+}
@@ -0,0 +1,182 @@
+package tracing
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// NewOTLPExporter returns an Exporter which sends spans to an OTLP/HTTP
+// endpoint using the JSON encoding, e.g. an OpenTelemetry collector or
+// Jaeger's OTLP receiver.
+func NewOTLPExporter(address string, headers map[string]string, serviceName string) Exporter {
+	return &otlpExporter{
+		url:         strings.TrimSuffix(address, "/") + "/v1/traces",
+		headers:     headers,
+		serviceName: serviceName,
+		client: &http.Client{
+			Timeout: 10 * time.Second,
+		},
+	}
+}
+
+type otlpExporter struct {
+	url         string
+	headers     map[string]string
+	serviceName string
+	client      *http.Client
+}
+
+type otlpRequest struct {
+	ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+}
+
+type otlpResourceSpans struct {
+	Resource   otlpResource     `json:"resource"`
+	ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpResource struct {
+	Attributes []otlpAttribute `json:"attributes"`
+}
+
+type otlpScopeSpans struct {
+	Scope otlpScope  `json:"scope"`
+	Spans []otlpSpan `json:"spans"`
+}
+
+type otlpScope struct {
+	Name string `json:"name"`
+}
+
+type otlpSpan struct {
+	TraceID           string          `json:"traceId"`
+	SpanID            string          `json:"spanId"`
+	ParentSpanID      string          `json:"parentSpanId,omitempty"`
+	Name              string          `json:"name"`
+	Kind              int             `json:"kind"`
+	StartTimeUnixNano string          `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string          `json:"endTimeUnixNano"`
+	Attributes        []otlpAttribute `json:"attributes,omitempty"`
+	Status            otlpStatus      `json:"status"`
+}
+
+type otlpAttribute struct {
+	Key   string    `json:"key"`
+	Value otlpValue `json:"value"`
+}
+
+type otlpValue struct {
+	StringValue string `json:"stringValue"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code"`
+	Message string `json:"message,omitempty"`
+}
+
+const (
+	otlpSpanKindInternal = 1
+
+	otlpStatusCodeOK    = 1
+	otlpStatusCodeError = 2
+)
+
+func (exporter *otlpExporter) ExportSpans(spans []SpanData) error {
+	otlpSpans := make([]otlpSpan, len(spans))
+	for i, data := range spans {
+		otlpSpans[i] = otlpSpan{
+			TraceID:           data.TraceID.String(),
+			SpanID:            data.SpanID.String(),
+			Name:              data.Name,
+			Kind:              otlpSpanKindInternal,
+			StartTimeUnixNano: strconv.FormatInt(data.StartTime.UnixNano(), 10),
+			EndTimeUnixNano:   strconv.FormatInt(data.EndTime.UnixNano(), 10),
+			Attributes:        otlpAttributes(data.Attributes),
+			Status: otlpStatus{
+				Code: otlpStatusCodeOK,
+			},
+		}
+
+		if data.ParentSpanID != (SpanID{}) {
+			otlpSpans[i].ParentSpanID = data.ParentSpanID.String()
+		}
+
+		if data.Error != "" {
+			otlpSpans[i].Status = otlpStatus{
+				Code:    otlpStatusCodeError,
+				Message: data.Error,
+			}
+		}
+	}
+
+	payload, err := json.Marshal(otlpRequest{
+		ResourceSpans: []otlpResourceSpans{
+			{
+				Resource: otlpResource{
+					Attributes: otlpAttributes(Attrs{"service.name": exporter.serviceName}),
+				},
+				ScopeSpans: []otlpScopeSpans{
+					{
+						Scope: otlpScope{Name: "concourse"},
+						Spans: otlpSpans,
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("POST", exporter.url, bytes.NewBuffer(payload))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range exporter.headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := exporter.client.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("exporting spans failed: %s", resp.Status)
+	}
+
+	return nil
+}
+
+func otlpAttributes(attrs Attrs) []otlpAttribute {
+	keys := make([]string, 0, len(attrs))
+	for k := range attrs {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	otlpAttrs := make([]otlpAttribute, len(keys))
+	for i, k := range keys {
+		otlpAttrs[i] = otlpAttribute{
+			Key:   k,
+			Value: otlpValue{StringValue: attrs[k]},
+		}
+	}
+
+	return otlpAttrs
+}
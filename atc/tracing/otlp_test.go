@@ -0,0 +1,89 @@
+package tracing_test
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/concourse/concourse/atc/tracing"
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("OTLPExporter", func() {
+	var (
+		server   *ghttp.Server
+		exporter tracing.Exporter
+
+		span tracing.SpanData
+	)
+
+	BeforeEach(func() {
+		server = ghttp.NewServer()
+		exporter = tracing.NewOTLPExporter(server.URL()+"/", map[string]string{"Authorization": "Bearer some-token"}, "some-service")
+
+		sc, ok := tracing.ParseTraceParent("00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01")
+		Expect(ok).To(BeTrue())
+
+		span = tracing.SpanData{
+			SpanContext: sc,
+			Name:        "some-span",
+			StartTime:   time.Unix(1, 0),
+			EndTime:     time.Unix(2, 0),
+			Attributes:  tracing.Attrs{"b": "2", "a": "1"},
+			Error:       "disaster",
+		}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("posts the spans as OTLP JSON", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("POST", "/v1/traces"),
+				ghttp.VerifyHeaderKV("Content-Type", "application/json"),
+				ghttp.VerifyHeaderKV("Authorization", "Bearer some-token"),
+				ghttp.VerifyJSON(`{
+					"resourceSpans": [{
+						"resource": {
+							"attributes": [{"key": "service.name", "value": {"stringValue": "some-service"}}]
+						},
+						"scopeSpans": [{
+							"scope": {"name": "concourse"},
+							"spans": [{
+								"traceId": "0af7651916cd43dd8448eb211c80319c",
+								"spanId": "b7ad6b7169203331",
+								"name": "some-span",
+								"kind": 1,
+								"startTimeUnixNano": "1000000000",
+								"endTimeUnixNano": "2000000000",
+								"attributes": [
+									{"key": "a", "value": {"stringValue": "1"}},
+									{"key": "b", "value": {"stringValue": "2"}}
+								],
+								"status": {"code": 2, "message": "disaster"}
+							}]
+						}]
+					}]
+				}`),
+				ghttp.RespondWith(http.StatusOK, "{}"),
+			),
+		)
+
+		Expect(exporter.ExportSpans([]tracing.SpanData{span})).To(Succeed())
+		Expect(server.ReceivedRequests()).To(HaveLen(1))
+	})
+
+	Context("when the endpoint rejects the spans", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(ghttp.RespondWith(http.StatusBadRequest, ""))
+		})
+
+		It("returns an error", func() {
+			Expect(exporter.ExportSpans([]tracing.SpanData{span})).To(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,320 @@
+package tracing
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Attrs are the attributes attached to a span, e.g. the name of the pipeline
+// and job a build belongs to.
+type Attrs map[string]string
+
+type TraceID [16]byte
+
+func (id TraceID) String() string { return hex.EncodeToString(id[:]) }
+
+type SpanID [8]byte
+
+func (id SpanID) String() string { return hex.EncodeToString(id[:]) }
+
+// SpanContext identifies a span within a trace, and is what gets propagated
+// to child spans and, via TRACEPARENT, to processes running in containers.
+type SpanContext struct {
+	TraceID TraceID
+	SpanID  SpanID
+	Sampled bool
+}
+
+func (sc SpanContext) IsValid() bool {
+	return sc.TraceID != TraceID{} && sc.SpanID != SpanID{}
+}
+
+// TraceParent formats the span context as a W3C Trace Context traceparent
+// header value.
+func (sc SpanContext) TraceParent() string {
+	flags := "00"
+	if sc.Sampled {
+		flags = "01"
+	}
+
+	return fmt.Sprintf("00-%s-%s-%s", sc.TraceID, sc.SpanID, flags)
+}
+
+// ParseTraceParent parses a W3C Trace Context traceparent header value.
+func ParseTraceParent(traceParent string) (SpanContext, bool) {
+	parts := strings.Split(traceParent, "-")
+	if len(parts) != 4 || parts[0] != "00" {
+		return SpanContext{}, false
+	}
+
+	var sc SpanContext
+
+	traceID, err := hex.DecodeString(parts[1])
+	if err != nil || len(traceID) != len(sc.TraceID) {
+		return SpanContext{}, false
+	}
+
+	spanID, err := hex.DecodeString(parts[2])
+	if err != nil || len(spanID) != len(sc.SpanID) {
+		return SpanContext{}, false
+	}
+
+	flags, err := hex.DecodeString(parts[3])
+	if err != nil || len(flags) != 1 {
+		return SpanContext{}, false
+	}
+
+	copy(sc.TraceID[:], traceID)
+	copy(sc.SpanID[:], spanID)
+	sc.Sampled = flags[0]&1 == 1
+
+	if !sc.IsValid() {
+		return SpanContext{}, false
+	}
+
+	return sc, true
+}
+
+// Span is a timed operation within a trace.
+type Span interface {
+	Context() SpanContext
+	SetAttribute(key string, value string)
+	SetError(err error)
+	End()
+}
+
+// SpanData is a finished span, as handed to the Exporter.
+type SpanData struct {
+	SpanContext
+	ParentSpanID SpanID
+
+	Name       string
+	StartTime  time.Time
+	EndTime    time.Time
+	Attributes Attrs
+	Error      string
+}
+
+//go:generate counterfeiter . Exporter
+
+// Exporter sends finished spans to a tracing backend.
+type Exporter interface {
+	ExportSpans([]SpanData) error
+}
+
+type spanContextKey struct{}
+
+// SpanContextFromContext returns the span context of the span most recently
+// started in ctx, if any.
+func SpanContextFromContext(ctx context.Context) (SpanContext, bool) {
+	sc, ok := ctx.Value(spanContextKey{}).(SpanContext)
+	return sc, ok
+}
+
+// ContextWithSpanContext returns a context whose spans are children of the
+// given span context, e.g. one parsed from a traceparent.
+func ContextWithSpanContext(ctx context.Context, sc SpanContext) context.Context {
+	return context.WithValue(ctx, spanContextKey{}, sc)
+}
+
+var configured struct {
+	sync.RWMutex
+
+	batcher       *batcher
+	samplingRatio float64
+}
+
+// Configure starts exporting spans to exporter, recording samplingRatio (0 to
+// 1) of new traces. Traces continued from a propagated span context follow
+// the sampling decision of their parent.
+//
+// Passing a nil exporter disables tracing.
+func Configure(exporter Exporter, samplingRatio float64) {
+	configured.Lock()
+	defer configured.Unlock()
+
+	if configured.batcher != nil {
+		configured.batcher.stop()
+		configured.batcher = nil
+	}
+
+	if exporter != nil {
+		configured.batcher = newBatcher(exporter)
+	}
+
+	configured.samplingRatio = samplingRatio
+}
+
+// Configured returns whether spans are being exported.
+func Configured() bool {
+	configured.RLock()
+	defer configured.RUnlock()
+
+	return configured.batcher != nil
+}
+
+// Flush exports all spans that have ended so far.
+func Flush() {
+	configured.RLock()
+	b := configured.batcher
+	configured.RUnlock()
+
+	if b != nil {
+		b.flush()
+	}
+}
+
+// Shutdown stops exporting spans, once the spans that have ended so far have
+// been exported.
+func Shutdown() {
+	Configure(nil, 0)
+}
+
+// StartSpan starts a span as a child of the span in ctx, or as the root of a
+// new trace if there is none. The returned context carries the new span. When
+// tracing is not configured the span does nothing.
+func StartSpan(ctx context.Context, name string, attrs Attrs) (context.Context, Span) {
+	configured.RLock()
+	b := configured.batcher
+	samplingRatio := configured.samplingRatio
+	configured.RUnlock()
+
+	if b == nil {
+		return ctx, noopSpan{}
+	}
+
+	parent, hasParent := SpanContextFromContext(ctx)
+
+	sc := SpanContext{
+		SpanID: newSpanID(),
+	}
+
+	if hasParent {
+		sc.TraceID = parent.TraceID
+		sc.Sampled = parent.Sampled
+	} else {
+		sc.TraceID = newTraceID()
+		sc.Sampled = sampled(sc.TraceID, samplingRatio)
+	}
+
+	data := SpanData{
+		SpanContext: sc,
+		Name:        name,
+		StartTime:   time.Now(),
+		Attributes:  Attrs{},
+	}
+
+	if hasParent {
+		data.ParentSpanID = parent.SpanID
+	}
+
+	for k, v := range attrs {
+		data.Attributes[k] = v
+	}
+
+	return ContextWithSpanContext(ctx, sc), &span{
+		data:    data,
+		batcher: b,
+	}
+}
+
+// End records err on the span, if any, and ends it.
+func End(span Span, err error) {
+	if err != nil {
+		span.SetError(err)
+	}
+
+	span.End()
+}
+
+// Env returns the environment variables propagating the trace in ctx into a
+// container, following the OpenTelemetry convention of a TRACEPARENT
+// variable.
+func Env(ctx context.Context) []string {
+	sc, ok := SpanContextFromContext(ctx)
+	if !ok || !sc.IsValid() {
+		return nil
+	}
+
+	return []string{"TRACEPARENT=" + sc.TraceParent()}
+}
+
+type span struct {
+	lock  sync.Mutex
+	data  SpanData
+	ended bool
+
+	batcher *batcher
+}
+
+func (s *span) Context() SpanContext {
+	return s.data.SpanContext
+}
+
+func (s *span) SetAttribute(key string, value string) {
+	s.lock.Lock()
+	s.data.Attributes[key] = value
+	s.lock.Unlock()
+}
+
+func (s *span) SetError(err error) {
+	s.lock.Lock()
+	s.data.Error = err.Error()
+	s.lock.Unlock()
+}
+
+func (s *span) End() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.ended {
+		return
+	}
+
+	s.ended = true
+
+	if !s.data.Sampled {
+		return
+	}
+
+	s.data.EndTime = time.Now()
+	s.batcher.add(s.data)
+}
+
+type noopSpan struct{}
+
+func (noopSpan) Context() SpanContext        { return SpanContext{} }
+func (noopSpan) SetAttribute(string, string) {}
+func (noopSpan) SetError(error)              {}
+func (noopSpan) End()                        {}
+
+func sampled(traceID TraceID, ratio float64) bool {
+	if ratio >= 1 {
+		return true
+	}
+
+	if ratio <= 0 {
+		return false
+	}
+
+	return binary.BigEndian.Uint64(traceID[8:]) < uint64(ratio*math.MaxUint64)
+}
+
+func newTraceID() TraceID {
+	var id TraceID
+	_, _ = rand.Read(id[:])
+	return id
+}
+
+func newSpanID() SpanID {
+	var id SpanID
+	_, _ = rand.Read(id[:])
+	return id
+}
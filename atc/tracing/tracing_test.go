@@ -0,0 +1,164 @@
+package tracing_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/concourse/concourse/atc/tracing"
+	"github.com/concourse/concourse/atc/tracing/tracingfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Tracing", func() {
+	var fakeExporter *tracingfakes.FakeExporter
+
+	exportedSpans := func() []tracing.SpanData {
+		tracing.Flush()
+
+		spans := []tracing.SpanData{}
+		for i := 0; i < fakeExporter.ExportSpansCallCount(); i++ {
+			spans = append(spans, fakeExporter.ExportSpansArgsForCall(i)...)
+		}
+
+		return spans
+	}
+
+	BeforeEach(func() {
+		fakeExporter = new(tracingfakes.FakeExporter)
+	})
+
+	AfterEach(func() {
+		tracing.Configure(nil, 0)
+	})
+
+	Context("when tracing is not configured", func() {
+		It("does not propagate anything", func() {
+			ctx, span := tracing.StartSpan(context.Background(), "some-span", nil)
+			span.End()
+
+			Expect(tracing.Configured()).To(BeFalse())
+			Expect(tracing.Env(ctx)).To(BeEmpty())
+		})
+	})
+
+	Context("when tracing is configured", func() {
+		BeforeEach(func() {
+			tracing.Configure(fakeExporter, 1)
+		})
+
+		It("exports ended spans", func() {
+			_, span := tracing.StartSpan(context.Background(), "some-span", tracing.Attrs{
+				"some": "attr",
+			})
+
+			span.SetAttribute("other", "attr")
+			tracing.End(span, errors.New("disaster"))
+
+			spans := exportedSpans()
+			Expect(spans).To(HaveLen(1))
+			Expect(spans[0].Name).To(Equal("some-span"))
+			Expect(spans[0].Attributes).To(Equal(tracing.Attrs{
+				"some":  "attr",
+				"other": "attr",
+			}))
+			Expect(spans[0].Error).To(Equal("disaster"))
+			Expect(spans[0].EndTime).ToNot(BeTemporally("<", spans[0].StartTime))
+		})
+
+		It("exports the spans which have ended when it is shut down", func() {
+			_, span := tracing.StartSpan(context.Background(), "some-span", nil)
+			span.End()
+
+			tracing.Shutdown()
+
+			Expect(tracing.Configured()).To(BeFalse())
+			Expect(fakeExporter.ExportSpansCallCount()).To(Equal(1))
+		})
+
+		It("nests spans started in the context of another", func() {
+			ctx, parent := tracing.StartSpan(context.Background(), "parent", nil)
+			_, child := tracing.StartSpan(ctx, "child", nil)
+
+			child.End()
+			parent.End()
+
+			spans := exportedSpans()
+			Expect(spans).To(HaveLen(2))
+			Expect(spans[0].TraceID).To(Equal(spans[1].TraceID))
+			Expect(spans[0].ParentSpanID).To(Equal(spans[1].SpanID))
+			Expect(spans[1].ParentSpanID).To(Equal(tracing.SpanID{}))
+		})
+
+		It("propagates the span context as TRACEPARENT", func() {
+			ctx, span := tracing.StartSpan(context.Background(), "some-span", nil)
+
+			Expect(tracing.Env(ctx)).To(Equal([]string{
+				"TRACEPARENT=" + span.Context().TraceParent(),
+			}))
+		})
+
+		It("continues traces from a propagated span context", func() {
+			parent, ok := tracing.ParseTraceParent("00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01")
+			Expect(ok).To(BeTrue())
+
+			_, span := tracing.StartSpan(tracing.ContextWithSpanContext(context.Background(), parent), "some-span", nil)
+			span.End()
+
+			spans := exportedSpans()
+			Expect(spans).To(HaveLen(1))
+			Expect(spans[0].TraceID.String()).To(Equal("0af7651916cd43dd8448eb211c80319c"))
+			Expect(spans[0].ParentSpanID.String()).To(Equal("b7ad6b7169203331"))
+		})
+
+		Context("when no traces are sampled", func() {
+			BeforeEach(func() {
+				tracing.Configure(fakeExporter, 0)
+			})
+
+			It("does not export spans", func() {
+				ctx, span := tracing.StartSpan(context.Background(), "some-span", nil)
+				span.End()
+
+				Expect(exportedSpans()).To(BeEmpty())
+				Expect(tracing.Env(ctx)).To(Equal([]string{
+					"TRACEPARENT=" + span.Context().TraceParent(),
+				}))
+				Expect(span.Context().TraceParent()).To(HaveSuffix("-00"))
+			})
+
+			It("follows the sampling decision of a propagated parent", func() {
+				parent, ok := tracing.ParseTraceParent("00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01")
+				Expect(ok).To(BeTrue())
+
+				_, span := tracing.StartSpan(tracing.ContextWithSpanContext(context.Background(), parent), "some-span", nil)
+				span.End()
+
+				Expect(exportedSpans()).To(HaveLen(1))
+			})
+		})
+	})
+
+	Describe("ParseTraceParent", func() {
+		It("round-trips", func() {
+			sc, ok := tracing.ParseTraceParent("00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01")
+			Expect(ok).To(BeTrue())
+			Expect(sc.Sampled).To(BeTrue())
+			Expect(sc.TraceParent()).To(Equal("00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01"))
+		})
+
+		It("rejects malformed values", func() {
+			for _, value := range []string{
+				"",
+				"01-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01",
+				"00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331",
+				"00-00000000000000000000000000000000-b7ad6b7169203331-01",
+				"00-0af7651916cd43dd-b7ad6b7169203331-01",
+			} {
+				_, ok := tracing.ParseTraceParent(value)
+				Expect(ok).To(BeFalse(), value)
+			}
+		})
+	})
+})
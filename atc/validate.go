@@ -3,6 +3,7 @@ package atc
 import (
 	"errors"
 	"fmt"
+	"regexp"
 	"sort"
 	"strings"
 	"time"
@@ -120,6 +121,8 @@ func validateResources(c Config) error {
 		if resource.Type == "" {
 			errorMessages = append(errorMessages, identifier+" has no type")
 		}
+
+		errorMessages = append(errorMessages, validateWebhook(identifier, resource)...)
 	}
 
 	errorMessages = append(errorMessages, validateResourcesUnused(c)...)
@@ -127,6 +130,43 @@ func validateResources(c Config) error {
 	return compositeErr(errorMessages)
 }
 
+func validateWebhook(identifier string, resource ResourceConfig) []string {
+	var errorMessages []string
+
+	if (resource.WebhookSignature != nil || len(resource.WebhookFilters) > 0) && resource.WebhookToken == "" {
+		errorMessages = append(errorMessages, identifier+" has webhook_signature or webhook_filters but no webhook_token")
+	}
+
+	if signature := resource.WebhookSignature; signature != nil {
+		switch signature.Type {
+		case WebhookSignatureGitHub, WebhookSignatureGitLab:
+		default:
+			errorMessages = append(errorMessages, fmt.Sprintf(
+				"%s.webhook_signature has unknown type '%s' (must be '%s' or '%s')",
+				identifier, signature.Type, WebhookSignatureGitHub, WebhookSignatureGitLab,
+			))
+		}
+
+		if signature.Secret == "" {
+			errorMessages = append(errorMessages, identifier+".webhook_signature has no secret")
+		}
+	}
+
+	for i, filter := range resource.WebhookFilters {
+		filterIdentifier := fmt.Sprintf("%s.webhook_filters[%d]", identifier, i)
+
+		if !strings.HasPrefix(filter.Path, "$") {
+			errorMessages = append(errorMessages, filterIdentifier+" has no path starting with '$'")
+		}
+
+		if len(filter.Values) == 0 {
+			errorMessages = append(errorMessages, filterIdentifier+" has no values")
+		}
+	}
+
+	return errorMessages
+}
+
 func validateResourceTypes(c Config) error {
 	errorMessages := []string{}
 
@@ -224,6 +264,15 @@ func validateJobs(c Config) ([]Warning, error) {
 			)
 		}
 
+		if job.Schedule != nil {
+			next, err := job.Schedule.Next(time.Now())
+			if err != nil {
+				errorMessages = append(errorMessages, identifier+" has an invalid schedule: "+err.Error())
+			} else if next.IsZero() {
+				errorMessages = append(errorMessages, identifier+fmt.Sprintf(" has a schedule that never fires: '%s'", job.Schedule.Cron))
+			}
+		}
+
 		planWarnings, planErrMessages := validatePlan(c, identifier+".plan", PlanConfig{Do: &job.Plan})
 		warnings = append(warnings, planWarnings...)
 		errorMessages = append(errorMessages, planErrMessages...)
@@ -319,6 +368,18 @@ func validatePlan(c Config, identifier string, plan PlanConfig) ([]Warning, []st
 		foundTypes.Find("task")
 	}
 
+	if plan.SetPipeline != "" {
+		foundTypes.Find("set_pipeline")
+	}
+
+	if plan.LoadVar != "" {
+		foundTypes.Find("load_var")
+	}
+
+	if plan.Run != "" {
+		foundTypes.Find("run")
+	}
+
 	if plan.Do != nil {
 		foundTypes.Find("do")
 	}
@@ -359,10 +420,20 @@ func validatePlan(c Config, identifier string, plan PlanConfig) ([]Warning, []st
 		identifier = fmt.Sprintf("%s.get.%s", identifier, plan.Get)
 
 		errorMessages = append(errorMessages, validateInapplicableFields(
-			[]string{"privileged", "config", "file"},
+			[]string{"privileged", "config", "file", "type", "inputs", "outputs", "vars", "var_files"},
 			plan, identifier)...,
 		)
 
+		if plan.Space != "" {
+			if len(plan.Passed) != 0 || plan.Trigger {
+				errorMessages = append(errorMessages, identifier+" cannot use `passed` or `trigger` with a `space`, as only the default space is scheduled")
+			}
+
+			if plan.Version != nil && plan.Version.Every {
+				errorMessages = append(errorMessages, identifier+" cannot use `version: every` with a `space`")
+			}
+		}
+
 		if plan.Resource != "" {
 			_, found := c.Resources.Lookup(plan.Resource)
 			if !found {
@@ -434,7 +505,7 @@ func validatePlan(c Config, identifier string, plan PlanConfig) ([]Warning, []st
 		identifier = fmt.Sprintf("%s.put.%s", identifier, plan.Put)
 
 		errorMessages = append(errorMessages, validateInapplicableFields(
-			[]string{"passed", "trigger", "privileged", "config", "file"},
+			[]string{"passed", "trigger", "privileged", "config", "file", "type", "inputs", "outputs", "vars", "var_files"},
 			plan, identifier)...,
 		)
 
@@ -488,10 +559,87 @@ func validatePlan(c Config, identifier string, plan PlanConfig) ([]Warning, []st
 					errorMessages = append(errorMessages, fmt.Sprintf("%s %s", identifier, strings.TrimSpace(message)))
 				}
 			}
+
+			outputs := map[string]bool{}
+			for _, output := range plan.TaskConfig.Outputs {
+				outputName := output.Name
+				if destinationName, ok := plan.OutputMapping[output.Name]; ok {
+					outputName = destinationName
+				}
+
+				outputs[outputName] = true
+			}
+
+			for _, artifact := range plan.Artifacts {
+				if !outputs[artifact] {
+					errorMessages = append(errorMessages, fmt.Sprintf("%s retains artifact '%s' which is not an output of the task", identifier, artifact))
+				}
+			}
+		}
+
+		errorMessages = append(errorMessages, validateInapplicableFields(
+			[]string{"resource", "passed", "trigger", "space", "type", "inputs", "outputs", "vars", "var_files"},
+			plan, identifier)...,
+		)
+
+	case plan.SetPipeline != "":
+		identifier = fmt.Sprintf("%s.set_pipeline.%s", identifier, plan.SetPipeline)
+
+		if plan.TaskConfigPath == "" {
+			errorMessages = append(errorMessages, identifier+" does not specify any pipeline configuration `file`")
+		}
+
+		errorMessages = append(errorMessages, validateInapplicableFields(
+			[]string{"resource", "passed", "trigger", "privileged", "config", "space", "type", "inputs", "outputs"},
+			plan, identifier)...,
+		)
+
+	case plan.LoadVar != "":
+		identifier = fmt.Sprintf("%s.load_var.%s", identifier, plan.LoadVar)
+
+		if plan.TaskConfigPath == "" {
+			errorMessages = append(errorMessages, identifier+" does not specify any var `file`")
+		}
+
+		switch plan.Format {
+		case "", "raw", "json", "yaml":
+		default:
+			errorMessages = append(errorMessages, identifier+fmt.Sprintf(" has an unknown format '%s' (must be raw, json or yaml)", plan.Format))
+		}
+
+		errorMessages = append(errorMessages, validateInapplicableFields(
+			[]string{"resource", "passed", "trigger", "privileged", "config", "space", "type", "inputs", "outputs", "vars", "var_files"},
+			plan, identifier)...,
+		)
+
+	case plan.Run != "":
+		identifier = fmt.Sprintf("%s.run.%s", identifier, plan.Run)
+
+		if !regexp.MustCompile(RunMessageRegex).MatchString(plan.Run) {
+			errorMessages = append(errorMessages, identifier+" has an invalid message name (must be lowercase letters, digits, '-' and '_', starting with a letter or digit)")
+		}
+
+		switch {
+		case plan.Type == "" && plan.Resource == "":
+			errorMessages = append(errorMessages, identifier+" does not specify a resource `type` or `resource`")
+		case plan.Type != "" && plan.Resource != "":
+			errorMessages = append(errorMessages, identifier+" specifies both a resource `type` and a `resource`")
+		case plan.Resource != "":
+			_, found := c.Resources.Lookup(plan.Resource)
+			if !found {
+				errorMessages = append(
+					errorMessages,
+					fmt.Sprintf(
+						"%s refers to a resource that does not exist ('%s')",
+						identifier,
+						plan.Resource,
+					),
+				)
+			}
 		}
 
 		errorMessages = append(errorMessages, validateInapplicableFields(
-			[]string{"resource", "passed", "trigger"},
+			[]string{"passed", "trigger", "privileged", "config", "file", "space", "vars", "var_files"},
 			plan, identifier)...,
 		)
 
@@ -543,6 +691,22 @@ func validatePlan(c Config, identifier string, plan PlanConfig) ([]Warning, []st
 		errorMessages = append(errorMessages, subIdentifier+fmt.Sprintf(" has an invalid number of attempts (%d)", plan.Attempts))
 	}
 
+	if plan.Across != nil {
+		subIdentifier := fmt.Sprintf("%s.across", identifier)
+
+		if plan.Across.Var == "" {
+			errorMessages = append(errorMessages, subIdentifier+" does not specify a var")
+		}
+
+		if len(plan.Across.Values) == 0 {
+			errorMessages = append(errorMessages, subIdentifier+" does not specify any values")
+		}
+
+		if plan.Across.MaxInFlight < 0 {
+			errorMessages = append(errorMessages, subIdentifier+fmt.Sprintf(" has an invalid max_in_flight (%d)", plan.Across.MaxInFlight))
+		}
+	}
+
 	return warnings, errorMessages
 }
 
@@ -576,6 +740,30 @@ func validateInapplicableFields(inapplicableFields []string, plan PlanConfig, id
 			if plan.TaskConfigPath != "" {
 				foundInapplicableFields = append(foundInapplicableFields, field)
 			}
+		case "space":
+			if plan.Space != "" {
+				foundInapplicableFields = append(foundInapplicableFields, field)
+			}
+		case "type":
+			if plan.Type != "" {
+				foundInapplicableFields = append(foundInapplicableFields, field)
+			}
+		case "inputs":
+			if len(plan.Inputs) != 0 {
+				foundInapplicableFields = append(foundInapplicableFields, field)
+			}
+		case "outputs":
+			if len(plan.Outputs) != 0 {
+				foundInapplicableFields = append(foundInapplicableFields, field)
+			}
+		case "vars":
+			if len(plan.Vars) != 0 {
+				foundInapplicableFields = append(foundInapplicableFields, field)
+			}
+		case "var_files":
+			if len(plan.VarFiles) != 0 {
+				foundInapplicableFields = append(foundInapplicableFields, field)
+			}
 		}
 	}
 
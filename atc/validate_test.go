@@ -190,6 +190,56 @@ var _ = Describe("ValidateConfig", func() {
 			})
 		})
 
+		Context("when a resource has an unknown webhook signature type", func() {
+			BeforeEach(func() {
+				config.Resources[0].WebhookToken = "some-token"
+				config.Resources[0].WebhookSignature = &WebhookSignatureConfig{
+					Type:   "bitbucket",
+					Secret: "((webhook-secret))",
+				}
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("invalid resources:"))
+				Expect(errorMessages[0]).To(ContainSubstring("resources.some-resource.webhook_signature has unknown type 'bitbucket' (must be 'github' or 'gitlab')"))
+			})
+		})
+
+		Context("when a resource has webhook filters without a webhook token", func() {
+			BeforeEach(func() {
+				config.Resources[0].WebhookFilters = []WebhookFilter{
+					{Path: "ref", Values: nil},
+				}
+			})
+
+			It("returns an error describing each problem", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("invalid resources:"))
+				Expect(errorMessages[0]).To(ContainSubstring("resources.some-resource has webhook_signature or webhook_filters but no webhook_token"))
+				Expect(errorMessages[0]).To(ContainSubstring("resources.some-resource.webhook_filters[0] has no path starting with '$'"))
+				Expect(errorMessages[0]).To(ContainSubstring("resources.some-resource.webhook_filters[0] has no values"))
+			})
+		})
+
+		Context("when a resource has a valid webhook configuration", func() {
+			BeforeEach(func() {
+				config.Resources[0].WebhookToken = "some-token"
+				config.Resources[0].CheckEvery = CheckEveryNever
+				config.Resources[0].WebhookSignature = &WebhookSignatureConfig{
+					Type:   WebhookSignatureGitHub,
+					Secret: "((webhook-secret))",
+				}
+				config.Resources[0].WebhookFilters = []WebhookFilter{
+					{Path: "$.ref", Values: []string{"refs/heads/main"}},
+				}
+			})
+
+			It("does not return an error", func() {
+				Expect(errorMessages).To(HaveLen(0))
+			})
+		})
+
 		Context("when two resources have the same name", func() {
 			BeforeEach(func() {
 				config.Resources = append(config.Resources, config.Resources...)
@@ -459,6 +509,54 @@ var _ = Describe("ValidateConfig", func() {
 			})
 		})
 
+		Context("when a job has a valid schedule", func() {
+			BeforeEach(func() {
+				job.Schedule = &ScheduleConfig{Cron: "0 9 * * mon-fri", Location: "America/New_York"}
+				config.Jobs = append(config.Jobs, job)
+			})
+
+			It("does not return an error", func() {
+				Expect(errorMessages).To(HaveLen(0))
+			})
+		})
+
+		Context("when a job has an invalid cron expression", func() {
+			BeforeEach(func() {
+				job.Schedule = &ScheduleConfig{Cron: "0 25 * * *"}
+				config.Jobs = append(config.Jobs, job)
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("invalid jobs:"))
+				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job has an invalid schedule: invalid cron expression '0 25 * * *': hour value 25 out of range 0-23"))
+			})
+		})
+
+		Context("when a job's schedule has an unknown location", func() {
+			BeforeEach(func() {
+				job.Schedule = &ScheduleConfig{Cron: "0 9 * * *", Location: "Nowhere/Special"}
+				config.Jobs = append(config.Jobs, job)
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job has an invalid schedule: unknown time zone Nowhere/Special"))
+			})
+		})
+
+		Context("when a job's schedule never fires", func() {
+			BeforeEach(func() {
+				job.Schedule = &ScheduleConfig{Cron: "0 0 30 feb *"}
+				config.Jobs = append(config.Jobs, job)
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job has a schedule that never fires: '0 0 30 feb *'"))
+			})
+		})
+
 		Context("when a job has duplicate inputs", func() {
 			BeforeEach(func() {
 				job.Plan = append(job.Plan, PlanConfig{
@@ -696,6 +794,270 @@ var _ = Describe("ValidateConfig", func() {
 				})
 			})
 
+			Context("when a task plan retains artifacts", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Task:          "some-task",
+						OutputMapping: map[string]string{"report": "some-report"},
+						Artifacts:     []string{"some-report", "other-output", "some-typo"},
+						TaskConfig: &TaskConfig{
+							Platform: "linux",
+							Run: TaskRunConfig{
+								Path: "ls",
+							},
+							Outputs: []TaskOutputConfig{
+								{Name: "report"},
+								{Name: "other-output"},
+							},
+						},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error for the artifacts which are not outputs of the task", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("invalid jobs:"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].task.some-task retains artifact 'some-typo' which is not an output of the task"))
+					Expect(errorMessages[0]).ToNot(ContainSubstring("'some-report'"))
+					Expect(errorMessages[0]).ToNot(ContainSubstring("'other-output'"))
+				})
+			})
+
+			Context("when a set_pipeline plan has no file specified", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						SetPipeline: "some-pipeline",
+						Vars:        Params{"branch": "master"},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("invalid jobs:"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].set_pipeline.some-pipeline does not specify any pipeline configuration `file`"))
+				})
+			})
+
+			Context("when a set_pipeline plan has invalid fields specified", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						SetPipeline:    "some-pipeline",
+						TaskConfigPath: "some-repo/pipeline.yml",
+						Resource:       "some-resource",
+						Privileged:     true,
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("invalid jobs:"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].set_pipeline.some-pipeline has invalid fields specified (resource, privileged)"))
+				})
+			})
+
+			Context("when a load_var plan has no file specified", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						LoadVar: "some-var",
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("invalid jobs:"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].load_var.some-var does not specify any var `file`"))
+				})
+			})
+
+			Context("when a load_var plan has an unknown format", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						LoadVar:        "some-var",
+						TaskConfigPath: "some-repo/version",
+						Format:         "toml",
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("invalid jobs:"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].load_var.some-var has an unknown format 'toml' (must be raw, json or yaml)"))
+				})
+			})
+
+			Context("when a run plan specifies a type", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Run:  "delete-branch",
+						Type: "git",
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("does not return an error", func() {
+					Expect(errorMessages).To(HaveLen(0))
+				})
+			})
+
+			Context("when a run plan specifies a resource", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Run:      "delete-branch",
+						Resource: "some-resource",
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("does not return an error", func() {
+					Expect(errorMessages).To(HaveLen(0))
+				})
+			})
+
+			Context("when a run plan specifies neither a type nor a resource", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Run: "delete-branch",
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].run.delete-branch does not specify a resource `type` or `resource`"))
+				})
+			})
+
+			Context("when a run plan specifies both a type and a resource", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Run:      "delete-branch",
+						Type:     "git",
+						Resource: "some-resource",
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].run.delete-branch specifies both a resource `type` and a `resource`"))
+				})
+			})
+
+			Context("when a run plan refers to a resource that does not exist", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Run:      "delete-branch",
+						Resource: "bogus-resource",
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].run.delete-branch refers to a resource that does not exist ('bogus-resource')"))
+				})
+			})
+
+			Context("when a run plan's message is not a plain name", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Run:  "../../bin/sh",
+						Type: "git",
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].run.../../bin/sh has an invalid message name"))
+				})
+			})
+
+			Context("when a get plan specifies the fields of a run plan", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Get:     "some-resource",
+						Type:    "git",
+						Inputs:  []string{"some-input"},
+						Outputs: []string{"some-output"},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].get.some-resource has invalid fields specified (type, inputs, outputs)"))
+				})
+			})
+
+			Context("when a task plan specifies the fields of a run plan", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Task:           "some-task",
+						TaskConfigPath: "some-file",
+						Outputs:        []string{"some-output"},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].task.some-task has invalid fields specified (outputs)"))
+				})
+			})
+
+			Context("when a task plan specifies the fields of a set_pipeline plan", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Task:           "some-task",
+						TaskConfigPath: "some-file",
+						Vars:           Params{"some": "var"},
+						VarFiles:       []string{"some-var-file"},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].task.some-task has invalid fields specified (vars, var_files)"))
+				})
+			})
+
+			Context("when a load_var plan specifies the fields of a set_pipeline plan", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						LoadVar:        "some-var",
+						TaskConfigPath: "some-file",
+						VarFiles:       []string{"some-var-file"},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].load_var.some-var has invalid fields specified (var_files)"))
+				})
+			})
+
 			Context("when a put plan has invalid fields specified", func() {
 				BeforeEach(func() {
 					job.Plan = append(job.Plan, PlanConfig{
@@ -1116,6 +1478,43 @@ var _ = Describe("ValidateConfig", func() {
 				})
 			})
 
+			Context("when an across plan has no var or values", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Put:    "some-resource",
+						Across: &AcrossConfig{},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("does return an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].put.some-resource.across does not specify a var"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].put.some-resource.across does not specify any values"))
+				})
+			})
+
+			Context("when an across plan has a negative max_in_flight", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Put: "some-resource",
+						Across: &AcrossConfig{
+							Var:         "some-var",
+							Values:      []interface{}{"a", "b"},
+							MaxInFlight: -1,
+						},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("does return an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].put.some-resource.across has an invalid max_in_flight (-1)"))
+				})
+			})
+
 			Context("when a put plan has a custom name but refers to a resource that does not exist", func() {
 				BeforeEach(func() {
 					job.Plan = append(job.Plan, PlanConfig{
@@ -51,6 +51,7 @@ type WorkerResourceType struct {
 	Image      string `json:"image"`
 	Version    string `json:"version"`
 	Privileged bool   `json:"privileged"`
+	Protocol   string `json:"protocol,omitempty"`
 }
 
 type PruneWorkerResponseBody struct {
@@ -17,7 +17,8 @@ type ArtifactName string
 // configured for a Task step).
 //
 // There is only one ArtifactRepository for the duration of a build plan's
-// execution.
+// execution, aside from scopes created with NewScope for steps that must not
+// see each other's artifacts.
 //
 // ArtifactRepository is, itself, an ArtifactSource. As an ArtifactSource it acts
 // as the set of all ArtifactSources it contains, as if they were each in
@@ -25,6 +26,8 @@ type ArtifactName string
 type ArtifactRepository struct {
 	repo  map[ArtifactName]ArtifactSource
 	repoL sync.RWMutex
+
+	parent *ArtifactRepository
 }
 
 // NewArtifactRepository constructs a new repository.
@@ -34,6 +37,16 @@ func NewArtifactRepository() *ArtifactRepository {
 	}
 }
 
+// NewScope returns a repository which contains all of this repository's
+// artifacts, but to which artifacts are registered without affecting this
+// repository. Artifacts registered to the scope take precedence over those of
+// the same name in this repository.
+func (repo *ArtifactRepository) NewScope() *ArtifactRepository {
+	scope := NewArtifactRepository()
+	scope.parent = repo
+	return scope
+}
+
 // RegisterSource inserts an ArtifactSource into the map under the given
 // ArtifactName. Producers of artifacts, e.g. the Get step and the Task step,
 // will call this after they've successfully produced their artifact(s).
@@ -49,6 +62,11 @@ func (repo *ArtifactRepository) SourceFor(name ArtifactName) (ArtifactSource, bo
 	repo.repoL.RLock()
 	source, found := repo.repo[name]
 	repo.repoL.RUnlock()
+
+	if !found && repo.parent != nil {
+		return repo.parent.SourceFor(name)
+	}
+
 	return source, found
 }
 
@@ -59,13 +77,7 @@ func (repo *ArtifactRepository) SourceFor(name ArtifactName) (ArtifactSource, bo
 // Each ArtifactSource will be streamed to a subdirectory matching its
 // ArtifactName.
 func (repo *ArtifactRepository) StreamTo(dest ArtifactDestination) error {
-	sources := map[ArtifactName]ArtifactSource{}
-
-	repo.repoL.RLock()
-	for k, v := range repo.repo {
-		sources[k] = v
-	}
-	repo.repoL.RUnlock()
+	sources := repo.AsMap()
 
 	for name, src := range sources {
 		err := src.StreamTo(subdirectoryDestination{dest, string(name)})
@@ -85,13 +97,7 @@ func (repo *ArtifactRepository) StreamTo(dest ArtifactDestination) error {
 // If the ArtifactSource determined by the path is not present,
 // FileNotFoundError will be returned.
 func (repo *ArtifactRepository) StreamFile(path string) (io.ReadCloser, error) {
-	sources := map[ArtifactName]ArtifactSource{}
-
-	repo.repoL.RLock()
-	for k, v := range repo.repo {
-		sources[k] = v
-	}
-	repo.repoL.RUnlock()
+	sources := repo.AsMap()
 
 	for name, src := range sources {
 		if strings.HasPrefix(path, string(name)+"/") {
@@ -114,6 +120,10 @@ func (repo *ArtifactRepository) VolumeOn(worker Worker) (Volume, bool, error) {
 func (repo *ArtifactRepository) AsMap() map[ArtifactName]ArtifactSource {
 	result := make(map[ArtifactName]ArtifactSource)
 
+	if repo.parent != nil {
+		result = repo.parent.AsMap()
+	}
+
 	repo.repoL.RLock()
 	for name, source := range repo.repo {
 		result[name] = source
@@ -182,4 +182,54 @@ var _ = Describe("ArtifactRepository", func() {
 			})
 		})
 	})
+
+	Describe("NewScope", func() {
+		var (
+			parentSource *workerfakes.FakeArtifactSource
+			scope        *ArtifactRepository
+		)
+
+		BeforeEach(func() {
+			parentSource = new(workerfakes.FakeArtifactSource)
+			repo.RegisterSource("parent-source", parentSource)
+
+			scope = repo.NewScope()
+		})
+
+		It("contains the artifacts of the repository", func() {
+			source, found := scope.SourceFor("parent-source")
+			Expect(found).To(BeTrue())
+			Expect(source).To(Equal(parentSource))
+		})
+
+		Context("when a source is registered to the scope", func() {
+			var scopedSource *workerfakes.FakeArtifactSource
+
+			BeforeEach(func() {
+				scopedSource = new(workerfakes.FakeArtifactSource)
+				scope.RegisterSource("parent-source", scopedSource)
+				scope.RegisterSource("scoped-source", scopedSource)
+			})
+
+			It("yields the scoped source over the repository's", func() {
+				source, found := scope.SourceFor("parent-source")
+				Expect(found).To(BeTrue())
+				Expect(source).To(Equal(scopedSource))
+
+				Expect(scope.AsMap()).To(Equal(map[ArtifactName]ArtifactSource{
+					"parent-source": scopedSource,
+					"scoped-source": scopedSource,
+				}))
+			})
+
+			It("does not affect the repository", func() {
+				source, found := repo.SourceFor("parent-source")
+				Expect(found).To(BeTrue())
+				Expect(source).To(Equal(parentSource))
+
+				_, found = repo.SourceFor("scoped-source")
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
 })
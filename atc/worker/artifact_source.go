@@ -2,6 +2,8 @@ package worker
 
 import (
 	"io"
+
+	"github.com/concourse/concourse/atc/db"
 )
 
 //go:generate counterfeiter . ArtifactSource
@@ -25,3 +27,14 @@ type ArtifactSource interface {
 	// `StreamTo` will be used to copy the data to the destination instead.
 	VolumeOn(Worker) (Volume, bool, error)
 }
+
+//go:generate counterfeiter . ResourceCacheArtifactSource
+
+// ResourceCacheArtifactSource is implemented by artifact sources backed by a
+// resource cache. When such a source has to be streamed to a worker, the copy
+// is kept on the worker and found by VolumeOn in subsequent builds.
+type ResourceCacheArtifactSource interface {
+	ArtifactSource
+
+	ResourceCache() db.UsedResourceCache
+}
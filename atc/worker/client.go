@@ -76,6 +76,10 @@ type Container interface {
 	WorkerName() string
 
 	MarkAsHijacked() error
+
+	// MarkTaskFinished records that the task running in the container has
+	// finished, so that it no longer counts towards the worker's active tasks.
+	MarkTaskFinished() error
 }
 
 type VolumeProperties map[string]string
@@ -75,6 +75,10 @@ func (container *gardenWorkerContainer) MarkAsHijacked() error {
 	return container.dbContainer.MarkAsHijacked()
 }
 
+func (container *gardenWorkerContainer) MarkTaskFinished() error {
+	return container.dbContainer.MarkTaskFinished()
+}
+
 func (container *gardenWorkerContainer) Run(spec garden.ProcessSpec, io garden.ProcessIO) (garden.Process, error) {
 	spec.User = container.user
 	return container.Container.Run(spec, io)
@@ -13,10 +13,15 @@ import (
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/lock"
 	"github.com/concourse/concourse/atc/metric"
+	"github.com/concourse/concourse/atc/tracing"
 )
 
 const creatingContainerRetryDelay = 1 * time.Second
 
+// streamedResourceCachePrefix distinguishes the volumes resource caches are
+// streamed into from the volumes mounted into the container.
+const streamedResourceCachePrefix = "streamed-resource-cache:"
+
 func NewContainerProvider(
 	gardenClient garden.Client,
 	baggageclaimClient baggageclaim.Client,
@@ -92,6 +97,27 @@ func (p *containerProvider) FindOrCreateContainer(
 	metadata db.ContainerMetadata,
 	spec ContainerSpec,
 	resourceTypes creds.VersionedResourceTypes,
+) (Container, error) {
+	ctx, span := tracing.StartSpan(ctx, "find-or-create-container", tracing.Attrs{
+		"worker": p.worker.Name(),
+		"type":   string(metadata.Type),
+	})
+
+	container, err := p.findOrCreateContainer(ctx, logger, owner, delegate, metadata, spec, resourceTypes)
+
+	tracing.End(span, err)
+
+	return container, err
+}
+
+func (p *containerProvider) findOrCreateContainer(
+	ctx context.Context,
+	logger lager.Logger,
+	owner db.ContainerOwner,
+	delegate ImageFetchingDelegate,
+	metadata db.ContainerMetadata,
+	spec ContainerSpec,
+	resourceTypes creds.VersionedResourceTypes,
 ) (Container, error) {
 	for {
 		var gardenContainer garden.Container
@@ -196,7 +222,12 @@ func (p *containerProvider) FindOrCreateContainer(
 
 			logger.Debug("fetching-image")
 
-			fetchedImage, err := image.FetchForContainer(ctx, logger, creatingContainer)
+			fetchCtx, fetchSpan := tracing.StartSpan(ctx, "fetch-image", tracing.Attrs{
+				"resource_type": spec.ImageSpec.ResourceType,
+			})
+
+			fetchedImage, err := image.FetchForContainer(fetchCtx, logger, creatingContainer)
+			tracing.End(fetchSpan, err)
 			if err != nil {
 				creatingContainer.Failed()
 				logger.Error("failed-to-fetch-image-for-container", err)
@@ -206,6 +237,7 @@ func (p *containerProvider) FindOrCreateContainer(
 			logger.Debug("creating-container-in-garden")
 
 			gardenContainer, err = p.createGardenContainer(
+				ctx,
 				logger,
 				creatingContainer,
 				spec,
@@ -318,6 +350,7 @@ func (p *containerProvider) constructGardenWorkerContainer(
 }
 
 func (p *containerProvider) createGardenContainer(
+	ctx context.Context,
 	logger lager.Logger,
 	creatingContainer db.CreatingContainer,
 	spec ContainerSpec,
@@ -397,6 +430,37 @@ func (p *containerProvider) createGardenContainer(
 			if err != nil {
 				return nil, err
 			}
+		} else if cacheSource, ok := inputSource.Source().(ResourceCacheArtifactSource); ok {
+			copyVolume, err := p.streamResourceCache(
+				ctx,
+				logger,
+				cacheSource,
+				VolumeSpec{
+					Strategy:   baggageclaim.EmptyStrategy{},
+					Privileged: fetchedImage.Privileged,
+				},
+				creatingContainer,
+				spec.TeamID,
+				inputSource.DestinationPath(),
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			inputVolume, err = p.volumeClient.FindOrCreateCOWVolumeForContainer(
+				logger,
+				VolumeSpec{
+					Strategy:   copyVolume.COWStrategy(),
+					Privileged: fetchedImage.Privileged,
+				},
+				creatingContainer,
+				copyVolume,
+				spec.TeamID,
+				inputSource.DestinationPath(),
+			)
+			if err != nil {
+				return nil, err
+			}
 		} else {
 			inputVolume, err = p.volumeClient.FindOrCreateVolumeForContainer(
 				logger,
@@ -412,7 +476,13 @@ func (p *containerProvider) createGardenContainer(
 				return nil, err
 			}
 
+			_, streamSpan := tracing.StartSpan(ctx, "stream-input", tracing.Attrs{
+				"worker": p.worker.Name(),
+				"path":   inputSource.DestinationPath(),
+			})
+
 			err = inputSource.Source().StreamTo(inputVolume)
+			tracing.End(streamSpan, err)
 			if err != nil {
 				return nil, err
 			}
@@ -475,6 +545,10 @@ func (p *containerProvider) createGardenContainer(
 		gardenProperties[userPropertyName] = fetchedImage.Metadata.User
 	}
 
+	if fetchedImage.Metadata.ResourceProtocol != "" {
+		gardenProperties[ResourceProtocolPropertyName] = fetchedImage.Metadata.ResourceProtocol
+	}
+
 	env := append(fetchedImage.Metadata.Env, spec.Env...)
 
 	if p.httpProxyURL != "" {
@@ -500,6 +574,50 @@ func (p *containerProvider) createGardenContainer(
 	})
 }
 
+// streamResourceCache streams a resource cache from another worker into a
+// volume which is then kept as a copy of the cache on this worker, so that
+// subsequent builds mount it rather than stream it again. Until the copy is
+// complete it is owned by the container, keyed by a path which is not mounted.
+func (p *containerProvider) streamResourceCache(
+	ctx context.Context,
+	logger lager.Logger,
+	source ResourceCacheArtifactSource,
+	volumeSpec VolumeSpec,
+	creatingContainer db.CreatingContainer,
+	teamID int,
+	path string,
+) (Volume, error) {
+	copyVolume, err := p.volumeClient.FindOrCreateVolumeForContainer(
+		logger,
+		volumeSpec,
+		creatingContainer,
+		teamID,
+		streamedResourceCachePrefix+path,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	_, streamSpan := tracing.StartSpan(ctx, "stream-input", tracing.Attrs{
+		"worker": p.worker.Name(),
+		"path":   path,
+	})
+
+	err = source.StreamTo(copyVolume)
+	tracing.End(streamSpan, err)
+	if err != nil {
+		return nil, err
+	}
+
+	err = copyVolume.InitializeStreamedResourceCache(source.ResourceCache())
+	if err != nil {
+		// the copy is still usable by this container; it just won't be reused
+		logger.Error("failed-to-initialize-streamed-resource-cache", err)
+	}
+
+	return copyVolume, nil
+}
+
 func (p *containerProvider) anyMountTo(path string, inputs []InputSource) bool {
 	for _, input := range inputs {
 		if input.DestinationPath() == path {
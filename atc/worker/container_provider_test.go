@@ -522,6 +522,101 @@ var _ = Describe("ContainerProvider", func() {
 
 		})
 
+		Context("when the fetched image speaks a resource protocol", func() {
+			BeforeEach(func() {
+				fakeImage.FetchForContainerReturns(FetchedImage{
+					Metadata: ImageMetadata{
+						Env:              []string{"IMAGE=ENV"},
+						ResourceProtocol: ResourceProtocolV2,
+					},
+					URL: "some-image-url",
+				}, nil)
+			})
+
+			It("records the protocol as a container property", func() {
+				Expect(fakeGardenClient.CreateCallCount()).To(Equal(1))
+
+				actualSpec := fakeGardenClient.CreateArgsForCall(0)
+				Expect(actualSpec.Properties).To(HaveKeyWithValue(ResourceProtocolPropertyName, ResourceProtocolV2))
+			})
+		})
+
+		Context("when a remote input is backed by a resource cache", func() {
+			var (
+				fakeResourceCache      *dbfakes.FakeUsedResourceCache
+				fakeRemoteCacheInputAS *workerfakes.FakeResourceCacheArtifactSource
+				fakeStreamedCopyVolume *workerfakes.FakeVolume
+			)
+
+			BeforeEach(func() {
+				fakeResourceCache = new(dbfakes.FakeUsedResourceCache)
+
+				fakeRemoteCacheInputAS = new(workerfakes.FakeResourceCacheArtifactSource)
+				fakeRemoteCacheInputAS.VolumeOnReturns(nil, false, nil)
+				fakeRemoteCacheInputAS.ResourceCacheReturns(fakeResourceCache)
+				fakeRemoteInput.SourceReturns(fakeRemoteCacheInputAS)
+
+				fakeStreamedCopyVolume = new(workerfakes.FakeVolume)
+				fakeStreamedCopyVolume.PathReturns("/fake/streamed/copy/volume")
+				stubbedVolumes["streamed-resource-cache:/some/work-dir/remote-input"] = fakeStreamedCopyVolume
+
+				fakeVolumeClient.FindOrCreateCOWVolumeForContainerStub = func(logger lager.Logger, volumeSpec VolumeSpec, creatingContainer db.CreatingContainer, parent Volume, teamID int, mountPath string) (Volume, error) {
+					if mountPath == "/some/work-dir/remote-input" {
+						Expect(parent).To(BeIdenticalTo(fakeStreamedCopyVolume))
+					} else {
+						Expect(parent).To(Equal(fakeLocalVolume))
+					}
+
+					volumeSpecs[mountPath] = volumeSpec
+
+					return stubbedVolumes[mountPath], nil
+				}
+			})
+
+			It("streams the input into a copy of the resource cache", func() {
+				Expect(fakeRemoteCacheInputAS.StreamToCallCount()).To(Equal(1))
+				Expect(fakeRemoteCacheInputAS.StreamToArgsForCall(0)).To(BeIdenticalTo(fakeStreamedCopyVolume))
+
+				Expect(volumeSpecs["streamed-resource-cache:/some/work-dir/remote-input"]).To(Equal(VolumeSpec{
+					Strategy: baggageclaim.EmptyStrategy{},
+				}))
+			})
+
+			It("keeps the copy on the worker for subsequent builds", func() {
+				Expect(fakeStreamedCopyVolume.InitializeStreamedResourceCacheCallCount()).To(Equal(1))
+				Expect(fakeStreamedCopyVolume.InitializeStreamedResourceCacheArgsForCall(0)).To(Equal(fakeResourceCache))
+			})
+
+			It("mounts a copy-on-write volume of the copy", func() {
+				Expect(volumeSpecs["/some/work-dir/remote-input"]).To(Equal(VolumeSpec{
+					Strategy: fakeStreamedCopyVolume.COWStrategy(),
+				}))
+				Expect(fakeRemoteInputContainerVolume.StreamInCallCount()).To(Equal(0))
+			})
+
+			Context("when keeping the copy fails", func() {
+				BeforeEach(func() {
+					fakeStreamedCopyVolume.InitializeStreamedResourceCacheReturns(errors.New("nope"))
+				})
+
+				It("still creates the container with the copy", func() {
+					Expect(findOrCreateErr).ToNot(HaveOccurred())
+					Expect(fakeCreatingContainer.CreatedCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("when streaming fails", func() {
+				BeforeEach(func() {
+					fakeRemoteCacheInputAS.StreamToReturns(errors.New("nope"))
+				})
+
+				It("returns the error without keeping the copy", func() {
+					Expect(findOrCreateErr).To(MatchError("nope"))
+					Expect(fakeStreamedCopyVolume.InitializeStreamedResourceCacheCallCount()).To(Equal(0))
+				})
+			})
+		})
+
 		Context("when an input has the path set to the workdir itself", func() {
 			BeforeEach(func() {
 				fakeLocalInput.DestinationPathReturns("/some/work-dir")
@@ -231,7 +231,9 @@ func (i *imageFromBaseResourceType) FetchForContainer(
 			}
 
 			return worker.FetchedImage{
-				Metadata:   worker.ImageMetadata{},
+				Metadata: worker.ImageMetadata{
+					ResourceProtocol: t.Protocol,
+				},
 				Version:    atc.Version{i.resourceTypeName: t.Version},
 				URL:        rootFSURL.String(),
 				Privileged: t.Privileged,
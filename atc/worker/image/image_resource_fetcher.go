@@ -156,6 +156,7 @@ func (i *imageResourceFetcher) Fetch(
 		version,
 		source,
 		params,
+		"",
 		i.customTypes,
 		resourceCache,
 		db.NewImageGetContainerOwner(container),
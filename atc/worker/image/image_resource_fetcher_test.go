@@ -364,6 +364,7 @@ var _ = Describe("Image", func() {
 									atc.Version{"v": "1"},
 									atc.Source{"some": "super-secret-sauce"},
 									atc.Params{"some": "params"},
+									"",
 									customTypes,
 									fakeUsedResourceCache,
 									db.NewImageGetContainerOwner(fakeCreatingContainer),
@@ -580,6 +581,7 @@ var _ = Describe("Image", func() {
 							atc.Version{"some": "version"},
 							atc.Source{"some": "super-secret-sauce"},
 							atc.Params{"some": "params"},
+							"",
 							customTypes,
 							fakeUsedResourceCache,
 							db.NewImageGetContainerOwner(fakeCreatingContainer),
@@ -568,6 +568,22 @@ var _ = Describe("Image", func() {
 			}))
 		})
 
+		Context("when the worker base resource type speaks a resource protocol", func() {
+			BeforeEach(func() {
+				workerResourceType.Protocol = worker.ResourceProtocolV2
+				fakeWorker.ResourceTypesReturns([]atc.WorkerResourceType{workerResourceType})
+			})
+
+			It("returns the protocol in the image metadata", func() {
+				fetchedImage, err := img.FetchForContainer(ctx, logger, fakeContainer)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fetchedImage.Metadata).To(Equal(worker.ImageMetadata{
+					ResourceProtocol: worker.ResourceProtocolV2,
+				}))
+			})
+		})
+
 		Context("when the worker base resource type is privileged", func() {
 			BeforeEach(func() {
 				workerResourceType.Privileged = true
@@ -4,10 +4,15 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"strings"
 
 	"github.com/concourse/concourse/atc/worker"
 )
 
+// ResourceProtocolEnv is the environment variable through which an image
+// declares the resource protocol it speaks.
+const ResourceProtocolEnv = "CONCOURSE_RESOURCE_PROTOCOL"
+
 type MalformedMetadataError struct {
 	UnmarshalError error
 }
@@ -26,5 +31,21 @@ func loadMetadata(tarReader io.ReadCloser) (worker.ImageMetadata, error) {
 		}
 	}
 
+	if imageMetadata.ResourceProtocol == "" {
+		imageMetadata.ResourceProtocol = resourceProtocolFromEnv(imageMetadata.Env)
+	}
+
 	return imageMetadata, nil
 }
+
+// resourceProtocolFromEnv detects the resource protocol of images which were
+// built from a Dockerfile declaring it, e.g. ENV CONCOURSE_RESOURCE_PROTOCOL=2.
+func resourceProtocolFromEnv(env []string) string {
+	for _, e := range env {
+		if strings.HasPrefix(e, ResourceProtocolEnv+"=") {
+			return strings.TrimPrefix(e, ResourceProtocolEnv+"=")
+		}
+	}
+
+	return ""
+}
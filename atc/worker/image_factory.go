@@ -53,8 +53,21 @@ type ImageFetchingDelegate interface {
 type ImageMetadata struct {
 	Env  []string `json:"env"`
 	User string   `json:"user"`
+
+	// ResourceProtocol is the version of the resource protocol spoken by a
+	// resource type's image. It is empty for images speaking the original
+	// check/in/out protocol.
+	ResourceProtocol string `json:"resource_protocol,omitempty"`
 }
 
+// ResourceProtocolV2 identifies resource type images providing an info script
+// and emitting events rather than a single JSON response.
+const ResourceProtocolV2 = "2"
+
+// ResourceProtocolPropertyName is the container property recording the
+// resource protocol of the container's image.
+const ResourceProtocolPropertyName = "concourse:resource-protocol"
+
 type NoopImageFetchingDelegate struct{}
 
 func (NoopImageFetchingDelegate) Stdout() io.Writer                                 { return ioutil.Discard }
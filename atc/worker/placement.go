@@ -9,17 +9,59 @@ type ContainerPlacementStrategy interface {
 	Choose([]Worker, ContainerSpec) (Worker, error)
 }
 
+// ContainerPlacementStrategyChainNode narrows down the workers a container
+// may be placed on, so that strategies can be chained together.
+type ContainerPlacementStrategyChainNode interface {
+	Candidates([]Worker, ContainerSpec) ([]Worker, error)
+}
+
+// ContainerPlacementStrategyChain passes the workers through each of its
+// nodes in order, choosing randomly amongst the workers left at the end.
+type ContainerPlacementStrategyChain struct {
+	nodes []ContainerPlacementStrategyChainNode
+	rand  *rand.Rand
+}
+
+func NewContainerPlacementStrategyChain(nodes ...ContainerPlacementStrategyChainNode) ContainerPlacementStrategy {
+	return &ContainerPlacementStrategyChain{
+		nodes: nodes,
+		rand:  rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+func (chain *ContainerPlacementStrategyChain) Choose(workers []Worker, spec ContainerSpec) (Worker, error) {
+	candidates := workers
+	for _, node := range chain.nodes {
+		var err error
+		candidates, err = node.Candidates(candidates, spec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return candidates[chain.rand.Intn(len(candidates))], nil
+}
+
 type VolumeLocalityPlacementStrategy struct {
 	rand *rand.Rand
 }
 
-func NewVolumeLocalityPlacementStrategy() ContainerPlacementStrategy {
+func NewVolumeLocalityPlacementStrategy() *VolumeLocalityPlacementStrategy {
 	return &VolumeLocalityPlacementStrategy{
 		rand: rand.New(rand.NewSource(time.Now().UnixNano())),
 	}
 }
 
 func (strategy *VolumeLocalityPlacementStrategy) Choose(workers []Worker, spec ContainerSpec) (Worker, error) {
+	highestLocalityWorkers, err := strategy.Candidates(workers, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	return highestLocalityWorkers[strategy.rand.Intn(len(highestLocalityWorkers))], nil
+}
+
+func (strategy *VolumeLocalityPlacementStrategy) Candidates(workers []Worker, spec ContainerSpec) ([]Worker, error) {
 	workersByCount := map[int][]Worker{}
 	var highestCount int
 	for _, w := range workers {
@@ -43,16 +85,52 @@ func (strategy *VolumeLocalityPlacementStrategy) Choose(workers []Worker, spec C
 		}
 	}
 
-	highestLocalityWorkers := workersByCount[highestCount]
+	return workersByCount[highestCount], nil
+}
 
-	return highestLocalityWorkers[strategy.rand.Intn(len(highestLocalityWorkers))], nil
+type FewestBuildContainersPlacementStrategy struct {
+	rand *rand.Rand
+}
+
+func NewFewestBuildContainersPlacementStrategy() *FewestBuildContainersPlacementStrategy {
+	return &FewestBuildContainersPlacementStrategy{
+		rand: rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+func (strategy *FewestBuildContainersPlacementStrategy) Choose(workers []Worker, spec ContainerSpec) (Worker, error) {
+	leastBusyWorkers, err := strategy.Candidates(workers, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	return leastBusyWorkers[strategy.rand.Intn(len(leastBusyWorkers))], nil
+}
+
+func (strategy *FewestBuildContainersPlacementStrategy) Candidates(workers []Worker, spec ContainerSpec) ([]Worker, error) {
+	workersByCount := map[int][]Worker{}
+	lowestCount := -1
+	for _, w := range workers {
+		count, err := w.BuildContainers()
+		if err != nil {
+			return nil, err
+		}
+
+		workersByCount[count] = append(workersByCount[count], w)
+
+		if lowestCount == -1 || count < lowestCount {
+			lowestCount = count
+		}
+	}
+
+	return workersByCount[lowestCount], nil
 }
 
 type RandomPlacementStrategy struct {
 	rand *rand.Rand
 }
 
-func NewRandomPlacementStrategy() ContainerPlacementStrategy {
+func NewRandomPlacementStrategy() *RandomPlacementStrategy {
 	return &RandomPlacementStrategy{
 		rand: rand.New(rand.NewSource(time.Now().UnixNano())),
 	}
@@ -61,3 +139,7 @@ func NewRandomPlacementStrategy() ContainerPlacementStrategy {
 func (strategy *RandomPlacementStrategy) Choose(workers []Worker, spec ContainerSpec) (Worker, error) {
 	return workers[strategy.rand.Intn(len(workers))], nil
 }
+
+func (strategy *RandomPlacementStrategy) Candidates(workers []Worker, spec ContainerSpec) ([]Worker, error) {
+	return workers, nil
+}
@@ -208,3 +208,153 @@ var _ = Describe("RandomPlacementStrategy", func() {
 		})
 	})
 })
+
+var _ = Describe("FewestBuildContainersPlacementStrategy", func() {
+	Describe("Choose", func() {
+		var (
+			busyWorker  *workerfakes.FakeWorker
+			idleWorker1 *workerfakes.FakeWorker
+			idleWorker2 *workerfakes.FakeWorker
+		)
+
+		JustBeforeEach(func() {
+			chosenWorker, chooseErr = strategy.Choose(
+				workers,
+				spec,
+			)
+		})
+
+		BeforeEach(func() {
+			strategy = NewFewestBuildContainersPlacementStrategy()
+
+			busyWorker = new(workerfakes.FakeWorker)
+			busyWorker.BuildContainersReturns(20, nil)
+
+			idleWorker1 = new(workerfakes.FakeWorker)
+			idleWorker1.BuildContainersReturns(2, nil)
+
+			idleWorker2 = new(workerfakes.FakeWorker)
+			idleWorker2.BuildContainersReturns(2, nil)
+		})
+
+		Context("with one having the fewest build containers", func() {
+			BeforeEach(func() {
+				workers = []Worker{
+					busyWorker,
+					idleWorker1,
+				}
+			})
+
+			It("creates it on the worker with the fewest build containers", func() {
+				Expect(chooseErr).ToNot(HaveOccurred())
+				Expect(chosenWorker).To(Equal(idleWorker1))
+			})
+		})
+
+		Context("with multiple with the same amount of build containers", func() {
+			BeforeEach(func() {
+				workers = []Worker{
+					busyWorker,
+					idleWorker1,
+					idleWorker2,
+				}
+			})
+
+			It("creates it on a random one of them", func() {
+				workerChoiceCounts := map[Worker]int{}
+
+				for i := 0; i < 100; i++ {
+					worker, err := strategy.Choose(
+						workers,
+						spec,
+					)
+					Expect(err).ToNot(HaveOccurred())
+					workerChoiceCounts[worker]++
+				}
+
+				Expect(workerChoiceCounts[idleWorker1]).ToNot(BeZero())
+				Expect(workerChoiceCounts[idleWorker2]).ToNot(BeZero())
+				Expect(workerChoiceCounts[busyWorker]).To(BeZero())
+			})
+		})
+	})
+})
+
+var _ = Describe("ContainerPlacementStrategyChain", func() {
+	Describe("Choose", func() {
+		var (
+			localBusyWorker *workerfakes.FakeWorker
+			localIdleWorker *workerfakes.FakeWorker
+			remoteWorker    *workerfakes.FakeWorker
+		)
+
+		JustBeforeEach(func() {
+			chosenWorker, chooseErr = strategy.Choose(
+				workers,
+				spec,
+			)
+		})
+
+		BeforeEach(func() {
+			localBusyWorker = new(workerfakes.FakeWorker)
+			localBusyWorker.BuildContainersReturns(10, nil)
+
+			localIdleWorker = new(workerfakes.FakeWorker)
+			localIdleWorker.BuildContainersReturns(5, nil)
+
+			remoteWorker = new(workerfakes.FakeWorker)
+			remoteWorker.BuildContainersReturns(0, nil)
+
+			fakeInput := new(workerfakes.FakeInputSource)
+			fakeInputAS := new(workerfakes.FakeArtifactSource)
+			fakeInputAS.VolumeOnStub = func(worker Worker) (Volume, bool, error) {
+				switch worker {
+				case localBusyWorker, localIdleWorker:
+					return new(workerfakes.FakeVolume), true, nil
+				default:
+					return nil, false, nil
+				}
+			}
+			fakeInput.SourceReturns(fakeInputAS)
+
+			spec = ContainerSpec{
+				TeamID: 4567,
+				Inputs: []InputSource{fakeInput},
+			}
+
+			workers = []Worker{
+				localBusyWorker,
+				localIdleWorker,
+				remoteWorker,
+			}
+		})
+
+		Context("when volume locality comes first", func() {
+			BeforeEach(func() {
+				strategy = NewContainerPlacementStrategyChain(
+					NewVolumeLocalityPlacementStrategy(),
+					NewFewestBuildContainersPlacementStrategy(),
+				)
+			})
+
+			It("chooses the least busy of the workers with the inputs", func() {
+				Expect(chooseErr).ToNot(HaveOccurred())
+				Expect(chosenWorker).To(Equal(localIdleWorker))
+			})
+		})
+
+		Context("when fewest build containers comes first", func() {
+			BeforeEach(func() {
+				strategy = NewContainerPlacementStrategyChain(
+					NewFewestBuildContainersPlacementStrategy(),
+					NewVolumeLocalityPlacementStrategy(),
+				)
+			})
+
+			It("chooses the least busy worker", func() {
+				Expect(chooseErr).ToNot(HaveOccurred())
+				Expect(chosenWorker).To(Equal(remoteWorker))
+			})
+		})
+	})
+})
@@ -60,18 +60,35 @@ func (err NoCompatibleWorkersError) Error() string {
 	)
 }
 
+// activeTasksPollingInterval is how often a task waiting for a worker to free
+// up a task slot checks the workers again.
+const activeTasksPollingInterval = 5 * time.Second
+
 type pool struct {
+	clock    clock.Clock
 	provider WorkerProvider
 
 	rand     *rand.Rand
 	strategy ContainerPlacementStrategy
+
+	maxActiveTasks int
 }
 
-func NewPool(provider WorkerProvider, strategy ContainerPlacementStrategy) Client {
+// NewPool constructs a Client which places containers on the workers given by
+// the provider. If maxActiveTasks is non-zero, task containers wait until a
+// worker is running fewer than that many tasks.
+func NewPool(
+	clock clock.Clock,
+	provider WorkerProvider,
+	strategy ContainerPlacementStrategy,
+	maxActiveTasks int,
+) Client {
 	return &pool{
-		provider: provider,
-		rand:     rand.New(rand.NewSource(time.Now().UnixNano())),
-		strategy: strategy,
+		clock:          clock,
+		provider:       provider,
+		rand:           rand.New(rand.NewSource(time.Now().UnixNano())),
+		strategy:       strategy,
+		maxActiveTasks: maxActiveTasks,
 	}
 }
 
@@ -144,12 +161,11 @@ func (pool *pool) FindOrCreateContainer(
 	}
 
 	if !found {
-		compatibleWorkers, err := pool.AllSatisfying(logger, spec.WorkerSpec(), resourceTypes)
-		if err != nil {
-			return nil, err
+		if pool.limitsActiveTasks(metadata) {
+			worker, err = pool.chooseWorkerForTask(ctx, logger, delegate, spec, resourceTypes)
+		} else {
+			worker, err = pool.chooseWorker(logger, spec, resourceTypes)
 		}
-
-		worker, err = pool.strategy.Choose(compatibleWorkers, spec)
 		if err != nil {
 			return nil, err
 		}
@@ -166,6 +182,80 @@ func (pool *pool) FindOrCreateContainer(
 	)
 }
 
+func (pool *pool) limitsActiveTasks(metadata db.ContainerMetadata) bool {
+	return pool.maxActiveTasks > 0 && metadata.Type == db.ContainerTypeTask
+}
+
+func (pool *pool) chooseWorker(logger lager.Logger, spec ContainerSpec, resourceTypes creds.VersionedResourceTypes) (Worker, error) {
+	compatibleWorkers, err := pool.AllSatisfying(logger, spec.WorkerSpec(), resourceTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	return pool.strategy.Choose(compatibleWorkers, spec)
+}
+
+// chooseWorkerForTask waits until a compatible worker is running fewer than
+// the maximum number of active tasks. The build is told why the task is
+// waiting, once.
+//
+// A worker's active tasks are counted from its task containers, so the
+// limit is not a strict one: tasks placed by more than one ATC at the same
+// moment may take a worker past it.
+func (pool *pool) chooseWorkerForTask(
+	ctx context.Context,
+	logger lager.Logger,
+	delegate ImageFetchingDelegate,
+	spec ContainerSpec,
+	resourceTypes creds.VersionedResourceTypes,
+) (Worker, error) {
+	logger = logger.Session("choose-worker-for-task", lager.Data{
+		"max-active-tasks": pool.maxActiveTasks,
+	})
+
+	notified := false
+	for {
+		compatibleWorkers, err := pool.AllSatisfying(logger, spec.WorkerSpec(), resourceTypes)
+		if err != nil {
+			return nil, err
+		}
+
+		availableWorkers := []Worker{}
+		for _, worker := range compatibleWorkers {
+			activeTasks, err := worker.ActiveTasks()
+			if err != nil {
+				return nil, err
+			}
+
+			if activeTasks < pool.maxActiveTasks {
+				availableWorkers = append(availableWorkers, worker)
+			}
+		}
+
+		if len(availableWorkers) > 0 {
+			return pool.strategy.Choose(availableWorkers, spec)
+		}
+
+		if !notified {
+			logger.Info("all-workers-busy")
+
+			fmt.Fprintf(
+				delegate.Stderr(),
+				"all workers are running the maximum of %d tasks; waiting for one to free up\n",
+				pool.maxActiveTasks,
+			)
+
+			notified = true
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-pool.clock.After(activeTasksPollingInterval):
+		}
+	}
+}
+
 func (pool *pool) FindContainerByHandle(logger lager.Logger, teamID int, handle string) (Container, bool, error) {
 	worker, found, err := pool.provider.FindWorkerForContainer(
 		logger.Session("find-worker"),
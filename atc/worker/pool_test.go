@@ -1,9 +1,13 @@
 package worker_test
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"io"
+	"time"
 
+	"code.cloudfoundry.org/clock/fakeclock"
 	"code.cloudfoundry.org/lager/lagertest"
 	"github.com/cloudfoundry/bosh-cli/director/template"
 	"github.com/concourse/concourse/atc"
@@ -19,18 +23,24 @@ import (
 
 var _ = Describe("Pool", func() {
 	var (
-		logger       *lagertest.TestLogger
-		fakeProvider *workerfakes.FakeWorkerProvider
-		fakeStrategy *workerfakes.FakeContainerPlacementStrategy
-		pool         Client
+		logger         *lagertest.TestLogger
+		fakeClock      *fakeclock.FakeClock
+		fakeProvider   *workerfakes.FakeWorkerProvider
+		fakeStrategy   *workerfakes.FakeContainerPlacementStrategy
+		maxActiveTasks int
+		pool           Client
 	)
 
 	BeforeEach(func() {
 		logger = lagertest.NewTestLogger("test")
+		fakeClock = fakeclock.NewFakeClock(time.Unix(123, 456))
 		fakeProvider = new(workerfakes.FakeWorkerProvider)
 		fakeStrategy = new(workerfakes.FakeContainerPlacementStrategy)
+		maxActiveTasks = 0
+	})
 
-		pool = NewPool(fakeProvider, fakeStrategy)
+	JustBeforeEach(func() {
+		pool = NewPool(fakeClock, fakeProvider, fakeStrategy, maxActiveTasks)
 	})
 
 	Describe("Satisfying", func() {
@@ -407,6 +417,8 @@ var _ = Describe("Pool", func() {
 		BeforeEach(func() {
 			ctx = context.Background()
 
+			metadata = db.ContainerMetadata{}
+
 			fakeImageFetchingDelegate = new(workerfakes.FakeImageFetchingDelegate)
 
 			fakeOwner = new(dbfakes.FakeContainerOwner)
@@ -571,6 +583,101 @@ var _ = Describe("Pool", func() {
 						Expect(createErr).To(Equal(strategyError))
 					})
 				})
+
+				Context("when the number of active tasks per worker is limited", func() {
+					var (
+						busyWorker *workerfakes.FakeWorker
+						stderr     *bytes.Buffer
+					)
+
+					BeforeEach(func() {
+						maxActiveTasks = 2
+
+						busyWorker = new(workerfakes.FakeWorker)
+						busyWorker.SatisfyingReturns(busyWorker, nil)
+						busyWorker.ActiveTasksReturns(2, nil)
+
+						fakeProvider.RunningWorkersReturns([]Worker{
+							busyWorker,
+							compatibleWorker,
+						}, nil)
+
+						compatibleWorker.ActiveTasksReturns(1, nil)
+
+						fakeStrategy.ChooseStub = func(workers []Worker, spec ContainerSpec) (Worker, error) {
+							return workers[0], nil
+						}
+
+						stderr = new(bytes.Buffer)
+						fakeImageFetchingDelegate.StderrReturns(stderr)
+					})
+
+					Context("when placing a task container", func() {
+						BeforeEach(func() {
+							metadata = db.ContainerMetadata{Type: db.ContainerTypeTask}
+						})
+
+						It("only chooses amongst workers with a free task slot", func() {
+							Expect(createErr).ToNot(HaveOccurred())
+
+							workers, _ := fakeStrategy.ChooseArgsForCall(0)
+							Expect(workers).To(Equal([]Worker{compatibleWorker}))
+						})
+
+						It("creates the container on the chosen worker", func() {
+							Expect(compatibleWorker.FindOrCreateContainerCallCount()).To(Equal(1))
+						})
+
+						Context("when every worker is busy", func() {
+							BeforeEach(func() {
+								compatibleWorker.ActiveTasksReturnsOnCall(0, 2, nil)
+
+								fakeImageFetchingDelegate.StderrStub = func() io.Writer {
+									go fakeClock.WaitForWatcherAndIncrement(5 * time.Second)
+									return stderr
+								}
+							})
+
+							It("tells the build it is waiting", func() {
+								Expect(stderr.String()).To(ContainSubstring("all workers are running the maximum of 2 tasks"))
+							})
+
+							It("places the container once a worker frees up", func() {
+								Expect(createErr).ToNot(HaveOccurred())
+								Expect(fakeStrategy.ChooseCallCount()).To(Equal(1))
+								Expect(compatibleWorker.FindOrCreateContainerCallCount()).To(Equal(1))
+							})
+						})
+
+						Context("when the context is canceled while waiting", func() {
+							BeforeEach(func() {
+								compatibleWorker.ActiveTasksReturns(2, nil)
+
+								var cancel context.CancelFunc
+								ctx, cancel = context.WithCancel(context.Background())
+								cancel()
+							})
+
+							It("returns the context's error", func() {
+								Expect(createErr).To(Equal(context.Canceled))
+								Expect(compatibleWorker.FindOrCreateContainerCallCount()).To(BeZero())
+							})
+						})
+					})
+
+					Context("when placing a container other than a task", func() {
+						BeforeEach(func() {
+							metadata = db.ContainerMetadata{Type: db.ContainerTypeGet}
+						})
+
+						It("chooses amongst all the compatible workers", func() {
+							Expect(createErr).ToNot(HaveOccurred())
+
+							workers, _ := fakeStrategy.ChooseArgsForCall(0)
+							Expect(workers).To(Equal([]Worker{busyWorker, compatibleWorker}))
+						})
+					})
+				})
 			})
 		})
 	})
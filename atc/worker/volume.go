@@ -27,6 +27,9 @@ type Volume interface {
 
 	InitializeResourceCache(db.UsedResourceCache) error
 	InitializeTaskCache(lager.Logger, int, string, string, bool) error
+	InitializeArtifact(int, string) error
+	InitializeTaskOutputCache(int, string) error
+	InitializeStreamedResourceCache(db.UsedResourceCache) error
 
 	CreateChildForContainer(db.CreatingContainer, string) (db.CreatingVolume, error)
 
@@ -127,6 +130,18 @@ func (v *volume) InitializeTaskCache(
 	return importVolume.InitializeTaskCache(logger, jobID, stepName, path, privileged)
 }
 
+func (v *volume) InitializeArtifact(buildID int, name string) error {
+	return v.dbVolume.InitializeArtifact(buildID, name)
+}
+
+func (v *volume) InitializeTaskOutputCache(cacheID int, name string) error {
+	return v.dbVolume.InitializeTaskOutputCache(cacheID, name)
+}
+
+func (v *volume) InitializeStreamedResourceCache(urc db.UsedResourceCache) error {
+	return v.dbVolume.InitializeStreamedResourceCache(urc)
+}
+
 func (v *volume) CreateChildForContainer(creatingContainer db.CreatingContainer, mountPath string) (db.CreatingVolume, error) {
 	return v.dbVolume.CreateChildForContainer(creatingContainer, mountPath)
 }
@@ -185,7 +185,16 @@ func (c *volumeClient) FindVolumeForResourceCache(
 	}
 
 	if !found {
-		return nil, false, nil
+		// fall back to a copy streamed to the worker by an earlier build
+		dbVolume, found, err = c.dbVolumeRepository.FindStreamedResourceCacheVolume(c.dbWorker.Name(), usedResourceCache)
+		if err != nil {
+			logger.Error("failed-to-lookup-streamed-resource-cache-volume-in-db", err)
+			return nil, false, err
+		}
+
+		if !found {
+			return nil, false, nil
+		}
 	}
 
 	bcVolume, found, err := c.baggageclaimClient.LookupVolume(logger, dbVolume.Handle())
@@ -566,6 +566,72 @@ var _ = Describe("VolumeClient", func() {
 		})
 	})
 
+	Describe("FindVolumeForResourceCache", func() {
+		var (
+			fakeResourceCache *dbfakes.FakeUsedResourceCache
+			bcVolume          *baggageclaimfakes.FakeVolume
+		)
+
+		BeforeEach(func() {
+			fakeResourceCache = new(dbfakes.FakeUsedResourceCache)
+
+			bcVolume = new(baggageclaimfakes.FakeVolume)
+			fakeBaggageclaimClient.LookupVolumeReturns(bcVolume, true, nil)
+		})
+
+		Context("when the resource cache volume exists on the worker", func() {
+			var dbVolume *dbfakes.FakeCreatedVolume
+
+			BeforeEach(func() {
+				dbVolume = new(dbfakes.FakeCreatedVolume)
+				fakeDBVolumeRepository.FindResourceCacheVolumeReturns(dbVolume, true, nil)
+			})
+
+			It("returns the volume", func() {
+				volume, found, err := volumeClient.FindVolumeForResourceCache(testLogger, fakeResourceCache)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(volume).To(Equal(worker.NewVolume(bcVolume, dbVolume, volumeClient)))
+
+				Expect(fakeDBVolumeRepository.FindStreamedResourceCacheVolumeCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the resource cache was only streamed to the worker", func() {
+			var dbVolume *dbfakes.FakeCreatedVolume
+
+			BeforeEach(func() {
+				dbVolume = new(dbfakes.FakeCreatedVolume)
+				fakeDBVolumeRepository.FindResourceCacheVolumeReturns(nil, false, nil)
+				fakeDBVolumeRepository.FindStreamedResourceCacheVolumeReturns(dbVolume, true, nil)
+			})
+
+			It("returns the streamed copy", func() {
+				volume, found, err := volumeClient.FindVolumeForResourceCache(testLogger, fakeResourceCache)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(volume).To(Equal(worker.NewVolume(bcVolume, dbVolume, volumeClient)))
+
+				workerName, resourceCache := fakeDBVolumeRepository.FindStreamedResourceCacheVolumeArgsForCall(0)
+				Expect(workerName).To(Equal("some-worker"))
+				Expect(resourceCache).To(Equal(fakeResourceCache))
+			})
+		})
+
+		Context("when the resource cache is not on the worker at all", func() {
+			BeforeEach(func() {
+				fakeDBVolumeRepository.FindResourceCacheVolumeReturns(nil, false, nil)
+				fakeDBVolumeRepository.FindStreamedResourceCacheVolumeReturns(nil, false, nil)
+			})
+
+			It("returns false", func() {
+				_, found, err := volumeClient.FindVolumeForResourceCache(testLogger, fakeResourceCache)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+
 	Describe("FindVolumeForTaskCache", func() {
 		Context("when worker task cache does not exist", func() {
 			BeforeEach(func() {
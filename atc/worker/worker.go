@@ -31,6 +31,8 @@ type Worker interface {
 	Client
 
 	ActiveContainers() int
+	BuildContainers() (int, error)
+	ActiveTasks() (int, error)
 
 	Description() string
 	Name() string
@@ -55,6 +57,8 @@ type gardenWorker struct {
 	volumeClient      VolumeClient
 	containerProvider ContainerProvider
 
+	dbWorker db.Worker
+
 	clock clock.Clock
 
 	activeContainers int
@@ -83,6 +87,8 @@ func NewGardenWorker(
 		volumeClient:       volumeClient,
 		containerProvider:  containerProvider,
 
+		dbWorker: dbWorker,
+
 		clock:            clock,
 		activeContainers: dbWorker.ActiveContainers(),
 		resourceTypes:    dbWorker.ResourceTypes(),
@@ -190,6 +196,14 @@ func (worker *gardenWorker) ActiveContainers() int {
 	return worker.activeContainers
 }
 
+func (worker *gardenWorker) BuildContainers() (int, error) {
+	return worker.dbWorker.BuildContainers()
+}
+
+func (worker *gardenWorker) ActiveTasks() (int, error) {
+	return worker.dbWorker.ActiveTasks()
+}
+
 func (worker *gardenWorker) Satisfying(logger lager.Logger, spec WorkerSpec, resourceTypes creds.VersionedResourceTypes) (Worker, error) {
 	if spec.TeamID != worker.teamID && worker.teamID != 0 {
 		return nil, ErrTeamMismatch
@@ -126,6 +126,16 @@ type FakeContainer struct {
 	markAsHijackedReturnsOnCall map[int]struct {
 		result1 error
 	}
+	MarkTaskFinishedStub        func() error
+	markTaskFinishedMutex       sync.RWMutex
+	markTaskFinishedArgsForCall []struct {
+	}
+	markTaskFinishedReturns struct {
+		result1 error
+	}
+	markTaskFinishedReturnsOnCall map[int]struct {
+		result1 error
+	}
 	MetricsStub        func() (garden.Metrics, error)
 	metricsMutex       sync.RWMutex
 	metricsArgsForCall []struct {
@@ -757,6 +767,48 @@ func (fake *FakeContainer) MarkAsHijackedReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeContainer) MarkTaskFinished() error {
+	fake.markTaskFinishedMutex.Lock()
+	ret, specificReturn := fake.markTaskFinishedReturnsOnCall[len(fake.markTaskFinishedArgsForCall)]
+	fake.markTaskFinishedArgsForCall = append(fake.markTaskFinishedArgsForCall, struct {
+	}{})
+	fake.recordInvocation("MarkTaskFinished", []interface{}{})
+	fake.markTaskFinishedMutex.Unlock()
+	if fake.MarkTaskFinishedStub != nil {
+		return fake.MarkTaskFinishedStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.markTaskFinishedReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeContainer) MarkTaskFinishedCallCount() int {
+	fake.markTaskFinishedMutex.RLock()
+	defer fake.markTaskFinishedMutex.RUnlock()
+	return len(fake.markTaskFinishedArgsForCall)
+}
+
+func (fake *FakeContainer) MarkTaskFinishedReturns(result1 error) {
+	fake.MarkTaskFinishedStub = nil
+	fake.markTaskFinishedReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeContainer) MarkTaskFinishedReturnsOnCall(i int, result1 error) {
+	fake.MarkTaskFinishedStub = nil
+	if fake.markTaskFinishedReturnsOnCall == nil {
+		fake.markTaskFinishedReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.markTaskFinishedReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeContainer) Metrics() (garden.Metrics, error) {
 	fake.metricsMutex.Lock()
 	ret, specificReturn := fake.metricsReturnsOnCall[len(fake.metricsArgsForCall)]
@@ -1472,6 +1524,8 @@ func (fake *FakeContainer) Invocations() map[string][][]interface{} {
 	defer fake.infoMutex.RUnlock()
 	fake.markAsHijackedMutex.RLock()
 	defer fake.markAsHijackedMutex.RUnlock()
+	fake.markTaskFinishedMutex.RLock()
+	defer fake.markTaskFinishedMutex.RUnlock()
 	fake.metricsMutex.RLock()
 	defer fake.metricsMutex.RUnlock()
 	fake.netInMutex.RLock()
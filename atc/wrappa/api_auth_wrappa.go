@@ -62,7 +62,8 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 		// resource belongs to authorized team
 		case atc.AbortBuild,
 			atc.SendInputToBuildPlan,
-			atc.ReadOutputFromBuildPlan:
+			atc.ReadOutputFromBuildPlan,
+			atc.GetBuildArtifact:
 			newHandler = wrappa.checkBuildWriteAccessHandlerFactory.HandlerFor(handler, rejector)
 
 		// requester is system, admin team, or worker owning team
@@ -106,6 +107,8 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.SetTeam,
 			atc.ListTeamBuilds,
 			atc.RenameTeam,
+			atc.SetTeamCredentialManager,
+			atc.ClearTeamCredentialManager,
 			atc.DestroyTeam,
 			atc.ListVolumes:
 			newHandler = auth.CheckAuthenticationHandler(handler, rejector)
@@ -119,6 +122,7 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 		case atc.CheckResource,
 			atc.CheckResourceType,
 			atc.CreateJobBuild,
+			atc.RerunJobBuild,
 			atc.CreatePipelineBuild,
 			atc.DeletePipeline,
 			atc.DisableResourceVersion,
@@ -133,7 +137,10 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.RenamePipeline,
 			atc.UnpauseJob,
 			atc.UnpausePipeline,
+			atc.ArchivePipeline,
 			atc.UnpauseResource,
+			atc.PinResourceVersion,
+			atc.UnpinResource,
 			atc.ExposePipeline,
 			atc.HidePipeline,
 			atc.SaveConfig,
@@ -165,6 +165,7 @@ var _ = Describe("APIAuthWrappa", func() {
 				atc.AbortBuild:              checkWritePermissionForBuild(inputHandlers[atc.AbortBuild]),
 				atc.SendInputToBuildPlan:    checkWritePermissionForBuild(inputHandlers[atc.SendInputToBuildPlan]),
 				atc.ReadOutputFromBuildPlan: checkWritePermissionForBuild(inputHandlers[atc.ReadOutputFromBuildPlan]),
+				atc.GetBuildArtifact:        checkWritePermissionForBuild(inputHandlers[atc.GetBuildArtifact]),
 
 				// resource belongs to authorized team
 				atc.PruneWorker:              checkTeamAccessForWorker(inputHandlers[atc.PruneWorker]),
@@ -208,6 +209,9 @@ var _ = Describe("APIAuthWrappa", func() {
 				atc.RenameTeam:      authenticated(inputHandlers[atc.RenameTeam]),
 				atc.DestroyTeam:     authenticated(inputHandlers[atc.DestroyTeam]),
 
+				atc.SetTeamCredentialManager:   authenticated(inputHandlers[atc.SetTeamCredentialManager]),
+				atc.ClearTeamCredentialManager: authenticated(inputHandlers[atc.ClearTeamCredentialManager]),
+
 				// authenticated and is admin
 				atc.GetLogLevel:  authenticatedAndAdmin(inputHandlers[atc.GetLogLevel]),
 				atc.SetLogLevel:  authenticatedAndAdmin(inputHandlers[atc.SetLogLevel]),
@@ -217,6 +221,7 @@ var _ = Describe("APIAuthWrappa", func() {
 				atc.CheckResource:          authorized(inputHandlers[atc.CheckResource]),
 				atc.CheckResourceType:      authorized(inputHandlers[atc.CheckResourceType]),
 				atc.CreateJobBuild:         authorized(inputHandlers[atc.CreateJobBuild]),
+				atc.RerunJobBuild:          authorized(inputHandlers[atc.RerunJobBuild]),
 				atc.DeletePipeline:         authorized(inputHandlers[atc.DeletePipeline]),
 				atc.DisableResourceVersion: authorized(inputHandlers[atc.DisableResourceVersion]),
 				atc.EnableResourceVersion:  authorized(inputHandlers[atc.EnableResourceVersion]),
@@ -231,7 +236,10 @@ var _ = Describe("APIAuthWrappa", func() {
 				atc.SaveConfig:             authorized(inputHandlers[atc.SaveConfig]),
 				atc.UnpauseJob:             authorized(inputHandlers[atc.UnpauseJob]),
 				atc.UnpausePipeline:        authorized(inputHandlers[atc.UnpausePipeline]),
+				atc.ArchivePipeline:        authorized(inputHandlers[atc.ArchivePipeline]),
 				atc.UnpauseResource:        authorized(inputHandlers[atc.UnpauseResource]),
+				atc.PinResourceVersion:     authorized(inputHandlers[atc.PinResourceVersion]),
+				atc.UnpinResource:          authorized(inputHandlers[atc.UnpinResource]),
 				atc.ExposePipeline:         authorized(inputHandlers[atc.ExposePipeline]),
 				atc.HidePipeline:           authorized(inputHandlers[atc.HidePipeline]),
 				atc.CreatePipelineBuild:    authorized(inputHandlers[atc.CreatePipelineBuild]),
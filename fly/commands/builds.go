@@ -19,11 +19,12 @@ import (
 const timeDateLayout = "2006-01-02@15:04:05-0700"
 
 type BuildsCommand struct {
-	Count    int                      `short:"c" long:"count" default:"50" description:"Number of builds you want to limit the return to"`
-	Job      flaghelpers.JobFlag      `short:"j" long:"job" value-name:"PIPELINE/JOB" description:"Name of a job to get builds for"`
-	Pipeline flaghelpers.PipelineFlag `short:"p" long:"pipeline" description:"Name of a pipeline to get builds for"`
-	Team     bool                     `short:"t"  long:"team" description:"Only show builds for the currently targeted team"`
-	Json     bool                     `long:"json" description:"Print command result as JSON"`
+	Count       int                      `short:"c" long:"count" default:"50" description:"Number of builds you want to limit the return to"`
+	Job         flaghelpers.JobFlag      `short:"j" long:"job" value-name:"PIPELINE/JOB" description:"Name of a job to get builds for"`
+	Pipeline    flaghelpers.PipelineFlag `short:"p" long:"pipeline" description:"Name of a pipeline to get builds for"`
+	Team        bool                     `short:"t"  long:"team" description:"Only show builds for the currently targeted team"`
+	Annotations []string                 `long:"annotation" value-name:"KEY" description:"Only show builds with the given annotation, and display its value (can be specified multiple times)"`
+	Json        bool                     `long:"json" description:"Print command result as JSON"`
 }
 
 func (command *BuildsCommand) Execute([]string) error {
@@ -37,7 +38,7 @@ func (command *BuildsCommand) Execute([]string) error {
 		return err
 	}
 
-	page := concourse.Page{Limit: command.Count}
+	page := concourse.Page{Limit: command.Count, Annotations: command.Annotations}
 
 	team := target.Team()
 	client := target.Client()
@@ -53,7 +54,7 @@ func (command *BuildsCommand) Execute([]string) error {
 
 		var found bool
 		builds, _, found, err = team.PipelineBuilds(
-			string(command.Pipeline),
+			command.Pipeline.Ref(),
 			page,
 		)
 		if err != nil {
@@ -66,7 +67,7 @@ func (command *BuildsCommand) Execute([]string) error {
 	} else if command.jobFlag() {
 		var found bool
 		builds, _, found, err = team.JobBuilds(
-			command.Job.PipelineName,
+			command.Job.PipelineRef(),
 			command.Job.JobName,
 			page,
 		)
@@ -109,6 +110,10 @@ func (command *BuildsCommand) Execute([]string) error {
 		},
 	}
 
+	for _, key := range command.Annotations {
+		table.Headers = append(table.Headers, ui.TableCell{Contents: key, Color: color.New(color.Bold)})
+	}
+
 	var rangeUntil int
 	if command.Count < len(builds) {
 		rangeUntil = command.Count
@@ -148,7 +153,7 @@ func (command *BuildsCommand) Execute([]string) error {
 			statusCell.Color = ui.PausedColor
 		}
 
-		table.Data = append(table.Data, []ui.TableCell{
+		row := []ui.TableCell{
 			{Contents: strconv.Itoa(b.ID)},
 			pipelineJobCell,
 			buildCell,
@@ -156,7 +161,13 @@ func (command *BuildsCommand) Execute([]string) error {
 			startTimeCell,
 			endTimeCell,
 			durationCell,
-		})
+		}
+
+		for _, key := range command.Annotations {
+			row = append(row, ui.TableCell{Contents: b.Annotations[key]})
+		}
+
+		table.Data = append(table.Data, row)
 	}
 
 	return table.Render(os.Stdout, Fly.PrintTableHeaders)
@@ -0,0 +1,38 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/rc"
+)
+
+type ClearTeamCredentialManagerCommand struct {
+	TeamName string `short:"n" long:"team-name" required:"true" description:"The team to go back to the cluster's credential manager"`
+}
+
+func (command *ClearTeamCredentialManagerCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	found, err := target.Client().Team(command.TeamName).ClearCredentialManager()
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		displayhelpers.Failf("Team '%s' not found\n", command.TeamName)
+		return nil
+	}
+
+	fmt.Printf("credential manager cleared for team %s\n", command.TeamName)
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+)
+
+type DisableResourceVersionCommand struct {
+	Resource flaghelpers.ResourceFlag `short:"r" long:"resource" required:"true" value-name:"PIPELINE/RESOURCE" description:"Name of the resource"`
+	Version  *atc.Version             `short:"v" long:"version"  required:"true" value-name:"VERSION"           description:"Fields of the version to disable, e.g. ref:abcd or path:thing-1.2.3.tgz"`
+}
+
+func (command *DisableResourceVersionCommand) Execute(args []string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	team := target.Team()
+
+	version, err := findResourceVersion(team, command.Resource, *command.Version)
+	if err != nil {
+		return err
+	}
+
+	found, err := team.DisableResourceVersion(command.Resource.PipelineName, command.Resource.ResourceName, version.ID)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return fmt.Errorf("pipeline '%s' or resource '%s' not found\n", command.Resource.PipelineName, command.Resource.ResourceName)
+	}
+
+	fmt.Printf("disabled version %s of '%s'\n", presentVersion(version.Version), command.Resource.ResourceName)
+	return nil
+}
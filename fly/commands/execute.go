@@ -95,7 +95,7 @@ func (command *ExecuteCommand) Execute(args []string) error {
 	var buildURL *url.URL
 
 	if command.InputsFrom.PipelineName != "" {
-		build, err = target.Team().CreatePipelineBuild(command.InputsFrom.PipelineName, plan)
+		build, err = target.Team().CreatePipelineBuild(command.InputsFrom.PipelineRef(), plan)
 		if err != nil {
 			return err
 		}
@@ -24,6 +24,9 @@ type FlyCommand struct {
 	RenameTeam  RenameTeamCommand  `command:"rename-team"   alias:"rt" description:"Rename a team"`
 	DestroyTeam DestroyTeamCommand `command:"destroy-team"  alias:"dt" description:"Destroy a team and delete all of its data"`
 
+	SetTeamCredentialManager   SetTeamCredentialManagerCommand   `command:"set-team-credential-manager"   description:"Configure a team's own credential manager"`
+	ClearTeamCredentialManager ClearTeamCredentialManagerCommand `command:"clear-team-credential-manager" description:"Make a team use the cluster's credential manager again"`
+
 	Checklist ChecklistCommand `command:"checklist" alias:"cl" description:"Print a Checkfile of the given pipeline"`
 
 	Execute ExecuteCommand `command:"execute" alias:"e" description:"Execute a one-off build using local bits"`
@@ -38,6 +41,7 @@ type FlyCommand struct {
 
 	Pipelines         PipelinesCommand         `command:"pipelines"           alias:"ps"   description:"List the configured pipelines"`
 	DestroyPipeline   DestroyPipelineCommand   `command:"destroy-pipeline"    alias:"dp"   description:"Destroy a pipeline"`
+	ArchivePipeline   ArchivePipelineCommand   `command:"archive-pipeline"    alias:"ap"   description:"Archive a pipeline"`
 	GetPipeline       GetPipelineCommand       `command:"get-pipeline"        alias:"gp"   description:"Get a pipeline's current configuration"`
 	SetPipeline       SetPipelineCommand       `command:"set-pipeline"        alias:"sp"   description:"Create or update a pipeline's configuration"`
 	PausePipeline     PausePipelineCommand     `command:"pause-pipeline"      alias:"pp"   description:"Pause a pipeline"`
@@ -52,11 +56,20 @@ type FlyCommand struct {
 	CheckResourceType CheckResourceTypeCommand `command:"check-resource-type" alias:"crt"  description:"Check a resource-type"`
 	PauseResource     PauseResourceCommand     `command:"pause-resource"      alias:"pr"   description:"Pause a resource"`
 	UnpauseResource   UnpauseResourceCommand   `command:"unpause-resource"    alias:"ur"   description:"Unpause a resource"`
+	PinResource       PinResourceCommand       `command:"pin-resource"        alias:"pir"  description:"Pin a version to a resource"`
+	UnpinResource     UnpinResourceCommand     `command:"unpin-resource"      alias:"upir" description:"Unpin a resource"`
+
+	ResourceVersions       ResourceVersionsCommand       `command:"resource-versions"        alias:"rvs" description:"List the versions of a resource"`
+	EnableResourceVersion  EnableResourceVersionCommand  `command:"enable-resource-version"  alias:"erv" description:"Enable a version of a resource"`
+	DisableResourceVersion DisableResourceVersionCommand `command:"disable-resource-version" alias:"drv" description:"Disable a version of a resource"`
+	ResourceCausality      ResourceCausalityCommand      `command:"resource-causality"       alias:"rc"  description:"Show the builds caused by a version of a resource"`
 
 	ClearTaskCache ClearTaskCacheCommand `command:"clear-task-cache" alias:"ctc" description:"Clears cache from a task container"`
 
-	Builds     BuildsCommand     `command:"builds"      alias:"bs" description:"List builds data"`
-	AbortBuild AbortBuildCommand `command:"abort-build" alias:"ab" description:"Abort a build"`
+	Builds           BuildsCommand           `command:"builds"            alias:"bs" description:"List builds data"`
+	AbortBuild       AbortBuildCommand       `command:"abort-build"       alias:"ab" description:"Abort a build"`
+	RerunBuild       RerunBuildCommand       `command:"rerun-build"       alias:"rb" description:"Rerun a build with the same inputs"`
+	DownloadArtifact DownloadArtifactCommand `command:"download-artifact" alias:"da" description:"Download an artifact retained by a build"`
 
 	TriggerJob TriggerJobCommand `command:"trigger-job" alias:"tj" description:"Start a job in a pipeline"`
 
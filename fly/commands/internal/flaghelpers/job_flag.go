@@ -5,38 +5,56 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/go-concourse/concourse"
 	"github.com/jessevdk/go-flags"
 
 	"github.com/concourse/concourse/fly/rc"
 )
 
+// JobFlag names a job of a pipeline, e.g. "some-pipeline/some-job". The
+// pipeline may be followed by the instance vars selecting one of its
+// instances, e.g. "some-pipeline/branch:master/some-job".
 type JobFlag struct {
 	PipelineName string
+	InstanceVars atc.InstanceVars
 	JobName      string
 }
 
 func (job *JobFlag) UnmarshalFlag(value string) error {
-	vs := strings.SplitN(value, "/", -1)
-
-	if len(vs) != 2 {
+	i := strings.LastIndex(value, "/")
+	if i == -1 {
 		return errors.New("argument format should be <pipeline>/<job>")
 	}
 
-	if vs[0] == "" {
+	pipeline := PipelineFlag(value[:i])
+	jobName := value[i+1:]
+
+	if pipeline == "" {
 		return concourse.NameRequiredError("pipeline")
 	}
 
-	if vs[1] == "" {
+	if jobName == "" {
 		return concourse.NameRequiredError("job")
 	}
 
-	job.PipelineName = vs[0]
-	job.JobName = vs[1]
+	ref, err := pipeline.parse()
+	if err != nil {
+		return err
+	}
+
+	job.PipelineName = ref.Name
+	job.InstanceVars = ref.InstanceVars
+	job.JobName = jobName
 
 	return nil
 }
 
+// PipelineRef returns the pipeline instance the job belongs to.
+func (job *JobFlag) PipelineRef() atc.PipelineRef {
+	return atc.PipelineRef{Name: job.PipelineName, InstanceVars: job.InstanceVars}
+}
+
 func (flag *JobFlag) Complete(match string) []flags.Completion {
 	fly := parseFlags()
 
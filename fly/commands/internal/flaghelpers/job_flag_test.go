@@ -1,6 +1,7 @@
 package flaghelpers_test
 
 import (
+	"github.com/concourse/concourse/atc"
 	. "github.com/concourse/concourse/fly/commands/internal/flaghelpers"
 
 	. "github.com/onsi/ginkgo"
@@ -16,4 +17,38 @@ var _ = Describe("JobFlag", func() {
 			Expect(err).To(MatchError("argument format should be <pipeline>/<job>"))
 		})
 	})
+
+	Context("when a pipeline and job are specified", func() {
+		It("selects the job of the pipeline", func() {
+			jobFlag := &JobFlag{}
+
+			err := jobFlag.UnmarshalFlag("some-pipeline/some-job")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(jobFlag.PipelineRef()).To(Equal(atc.PipelineRef{Name: "some-pipeline"}))
+			Expect(jobFlag.JobName).To(Equal("some-job"))
+		})
+	})
+
+	Context("when the pipeline has instance vars", func() {
+		It("selects the job of the pipeline instance", func() {
+			jobFlag := &JobFlag{}
+
+			err := jobFlag.UnmarshalFlag("some-pipeline/branch:feature/foo,version:2/some-job")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(jobFlag.PipelineRef()).To(Equal(atc.PipelineRef{
+				Name:         "some-pipeline",
+				InstanceVars: atc.InstanceVars{"branch": "feature/foo", "version": 2},
+			}))
+			Expect(jobFlag.JobName).To(Equal("some-job"))
+		})
+
+		Context("when an instance var is malformed", func() {
+			It("reports the instance var", func() {
+				jobFlag := &JobFlag{}
+
+				err := jobFlag.UnmarshalFlag("some-pipeline/branch/some-job")
+				Expect(err).To(MatchError("invalid instance var 'branch': must be of the form key:value"))
+			})
+		})
+	})
 })
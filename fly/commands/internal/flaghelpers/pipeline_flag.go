@@ -1,21 +1,61 @@
 package flaghelpers
 
 import (
-	"errors"
+	"fmt"
 	"strings"
 
 	"github.com/jessevdk/go-flags"
+	yaml "gopkg.in/yaml.v2"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/rc"
 )
 
+// PipelineFlag names a pipeline, optionally followed by the instance vars
+// selecting one of its instances, e.g. "some-pipeline/branch:master".
 type PipelineFlag string
 
 func (flag *PipelineFlag) Validate() error {
-	if strings.Contains(string(*flag), "/") {
-		return errors.New("pipeline name cannot contain '/'")
+	_, err := flag.parse()
+	return err
+}
+
+// Ref returns the pipeline and instance vars named by the flag. The flag
+// must have been validated first.
+func (flag *PipelineFlag) Ref() atc.PipelineRef {
+	ref, err := flag.parse()
+	if err != nil {
+		return atc.PipelineRef{Name: string(*flag)}
+	}
+
+	return ref
+}
+
+func (flag *PipelineFlag) parse() (atc.PipelineRef, error) {
+	vs := strings.SplitN(string(*flag), "/", 2)
+
+	ref := atc.PipelineRef{Name: vs[0]}
+	if len(vs) == 1 {
+		return ref, nil
 	}
-	return nil
+
+	ref.InstanceVars = atc.InstanceVars{}
+	for _, pair := range strings.Split(vs[1], ",") {
+		kv := strings.SplitN(pair, ":", 2)
+		if len(kv) != 2 || kv[0] == "" {
+			return atc.PipelineRef{}, fmt.Errorf("invalid instance var '%s': must be of the form key:value", pair)
+		}
+
+		var value interface{}
+		err := yaml.Unmarshal([]byte(kv[1]), &value)
+		if err != nil {
+			return atc.PipelineRef{}, fmt.Errorf("invalid instance var '%s': %s", kv[0], err)
+		}
+
+		ref.InstanceVars[kv[0]] = value
+	}
+
+	return ref, nil
 }
 
 func (flag *PipelineFlag) Complete(match string) []flags.Completion {
@@ -38,8 +78,9 @@ func (flag *PipelineFlag) Complete(match string) []flags.Completion {
 
 	comps := []flags.Completion{}
 	for _, pipeline := range pipelines {
-		if strings.HasPrefix(pipeline.Name, match) {
-			comps = append(comps, flags.Completion{Item: pipeline.Name})
+		name := pipeline.Ref().String()
+		if strings.HasPrefix(name, match) {
+			comps = append(comps, flags.Completion{Item: name})
 		}
 	}
 
@@ -0,0 +1,51 @@
+package flaghelpers_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	. "github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PipelineFlag", func() {
+	Context("when only a name is given", func() {
+		It("refers to the plain pipeline", func() {
+			flag := PipelineFlag("some-pipeline")
+
+			Expect(flag.Validate()).To(Succeed())
+			Expect(flag.Ref()).To(Equal(atc.PipelineRef{Name: "some-pipeline"}))
+		})
+	})
+
+	Context("when instance vars are given", func() {
+		It("refers to the instance", func() {
+			flag := PipelineFlag("some-pipeline/branch:feature/foo,version:2")
+
+			Expect(flag.Validate()).To(Succeed())
+			Expect(flag.Ref()).To(Equal(atc.PipelineRef{
+				Name: "some-pipeline",
+				InstanceVars: atc.InstanceVars{
+					"branch":  "feature/foo",
+					"version": 2,
+				},
+			}))
+		})
+	})
+
+	Context("when the part after '/' is not a list of instance vars", func() {
+		It("displays an error message", func() {
+			flag := PipelineFlag("forbidden/pipelinename")
+
+			Expect(flag.Validate()).To(MatchError("invalid instance var 'pipelinename': must be of the form key:value"))
+		})
+	})
+
+	Context("when an instance var is malformed", func() {
+		It("reports the instance var", func() {
+			flag := PipelineFlag("some-pipeline/branch:master,version")
+
+			Expect(flag.Validate()).To(MatchError("invalid instance var 'version': must be of the form key:value"))
+		})
+	})
+})
@@ -2,6 +2,7 @@ package commands
 
 import (
 	"os"
+	"time"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
@@ -45,6 +46,19 @@ func (command *JobsCommand) Execute([]string) error {
 	}
 
 	headers = []string{"name", "paused", "status", "next"}
+
+	var scheduled bool
+	for _, j := range jobs {
+		if j.NextRunTime != 0 {
+			scheduled = true
+			break
+		}
+	}
+
+	if scheduled {
+		headers = append(headers, "next run")
+	}
+
 	table := ui.Table{Headers: ui.TableRow{}}
 	for _, h := range headers {
 		table.Headers = append(table.Headers, ui.TableCell{Contents: h, Color: color.New(color.Bold)})
@@ -102,6 +116,16 @@ func (command *JobsCommand) Execute([]string) error {
 		}
 		row = append(row, nextColumn)
 
+		if scheduled {
+			var nextRunColumn ui.TableCell
+			if p.NextRunTime != 0 {
+				nextRunColumn.Contents = time.Unix(p.NextRunTime, 0).Local().Format(timeDateLayout)
+			} else {
+				nextRunColumn.Contents = "n/a"
+			}
+			row = append(row, nextRunColumn)
+		}
+
 		table.Data = append(table.Data, row)
 	}
 
@@ -0,0 +1,39 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+)
+
+type PinResourceCommand struct {
+	Resource flaghelpers.ResourceFlag `short:"r" long:"resource" required:"true" value-name:"PIPELINE/RESOURCE" description:"Name of the resource to pin"`
+	Version  *atc.Version             `short:"v" long:"version"  required:"true" value-name:"VERSION"           description:"Version of the resource to pin, e.g. ref:abcd or path:thing-1.2.3.tgz"`
+	Comment  string                   `short:"c" long:"comment"                  value-name:"COMMENT"           description:"Reason for pinning the version"`
+}
+
+func (command *PinResourceCommand) Execute(args []string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	found, err := target.Team().PinResourceVersion(command.Resource.PipelineName, command.Resource.ResourceName, *command.Version, command.Comment)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return fmt.Errorf("pipeline '%s' or resource '%s' not found\n", command.Resource.PipelineName, command.Resource.ResourceName)
+	}
+
+	fmt.Printf("pinned '%s'\n", command.Resource.ResourceName)
+	return nil
+}
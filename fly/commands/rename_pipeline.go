@@ -1,7 +1,9 @@
 package commands
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
 	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
@@ -19,7 +21,12 @@ func (command *RenamePipelineCommand) Validate() error {
 		return err
 	}
 
-	return command.Name.Validate()
+	// the new name names a pipeline, not an instance of one
+	if strings.Contains(string(command.Name), "/") {
+		return errors.New("pipeline name cannot contain '/'")
+	}
+
+	return nil
 }
 
 func (command *RenamePipelineCommand) Execute([]string) error {
@@ -0,0 +1,96 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+)
+
+type ResourceCausalityCommand struct {
+	Resource flaghelpers.ResourceFlag `short:"r" long:"resource" required:"true" value-name:"PIPELINE/RESOURCE" description:"Name of the resource"`
+	Version  *atc.Version             `short:"v" long:"version"  required:"true" value-name:"VERSION"           description:"Fields of the version to show the causality of, e.g. ref:abcd or path:thing-1.2.3.tgz"`
+	Json     bool                     `long:"json" description:"Print command result as JSON"`
+}
+
+func (command *ResourceCausalityCommand) Execute(args []string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	team := target.Team()
+
+	version, err := findResourceVersion(team, command.Resource, *command.Version)
+	if err != nil {
+		return err
+	}
+
+	causality, found, err := team.ResourceCausality(command.Resource.PipelineName, command.Resource.ResourceName, version.ID)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return fmt.Errorf("pipeline '%s' or resource '%s' not found\n", command.Resource.PipelineName, command.Resource.ResourceName)
+	}
+
+	if command.Json {
+		err = displayhelpers.JsonPrint(causality)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "build", Color: color.New(color.Bold)},
+			{Contents: "resource", Color: color.New(color.Bold)},
+			{Contents: "version", Color: color.New(color.Bold)},
+		},
+	}
+
+	causesByParent := map[int][]atc.Cause{}
+	for _, cause := range causality {
+		causesByParent[cause.ParentBuildID] = append(causesByParent[cause.ParentBuildID], cause)
+	}
+
+	table.Data = causalityRows(causesByParent, 0, 0, map[int]bool{})
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
+
+// causalityRows renders the builds which used a version produced by the
+// parent build, each followed by the builds which used its own outputs.
+func causalityRows(causesByParent map[int][]atc.Cause, parentBuildID int, depth int, visited map[int]bool) ui.Data {
+	var rows ui.Data
+	for _, cause := range causesByParent[parentBuildID] {
+		rows = append(rows, ui.TableRow{
+			{Contents: fmt.Sprintf("%s%s #%s", strings.Repeat("  ", depth), cause.JobName, cause.BuildName)},
+			{Contents: cause.ResourceName},
+			{Contents: presentVersion(cause.Version)},
+		})
+
+		if visited[cause.BuildID] {
+			continue
+		}
+
+		visited[cause.BuildID] = true
+		rows = append(rows, causalityRows(causesByParent, cause.BuildID, depth+1, visited)...)
+		delete(visited, cause.BuildID)
+	}
+
+	return rows
+}
@@ -0,0 +1,135 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/fatih/color"
+)
+
+type ResourceVersionsCommand struct {
+	Resource flaghelpers.ResourceFlag `short:"r" long:"resource" required:"true" value-name:"PIPELINE/RESOURCE" description:"Name of the resource to list versions of"`
+	Count    int                      `short:"c" long:"count" default:"50" description:"Number of versions you want to limit the return to"`
+	Json     bool                     `long:"json" description:"Print command result as JSON"`
+}
+
+func (command *ResourceVersionsCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	page := concourse.Page{Limit: command.Count}
+
+	versions, _, found, err := target.Team().ResourceVersions(command.Resource.PipelineName, command.Resource.ResourceName, page)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return fmt.Errorf("pipeline '%s' or resource '%s' not found\n", command.Resource.PipelineName, command.Resource.ResourceName)
+	}
+
+	if command.Json {
+		err = displayhelpers.JsonPrint(versions)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "id", Color: color.New(color.Bold)},
+			{Contents: "version", Color: color.New(color.Bold)},
+			{Contents: "enabled", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, version := range versions {
+		var enabledColumn ui.TableCell
+		if version.Enabled {
+			enabledColumn.Contents = "yes"
+		} else {
+			enabledColumn.Contents = "no"
+			enabledColumn.Color = color.New(color.FgCyan)
+		}
+
+		table.Data = append(table.Data, ui.TableRow{
+			{Contents: fmt.Sprintf("%d", version.ID)},
+			{Contents: presentVersion(version.Version)},
+			enabledColumn,
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
+
+// findResourceVersion looks up the one version of the resource which has
+// every field given in the version, e.g. ref:abcd.
+func findResourceVersion(team concourse.Team, resource flaghelpers.ResourceFlag, version atc.Version) (atc.VersionedResource, error) {
+	var matches []atc.VersionedResource
+
+	page := &concourse.Page{Limit: atc.PaginationAPIDefaultLimit}
+	for page != nil {
+		versions, pagination, found, err := team.ResourceVersions(resource.PipelineName, resource.ResourceName, *page)
+		if err != nil {
+			return atc.VersionedResource{}, err
+		}
+
+		if !found {
+			return atc.VersionedResource{}, fmt.Errorf("pipeline '%s' or resource '%s' not found\n", resource.PipelineName, resource.ResourceName)
+		}
+
+		for _, v := range versions {
+			if versionMatches(v.Version, version) {
+				matches = append(matches, v)
+			}
+		}
+
+		page = pagination.Next
+	}
+
+	switch len(matches) {
+	case 0:
+		return atc.VersionedResource{}, fmt.Errorf("could not find version matching %s\n", presentVersion(version))
+	case 1:
+		return matches[0], nil
+	default:
+		return atc.VersionedResource{}, fmt.Errorf("%d versions match %s, specify more fields to select one\n", len(matches), presentVersion(version))
+	}
+}
+
+func versionMatches(version atc.Version, fields atc.Version) bool {
+	for k, v := range fields {
+		if version[k] != v {
+			return false
+		}
+	}
+
+	return true
+}
+
+func presentVersion(version atc.Version) string {
+	fields := make([]string, 0, len(version))
+	for k, v := range version {
+		fields = append(fields, k+":"+v)
+	}
+
+	sort.Strings(fields)
+
+	return strings.Join(fields, ",")
+}
@@ -20,6 +20,8 @@ type SetPipelineCommand struct {
 	Var     []flaghelpers.VariablePairFlag     `short:"v"  long:"var"       value-name:"[NAME=STRING]"  description:"Specify a string value to set for a variable in the pipeline"`
 	YAMLVar []flaghelpers.YAMLVariablePairFlag `short:"y"  long:"yaml-var"  value-name:"[NAME=YAML]"    description:"Specify a YAML value to set for a variable in the pipeline"`
 
+	InstanceVar []flaghelpers.YAMLVariablePairFlag `short:"i"  long:"instance-var"  value-name:"[NAME=YAML]"  description:"Specify a YAML value identifying an instance of the pipeline; it is also set as a variable"`
+
 	VarsFrom []atc.PathFlag `short:"l"  long:"load-vars-from"  description:"Variable flag that can be used for filling in template values in configuration from a YAML file"`
 }
 
@@ -34,7 +36,17 @@ func (command *SetPipelineCommand) Execute(args []string) error {
 	}
 	configPath := command.Config
 	templateVariablesFiles := command.VarsFrom
-	pipelineName := string(command.Pipeline)
+	pipelineRef := command.Pipeline.Ref()
+
+	yamlVars := command.YAMLVar
+	for _, instanceVar := range command.InstanceVar {
+		if pipelineRef.InstanceVars == nil {
+			pipelineRef.InstanceVars = atc.InstanceVars{}
+		}
+
+		pipelineRef.InstanceVars[instanceVar.Name] = instanceVar.Value
+		yamlVars = append(yamlVars, instanceVar)
+	}
 
 	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
 	if err != nil {
@@ -50,11 +62,11 @@ func (command *SetPipelineCommand) Execute(args []string) error {
 
 	atcConfig := setpipelinehelpers.ATCConfig{
 		Team:             target.Team(),
-		PipelineName:     pipelineName,
+		PipelineRef:      pipelineRef,
 		Target:           target.Client().URL(),
 		SkipInteraction:  command.SkipInteractive,
 		CheckCredentials: command.CheckCredentials,
 	}
 
-	return atcConfig.Set(configPath, command.Var, command.YAMLVar, templateVariablesFiles)
+	return atcConfig.Set(configPath, command.Var, yamlVars, templateVariablesFiles)
 }
@@ -0,0 +1,53 @@
+package commands
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"gopkg.in/yaml.v2"
+)
+
+type SetTeamCredentialManagerCommand struct {
+	TeamName string       `short:"n" long:"team-name" required:"true" description:"The team to configure"`
+	Config   atc.PathFlag `short:"c" long:"config"    required:"true" description:"Credential manager configuration file, giving its type and flags"`
+}
+
+func (command *SetTeamCredentialManagerCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	configBytes, err := ioutil.ReadFile(string(command.Config))
+	if err != nil {
+		return err
+	}
+
+	var config atc.CredentialManagerConfig
+	err = yaml.Unmarshal(configBytes, &config)
+	if err != nil {
+		return fmt.Errorf("invalid credential manager config: %s", err)
+	}
+
+	found, err := target.Client().Team(command.TeamName).SetCredentialManager(config)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		displayhelpers.Failf("Team '%s' not found\n", command.TeamName)
+		return nil
+	}
+
+	fmt.Printf("credential manager configured for team %s\n", command.TeamName)
+
+	return nil
+}
@@ -22,7 +22,7 @@ func (command *UnpauseJobCommand) Execute(args []string) error {
 		return err
 	}
 
-	_, err = target.Team().UnpauseJob(command.Job.PipelineName, command.Job.JobName)
+	_, err = target.Team().UnpauseJob(command.Job.PipelineRef(), command.Job.JobName)
 	if err != nil {
 		return err
 	}
@@ -1,13 +1,17 @@
 package commands
 
 import (
+	"errors"
 	"fmt"
 	"os"
-	"strconv"
+	"reflect"
+	"time"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
 	"github.com/concourse/concourse/fly/eventstream"
 	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/go-concourse/concourse"
 )
 
 type WatchCommand struct {
@@ -26,22 +30,26 @@ func (command *WatchCommand) Execute(args []string) error {
 		return err
 	}
 
-	var buildId int
+	var build atc.Build
 	client := target.Client()
 	if command.Job.JobName != "" || command.Build == "" {
-		build, err := GetBuild(client, target.Team(), command.Job.JobName, command.Build, command.Job.PipelineName)
-		if err != nil {
-			return err
-		}
-		buildId = build.ID
-	} else if command.Build != "" {
-		buildId, err = strconv.Atoi(command.Build)
+		build, err = GetBuild(client, target.Team(), command.Job.JobName, command.Build, command.Job.PipelineRef())
+	} else {
+		build, err = GetBuild(client, nil, "", command.Build, atc.PipelineRef{})
+	}
+	if err != nil {
+		return err
+	}
 
+	if build.Status == string(atc.StatusPending) {
+		err = waitForPendingBuild(client, build)
 		if err != nil {
 			return err
 		}
 	}
 
+	buildId := build.ID
+
 	eventSource, err := client.BuildEvents(fmt.Sprintf("%d", buildId))
 	if err != nil {
 		return err
@@ -55,3 +63,40 @@ func (command *WatchCommand) Execute(args []string) error {
 
 	return nil
 }
+
+const pendingBuildPollInterval = time.Second
+
+// waitForPendingBuild prints why the build has not started yet, whenever the
+// reasons change, until the build is no longer pending.
+func waitForPendingBuild(client concourse.Client, build atc.Build) error {
+	var lastReasons []string
+
+	for build.Status == string(atc.StatusPending) {
+		preparation, found, err := client.BuildPreparation(build.ID)
+		if err != nil {
+			return err
+		}
+
+		if found && len(preparation.BlockingReasons) > 0 && !reflect.DeepEqual(preparation.BlockingReasons, lastReasons) {
+			fmt.Println("waiting for build to start:")
+			for _, reason := range preparation.BlockingReasons {
+				fmt.Printf("  %s\n", reason)
+			}
+
+			lastReasons = preparation.BlockingReasons
+		}
+
+		time.Sleep(pendingBuildPollInterval)
+
+		build, found, err = client.Build(fmt.Sprintf("%d", build.ID))
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			return errors.New("build not found")
+		}
+	}
+
+	return nil
+}
@@ -44,6 +44,9 @@ func Render(dst io.Writer, src eventstream.EventStream) int {
 		case event.FinishTask:
 			exitStatus = e.ExitStatus
 
+		case event.StartAcrossIteration:
+			fmt.Fprintf(dst, "\x1b[1macross %s: %v\x1b[0m\n", e.Var, e.Value)
+
 		case event.Error:
 			errCol := ui.ErroredColor.SprintFunc()
 			fmt.Fprintf(dst, "%s\n", errCol(e.Message))
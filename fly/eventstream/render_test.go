@@ -83,6 +83,19 @@ var _ = Describe("V1.0 Renderer", func() {
 		})
 	})
 
+	Context("when a StartAcrossIteration event is received", func() {
+		BeforeEach(func() {
+			receivedEvents <- event.StartAcrossIteration{
+				Var:   "go_version",
+				Value: "1.11",
+			}
+		})
+
+		It("prints the value of the var", func() {
+			Expect(out.Contents()).To(ContainSubstring("\x1b[1macross go_version: 1.11\x1b[0m\n"))
+		})
+	})
+
 	Context("and a StartTask event is received", func() {
 		BeforeEach(func() {
 			receivedEvents <- event.StartTask{
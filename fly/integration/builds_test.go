@@ -252,6 +252,73 @@ var _ = Describe("Fly CLI", func() {
 			})
 		})
 
+		Context("when passing the annotation argument", func() {
+			BeforeEach(func() {
+				cmdArgs = append(cmdArgs, "--annotation", "version")
+
+				expectedURL = "/api/v1/builds"
+				queryParams = "annotation=version&limit=50"
+
+				returnedStatusCode = http.StatusOK
+				returnedBuilds = []atc.Build{
+					{
+						ID:           3,
+						PipelineName: "some-pipeline",
+						JobName:      "some-job",
+						Name:         "63",
+						Status:       "succeeded",
+						StartTime:    succeededBuildStartTime.Unix(),
+						EndTime:      succeededBuildEndTime.Unix(),
+						Annotations:  map[string]string{"version": "1.2.3"},
+					},
+				}
+			})
+
+			It("shows the builds with the annotation's value", func() {
+				Eventually(session.Out).Should(PrintTable(ui.Table{
+					Headers: append(expectedHeaders, ui.TableCell{Contents: "version", Color: color.New(color.Bold)}),
+					Data: []ui.TableRow{
+						{
+							{Contents: "3"},
+							{Contents: "some-pipeline/some-job"},
+							{Contents: "63"},
+							{Contents: "succeeded"},
+							{Contents: succeededBuildStartTime.Local().Format(timeDateLayout)},
+							{Contents: succeededBuildEndTime.Local().Format(timeDateLayout)},
+							{Contents: "1h15m0s"},
+							{Contents: "1.2.3"},
+						},
+					},
+				}))
+
+				Eventually(session).Should(gexec.Exit(0))
+			})
+
+			Context("when --json is given", func() {
+				BeforeEach(func() {
+					cmdArgs = append(cmdArgs, "--json")
+				})
+
+				It("prints the annotated builds", func() {
+					Eventually(session).Should(gexec.Exit(0))
+					Expect(session.Out.Contents()).To(MatchJSON(`[
+              {
+                "id": 3,
+                "team_name": "",
+                "name": "63",
+                "status": "succeeded",
+                "job_name": "some-job",
+                "api_url": "",
+                "pipeline_name": "some-pipeline",
+                "start_time": 1448932815,
+                "end_time": 1448937315,
+                "annotations": {"version": "1.2.3"}
+              }
+            ]`))
+				})
+			})
+		})
+
 		Context("when passing the limit argument", func() {
 			BeforeEach(func() {
 				cmdArgs = append(cmdArgs, "-c")
@@ -369,6 +436,43 @@ var _ = Describe("Fly CLI", func() {
 				})
 			})
 
+			Context("when the pipeline is archived", func() {
+				BeforeEach(func() {
+					cmdArgs = []string{"-t", targetName, "builds", "-j", "archived-pipeline/some-job"}
+
+					expectedURL = "/api/v1/teams/main/pipelines/archived-pipeline/jobs/some-job/builds"
+					returnedBuilds = []atc.Build{
+						{
+							ID:           4,
+							PipelineName: "archived-pipeline",
+							JobName:      "some-job",
+							Name:         "12",
+							Status:       "failed",
+							StartTime:    succeededBuildStartTime.Unix(),
+							EndTime:      succeededBuildEndTime.Unix(),
+						},
+					}
+				})
+
+				It("lists the builds of the archived pipeline's job", func() {
+					Eventually(session.Out).Should(PrintTable(ui.Table{
+						Headers: expectedHeaders,
+						Data: []ui.TableRow{
+							{
+								{Contents: "4"},
+								{Contents: "archived-pipeline/some-job"},
+								{Contents: "12"},
+								{Contents: "failed"},
+								{Contents: succeededBuildStartTime.Local().Format(timeDateLayout)},
+								{Contents: succeededBuildEndTime.Local().Format(timeDateLayout)},
+								{Contents: "1h15m0s"},
+							},
+						},
+					}))
+					Eventually(session).Should(gexec.Exit(0))
+				})
+			})
+
 			Context("and the count argument", func() {
 				BeforeEach(func() {
 					cmdArgs = append(cmdArgs, "-j")
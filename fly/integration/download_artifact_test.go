@@ -0,0 +1,164 @@
+package integration_test
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+
+	"github.com/concourse/concourse/atc"
+)
+
+var _ = Describe("DownloadArtifact", func() {
+	var (
+		outputDir string
+
+		expectedBuild = atc.Build{
+			ID:      23,
+			Name:    "42",
+			Status:  "succeeded",
+			JobName: "myjob",
+			APIURL:  "api/v1/builds/23",
+		}
+	)
+
+	BeforeEach(func() {
+		var err error
+		outputDir, err = ioutil.TempDir("", "fly-download-artifact")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(outputDir)
+	})
+
+	streamArtifact := func(w http.ResponseWriter, req *http.Request) {
+		gw := gzip.NewWriter(w)
+		tw := tar.NewWriter(gw)
+
+		contents := []byte("some-report")
+
+		err := tw.WriteHeader(&tar.Header{
+			Name: "report.xml",
+			Mode: 0644,
+			Size: int64(len(contents)),
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = tw.Write(contents)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(tw.Close()).To(Succeed())
+		Expect(gw.Close()).To(Succeed())
+	}
+
+	Context("when the build id is specified", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/builds/23"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedBuild),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/builds/23/artifacts/test-report"),
+					streamArtifact,
+				),
+			)
+		})
+
+		It("extracts the artifact into the output directory", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "download-artifact", "-b", "23", "-a", "test-report", "-o", outputDir)
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+
+			contents, err := ioutil.ReadFile(filepath.Join(outputDir, "report.xml"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(contents)).To(Equal("some-report"))
+		})
+	})
+
+	Context("when the job and build name are specified", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/my-pipeline/jobs/myjob/builds/42"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedBuild),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/builds/23/artifacts/test-report"),
+					streamArtifact,
+				),
+			)
+		})
+
+		It("extracts the artifact of the job's build", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "download-artifact", "-j", "my-pipeline/myjob", "-b", "42", "-a", "test-report", "-o", outputDir)
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+
+			_, err = os.Stat(filepath.Join(outputDir, "report.xml"))
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Context("when the artifact does not exist", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/builds/23"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedBuild),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/builds/23/artifacts/test-report"),
+					ghttp.RespondWith(http.StatusNotFound, ""),
+				),
+			)
+		})
+
+		It("errors", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "download-artifact", "-b", "23", "-a", "test-report", "-o", outputDir)
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(1))
+			Expect(sess.Err).To(gbytes.Say("artifact 'test-report' not found"))
+		})
+	})
+
+	Context("when the build does not exist", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/builds/23"),
+					ghttp.RespondWith(http.StatusNotFound, ""),
+				),
+			)
+		})
+
+		It("errors", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "download-artifact", "-b", "23", "-a", "test-report", "-o", outputDir)
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(1))
+			Expect(sess.Err).To(gbytes.Say("build does not exist"))
+		})
+	})
+})
@@ -0,0 +1,103 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("enable-resource-version", func() {
+		var (
+			flyCmd      *exec.Cmd
+			versionsURL = "/api/v1/teams/main/pipelines/some-pipeline/resources/some-resource/versions"
+		)
+
+		BeforeEach(func() {
+			flyCmd = exec.Command(flyPath, "-t", targetName, "enable-resource-version", "-r", "some-pipeline/some-resource", "-v", "ref:abc")
+		})
+
+		Context("when one version matches across pages", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", versionsURL, "limit=100"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.VersionedResource{
+							{ID: 3, Version: atc.Version{"ref": "ghi"}},
+						}, http.Header{
+							"Link": []string{`<` + atcServer.URL() + versionsURL + `?until=3&limit=100>; rel="next"`},
+						}),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", versionsURL, "until=3&limit=100"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.VersionedResource{
+							{ID: 2, Version: atc.Version{"ref": "def"}},
+							{ID: 1, Version: atc.Version{"ref": "abc", "branch": "master"}},
+						}),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", versionsURL+"/1/enable"),
+						ghttp.RespondWith(http.StatusOK, ""),
+					),
+				)
+			})
+
+			It("enables the matching version", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say(`enabled version branch:master,ref:abc of 'some-resource'`))
+			})
+		})
+
+		Context("when no version matches", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", versionsURL),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.VersionedResource{
+							{ID: 2, Version: atc.Version{"ref": "def"}},
+						}),
+					),
+				)
+			})
+
+			It("exits 1 and outputs an error", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess.Err).Should(gbytes.Say(`could not find version matching ref:abc`))
+				Eventually(sess).Should(gexec.Exit(1))
+			})
+		})
+
+		Context("when several versions match", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", versionsURL),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.VersionedResource{
+							{ID: 2, Version: atc.Version{"ref": "abc", "path": "b"}},
+							{ID: 1, Version: atc.Version{"ref": "abc", "path": "a"}},
+						}),
+					),
+				)
+			})
+
+			It("exits 1 and outputs an error", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess.Err).Should(gbytes.Say(`2 versions match ref:abc`))
+				Eventually(sess).Should(gexec.Exit(1))
+			})
+		})
+	})
+})
@@ -3,6 +3,7 @@ package integration_test
 import (
 	"fmt"
 	"os/exec"
+	"time"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/ui"
@@ -151,6 +152,38 @@ var _ = Describe("Fly CLI", func() {
 			})
 		})
 
+		Context("when some jobs are scheduled", func() {
+			var nextRun time.Time
+
+			BeforeEach(func() {
+				nextRun = time.Date(2018, time.October, 18, 9, 0, 0, 0, time.UTC)
+
+				flyCmd = exec.Command(flyPath, "-t", targetName, "jobs", "--pipeline", "pipeline")
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/pipeline/jobs"),
+						ghttp.RespondWithJSONEncoded(200, []atc.Job{
+							{Name: "job-1", NextRunTime: nextRun.Unix()},
+							{Name: "job-2"},
+						}),
+					),
+				)
+			})
+
+			It("shows when they will next run", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(sess.Out).To(PrintTable(ui.Table{
+					Data: []ui.TableRow{
+						{{Contents: "job-1"}, {Contents: "no"}, {Contents: "n/a"}, {Contents: "n/a"}, {Contents: nextRun.Local().Format(timeDateLayout)}},
+						{{Contents: "job-2"}, {Contents: "no"}, {Contents: "n/a"}, {Contents: "n/a"}, {Contents: "n/a"}},
+					},
+				}))
+			})
+		})
+
 		Context("when the api returns an internal server error", func() {
 			BeforeEach(func() {
 				flyCmd = exec.Command(flyPath, "-t", targetName, "jobs", "-p", "pipeline")
@@ -0,0 +1,115 @@
+package integration_test
+
+import (
+	"fmt"
+	"net/http"
+	"os/exec"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("Pin Resource", func() {
+		var (
+			flyCmd *exec.Cmd
+		)
+
+		Context("when the resource and version flags are provided", func() {
+			pipelineName := "pipeline"
+			resourceName := "resource-name-potato"
+			fullResourceName := fmt.Sprintf("%s/%s", pipelineName, resourceName)
+			apiPath := fmt.Sprintf("/api/v1/teams/main/pipelines/%s/resources/%s/pin", pipelineName, resourceName)
+
+			BeforeEach(func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "pin-resource", "-r", fullResourceName, "-v", "ref:abcd", "-c", "upstream is broken")
+			})
+
+			Context("when the version is pinned using the API", func() {
+				BeforeEach(func() {
+					atcServer.AppendHandlers(
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("PUT", apiPath),
+							ghttp.VerifyJSON(`{"version":{"ref":"abcd"},"comment":"upstream is broken"}`),
+							ghttp.RespondWith(http.StatusOK, nil),
+						),
+					)
+				})
+
+				It("successfully pins the resource", func() {
+					Expect(func() {
+						sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+						Expect(err).NotTo(HaveOccurred())
+
+						<-sess.Exited
+						Expect(sess.ExitCode()).To(Equal(0))
+						Eventually(sess).Should(gbytes.Say(fmt.Sprintf("pinned '%s'\n", resourceName)))
+					}).To(Change(func() int {
+						return len(atcServer.ReceivedRequests())
+					}).By(2))
+				})
+			})
+
+			Context("when the resource is not found", func() {
+				BeforeEach(func() {
+					atcServer.AppendHandlers(
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("PUT", apiPath),
+							ghttp.RespondWith(http.StatusNotFound, nil),
+						),
+					)
+				})
+
+				It("exits 1 and outputs an error", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess.Err).Should(gbytes.Say(`pipeline 'pipeline' or resource 'resource-name-potato' not found`))
+
+					<-sess.Exited
+					Expect(sess.ExitCode()).To(Equal(1))
+				})
+			})
+
+			Context("when the resource is pinned in the pipeline config", func() {
+				BeforeEach(func() {
+					atcServer.AppendHandlers(
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("PUT", apiPath),
+							ghttp.RespondWith(http.StatusConflict, "resource is pinned in the pipeline config"),
+						),
+					)
+				})
+
+				It("exits 1 and outputs an error", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess.Err).Should(gbytes.Say(`pinned in the pipeline config`))
+
+					<-sess.Exited
+					Expect(sess.ExitCode()).To(Equal(1))
+				})
+			})
+		})
+
+		Context("when the version flag is not provided", func() {
+			BeforeEach(func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "pin-resource", "-r", "pipeline/resource")
+			})
+
+			It("exits 1 and outputs an error", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess.Err).Should(gbytes.Say(`error`))
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(1))
+			})
+		})
+	})
+})
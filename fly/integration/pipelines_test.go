@@ -31,6 +31,7 @@ var _ = Describe("Fly CLI", func() {
 								{Name: "pipeline-1-longer", Paused: false, Public: false},
 								{Name: "pipeline-2", Paused: true, Public: false},
 								{Name: "pipeline-3", Paused: false, Public: true},
+								{Name: "pipeline-3", Paused: false, Public: true, InstanceVars: atc.InstanceVars{"branch": "feature"}},
 							}),
 						),
 					)
@@ -67,6 +68,14 @@ var _ = Describe("Fly CLI", func() {
                   "paused": false,
                   "public": true,
                   "team_name": ""
+                },
+                {
+                  "id": 0,
+                  "name": "pipeline-3",
+                  "instance_vars": {"branch": "feature"},
+                  "paused": false,
+                  "public": true,
+                  "team_name": ""
                 }
               ]`))
 					})
@@ -87,6 +96,7 @@ var _ = Describe("Fly CLI", func() {
 							{{Contents: "pipeline-1-longer"}, {Contents: "no"}, {Contents: "no"}},
 							{{Contents: "pipeline-2"}, {Contents: "yes", Color: color.New(color.FgCyan)}, {Contents: "no"}},
 							{{Contents: "pipeline-3"}, {Contents: "no"}, {Contents: "yes", Color: color.New(color.FgCyan)}},
+							{{Contents: "pipeline-3/branch:feature"}, {Contents: "no"}, {Contents: "yes", Color: color.New(color.FgCyan)}},
 						},
 					}))
 				})
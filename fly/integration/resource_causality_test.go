@@ -0,0 +1,100 @@
+package integration_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"os/exec"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("resource-causality", func() {
+		var (
+			flyCmd      *exec.Cmd
+			versionsURL = "/api/v1/teams/main/pipelines/some-pipeline/resources/some-resource/versions"
+			causality   []atc.Cause
+		)
+
+		BeforeEach(func() {
+			flyCmd = exec.Command(flyPath, "-t", targetName, "resource-causality", "-r", "some-pipeline/some-resource", "-v", "ref:abc")
+
+			causality = []atc.Cause{
+				{VersionedResourceID: 1, BuildID: 10, ResourceName: "some-resource", Version: atc.Version{"ref": "abc"}, JobName: "unit", BuildName: "4"},
+				{VersionedResourceID: 1, BuildID: 11, ResourceName: "some-resource", Version: atc.Version{"ref": "abc"}, JobName: "build", BuildName: "7"},
+				{VersionedResourceID: 5, BuildID: 12, ParentBuildID: 11, ResourceName: "some-image", Version: atc.Version{"digest": "sha256:1"}, JobName: "deploy", BuildName: "2"},
+			}
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", versionsURL),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.VersionedResource{
+						{ID: 1, Version: atc.Version{"ref": "abc"}},
+					}),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", versionsURL+"/1/causality"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, causality),
+				),
+			)
+		})
+
+		It("renders the causality as an indented table", func() {
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+
+			Expect(sess.Out).To(PrintTable(ui.Table{
+				Headers: ui.TableRow{
+					{Contents: "build", Color: color.New(color.Bold)},
+					{Contents: "resource", Color: color.New(color.Bold)},
+					{Contents: "version", Color: color.New(color.Bold)},
+				},
+				Data: []ui.TableRow{
+					{{Contents: "unit #4"}, {Contents: "some-resource"}, {Contents: "ref:abc"}},
+					{{Contents: "build #7"}, {Contents: "some-resource"}, {Contents: "ref:abc"}},
+					{{Contents: "  deploy #2"}, {Contents: "some-image"}, {Contents: "digest:sha256:1"}},
+				},
+			}))
+		})
+
+		Context("when --json is given", func() {
+			BeforeEach(func() {
+				flyCmd.Args = append(flyCmd.Args, "--json")
+			})
+
+			It("prints the causality as JSON", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+
+				expectedJSON, err := json.Marshal(causality)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(sess.Out.Contents()).To(MatchJSON(expectedJSON))
+			})
+		})
+
+		Context("when the version flag is not provided", func() {
+			BeforeEach(func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "resource-causality", "-r", "some-pipeline/some-resource")
+			})
+
+			It("exits 1 and outputs an error", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess.Err).Should(gbytes.Say(`error`))
+				Eventually(sess).Should(gexec.Exit(1))
+			})
+		})
+	})
+})
@@ -0,0 +1,110 @@
+package integration_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"os/exec"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("resource-versions", func() {
+		var (
+			flyCmd   *exec.Cmd
+			versions []atc.VersionedResource
+		)
+
+		BeforeEach(func() {
+			flyCmd = exec.Command(flyPath, "-t", targetName, "resource-versions", "-r", "some-pipeline/some-resource", "-c", "2")
+
+			versions = []atc.VersionedResource{
+				{
+					ID:       2,
+					Resource: "some-resource",
+					Version:  atc.Version{"ref": "def", "branch": "master"},
+					Enabled:  true,
+				},
+				{
+					ID:       1,
+					Resource: "some-resource",
+					Version:  atc.Version{"ref": "abc", "branch": "master"},
+					Enabled:  false,
+				},
+			}
+		})
+
+		Context("when versions are returned from the API", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/resources/some-resource/versions", "limit=2"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, versions),
+					),
+				)
+			})
+
+			It("lists them to the user", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(sess.Out).To(PrintTable(ui.Table{
+					Headers: ui.TableRow{
+						{Contents: "id", Color: color.New(color.Bold)},
+						{Contents: "version", Color: color.New(color.Bold)},
+						{Contents: "enabled", Color: color.New(color.Bold)},
+					},
+					Data: []ui.TableRow{
+						{{Contents: "2"}, {Contents: "branch:master,ref:def"}, {Contents: "yes"}},
+						{{Contents: "1"}, {Contents: "branch:master,ref:abc"}, {Contents: "no", Color: color.New(color.FgCyan)}},
+					},
+				}))
+			})
+
+			Context("when --json is given", func() {
+				BeforeEach(func() {
+					flyCmd.Args = append(flyCmd.Args, "--json")
+				})
+
+				It("prints the versions as JSON", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(0))
+
+					expectedJSON, err := json.Marshal(versions)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(sess.Out.Contents()).To(MatchJSON(expectedJSON))
+				})
+			})
+		})
+
+		Context("when the resource is not found", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/resources/some-resource/versions"),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("exits 1 and outputs an error", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess.Err).Should(gbytes.Say(`pipeline 'some-pipeline' or resource 'some-resource' not found`))
+				Eventually(sess).Should(gexec.Exit(1))
+			})
+		})
+	})
+})
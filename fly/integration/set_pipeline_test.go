@@ -742,7 +742,7 @@ this is super secure
 			})
 
 			Context("when specifying a pipeline name with a '/' character in it", func() {
-				It("fails and says '/' characters are not allowed", func() {
+				It("fails and says the instance vars are invalid", func() {
 					flyCmd := exec.Command(flyPath, "-t", targetName, "set-pipeline", "-p", "forbidden/pipelinename", "-c", configFile.Name())
 
 					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
@@ -751,7 +751,7 @@ this is super secure
 					<-sess.Exited
 					Expect(sess.ExitCode()).To(Equal(1))
 
-					Expect(sess.Err).To(gbytes.Say("error: pipeline name cannot contain '/'"))
+					Expect(sess.Err).To(gbytes.Say("error: invalid instance var 'pipelinename': must be of the form key:value"))
 				})
 			})
 
@@ -1040,6 +1040,60 @@ this is super secure
 				})
 			})
 
+			Context("when setting an instance of the pipeline", func() {
+				BeforeEach(func() {
+					getPath, err := atc.Routes.CreatePathForRoute(atc.GetConfig, rata.Params{"pipeline_name": "awesome-pipeline", "team_name": "main"})
+					Expect(err).NotTo(HaveOccurred())
+
+					atcServer.RouteToHandler("GET", getPath, ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", getPath, `vars={"branch":"feature"}`),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.ConfigResponse{Config: &config}, http.Header{atc.ConfigVersionHeader: {"42"}}),
+					))
+
+					putPath, err := atc.Routes.CreatePathForRoute(atc.SaveConfig, rata.Params{"pipeline_name": "awesome-pipeline", "team_name": "main"})
+					Expect(err).NotTo(HaveOccurred())
+
+					atcServer.RouteToHandler("PUT", putPath, ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", putPath, `vars={"branch":"feature"}`),
+						ghttp.VerifyHeaderKV(atc.ConfigVersionHeader, "42"),
+						func(w http.ResponseWriter, r *http.Request) {
+							receivedConfig := atc.Config{}
+							err := yaml.Unmarshal(getConfig(r), &receivedConfig)
+							Expect(err).NotTo(HaveOccurred())
+
+							Expect(receivedConfig.Resources[0].Source).To(Equal(atc.Source{"branch": "feature"}))
+						},
+						ghttp.RespondWith(http.StatusOK, "{}"),
+					))
+
+					changedConfig.Resources = append(atc.ResourceConfigs{}, config.Resources...)
+					changedConfig.Resources[0].Source = atc.Source{"branch": "((branch))"}
+				})
+
+				It("saves the config for the instance, interpolating the instance vars", func() {
+					Expect(func() {
+						flyCmd := exec.Command(
+							flyPath, "-t", targetName,
+							"set-pipeline",
+							"-n",
+							"-p", "awesome-pipeline",
+							"-c", configFile.Name(),
+							"--instance-var", "branch=feature",
+						)
+
+						sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+						Expect(err).NotTo(HaveOccurred())
+
+						Eventually(sess).Should(gbytes.Say("configuration updated"))
+
+						<-sess.Exited
+						Expect(sess.ExitCode()).To(Equal(0))
+					}).To(Change(func() int {
+						return len(atcServer.ReceivedRequests())
+					}).By(3))
+				})
+			})
+
 			Context("when the server returns warnings", func() {
 				BeforeEach(func() {
 					path, err := atc.Routes.CreatePathForRoute(atc.SaveConfig, rata.Params{"pipeline_name": "awesome-pipeline", "team_name": "main"})
@@ -385,6 +385,80 @@ var _ = Describe("Fly CLI", func() {
 			})
 		})
 
+		Describe("custom roles", func() {
+			BeforeEach(func() {
+				cmdParams = []string{"-c", "fixtures/team_config_with_custom_role.yml"}
+			})
+
+			Context("when the role is defined by the server", func() {
+				BeforeEach(func() {
+					atcServer.AppendHandlers(
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("PUT", "/api/v1/teams/venture"),
+							ghttp.VerifyJSON(`{
+								"auth": {
+									"owner":{
+										"users": ["local:some-admin"],
+										"groups": []
+									},
+									"operator":{
+										"users": ["local:some-operator"],
+										"groups": []
+									}
+								}
+							}`),
+							ghttp.RespondWithJSONEncoded(http.StatusCreated, atc.Team{
+								Name: "venture",
+								ID:   8,
+							}),
+						),
+					)
+				})
+
+				It("shows and sends the custom role", func() {
+					stdin, err := flyCmd.StdinPipe()
+					Expect(err).NotTo(HaveOccurred())
+
+					sess, err := gexec.Start(flyCmd, nil, nil)
+					Expect(err).ToNot(HaveOccurred())
+
+					Eventually(sess.Out).Should(gbytes.Say("Users \\(operator\\):"))
+					Eventually(sess.Out).Should(gbytes.Say("- local:some-operator"))
+
+					Eventually(sess).Should(gbytes.Say(`apply configuration\? \[yN\]: `))
+					yes(stdin)
+
+					Eventually(sess.Out).Should(gbytes.Say("team created"))
+					Eventually(sess).Should(gexec.Exit(0))
+				})
+			})
+
+			Context("when the role is not defined by the server", func() {
+				BeforeEach(func() {
+					atcServer.AppendHandlers(
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("PUT", "/api/v1/teams/venture"),
+							ghttp.RespondWith(http.StatusBadRequest, "unknown role 'operator' (known roles: member, owner, viewer)"),
+						),
+					)
+				})
+
+				It("reports the error", func() {
+					stdin, err := flyCmd.StdinPipe()
+					Expect(err).NotTo(HaveOccurred())
+
+					sess, err := gexec.Start(flyCmd, nil, nil)
+					Expect(err).ToNot(HaveOccurred())
+
+					Eventually(sess).Should(gbytes.Say(`apply configuration\? \[yN\]: `))
+					yes(stdin)
+
+					Eventually(sess.Err).Should(gbytes.Say("unknown role 'operator' \\(known roles: member, owner, viewer\\)"))
+					Eventually(sess).Should(gexec.Exit(1))
+				})
+			})
+		})
+
 		Describe("handling server response", func() {
 			BeforeEach(func() {
 				cmdParams = []string{"-c", "fixtures/team_config_mixed.yml"}
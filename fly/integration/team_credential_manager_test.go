@@ -0,0 +1,120 @@
+package integration_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("SetTeamCredentialManager", func() {
+	var configFile *os.File
+
+	BeforeEach(func() {
+		var err error
+		configFile, err = ioutil.TempFile("", "fly-credential-manager")
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = configFile.WriteString(`---
+type: vault
+config:
+  url: https://vault.example.com
+  auth-backend: approle
+  auth-param:
+    role_id: some-role
+`)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(configFile.Close()).To(Succeed())
+
+		atcServer.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("PUT", "/api/v1/teams/some-team/credential_manager"),
+				ghttp.VerifyJSON(`{
+					"type": "vault",
+					"config": {
+						"url": "https://vault.example.com",
+						"auth-backend": "approle",
+						"auth-param": {"role_id": "some-role"}
+					}
+				}`),
+				ghttp.RespondWith(http.StatusNoContent, ""),
+			),
+		)
+	})
+
+	AfterEach(func() {
+		os.Remove(configFile.Name())
+	})
+
+	It("sends the config to the team", func() {
+		flyCmd := exec.Command(flyPath, "-t", targetName, "set-team-credential-manager", "-n", "some-team", "-c", configFile.Name())
+
+		sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+		Expect(err).NotTo(HaveOccurred())
+
+		Eventually(sess).Should(gexec.Exit(0))
+		Expect(atcServer.ReceivedRequests()).To(HaveLen(4))
+		Expect(sess.Out).To(gbytes.Say("credential manager configured for team some-team"))
+	})
+
+	Context("when the config is rejected", func() {
+		BeforeEach(func() {
+			atcServer.SetHandler(3, ghttp.RespondWith(http.StatusBadRequest, "unknown credential manager type: vault"))
+		})
+
+		It("prints the error", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "set-team-credential-manager", "-n", "some-team", "-c", configFile.Name())
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(1))
+			Expect(sess.Err).To(gbytes.Say("unknown credential manager type: vault"))
+		})
+	})
+})
+
+var _ = Describe("ClearTeamCredentialManager", func() {
+	BeforeEach(func() {
+		atcServer.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("DELETE", "/api/v1/teams/some-team/credential_manager"),
+				ghttp.RespondWith(http.StatusNoContent, ""),
+			),
+		)
+	})
+
+	It("clears the team's config", func() {
+		flyCmd := exec.Command(flyPath, "-t", targetName, "clear-team-credential-manager", "-n", "some-team")
+
+		sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+		Expect(err).NotTo(HaveOccurred())
+
+		Eventually(sess).Should(gexec.Exit(0))
+		Expect(atcServer.ReceivedRequests()).To(HaveLen(4))
+		Expect(sess.Out).To(gbytes.Say("credential manager cleared for team some-team"))
+	})
+
+	Context("when the team is not found", func() {
+		BeforeEach(func() {
+			atcServer.SetHandler(3, ghttp.RespondWith(http.StatusNotFound, ""))
+		})
+
+		It("returns an error", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "clear-team-credential-manager", "-n", "some-team")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(1))
+			Expect(sess.Err).To(gbytes.Say("Team 'some-team' not found"))
+		})
+	})
+})
@@ -156,6 +156,29 @@ var _ = Describe("Fly CLI", func() {
 					}).By(2))
 				})
 			})
+
+			Context("when the pipeline is an instance", func() {
+				BeforeEach(func() {
+					atcServer.AppendHandlers(
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("POST", path, `vars={"branch":"master"}`),
+							ghttp.RespondWithJSONEncoded(http.StatusOK, atc.Build{ID: 57, Name: "42"}),
+						),
+					)
+				})
+
+				It("starts the build of the instance's job", func() {
+					flyCmd := exec.Command(flyPath, "-t", targetName, "trigger-job", "-j", "awesome-pipeline/branch:master/awesome-job")
+
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gbytes.Say(`started awesome-pipeline/branch:master/awesome-job #42`))
+
+					<-sess.Exited
+					Expect(sess.ExitCode()).To(Equal(0))
+				})
+			})
 		})
 
 		Context("when the pipeline/job name is not specified", func() {
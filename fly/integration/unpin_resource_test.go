@@ -0,0 +1,93 @@
+package integration_test
+
+import (
+	"fmt"
+	"net/http"
+	"os/exec"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("Unpin Resource", func() {
+		var (
+			flyCmd *exec.Cmd
+		)
+
+		Context("when the resource flag is provided", func() {
+			pipelineName := "pipeline"
+			resourceName := "resource-name-potato"
+			fullResourceName := fmt.Sprintf("%s/%s", pipelineName, resourceName)
+			apiPath := fmt.Sprintf("/api/v1/teams/main/pipelines/%s/resources/%s/unpin", pipelineName, resourceName)
+
+			BeforeEach(func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "unpin-resource", "-r", fullResourceName)
+			})
+
+			Context("when the resource is unpinned using the API", func() {
+				BeforeEach(func() {
+					atcServer.AppendHandlers(
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("PUT", apiPath),
+							ghttp.RespondWith(http.StatusOK, nil),
+						),
+					)
+				})
+
+				It("successfully unpins the resource", func() {
+					Expect(func() {
+						sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+						Expect(err).NotTo(HaveOccurred())
+
+						<-sess.Exited
+						Expect(sess.ExitCode()).To(Equal(0))
+						Eventually(sess).Should(gbytes.Say(fmt.Sprintf("unpinned '%s'\n", resourceName)))
+					}).To(Change(func() int {
+						return len(atcServer.ReceivedRequests())
+					}).By(2))
+				})
+			})
+
+			Context("when the API call fails", func() {
+				BeforeEach(func() {
+					atcServer.AppendHandlers(
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("PUT", apiPath),
+							ghttp.RespondWith(http.StatusInternalServerError, nil),
+						),
+					)
+				})
+
+				It("exits 1 and outputs an error", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess.Err).Should(gbytes.Say(`error`))
+
+					<-sess.Exited
+					Expect(sess.ExitCode()).To(Equal(1))
+				})
+			})
+		})
+
+		Context("when the resource flag is not provided", func() {
+			BeforeEach(func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "unpin-resource")
+			})
+
+			It("exits 1 and outputs an error", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess.Err).Should(gbytes.Say(`error`))
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(1))
+			})
+		})
+	})
+})
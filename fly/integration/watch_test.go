@@ -114,6 +114,10 @@ var _ = Describe("Watching", func() {
 	Context("with a build ID and no job", func() {
 		BeforeEach(func() {
 			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/builds/3"),
+					ghttp.RespondWithJSONEncoded(200, atc.Build{ID: 3, Name: "3", Status: "started"}),
+				),
 				eventsHandler(),
 			)
 		})
@@ -121,6 +125,73 @@ var _ = Describe("Watching", func() {
 		It("Watches the given build id", func() {
 			watch("--build", "3")
 		})
+
+		Context("when the build is not found", func() {
+			BeforeEach(func() {
+				atcServer.SetHandler(0, ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/builds/3"),
+					ghttp.RespondWith(http.StatusNotFound, nil),
+				))
+			})
+
+			It("returns an error and exits", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "watch", "--build", "3")
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess.Err).Should(gbytes.Say("build not found"))
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(1))
+			})
+		})
+	})
+
+	Context("when the build is pending", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/builds/3"),
+					ghttp.RespondWithJSONEncoded(200, atc.Build{ID: 3, Name: "3", Status: "pending"}),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/builds/3/preparation"),
+					ghttp.RespondWithJSONEncoded(200, atc.BuildPreparation{
+						BuildID: 3,
+						BlockingReasons: []string{
+							"waiting on serial group some-group held by build other-job #2",
+							"no worker with tags [some-tag]",
+						},
+					}),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/builds/3"),
+					ghttp.RespondWithJSONEncoded(200, atc.Build{ID: 3, Name: "3", Status: "started"}),
+				),
+				eventsHandler(),
+			)
+		})
+
+		It("shows why the build is pending before watching it", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "watch", "--build", "3")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess.Out).Should(gbytes.Say("waiting for build to start:"))
+			Eventually(sess.Out).Should(gbytes.Say("waiting on serial group some-group held by build other-job #2"))
+			Eventually(sess.Out).Should(gbytes.Say(`no worker with tags \[some-tag\]`))
+
+			Eventually(streaming, 5).Should(BeClosed())
+
+			events <- event.Log{Payload: "sup"}
+
+			Eventually(sess.Out).Should(gbytes.Say("sup"))
+
+			close(events)
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(0))
+		})
 	})
 
 	Context("with a specific job and pipeline", func() {
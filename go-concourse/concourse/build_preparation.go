@@ -0,0 +1,32 @@
+package concourse
+
+import (
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+func (client *client) BuildPreparation(buildID int) (atc.BuildPreparation, bool, error) {
+	params := rata.Params{
+		"build_id": strconv.Itoa(buildID),
+	}
+
+	var buildPreparation atc.BuildPreparation
+	err := client.connection.Send(internal.Request{
+		RequestName: atc.GetBuildPreparation,
+		Params:      params,
+	}, &internal.Response{
+		Result: &buildPreparation,
+	})
+
+	switch err.(type) {
+	case nil:
+		return buildPreparation, true, nil
+	case internal.ResourceNotFoundError:
+		return buildPreparation, false, nil
+	default:
+		return buildPreparation, false, err
+	}
+}
@@ -0,0 +1,63 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Build Preparation", func() {
+	Describe("BuildPreparation", func() {
+		expectedURL := "/api/v1/builds/1234/preparation"
+
+		Context("when the build exists", func() {
+			expectedBuildPreparation := atc.BuildPreparation{
+				BuildID:             1234,
+				PausedPipeline:      atc.BuildPreparationStatusNotBlocking,
+				PausedJob:           atc.BuildPreparationStatusNotBlocking,
+				MaxRunningBuilds:    atc.BuildPreparationStatusBlocking,
+				Inputs:              map[string]atc.BuildPreparationStatus{},
+				InputsSatisfied:     atc.BuildPreparationStatusNotBlocking,
+				MissingInputReasons: atc.MissingInputReasons{},
+				BlockingReasons:     []string{"max_in_flight 2 reached"},
+			}
+
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedBuildPreparation),
+					),
+				)
+			})
+
+			It("returns the build preparation", func() {
+				preparation, found, err := client.BuildPreparation(1234)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(preparation).To(Equal(expectedBuildPreparation))
+			})
+		})
+
+		Context("when the build does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWithJSONEncoded(http.StatusNotFound, nil),
+					),
+				)
+			})
+
+			It("returns false and no error", func() {
+				_, found, err := client.BuildPreparation(1234)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+})
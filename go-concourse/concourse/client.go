@@ -20,8 +20,10 @@ type Client interface {
 	BuildResources(buildID int) (atc.BuildInputsOutputs, bool, error)
 	AbortBuild(buildID string) error
 	BuildPlan(buildID int) (atc.PublicBuildPlan, bool, error)
+	BuildPreparation(buildID int) (atc.BuildPreparation, bool, error)
 	SendInputToBuildPlan(buildID int, planID atc.PlanID, src io.Reader) (bool, error)
 	ReadOutputFromBuildPlan(buildID int, planID atc.PlanID) (io.ReadCloser, bool, error)
+	BuildArtifact(buildID int, name string) (io.ReadCloser, bool, error)
 	SaveWorker(atc.Worker, *time.Duration) (*atc.Worker, error)
 	ListWorkers() ([]atc.Worker, error)
 	PruneWorker(workerName string) error
@@ -38,6 +38,22 @@ type FakeClient struct {
 		result2 bool
 		result3 error
 	}
+	BuildArtifactStub        func(int, string) (io.ReadCloser, bool, error)
+	buildArtifactMutex       sync.RWMutex
+	buildArtifactArgsForCall []struct {
+		arg1 int
+		arg2 string
+	}
+	buildArtifactReturns struct {
+		result1 io.ReadCloser
+		result2 bool
+		result3 error
+	}
+	buildArtifactReturnsOnCall map[int]struct {
+		result1 io.ReadCloser
+		result2 bool
+		result3 error
+	}
 	BuildEventsStub        func(string) (concourse.Events, error)
 	buildEventsMutex       sync.RWMutex
 	buildEventsArgsForCall []struct {
@@ -66,6 +82,21 @@ type FakeClient struct {
 		result2 bool
 		result3 error
 	}
+	BuildPreparationStub        func(int) (atc.BuildPreparation, bool, error)
+	buildPreparationMutex       sync.RWMutex
+	buildPreparationArgsForCall []struct {
+		arg1 int
+	}
+	buildPreparationReturns struct {
+		result1 atc.BuildPreparation
+		result2 bool
+		result3 error
+	}
+	buildPreparationReturnsOnCall map[int]struct {
+		result1 atc.BuildPreparation
+		result2 bool
+		result3 error
+	}
 	BuildResourcesStub        func(int) (atc.BuildInputsOutputs, bool, error)
 	buildResourcesMutex       sync.RWMutex
 	buildResourcesArgsForCall []struct {
@@ -368,6 +399,63 @@ func (fake *FakeClient) BuildReturnsOnCall(i int, result1 atc.Build, result2 boo
 	}{result1, result2, result3}
 }
 
+func (fake *FakeClient) BuildArtifact(arg1 int, arg2 string) (io.ReadCloser, bool, error) {
+	fake.buildArtifactMutex.Lock()
+	ret, specificReturn := fake.buildArtifactReturnsOnCall[len(fake.buildArtifactArgsForCall)]
+	fake.buildArtifactArgsForCall = append(fake.buildArtifactArgsForCall, struct {
+		arg1 int
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("BuildArtifact", []interface{}{arg1, arg2})
+	fake.buildArtifactMutex.Unlock()
+	if fake.BuildArtifactStub != nil {
+		return fake.BuildArtifactStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.buildArtifactReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClient) BuildArtifactCallCount() int {
+	fake.buildArtifactMutex.RLock()
+	defer fake.buildArtifactMutex.RUnlock()
+	return len(fake.buildArtifactArgsForCall)
+}
+
+func (fake *FakeClient) BuildArtifactArgsForCall(i int) (int, string) {
+	fake.buildArtifactMutex.RLock()
+	defer fake.buildArtifactMutex.RUnlock()
+	argsForCall := fake.buildArtifactArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) BuildArtifactReturns(result1 io.ReadCloser, result2 bool, result3 error) {
+	fake.BuildArtifactStub = nil
+	fake.buildArtifactReturns = struct {
+		result1 io.ReadCloser
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClient) BuildArtifactReturnsOnCall(i int, result1 io.ReadCloser, result2 bool, result3 error) {
+	fake.BuildArtifactStub = nil
+	if fake.buildArtifactReturnsOnCall == nil {
+		fake.buildArtifactReturnsOnCall = make(map[int]struct {
+			result1 io.ReadCloser
+			result2 bool
+			result3 error
+		})
+	}
+	fake.buildArtifactReturnsOnCall[i] = struct {
+		result1 io.ReadCloser
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeClient) BuildEvents(arg1 string) (concourse.Events, error) {
 	fake.buildEventsMutex.Lock()
 	ret, specificReturn := fake.buildEventsReturnsOnCall[len(fake.buildEventsArgsForCall)]
@@ -477,6 +565,62 @@ func (fake *FakeClient) BuildPlanReturnsOnCall(i int, result1 atc.PublicBuildPla
 	}{result1, result2, result3}
 }
 
+func (fake *FakeClient) BuildPreparation(arg1 int) (atc.BuildPreparation, bool, error) {
+	fake.buildPreparationMutex.Lock()
+	ret, specificReturn := fake.buildPreparationReturnsOnCall[len(fake.buildPreparationArgsForCall)]
+	fake.buildPreparationArgsForCall = append(fake.buildPreparationArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("BuildPreparation", []interface{}{arg1})
+	fake.buildPreparationMutex.Unlock()
+	if fake.BuildPreparationStub != nil {
+		return fake.BuildPreparationStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.buildPreparationReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClient) BuildPreparationCallCount() int {
+	fake.buildPreparationMutex.RLock()
+	defer fake.buildPreparationMutex.RUnlock()
+	return len(fake.buildPreparationArgsForCall)
+}
+
+func (fake *FakeClient) BuildPreparationArgsForCall(i int) int {
+	fake.buildPreparationMutex.RLock()
+	defer fake.buildPreparationMutex.RUnlock()
+	argsForCall := fake.buildPreparationArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) BuildPreparationReturns(result1 atc.BuildPreparation, result2 bool, result3 error) {
+	fake.BuildPreparationStub = nil
+	fake.buildPreparationReturns = struct {
+		result1 atc.BuildPreparation
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClient) BuildPreparationReturnsOnCall(i int, result1 atc.BuildPreparation, result2 bool, result3 error) {
+	fake.BuildPreparationStub = nil
+	if fake.buildPreparationReturnsOnCall == nil {
+		fake.buildPreparationReturnsOnCall = make(map[int]struct {
+			result1 atc.BuildPreparation
+			result2 bool
+			result3 error
+		})
+	}
+	fake.buildPreparationReturnsOnCall[i] = struct {
+		result1 atc.BuildPreparation
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeClient) BuildResources(arg1 int) (atc.BuildInputsOutputs, bool, error) {
 	fake.buildResourcesMutex.Lock()
 	ret, specificReturn := fake.buildResourcesReturnsOnCall[len(fake.buildResourcesArgsForCall)]
@@ -1233,10 +1377,14 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.abortBuildMutex.RUnlock()
 	fake.buildMutex.RLock()
 	defer fake.buildMutex.RUnlock()
+	fake.buildArtifactMutex.RLock()
+	defer fake.buildArtifactMutex.RUnlock()
 	fake.buildEventsMutex.RLock()
 	defer fake.buildEventsMutex.RUnlock()
 	fake.buildPlanMutex.RLock()
 	defer fake.buildPlanMutex.RUnlock()
+	fake.buildPreparationMutex.RLock()
+	defer fake.buildPreparationMutex.RUnlock()
 	fake.buildResourcesMutex.RLock()
 	defer fake.buildResourcesMutex.RUnlock()
 	fake.buildsMutex.RLock()
@@ -46,6 +46,9 @@ type Page struct {
 	Since int
 	Until int
 	Limit int
+
+	// Annotations only lists builds with every one of the annotations.
+	Annotations []string
 }
 
 func pageFromURI(uri string) (Page, error) {
@@ -59,6 +62,7 @@ func pageFromURI(uri string) (Page, error) {
 	page.Since, _ = strconv.Atoi(params.Get("since"))
 	page.Until, _ = strconv.Atoi(params.Get("until"))
 	page.Limit, _ = strconv.Atoi(params.Get("limit"))
+	page.Annotations = params["annotation"]
 
 	return page, nil
 }
@@ -75,5 +79,9 @@ func (p Page) QueryParams() url.Values {
 		queryParams.Add("limit", strconv.Itoa(p.Limit))
 	}
 
+	for _, key := range p.Annotations {
+		queryParams.Add("annotation", key)
+	}
+
 	return queryParams
 }
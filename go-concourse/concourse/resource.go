@@ -1,6 +1,10 @@
 package concourse
 
 import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/go-concourse/concourse/internal"
 	"github.com/tedsuo/rata"
@@ -72,3 +76,56 @@ func (team *team) UnpauseResource(pipelineName string, resourceName string) (boo
 		return false, err
 	}
 }
+
+func (team *team) PinResourceVersion(pipelineName string, resourceName string, version atc.Version, comment string) (bool, error) {
+	params := rata.Params{
+		"pipeline_name": pipelineName,
+		"resource_name": resourceName,
+		"team_name":     team.name,
+	}
+
+	jsonBytes, err := json.Marshal(atc.PinRequestBody{
+		Version: version,
+		Comment: comment,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	err = team.connection.Send(internal.Request{
+		RequestName: atc.PinResourceVersion,
+		Params:      params,
+		Body:        bytes.NewBuffer(jsonBytes),
+		Header:      http.Header{"Content-Type": []string{"application/json"}},
+	}, &internal.Response{})
+
+	switch err.(type) {
+	case nil:
+		return true, nil
+	case internal.ResourceNotFoundError:
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
+func (team *team) UnpinResource(pipelineName string, resourceName string) (bool, error) {
+	params := rata.Params{
+		"pipeline_name": pipelineName,
+		"resource_name": resourceName,
+		"team_name":     team.name,
+	}
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.UnpinResource,
+		Params:      params,
+	}, &internal.Response{})
+
+	switch err.(type) {
+	case nil:
+		return true, nil
+	case internal.ResourceNotFoundError:
+		return false, nil
+	default:
+		return false, err
+	}
+}
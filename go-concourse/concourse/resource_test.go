@@ -209,4 +209,115 @@ var _ = Describe("ATC Handler Resource", func() {
 			})
 		})
 	})
+
+	Describe("PinResourceVersion", func() {
+		var (
+			expectedStatus int
+			pipelineName   = "banana"
+			resourceName   = "disResource"
+			expectedURL    = fmt.Sprintf("/api/v1/teams/some-team/pipelines/%s/resources/%s/pin", pipelineName, resourceName)
+		)
+
+		JustBeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", expectedURL),
+					ghttp.VerifyJSON(`{"version":{"ref":"v1"},"comment":"broken upstream"}`),
+					ghttp.RespondWith(expectedStatus, nil),
+				),
+			)
+		})
+
+		Context("when the resource exists and there are no issues", func() {
+			BeforeEach(func() {
+				expectedStatus = http.StatusOK
+			})
+
+			It("pins the version and returns no error", func() {
+				pinned, err := team.PinResourceVersion(pipelineName, resourceName, atc.Version{"ref": "v1"}, "broken upstream")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(pinned).To(BeTrue())
+				Expect(atcServer.ReceivedRequests()).To(HaveLen(1))
+			})
+		})
+
+		Context("when the resource is pinned in the pipeline config", func() {
+			BeforeEach(func() {
+				expectedStatus = http.StatusConflict
+			})
+
+			It("returns an error", func() {
+				pinned, err := team.PinResourceVersion(pipelineName, resourceName, atc.Version{"ref": "v1"}, "broken upstream")
+				Expect(err).To(HaveOccurred())
+				Expect(pinned).To(BeFalse())
+			})
+		})
+
+		Context("when the resource does not exist", func() {
+			BeforeEach(func() {
+				expectedStatus = http.StatusNotFound
+			})
+
+			It("returns false and no error", func() {
+				pinned, err := team.PinResourceVersion(pipelineName, resourceName, atc.Version{"ref": "v1"}, "broken upstream")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(pinned).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("UnpinResource", func() {
+		var (
+			expectedStatus int
+			pipelineName   = "banana"
+			resourceName   = "disResource"
+			expectedURL    = fmt.Sprintf("/api/v1/teams/some-team/pipelines/%s/resources/%s/unpin", pipelineName, resourceName)
+		)
+
+		JustBeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", expectedURL),
+					ghttp.RespondWith(expectedStatus, nil),
+				),
+			)
+		})
+
+		Context("when the resource exists and there are no issues", func() {
+			BeforeEach(func() {
+				expectedStatus = http.StatusOK
+			})
+
+			It("unpins the resource and returns no error", func() {
+				unpinned, err := team.UnpinResource(pipelineName, resourceName)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(unpinned).To(BeTrue())
+				Expect(atcServer.ReceivedRequests()).To(HaveLen(1))
+			})
+		})
+
+		Context("when the unpin resource call fails", func() {
+			BeforeEach(func() {
+				expectedStatus = http.StatusInternalServerError
+			})
+
+			It("returns an error", func() {
+				unpinned, err := team.UnpinResource(pipelineName, resourceName)
+				Expect(err).To(HaveOccurred())
+				Expect(unpinned).To(BeFalse())
+			})
+		})
+
+		Context("when the resource does not exist", func() {
+			BeforeEach(func() {
+				expectedStatus = http.StatusNotFound
+			})
+
+			It("returns false and no error", func() {
+				unpinned, err := team.UnpinResource(pipelineName, resourceName)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(unpinned).To(BeFalse())
+			})
+		})
+	})
 })
@@ -72,3 +72,29 @@ func (team *team) sendResourceVersion(pipelineName string, resourceName string,
 		return false, err
 	}
 }
+
+func (team *team) ResourceCausality(pipelineName string, resourceName string, resourceVersionID int) ([]atc.Cause, bool, error) {
+	params := rata.Params{
+		"pipeline_name":       pipelineName,
+		"resource_name":       resourceName,
+		"resource_version_id": strconv.Itoa(resourceVersionID),
+		"team_name":           team.name,
+	}
+
+	var causality []atc.Cause
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.GetResourceCausality,
+		Params:      params,
+	}, &internal.Response{
+		Result: &causality,
+	})
+
+	switch err.(type) {
+	case nil:
+		return causality, true, nil
+	case internal.ResourceNotFoundError:
+		return nil, false, nil
+	default:
+		return nil, false, err
+	}
+}
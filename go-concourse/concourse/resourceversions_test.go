@@ -363,4 +363,87 @@ var _ = Describe("ATC Handler Resource Versions", func() {
 			})
 		})
 	})
+
+	Describe("ResourceCausality", func() {
+		expectedURL := "/api/v1/teams/some-team/pipelines/mypipeline/resources/myresource/versions/42/causality"
+
+		var causality []atc.Cause
+		var found bool
+		var clientErr error
+
+		JustBeforeEach(func() {
+			causality, found, clientErr = team.ResourceCausality("mypipeline", "myresource", 42)
+		})
+
+		Context("when the causality is returned", func() {
+			var expectedCausality []atc.Cause
+
+			BeforeEach(func() {
+				expectedCausality = []atc.Cause{
+					{
+						VersionedResourceID: 42,
+						BuildID:             1,
+						ResourceName:        "myresource",
+						Version:             atc.Version{"ref": "v1"},
+						JobName:             "some-job",
+						BuildName:           "1",
+					},
+					{
+						VersionedResourceID: 43,
+						BuildID:             2,
+						ParentBuildID:       1,
+						ResourceName:        "some-output",
+						Version:             atc.Version{"ref": "v2"},
+						JobName:             "some-other-job",
+						BuildName:           "7",
+					},
+				}
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedCausality),
+					),
+				)
+			})
+
+			It("returns the causality", func() {
+				Expect(clientErr).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(causality).To(Equal(expectedCausality))
+			})
+		})
+
+		Context("when the server returns not found", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false and no error", func() {
+				Expect(clientErr).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+
+		Context("when the server returns an error", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWith(http.StatusInternalServerError, ""),
+					),
+				)
+			})
+
+			It("returns the error", func() {
+				Expect(clientErr).To(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
 })
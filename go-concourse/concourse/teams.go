@@ -14,6 +14,16 @@ import (
 
 var ErrDestroyRefused = errors.New("not-permitted-to-destroy-as-requested")
 
+// InvalidTeamAuthError is returned when the ATC rejects a team's auth config,
+// e.g. because it grants a role that is not defined by the ATC's role policy.
+type InvalidTeamAuthError struct {
+	Message string
+}
+
+func (err InvalidTeamAuthError) Error() string {
+	return err.Message
+}
+
 // CreateOrUpdate creates or updates team teamName with the settings provided in passedTeam.
 // passedTeam should reflect the desired state of team's configuration.
 func (team *team) CreateOrUpdate(passedTeam atc.Team) (atc.Team, bool, bool, error) {
@@ -38,6 +48,12 @@ func (team *team) CreateOrUpdate(passedTeam atc.Team) (atc.Team, bool, bool, err
 		},
 	}, &response)
 
+	if unexpectedResponseError, ok := err.(internal.UnexpectedResponseError); ok {
+		if unexpectedResponseError.StatusCode == http.StatusBadRequest {
+			return savedTeam, false, false, InvalidTeamAuthError{unexpectedResponseError.Body}
+		}
+	}
+
 	if err != nil {
 		return savedTeam, false, false, err
 	}
@@ -96,6 +112,66 @@ func (team *team) RenameTeam(teamName, name string) (bool, error) {
 	}
 }
 
+// InvalidCredentialManagerConfigError is returned when the ATC rejects a
+// team's credential manager config, e.g. for an unknown manager type.
+type InvalidCredentialManagerConfigError struct {
+	Message string
+}
+
+func (err InvalidCredentialManagerConfigError) Error() string {
+	return err.Message
+}
+
+// SetCredentialManager configures the team's own credential manager, used in
+// place of the one configured for the whole cluster.
+func (team *team) SetCredentialManager(config atc.CredentialManagerConfig) (bool, error) {
+	params := rata.Params{"team_name": team.name}
+
+	jsonBytes, err := json.Marshal(config)
+	if err != nil {
+		return false, err
+	}
+
+	err = team.connection.Send(internal.Request{
+		RequestName: atc.SetTeamCredentialManager,
+		Params:      params,
+		Body:        bytes.NewBuffer(jsonBytes),
+		Header:      http.Header{"Content-Type": []string{"application/json"}},
+	}, nil)
+	switch e := err.(type) {
+	case nil:
+		return true, nil
+	case internal.ResourceNotFoundError:
+		return false, nil
+	case internal.UnexpectedResponseError:
+		if e.StatusCode == http.StatusBadRequest {
+			return false, InvalidCredentialManagerConfigError{e.Body}
+		}
+		return false, err
+	default:
+		return false, err
+	}
+}
+
+// ClearCredentialManager removes the team's own credential manager, so that
+// it goes back to using the one configured for the whole cluster.
+func (team *team) ClearCredentialManager() (bool, error) {
+	params := rata.Params{"team_name": team.name}
+
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.ClearTeamCredentialManager,
+		Params:      params,
+	}, nil)
+	switch err.(type) {
+	case nil:
+		return true, nil
+	case internal.ResourceNotFoundError:
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
 func (client *client) ListTeams() ([]atc.Team, error) {
 	var teams []atc.Team
 	err := client.connection.Send(internal.Request{
@@ -83,6 +83,25 @@ var _ = Describe("ATC Handler Teams", func() {
 				Expect(updated).To(BeTrue())
 			})
 		})
+
+		Context("when the team auth is rejected", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", expectedURL),
+						ghttp.VerifyJSONRepresenting(desiredTeam),
+						ghttp.RespondWith(http.StatusBadRequest, "unknown role 'operator'"),
+					),
+				)
+			})
+
+			It("returns an error describing the problem", func() {
+				_, _, _, err := team.CreateOrUpdate(desiredTeam)
+				Expect(err).To(Equal(concourse.InvalidTeamAuthError{
+					Message: "unknown role 'operator'",
+				}))
+			})
+		})
 	})
 
 	Describe("Destroy", func() {
@@ -182,4 +201,102 @@ var _ = Describe("ATC Handler Teams", func() {
 			Expect(teams).To(Equal(expectedTeams))
 		})
 	})
+
+	Describe("SetCredentialManager", func() {
+		var (
+			config atc.CredentialManagerConfig
+			found  bool
+			err    error
+		)
+
+		BeforeEach(func() {
+			config = atc.CredentialManagerConfig{
+				Type:   "vault",
+				Config: map[string]interface{}{"url": "https://vault.example.com"},
+			}
+
+			team = client.Team("some-team")
+		})
+
+		JustBeforeEach(func() {
+			found, err = team.SetCredentialManager(config)
+		})
+
+		Context("when the server saves the config", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/some-team/credential_manager"),
+						ghttp.VerifyJSONRepresenting(config),
+						ghttp.RespondWith(http.StatusNoContent, nil),
+					),
+				)
+			})
+
+			It("succeeds", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+			})
+		})
+
+		Context("when the team does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/some-team/credential_manager"),
+						ghttp.RespondWith(http.StatusNotFound, nil),
+					),
+				)
+			})
+
+			It("returns not found", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+
+		Context("when the server rejects the config", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/some-team/credential_manager"),
+						ghttp.RespondWith(http.StatusBadRequest, "unknown credential manager type: bogus"),
+					),
+				)
+			})
+
+			It("returns the error", func() {
+				Expect(err).To(Equal(concourse.InvalidCredentialManagerConfigError{
+					Message: "unknown credential manager type: bogus",
+				}))
+			})
+		})
+	})
+
+	Describe("ClearCredentialManager", func() {
+		var (
+			found bool
+			err   error
+		)
+
+		BeforeEach(func() {
+			team = client.Team("some-team")
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("DELETE", "/api/v1/teams/some-team/credential_manager"),
+					ghttp.RespondWith(http.StatusNoContent, nil),
+				),
+			)
+		})
+
+		JustBeforeEach(func() {
+			found, err = team.ClearCredentialManager()
+		})
+
+		It("succeeds", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+		})
+	})
 })
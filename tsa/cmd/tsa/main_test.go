@@ -213,7 +213,7 @@ var _ = Describe("TSA SSH Registrar", func() {
 			signingKey, err := jwt.ParseRSAPrivateKeyFromPEM(rsaKeyBlob)
 			Expect(err).NotTo(HaveOccurred())
 
-			accessFactory = accessor.NewAccessFactory(&signingKey.PublicKey)
+			accessFactory = accessor.NewAccessFactory(&signingKey.PublicKey, accessor.DefaultRolePolicy())
 
 			tsaCommand := exec.Command(
 				tsaPath,